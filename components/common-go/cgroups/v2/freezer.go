@@ -0,0 +1,86 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cgroups_v2
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/cgroups"
+)
+
+// freezerPollInterval is how often we check whether the kernel finished freezing or thawing a cgroup
+const freezerPollInterval = 10 * time.Millisecond
+
+// Freezer stops and resumes all processes of a cgroup, see
+// https://docs.kernel.org/admin-guide/cgroup-v2.html#core-interface-files (cgroup.freeze).
+// Frozen processes keep their memory but are not scheduled, hence use no CPU.
+type Freezer struct {
+	path string
+}
+
+func NewFreezerWithMount(mountPoint, path string) *Freezer {
+	fullPath := filepath.Join(mountPoint, path)
+	return &Freezer{
+		path: fullPath,
+	}
+}
+
+func NewFreezer(path string) *Freezer {
+	return &Freezer{
+		path: path,
+	}
+}
+
+// Freeze freezes the cgroup and its descendants, and waits until all their processes are frozen
+func (f *Freezer) Freeze(ctx context.Context) error {
+	return f.set(ctx, true)
+}
+
+// Thaw resumes the processes of a frozen cgroup, and waits until the cgroup is no longer frozen
+func (f *Freezer) Thaw(ctx context.Context) error {
+	return f.set(ctx, false)
+}
+
+// IsFrozen returns true if all processes of the cgroup are frozen
+func (f *Freezer) IsFrozen() (bool, error) {
+	events, err := cgroups.ReadFlatKeyedFile(filepath.Join(f.path, "cgroup.events"))
+	if err != nil {
+		return false, err
+	}
+	return events["frozen"] == 1, nil
+}
+
+func (f *Freezer) set(ctx context.Context, frozen bool) error {
+	value := "0"
+	if frozen {
+		value = "1"
+	}
+	err := os.WriteFile(filepath.Join(f.path, "cgroup.freeze"), []byte(value), 0644)
+	if err != nil {
+		return err
+	}
+
+	// Freezing completes asynchronously, e.g. processes in uninterruptible sleep are frozen only once they wake up
+	ticker := time.NewTicker(freezerPollInterval)
+	defer ticker.Stop()
+	for {
+		current, err := f.IsFrozen()
+		if err != nil {
+			return err
+		}
+		if current == frozen {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cgroups_v2
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFreezer(t *testing.T) {
+	mountPoint := t.TempDir()
+	cgroupPath := filepath.Join(mountPoint, "cgroup")
+	if err := os.MkdirAll(cgroupPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeEvents := func(frozen string) {
+		if err := os.WriteFile(filepath.Join(cgroupPath, "cgroup.events"), []byte("populated 1\nfrozen "+frozen+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeEvents("0")
+
+	freezer := NewFreezerWithMount(mountPoint, "cgroup")
+
+	// the kernel reports the cgroup as frozen some time after the freeze was requested
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		writeEvents("1")
+	}()
+	err := freezer.Freeze(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := os.ReadFile(filepath.Join(cgroupPath, "cgroup.freeze"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "1", string(content))
+	frozen, err := freezer.IsFrozen()
+	assert.NoError(t, err)
+	assert.True(t, frozen)
+
+	// the cgroup never thaws
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err = freezer.Thaw(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	content, err = os.ReadFile(filepath.Join(cgroupPath, "cgroup.freeze"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "0", string(content))
+}
@@ -79,6 +79,27 @@ const (
 	// workspacePressureStallInfo indicates if pressure stall information should be retrieved for the workspace
 	WorkspacePressureStallInfoAnnotation = "gitpod.io/psi"
 
+	// WorkspaceNetDownloadLimitAnnotation denotes the bandwidth in bytes per second a workspace can receive data with
+	WorkspaceNetDownloadLimitAnnotation = "gitpod.io/netDownloadLimit"
+
+	// WorkspaceNetUploadLimitAnnotation denotes the bandwidth in bytes per second a workspace can send data with
+	WorkspaceNetUploadLimitAnnotation = "gitpod.io/netUploadLimit"
+
+	// WorkspaceNetBurstAnnotation denotes the number of bytes a workspace can transfer beyond its network bandwidth limits at once
+	WorkspaceNetBurstAnnotation = "gitpod.io/netBurst"
+
+	// WorkspaceEgressPolicyAnnotation contains the JSON serialized network egress policy ws-daemon enforces for the workspace
+	WorkspaceEgressPolicyAnnotation = "gitpod.io/egressPolicy"
+
+	// WorkspaceMemoryPressureAnnotation is set by ws-daemon on the Workspace resource when a workspace remains under memory pressure
+	// despite reclaim. It contains the share of time its tasks stalled on memory during the last control period.
+	// ws-manager stops the workspace with the highest value on a node.
+	WorkspaceMemoryPressureAnnotation = "gitpod.io/memoryPressure"
+
+	// WorkspaceTraceContextAnnotation contains the span context of the request which started the workspace (see tracing.GetTraceID).
+	// ws-manager, ws-daemon and supervisor add the spans of the workspace's startup to that trace.
+	WorkspaceTraceContextAnnotation = "gitpod.io/traceContext"
+
 	// ImageNameAnnotation indicates the original format of the main image of the pod
 	ImageNameAnnotation = "gitpod.io/image_name"
 )
@@ -26,6 +26,8 @@ import (
 
 type tracingOptions struct {
 	prometheusReporter *PromReporter
+	collectorEndpoint  string
+	agentHostPort      string
 }
 
 // Option configures the tracing
@@ -38,6 +40,25 @@ func WithPrometheusReporter(p *PromReporter) Option {
 	}
 }
 
+// WithReporterEndpoint reports spans to the collector endpoint or agent (host:port) instead of the ones configured
+// in the environment. Use it for processes which cannot be given the JAEGER_* environment (see ReporterEndpoint).
+func WithReporterEndpoint(collectorEndpoint, agentHostPort string) Option {
+	return func(o *tracingOptions) {
+		o.collectorEndpoint = collectorEndpoint
+		o.agentHostPort = agentHostPort
+	}
+}
+
+// ReporterEndpoint returns the collector endpoint and the agent (host:port) spans are reported to as configured
+// in the environment. Both are empty if tracing is disabled.
+func ReporterEndpoint() (collectorEndpoint, agentHostPort string) {
+	cfg, err := jaegercfg.FromEnv()
+	if err != nil || cfg.Disabled || cfg.Reporter == nil {
+		return "", ""
+	}
+	return cfg.Reporter.CollectorEndpoint, cfg.Reporter.LocalAgentHostPort
+}
+
 // Init initializes tracing for this application
 func Init(serviceName string, opts ...Option) io.Closer {
 	cfg, err := jaegercfg.FromEnv()
@@ -46,6 +67,17 @@ func Init(serviceName string, opts ...Option) io.Closer {
 		return nil
 	}
 
+	var options tracingOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if options.collectorEndpoint != "" || options.agentHostPort != "" {
+		cfg.Disabled = false
+		cfg.Reporter.CollectorEndpoint = options.collectorEndpoint
+		cfg.Reporter.LocalAgentHostPort = options.agentHostPort
+	}
+
 	cfg.Tags = append(cfg.Tags, opentracing.Tag{
 		Key:   "service.build.commit",
 		Value: os.Getenv("GITPOD_BUILD_GIT_COMMIT"),
@@ -61,11 +93,6 @@ func Init(serviceName string, opts ...Option) io.Closer {
 		return nil
 	}
 
-	var options tracingOptions
-	for _, opt := range opts {
-		opt(&options)
-	}
-
 	if options.prometheusReporter != nil {
 		promrep := options.prometheusReporter
 		err = promrep.RegisterMetrics()
@@ -147,6 +174,20 @@ func FromTraceID(traceID string) opentracing.SpanContext {
 	return spanCtx
 }
 
+// ChildOfTraceID makes a span a child of the span context produced by GetTraceID.
+// If traceID is invalid, the option does nothing.
+func ChildOfTraceID(traceID string) opentracing.StartSpanOption {
+	spanCtx := FromTraceID(traceID)
+	if spanCtx == nil {
+		return noopStartSpanOption{}
+	}
+	return opentracing.ChildOf(spanCtx)
+}
+
+type noopStartSpanOption struct{}
+
+func (noopStartSpanOption) Apply(*opentracing.StartSpanOptions) {}
+
 // LogError logs an error and marks the span as errornous
 func LogError(span opentracing.Span, err error) {
 	span.LogFields(tracelog.Error(err))
@@ -8,6 +8,7 @@ import (
 	"os"
 
 	"github.com/gitpod-io/gitpod/common-go/baseserver"
+	"github.com/gitpod-io/gitpod/common-go/util"
 )
 
 // StorageConfig configures the remote storage we use
@@ -27,6 +28,12 @@ type StorageConfig struct {
 	// S3Config configures the S3 remote storage
 	S3Config *S3Config `json:"s3,omitempty"`
 
+	// AzureConfig configures the Azure Blob Storage remote storage
+	AzureConfig *AzureConfig `json:"azure,omitempty"`
+
+	// LocalConfig configures the local filesystem remote storage
+	LocalConfig *LocalConfig `json:"local,omitempty"`
+
 	BlobQuota int64 `json:"blobQuota"`
 }
 
@@ -58,6 +65,13 @@ const (
 	// exist in the environment. See https://pkg.go.dev/github.com/aws/aws-sdk-go-v2/config#LoadDefaultConfig for more details.
 	S3Storage RemoteStorageType = "s3"
 
+	// AzureStorage stores workspaces in Azure Blob Storage containers
+	AzureStorage RemoteStorageType = "azure"
+
+	// LocalStorage stores workspaces in a directory on the local filesystem. Presigned URLs are
+	// served by content-service itself, hence this is meant for single-node and test installations only.
+	LocalStorage RemoteStorageType = "local"
+
 	// NullStorage does not synchronize workspaces at all
 	NullStorage RemoteStorageType = ""
 )
@@ -116,6 +130,52 @@ type S3Config struct {
 	CredentialsFile string `json:"credentialsFile"`
 }
 
+// AzureConfig configures the Azure Blob Storage remote storage backend
+type AzureConfig struct {
+	AccountName    string `json:"accountName"`
+	AccountKey     string `json:"accountKey"`
+	AccountKeyFile string `json:"accountKeyFile"`
+
+	// Endpoint is the blob service URL, e.g. to use the Azurite emulator. Defaults to https://<accountName>.blob.core.windows.net/
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// ContainerName is the single container all content is stored in. If empty, each user gets their own container.
+	ContainerName  string `json:"container,omitempty"`
+	ParallelUpload uint   `json:"parallelUpload,omitempty"`
+}
+
+// LocalConfig configures the local filesystem remote storage backend
+type LocalConfig struct {
+	// Path is the directory all buckets are stored in. All components using the storage must see the same directory.
+	Path string `json:"path"`
+
+	// BaseURL is the URL under which content-service serves the presigned URLs, e.g. http://content-service:8081/storage
+	BaseURL string `json:"baseURL"`
+
+	// SigningKey is the secret used to sign presigned URLs
+	SigningKey     string `json:"signingKey"`
+	SigningKeyFile string `json:"signingKeyFile"`
+}
+
+// RetentionConfig configures which stored content content-service deletes over time. Rules which are not set are not enforced.
+type RetentionConfig struct {
+	// Interval is the time between two retention runs
+	Interval util.Duration `json:"interval"`
+
+	// DryRun reports what would be deleted without deleting anything
+	DryRun bool `json:"dryRun"`
+
+	// SnapshotMaxAge is the age after which snapshots are deleted unless they are referenced
+	SnapshotMaxAge util.Duration `json:"snapshotMaxAge,omitempty"`
+
+	// HeadlessLogMaxAge is the age after which headless logs are deleted
+	HeadlessLogMaxAge util.Duration `json:"headlessLogMaxAge,omitempty"`
+
+	// MaxBytesPerOwner caps the total size of workspace content per owner. If an owner exceeds the cap,
+	// their oldest deletable content is removed first.
+	MaxBytesPerOwner int64 `json:"maxBytesPerOwner,omitempty"`
+}
+
 type PProf struct {
 	Addr string `json:"address"`
 }
@@ -128,6 +188,10 @@ type UsageReportConfig struct {
 type ServiceConfig struct {
 	Service baseserver.ServerConfiguration `json:"service"`
 	Storage StorageConfig                  `json:"storage"`
+	// HTTP configures the HTTP server which serves the presigned URLs of the local storage backend
+	HTTP *baseserver.ServerConfiguration `json:"http,omitempty"`
+	// Retention configures the periodic enforcement of storage retention rules
+	Retention *RetentionConfig `json:"retention,omitempty"`
 	// Deprecated
 	_ UsageReportConfig `json:"usageReport"`
 }
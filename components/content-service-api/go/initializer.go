@@ -50,21 +50,16 @@ func extractSecretsFromInitializer(init *WorkspaceInitializer, replaceValue bool
 	res := make(map[string]string)
 
 	_ = WalkInitializer([]string{"initializer"}, init, func(path []string, init *WorkspaceInitializer) error {
-		git, ok := init.Spec.(*WorkspaceInitializer_Git)
-		if !ok {
-			return nil
-		}
-
-		pwd := git.Git.Config.AuthPassword
-		if pwd == "" || strings.HasPrefix(pwd, extractedSecretPrefix) {
+		pwd := secretOf(init)
+		if pwd == nil || *pwd == "" || strings.HasPrefix(*pwd, extractedSecretPrefix) {
 			return nil
 		}
 
 		name := strings.Join(path, ".")
-		res[name] = pwd
+		res[name] = *pwd
 
 		if replaceValue {
-			git.Git.Config.AuthPassword = extractedSecretPrefix + name
+			*pwd = extractedSecretPrefix + name
 		}
 
 		return nil
@@ -76,28 +71,38 @@ func extractSecretsFromInitializer(init *WorkspaceInitializer, replaceValue bool
 // InjectSecretsToInitializer injects secrets to the initializer. This is the counterpart of ExtractSecretsFromInitializer.
 func InjectSecretsToInitializer(init *WorkspaceInitializer, secrets map[string][]byte) error {
 	return WalkInitializer([]string{"initializer"}, init, func(path []string, init *WorkspaceInitializer) error {
-		git, ok := init.Spec.(*WorkspaceInitializer_Git)
-		if !ok {
+		pwd := secretOf(init)
+		if pwd == nil || !strings.HasPrefix(*pwd, extractedSecretPrefix) {
 			return nil
 		}
 
-		pwd := git.Git.Config.AuthPassword
-		if !strings.HasPrefix(pwd, extractedSecretPrefix) {
-			return nil
-		}
-
-		name := strings.TrimPrefix(pwd, extractedSecretPrefix)
+		name := strings.TrimPrefix(*pwd, extractedSecretPrefix)
 		val, ok := secrets[name]
 		if !ok {
 			return xerrors.Errorf("secret %s not found", name)
 		}
 
-		git.Git.Config.AuthPassword = string(val)
+		*pwd = string(val)
 
 		return nil
 	})
 }
 
+// secretOf returns a pointer to the secret of an initializer, or nil if the initializer carries no secret
+func secretOf(init *WorkspaceInitializer) *string {
+	switch spec := init.Spec.(type) {
+	case *WorkspaceInitializer_Git:
+		if spec.Git.Config == nil {
+			return nil
+		}
+		return &spec.Git.Config.AuthPassword
+	case *WorkspaceInitializer_Oci:
+		return &spec.Oci.AuthPassword
+	default:
+		return nil
+	}
+}
+
 // WalkInitializer walks the initializer structure
 func WalkInitializer(path []string, init *WorkspaceInitializer, visitor func(path []string, init *WorkspaceInitializer) error) error {
 	if init == nil {
@@ -141,6 +146,8 @@ func WalkInitializer(path []string, init *WorkspaceInitializer, visitor func(pat
 		return visitor(append(path, "download"), init)
 	case *WorkspaceInitializer_Backup:
 		return visitor(append(path, "backup"), init)
+	case *WorkspaceInitializer_Oci:
+		return visitor(append(path, "oci"), init)
 
 	default:
 		return fmt.Errorf("unsupported workspace initializer in walkInitializer - this is a bug in Gitpod")
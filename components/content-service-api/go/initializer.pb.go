@@ -81,6 +81,120 @@ func (CloneTargetMode) EnumDescriptor() ([]byte, []int) {
 	return file_initializer_proto_rawDescGZIP(), []int{0}
 }
 
+// GitLFSMode determines how Git LFS content is handled during clone
+type GitLFSMode int32
+
+const (
+	// LFS_DEFAULT leaves LFS to the Git installation of the workspace image
+	GitLFSMode_LFS_DEFAULT GitLFSMode = 0
+	// LFS_SKIP checks out LFS pointer files only
+	GitLFSMode_LFS_SKIP GitLFSMode = 1
+	// LFS_FETCH downloads LFS objects into the local LFS store, but checks out pointer files only
+	GitLFSMode_LFS_FETCH GitLFSMode = 2
+	// LFS_PULL downloads LFS objects and checks them out
+	GitLFSMode_LFS_PULL GitLFSMode = 3
+)
+
+// Enum value maps for GitLFSMode.
+var (
+	GitLFSMode_name = map[int32]string{
+		0: "LFS_DEFAULT",
+		1: "LFS_SKIP",
+		2: "LFS_FETCH",
+		3: "LFS_PULL",
+	}
+	GitLFSMode_value = map[string]int32{
+		"LFS_DEFAULT": 0,
+		"LFS_SKIP":    1,
+		"LFS_FETCH":   2,
+		"LFS_PULL":    3,
+	}
+)
+
+func (x GitLFSMode) Enum() *GitLFSMode {
+	p := new(GitLFSMode)
+	*p = x
+	return p
+}
+
+func (x GitLFSMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GitLFSMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_initializer_proto_enumTypes[1].Descriptor()
+}
+
+func (GitLFSMode) Type() protoreflect.EnumType {
+	return &file_initializer_proto_enumTypes[1]
+}
+
+func (x GitLFSMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GitLFSMode.Descriptor instead.
+func (GitLFSMode) EnumDescriptor() ([]byte, []int) {
+	return file_initializer_proto_rawDescGZIP(), []int{1}
+}
+
+// GitSubmoduleMode determines which submodules are checked out after clone
+type GitSubmoduleMode int32
+
+const (
+	// SUBMODULES_RECURSIVE checks out all submodules recursively
+	GitSubmoduleMode_SUBMODULES_RECURSIVE GitSubmoduleMode = 0
+	// SUBMODULES_NONE does not check out any submodule
+	GitSubmoduleMode_SUBMODULES_NONE GitSubmoduleMode = 1
+	// SUBMODULES_TOP_LEVEL checks out the submodules of the repository, but not their submodules
+	GitSubmoduleMode_SUBMODULES_TOP_LEVEL GitSubmoduleMode = 2
+	// SUBMODULES_SHALLOW checks out all submodules recursively with a history depth of one
+	GitSubmoduleMode_SUBMODULES_SHALLOW GitSubmoduleMode = 3
+)
+
+// Enum value maps for GitSubmoduleMode.
+var (
+	GitSubmoduleMode_name = map[int32]string{
+		0: "SUBMODULES_RECURSIVE",
+		1: "SUBMODULES_NONE",
+		2: "SUBMODULES_TOP_LEVEL",
+		3: "SUBMODULES_SHALLOW",
+	}
+	GitSubmoduleMode_value = map[string]int32{
+		"SUBMODULES_RECURSIVE": 0,
+		"SUBMODULES_NONE":      1,
+		"SUBMODULES_TOP_LEVEL": 2,
+		"SUBMODULES_SHALLOW":   3,
+	}
+)
+
+func (x GitSubmoduleMode) Enum() *GitSubmoduleMode {
+	p := new(GitSubmoduleMode)
+	*p = x
+	return p
+}
+
+func (x GitSubmoduleMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GitSubmoduleMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_initializer_proto_enumTypes[2].Descriptor()
+}
+
+func (GitSubmoduleMode) Type() protoreflect.EnumType {
+	return &file_initializer_proto_enumTypes[2]
+}
+
+func (x GitSubmoduleMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GitSubmoduleMode.Descriptor instead.
+func (GitSubmoduleMode) EnumDescriptor() ([]byte, []int) {
+	return file_initializer_proto_rawDescGZIP(), []int{2}
+}
+
 // GitAuthMethod is the means of authentication used during clone
 type GitAuthMethod int32
 
@@ -119,11 +233,11 @@ func (x GitAuthMethod) String() string {
 }
 
 func (GitAuthMethod) Descriptor() protoreflect.EnumDescriptor {
-	return file_initializer_proto_enumTypes[1].Descriptor()
+	return file_initializer_proto_enumTypes[3].Descriptor()
 }
 
 func (GitAuthMethod) Type() protoreflect.EnumType {
-	return &file_initializer_proto_enumTypes[1]
+	return &file_initializer_proto_enumTypes[3]
 }
 
 func (x GitAuthMethod) Number() protoreflect.EnumNumber {
@@ -132,7 +246,7 @@ func (x GitAuthMethod) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GitAuthMethod.Descriptor instead.
 func (GitAuthMethod) EnumDescriptor() ([]byte, []int) {
-	return file_initializer_proto_rawDescGZIP(), []int{1}
+	return file_initializer_proto_rawDescGZIP(), []int{3}
 }
 
 // WorkspaceInitializer specifies how a workspace is to be initialized
@@ -150,6 +264,7 @@ type WorkspaceInitializer struct {
 	//	*WorkspaceInitializer_Composite
 	//	*WorkspaceInitializer_Download
 	//	*WorkspaceInitializer_Backup
+	//	*WorkspaceInitializer_Oci
 	Spec isWorkspaceInitializer_Spec `protobuf_oneof:"spec"`
 }
 
@@ -241,6 +356,13 @@ func (x *WorkspaceInitializer) GetBackup() *FromBackupInitializer {
 	return nil
 }
 
+func (x *WorkspaceInitializer) GetOci() *OCIInitializer {
+	if x, ok := x.GetSpec().(*WorkspaceInitializer_Oci); ok {
+		return x.Oci
+	}
+	return nil
+}
+
 type isWorkspaceInitializer_Spec interface {
 	isWorkspaceInitializer_Spec()
 }
@@ -273,6 +395,10 @@ type WorkspaceInitializer_Backup struct {
 	Backup *FromBackupInitializer `protobuf:"bytes,7,opt,name=backup,proto3,oneof"`
 }
 
+type WorkspaceInitializer_Oci struct {
+	Oci *OCIInitializer `protobuf:"bytes,8,opt,name=oci,proto3,oneof"`
+}
+
 func (*WorkspaceInitializer_Empty) isWorkspaceInitializer_Spec() {}
 
 func (*WorkspaceInitializer_Git) isWorkspaceInitializer_Spec() {}
@@ -287,6 +413,8 @@ func (*WorkspaceInitializer_Download) isWorkspaceInitializer_Spec() {}
 
 func (*WorkspaceInitializer_Backup) isWorkspaceInitializer_Spec() {}
 
+func (*WorkspaceInitializer_Oci) isWorkspaceInitializer_Spec() {}
+
 // CompositeInitializer uses a collection of initializer to produce workspace content.
 // All initializer are executed in the order they're provided.
 type CompositeInitializer struct {
@@ -392,6 +520,91 @@ func (x *FileDownloadInitializer) GetTargetLocation() string {
 	return ""
 }
 
+// OCIInitializer pulls an OCI artifact from a registry and extracts its layers as workspace content.
+type OCIInitializer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ref is the reference of the artifact, e.g. `registry.example.com/datasets/mnist:v1`
+	Ref string `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	// digest is the digest of the artifact manifest in the OCI digest format. The pulled manifest and all its layers
+	// are verified against it. May be omitted if ref is a digested reference.
+	Digest string `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+	// target_location is relative to the workspace root, e.g. `vendor/sdk` produces content in `/workspace/vendor/sdk`
+	TargetLocation string `protobuf:"bytes,3,opt,name=target_location,json=targetLocation,proto3" json:"target_location,omitempty"`
+	// auth_user and auth_password authenticate against the registry. If empty the artifact is pulled anonymously.
+	AuthUser     string `protobuf:"bytes,4,opt,name=auth_user,json=authUser,proto3" json:"auth_user,omitempty"`
+	AuthPassword string `protobuf:"bytes,5,opt,name=auth_password,json=authPassword,proto3" json:"auth_password,omitempty"`
+}
+
+func (x *OCIInitializer) Reset() {
+	*x = OCIInitializer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initializer_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OCIInitializer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OCIInitializer) ProtoMessage() {}
+
+func (x *OCIInitializer) ProtoReflect() protoreflect.Message {
+	mi := &file_initializer_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OCIInitializer.ProtoReflect.Descriptor instead.
+func (*OCIInitializer) Descriptor() ([]byte, []int) {
+	return file_initializer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OCIInitializer) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *OCIInitializer) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *OCIInitializer) GetTargetLocation() string {
+	if x != nil {
+		return x.TargetLocation
+	}
+	return ""
+}
+
+func (x *OCIInitializer) GetAuthUser() string {
+	if x != nil {
+		return x.AuthUser
+	}
+	return ""
+}
+
+func (x *OCIInitializer) GetAuthPassword() string {
+	if x != nil {
+		return x.AuthPassword
+	}
+	return ""
+}
+
 type EmptyInitializer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -401,7 +614,7 @@ type EmptyInitializer struct {
 func (x *EmptyInitializer) Reset() {
 	*x = EmptyInitializer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initializer_proto_msgTypes[3]
+		mi := &file_initializer_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -414,7 +627,7 @@ func (x *EmptyInitializer) String() string {
 func (*EmptyInitializer) ProtoMessage() {}
 
 func (x *EmptyInitializer) ProtoReflect() protoreflect.Message {
-	mi := &file_initializer_proto_msgTypes[3]
+	mi := &file_initializer_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -427,7 +640,7 @@ func (x *EmptyInitializer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmptyInitializer.ProtoReflect.Descriptor instead.
 func (*EmptyInitializer) Descriptor() ([]byte, []int) {
-	return file_initializer_proto_rawDescGZIP(), []int{3}
+	return file_initializer_proto_rawDescGZIP(), []int{4}
 }
 
 type GitInitializer struct {
@@ -449,12 +662,17 @@ type GitInitializer struct {
 	Config *GitConfig `protobuf:"bytes,6,opt,name=config,proto3" json:"config,omitempty"`
 	// full_clone determines if the entire repository should be cloned, instead of with `--depth=1`
 	FullClone bool `protobuf:"varint,7,opt,name=full_clone,json=fullClone,proto3" json:"full_clone,omitempty"`
+	// clone_filter produces a partial clone using `git clone --filter`. Supported filters are `blob:none` and `tree:0`.
+	// A partial clone fetches the entire history, hence it is never shallow.
+	CloneFilter string `protobuf:"bytes,8,opt,name=clone_filter,json=cloneFilter,proto3" json:"clone_filter,omitempty"`
+	// sparse_checkout_patterns are the directories checked out in cone mode. If empty, the whole tree is checked out.
+	SparseCheckoutPatterns []string `protobuf:"bytes,9,rep,name=sparse_checkout_patterns,json=sparseCheckoutPatterns,proto3" json:"sparse_checkout_patterns,omitempty"`
 }
 
 func (x *GitInitializer) Reset() {
 	*x = GitInitializer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initializer_proto_msgTypes[4]
+		mi := &file_initializer_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -467,7 +685,7 @@ func (x *GitInitializer) String() string {
 func (*GitInitializer) ProtoMessage() {}
 
 func (x *GitInitializer) ProtoReflect() protoreflect.Message {
-	mi := &file_initializer_proto_msgTypes[4]
+	mi := &file_initializer_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -480,7 +698,7 @@ func (x *GitInitializer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitInitializer.ProtoReflect.Descriptor instead.
 func (*GitInitializer) Descriptor() ([]byte, []int) {
-	return file_initializer_proto_rawDescGZIP(), []int{4}
+	return file_initializer_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GitInitializer) GetRemoteUri() string {
@@ -532,6 +750,20 @@ func (x *GitInitializer) GetFullClone() bool {
 	return false
 }
 
+func (x *GitInitializer) GetCloneFilter() string {
+	if x != nil {
+		return x.CloneFilter
+	}
+	return ""
+}
+
+func (x *GitInitializer) GetSparseCheckoutPatterns() []string {
+	if x != nil {
+		return x.SparseCheckoutPatterns
+	}
+	return nil
+}
+
 type GitConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -548,12 +780,19 @@ type GitConfig struct {
 	// auth_ots is a URL where one can download the authentication secret (<username>:<password>)
 	// using a GET request.
 	AuthOts string `protobuf:"bytes,5,opt,name=auth_ots,json=authOts,proto3" json:"auth_ots,omitempty"`
+	// lfs determines how Git LFS content is handled during clone
+	Lfs GitLFSMode `protobuf:"varint,6,opt,name=lfs,proto3,enum=contentservice.GitLFSMode" json:"lfs,omitempty"`
+	// lfs_include and lfs_exclude are globs restricting which LFS objects are downloaded (see `lfs.fetchinclude` and `lfs.fetchexclude`)
+	LfsInclude []string `protobuf:"bytes,7,rep,name=lfs_include,json=lfsInclude,proto3" json:"lfs_include,omitempty"`
+	LfsExclude []string `protobuf:"bytes,8,rep,name=lfs_exclude,json=lfsExclude,proto3" json:"lfs_exclude,omitempty"`
+	// submodules determines which submodules are checked out after clone
+	Submodules GitSubmoduleMode `protobuf:"varint,9,opt,name=submodules,proto3,enum=contentservice.GitSubmoduleMode" json:"submodules,omitempty"`
 }
 
 func (x *GitConfig) Reset() {
 	*x = GitConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initializer_proto_msgTypes[5]
+		mi := &file_initializer_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -566,7 +805,7 @@ func (x *GitConfig) String() string {
 func (*GitConfig) ProtoMessage() {}
 
 func (x *GitConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_initializer_proto_msgTypes[5]
+	mi := &file_initializer_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,7 +818,7 @@ func (x *GitConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitConfig.ProtoReflect.Descriptor instead.
 func (*GitConfig) Descriptor() ([]byte, []int) {
-	return file_initializer_proto_rawDescGZIP(), []int{5}
+	return file_initializer_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GitConfig) GetCustomConfig() map[string]string {
@@ -617,6 +856,34 @@ func (x *GitConfig) GetAuthOts() string {
 	return ""
 }
 
+func (x *GitConfig) GetLfs() GitLFSMode {
+	if x != nil {
+		return x.Lfs
+	}
+	return GitLFSMode_LFS_DEFAULT
+}
+
+func (x *GitConfig) GetLfsInclude() []string {
+	if x != nil {
+		return x.LfsInclude
+	}
+	return nil
+}
+
+func (x *GitConfig) GetLfsExclude() []string {
+	if x != nil {
+		return x.LfsExclude
+	}
+	return nil
+}
+
+func (x *GitConfig) GetSubmodules() GitSubmoduleMode {
+	if x != nil {
+		return x.Submodules
+	}
+	return GitSubmoduleMode_SUBMODULES_RECURSIVE
+}
+
 type SnapshotInitializer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -631,7 +898,7 @@ type SnapshotInitializer struct {
 func (x *SnapshotInitializer) Reset() {
 	*x = SnapshotInitializer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initializer_proto_msgTypes[6]
+		mi := &file_initializer_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -644,7 +911,7 @@ func (x *SnapshotInitializer) String() string {
 func (*SnapshotInitializer) ProtoMessage() {}
 
 func (x *SnapshotInitializer) ProtoReflect() protoreflect.Message {
-	mi := &file_initializer_proto_msgTypes[6]
+	mi := &file_initializer_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -657,7 +924,7 @@ func (x *SnapshotInitializer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SnapshotInitializer.ProtoReflect.Descriptor instead.
 func (*SnapshotInitializer) Descriptor() ([]byte, []int) {
-	return file_initializer_proto_rawDescGZIP(), []int{6}
+	return file_initializer_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SnapshotInitializer) GetSnapshot() string {
@@ -688,7 +955,7 @@ type PrebuildInitializer struct {
 func (x *PrebuildInitializer) Reset() {
 	*x = PrebuildInitializer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initializer_proto_msgTypes[7]
+		mi := &file_initializer_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -701,7 +968,7 @@ func (x *PrebuildInitializer) String() string {
 func (*PrebuildInitializer) ProtoMessage() {}
 
 func (x *PrebuildInitializer) ProtoReflect() protoreflect.Message {
-	mi := &file_initializer_proto_msgTypes[7]
+	mi := &file_initializer_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -714,7 +981,7 @@ func (x *PrebuildInitializer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrebuildInitializer.ProtoReflect.Descriptor instead.
 func (*PrebuildInitializer) Descriptor() ([]byte, []int) {
-	return file_initializer_proto_rawDescGZIP(), []int{7}
+	return file_initializer_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PrebuildInitializer) GetPrebuild() *SnapshotInitializer {
@@ -744,7 +1011,7 @@ type FromBackupInitializer struct {
 func (x *FromBackupInitializer) Reset() {
 	*x = FromBackupInitializer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initializer_proto_msgTypes[8]
+		mi := &file_initializer_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -757,7 +1024,7 @@ func (x *FromBackupInitializer) String() string {
 func (*FromBackupInitializer) ProtoMessage() {}
 
 func (x *FromBackupInitializer) ProtoReflect() protoreflect.Message {
-	mi := &file_initializer_proto_msgTypes[8]
+	mi := &file_initializer_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -770,7 +1037,7 @@ func (x *FromBackupInitializer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FromBackupInitializer.ProtoReflect.Descriptor instead.
 func (*FromBackupInitializer) Descriptor() ([]byte, []int) {
-	return file_initializer_proto_rawDescGZIP(), []int{8}
+	return file_initializer_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *FromBackupInitializer) GetCheckoutLocation() string {
@@ -814,7 +1081,7 @@ type GitStatus struct {
 func (x *GitStatus) Reset() {
 	*x = GitStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initializer_proto_msgTypes[9]
+		mi := &file_initializer_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -827,7 +1094,7 @@ func (x *GitStatus) String() string {
 func (*GitStatus) ProtoMessage() {}
 
 func (x *GitStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_initializer_proto_msgTypes[9]
+	mi := &file_initializer_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -840,7 +1107,7 @@ func (x *GitStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitStatus.ProtoReflect.Descriptor instead.
 func (*GitStatus) Descriptor() ([]byte, []int) {
-	return file_initializer_proto_rawDescGZIP(), []int{9}
+	return file_initializer_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GitStatus) GetBranch() string {
@@ -919,7 +1186,7 @@ type FileDownloadInitializer_FileInfo struct {
 func (x *FileDownloadInitializer_FileInfo) Reset() {
 	*x = FileDownloadInitializer_FileInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initializer_proto_msgTypes[10]
+		mi := &file_initializer_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -932,7 +1199,7 @@ func (x *FileDownloadInitializer_FileInfo) String() string {
 func (*FileDownloadInitializer_FileInfo) ProtoMessage() {}
 
 func (x *FileDownloadInitializer_FileInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_initializer_proto_msgTypes[10]
+	mi := &file_initializer_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -974,7 +1241,7 @@ var File_initializer_proto protoreflect.FileDescriptor
 var file_initializer_proto_rawDesc = []byte{
 	0x0a, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x22, 0xe0, 0x03, 0x0a, 0x14, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x69, 0x63, 0x65, 0x22, 0x94, 0x04, 0x0a, 0x14, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
 	0x65, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x05,
 	0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f,
 	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6d, 0x70,
@@ -1003,128 +1270,171 @@ var file_initializer_proto_rawDesc = []byte{
 	0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
 	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46,
 	0x72, 0x6f, 0x6d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x42, 0x06,
-	0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x22, 0x5e, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x46,
-	0x0a, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x22, 0xdd, 0x01, 0x0a, 0x17, 0x46, 0x69, 0x6c, 0x65, 0x44,
-	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a,
-	0x65, 0x72, 0x12, 0x46, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x1a, 0x51, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
-	0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16,
-	0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x22, 0x12, 0x0a, 0x10, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x49,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x22, 0xc1, 0x02, 0x0a, 0x0e, 0x47,
-	0x69, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x1d, 0x0a,
-	0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x55, 0x72, 0x69, 0x12, 0x2e, 0x0a, 0x13,
-	0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f,
-	0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x75, 0x70, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x55, 0x72, 0x69, 0x12, 0x40, 0x0a, 0x0b,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x6f,
-	0x64, 0x65, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1f,
-	0x0a, 0x0b, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x54, 0x61, 0x67, 0x65, 0x74, 0x12,
-	0x2b, 0x0a, 0x11, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x68, 0x65, 0x63,
-	0x6b, 0x6f, 0x75, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x06,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x69, 0x7a, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x32,
+	0x0a, 0x03, 0x6f, 0x63, 0x69, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x43, 0x49,
+	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x48, 0x00, 0x52, 0x03, 0x6f,
+	0x63, 0x69, 0x42, 0x06, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x22, 0x5e, 0x0a, 0x14, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x72, 0x12, 0x46, 0x0a, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x52, 0x0b, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x22, 0xdd, 0x01, 0x0a, 0x17, 0x46,
+	0x69, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x51, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x22, 0xa5, 0x01, 0x0a, 0x0e, 0x4f,
+	0x43, 0x49, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x10, 0x0a,
+	0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a,
+	0x0d, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x22, 0x12, 0x0a, 0x10, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x49, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x22, 0x9e, 0x03, 0x0a, 0x0e, 0x47, 0x69, 0x74, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x55, 0x72, 0x69, 0x12, 0x2e, 0x0a, 0x13, 0x75, 0x70, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x55, 0x72, 0x69, 0x12, 0x40, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
+	0x6c, 0x6f, 0x6e, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c,
+	0x6f, 0x6e, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x54, 0x61, 0x67, 0x65, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x66,
+	0x75, 0x6c, 0x6c, 0x5f, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x66, 0x75, 0x6c, 0x6c, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c,
+	0x6f, 0x6e, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x38, 0x0a,
+	0x18, 0x73, 0x70, 0x61, 0x72, 0x73, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74,
+	0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x16, 0x73, 0x70, 0x61, 0x72, 0x73, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x22, 0xf4, 0x03, 0x0a, 0x09, 0x47, 0x69, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x50, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63,
 	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x69,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x1d, 0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x22, 0xc2,
-	0x02, 0x0a, 0x09, 0x47, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x50, 0x0a, 0x0d,
-	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x45,
-	0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x4d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x75, 0x73,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x50,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x5f,
-	0x6f, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x4f,
-	0x74, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x63, 0x0a, 0x13, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x76,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x66, 0x72, 0x6f, 0x6d, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x65,
-	0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72,
-	0x12, 0x3f, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c,
-	0x64, 0x12, 0x30, 0x0a, 0x03, 0x67, 0x69, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
-	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x47, 0x69, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x52, 0x03,
-	0x67, 0x69, 0x74, 0x22, 0x76, 0x0a, 0x15, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x61, 0x63, 0x6b, 0x75,
-	0x70, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x11,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75,
-	0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x66, 0x72, 0x6f,
-	0x6d, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x66, 0x72, 0x6f, 0x6d, 0x56, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0xe7, 0x02, 0x0a, 0x09,
-	0x47, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61,
-	0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63,
-	0x68, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0f, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65,
-	0x73, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x6e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0e, 0x75, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73,
-	0x12, 0x32, 0x0a, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x6b, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46,
-	0x69, 0x6c, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x70, 0x75, 0x73, 0x68, 0x65, 0x64,
-	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f,
-	0x75, 0x6e, 0x70, 0x75, 0x73, 0x68, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12,
-	0x34, 0x0a, 0x16, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x70, 0x75, 0x73, 0x68, 0x65,
-	0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x6e, 0x70, 0x75, 0x73, 0x68, 0x65, 0x64, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x2a, 0x5a, 0x0a, 0x0f, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x54, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x45, 0x4d, 0x4f,
-	0x54, 0x45, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x4d,
-	0x4f, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d,
-	0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x42, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x10, 0x02, 0x12,
-	0x10, 0x0a, 0x0c, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x42, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x10,
-	0x03, 0x2a, 0x40, 0x0a, 0x0d, 0x47, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x4f, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x10, 0x00, 0x12,
-	0x0e, 0x0a, 0x0a, 0x42, 0x41, 0x53, 0x49, 0x43, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x10, 0x01, 0x12,
-	0x12, 0x0a, 0x0e, 0x42, 0x41, 0x53, 0x49, 0x43, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x4f, 0x54,
-	0x53, 0x10, 0x02, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x67, 0x69, 0x74, 0x70, 0x6f, 0x64, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x69, 0x74, 0x70,
-	0x6f, 0x64, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x45, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1d, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x47, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0e,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b,
+	0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x75, 0x74, 0x68, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6f, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x4f, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x03, 0x6c,
+	0x66, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x69, 0x74, 0x4c, 0x46, 0x53,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x03, 0x6c, 0x66, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x66, 0x73,
+	0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x6c, 0x66, 0x73, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x66,
+	0x73, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x6c, 0x66, 0x73, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x73,
+	0x75, 0x62, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x20, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x47, 0x69, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x3f, 0x0a,
+	0x11, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x63,
+	0x0a, 0x13, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x12, 0x30, 0x0a, 0x14, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x12, 0x66, 0x72, 0x6f, 0x6d, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x08, 0x70,
+	0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x30, 0x0a, 0x03,
+	0x67, 0x69, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x69, 0x74, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x52, 0x03, 0x67, 0x69, 0x74, 0x22, 0x76,
+	0x0a, 0x15, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x12, 0x66, 0x72, 0x6f, 0x6d, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0xe7, 0x02, 0x0a, 0x09, 0x47, 0x69, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x23, 0x0a, 0x0d,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x6e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x16,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x65, 0x64,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x55, 0x6e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x55, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12,
+	0x29, 0x0a, 0x10, 0x75, 0x6e, 0x70, 0x75, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x6e, 0x70, 0x75, 0x73,
+	0x68, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x70, 0x75, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x55, 0x6e, 0x70, 0x75, 0x73, 0x68, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x2a, 0x5a, 0x0a, 0x0f, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x48, 0x45,
+	0x41, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x43,
+	0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x4d, 0x4f, 0x54,
+	0x45, 0x5f, 0x42, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x4f,
+	0x43, 0x41, 0x4c, 0x5f, 0x42, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x10, 0x03, 0x2a, 0x48, 0x0a, 0x0a,
+	0x47, 0x69, 0x74, 0x4c, 0x46, 0x53, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x46,
+	0x53, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4c,
+	0x46, 0x53, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x46, 0x53,
+	0x5f, 0x46, 0x45, 0x54, 0x43, 0x48, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x46, 0x53, 0x5f,
+	0x50, 0x55, 0x4c, 0x4c, 0x10, 0x03, 0x2a, 0x73, 0x0a, 0x10, 0x47, 0x69, 0x74, 0x53, 0x75, 0x62,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x55,
+	0x42, 0x4d, 0x4f, 0x44, 0x55, 0x4c, 0x45, 0x53, 0x5f, 0x52, 0x45, 0x43, 0x55, 0x52, 0x53, 0x49,
+	0x56, 0x45, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x55, 0x42, 0x4d, 0x4f, 0x44, 0x55, 0x4c,
+	0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x55, 0x42,
+	0x4d, 0x4f, 0x44, 0x55, 0x4c, 0x45, 0x53, 0x5f, 0x54, 0x4f, 0x50, 0x5f, 0x4c, 0x45, 0x56, 0x45,
+	0x4c, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x55, 0x42, 0x4d, 0x4f, 0x44, 0x55, 0x4c, 0x45,
+	0x53, 0x5f, 0x53, 0x48, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x03, 0x2a, 0x40, 0x0a, 0x0d, 0x47,
+	0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x0b, 0x0a, 0x07,
+	0x4e, 0x4f, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x41, 0x53,
+	0x49, 0x43, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x41, 0x53,
+	0x49, 0x43, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x4f, 0x54, 0x53, 0x10, 0x02, 0x42, 0x31, 0x5a,
+	0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x69, 0x74, 0x70,
+	0x6f, 0x64, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x69, 0x74, 0x70, 0x6f, 0x64, 0x2f, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1139,45 +1449,51 @@ func file_initializer_proto_rawDescGZIP() []byte {
 	return file_initializer_proto_rawDescData
 }
 
-var file_initializer_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_initializer_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_initializer_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_initializer_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_initializer_proto_goTypes = []interface{}{
 	(CloneTargetMode)(0),                     // 0: contentservice.CloneTargetMode
-	(GitAuthMethod)(0),                       // 1: contentservice.GitAuthMethod
-	(*WorkspaceInitializer)(nil),             // 2: contentservice.WorkspaceInitializer
-	(*CompositeInitializer)(nil),             // 3: contentservice.CompositeInitializer
-	(*FileDownloadInitializer)(nil),          // 4: contentservice.FileDownloadInitializer
-	(*EmptyInitializer)(nil),                 // 5: contentservice.EmptyInitializer
-	(*GitInitializer)(nil),                   // 6: contentservice.GitInitializer
-	(*GitConfig)(nil),                        // 7: contentservice.GitConfig
-	(*SnapshotInitializer)(nil),              // 8: contentservice.SnapshotInitializer
-	(*PrebuildInitializer)(nil),              // 9: contentservice.PrebuildInitializer
-	(*FromBackupInitializer)(nil),            // 10: contentservice.FromBackupInitializer
-	(*GitStatus)(nil),                        // 11: contentservice.GitStatus
-	(*FileDownloadInitializer_FileInfo)(nil), // 12: contentservice.FileDownloadInitializer.FileInfo
-	nil,                                      // 13: contentservice.GitConfig.CustomConfigEntry
+	(GitLFSMode)(0),                          // 1: contentservice.GitLFSMode
+	(GitSubmoduleMode)(0),                    // 2: contentservice.GitSubmoduleMode
+	(GitAuthMethod)(0),                       // 3: contentservice.GitAuthMethod
+	(*WorkspaceInitializer)(nil),             // 4: contentservice.WorkspaceInitializer
+	(*CompositeInitializer)(nil),             // 5: contentservice.CompositeInitializer
+	(*FileDownloadInitializer)(nil),          // 6: contentservice.FileDownloadInitializer
+	(*OCIInitializer)(nil),                   // 7: contentservice.OCIInitializer
+	(*EmptyInitializer)(nil),                 // 8: contentservice.EmptyInitializer
+	(*GitInitializer)(nil),                   // 9: contentservice.GitInitializer
+	(*GitConfig)(nil),                        // 10: contentservice.GitConfig
+	(*SnapshotInitializer)(nil),              // 11: contentservice.SnapshotInitializer
+	(*PrebuildInitializer)(nil),              // 12: contentservice.PrebuildInitializer
+	(*FromBackupInitializer)(nil),            // 13: contentservice.FromBackupInitializer
+	(*GitStatus)(nil),                        // 14: contentservice.GitStatus
+	(*FileDownloadInitializer_FileInfo)(nil), // 15: contentservice.FileDownloadInitializer.FileInfo
+	nil,                                      // 16: contentservice.GitConfig.CustomConfigEntry
 }
 var file_initializer_proto_depIdxs = []int32{
-	5,  // 0: contentservice.WorkspaceInitializer.empty:type_name -> contentservice.EmptyInitializer
-	6,  // 1: contentservice.WorkspaceInitializer.git:type_name -> contentservice.GitInitializer
-	8,  // 2: contentservice.WorkspaceInitializer.snapshot:type_name -> contentservice.SnapshotInitializer
-	9,  // 3: contentservice.WorkspaceInitializer.prebuild:type_name -> contentservice.PrebuildInitializer
-	3,  // 4: contentservice.WorkspaceInitializer.composite:type_name -> contentservice.CompositeInitializer
-	4,  // 5: contentservice.WorkspaceInitializer.download:type_name -> contentservice.FileDownloadInitializer
-	10, // 6: contentservice.WorkspaceInitializer.backup:type_name -> contentservice.FromBackupInitializer
-	2,  // 7: contentservice.CompositeInitializer.initializer:type_name -> contentservice.WorkspaceInitializer
-	12, // 8: contentservice.FileDownloadInitializer.files:type_name -> contentservice.FileDownloadInitializer.FileInfo
-	0,  // 9: contentservice.GitInitializer.target_mode:type_name -> contentservice.CloneTargetMode
-	7,  // 10: contentservice.GitInitializer.config:type_name -> contentservice.GitConfig
-	13, // 11: contentservice.GitConfig.custom_config:type_name -> contentservice.GitConfig.CustomConfigEntry
-	1,  // 12: contentservice.GitConfig.authentication:type_name -> contentservice.GitAuthMethod
-	8,  // 13: contentservice.PrebuildInitializer.prebuild:type_name -> contentservice.SnapshotInitializer
-	6,  // 14: contentservice.PrebuildInitializer.git:type_name -> contentservice.GitInitializer
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	8,  // 0: contentservice.WorkspaceInitializer.empty:type_name -> contentservice.EmptyInitializer
+	9,  // 1: contentservice.WorkspaceInitializer.git:type_name -> contentservice.GitInitializer
+	11, // 2: contentservice.WorkspaceInitializer.snapshot:type_name -> contentservice.SnapshotInitializer
+	12, // 3: contentservice.WorkspaceInitializer.prebuild:type_name -> contentservice.PrebuildInitializer
+	5,  // 4: contentservice.WorkspaceInitializer.composite:type_name -> contentservice.CompositeInitializer
+	6,  // 5: contentservice.WorkspaceInitializer.download:type_name -> contentservice.FileDownloadInitializer
+	13, // 6: contentservice.WorkspaceInitializer.backup:type_name -> contentservice.FromBackupInitializer
+	7,  // 7: contentservice.WorkspaceInitializer.oci:type_name -> contentservice.OCIInitializer
+	4,  // 8: contentservice.CompositeInitializer.initializer:type_name -> contentservice.WorkspaceInitializer
+	15, // 9: contentservice.FileDownloadInitializer.files:type_name -> contentservice.FileDownloadInitializer.FileInfo
+	0,  // 10: contentservice.GitInitializer.target_mode:type_name -> contentservice.CloneTargetMode
+	10, // 11: contentservice.GitInitializer.config:type_name -> contentservice.GitConfig
+	16, // 12: contentservice.GitConfig.custom_config:type_name -> contentservice.GitConfig.CustomConfigEntry
+	3,  // 13: contentservice.GitConfig.authentication:type_name -> contentservice.GitAuthMethod
+	1,  // 14: contentservice.GitConfig.lfs:type_name -> contentservice.GitLFSMode
+	2,  // 15: contentservice.GitConfig.submodules:type_name -> contentservice.GitSubmoduleMode
+	11, // 16: contentservice.PrebuildInitializer.prebuild:type_name -> contentservice.SnapshotInitializer
+	9,  // 17: contentservice.PrebuildInitializer.git:type_name -> contentservice.GitInitializer
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_initializer_proto_init() }
@@ -1223,7 +1539,7 @@ func file_initializer_proto_init() {
 			}
 		}
 		file_initializer_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmptyInitializer); i {
+			switch v := v.(*OCIInitializer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1235,7 +1551,7 @@ func file_initializer_proto_init() {
 			}
 		}
 		file_initializer_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitInitializer); i {
+			switch v := v.(*EmptyInitializer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1247,7 +1563,7 @@ func file_initializer_proto_init() {
 			}
 		}
 		file_initializer_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitConfig); i {
+			switch v := v.(*GitInitializer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1259,7 +1575,7 @@ func file_initializer_proto_init() {
 			}
 		}
 		file_initializer_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotInitializer); i {
+			switch v := v.(*GitConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1271,7 +1587,7 @@ func file_initializer_proto_init() {
 			}
 		}
 		file_initializer_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrebuildInitializer); i {
+			switch v := v.(*SnapshotInitializer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1283,7 +1599,7 @@ func file_initializer_proto_init() {
 			}
 		}
 		file_initializer_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FromBackupInitializer); i {
+			switch v := v.(*PrebuildInitializer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1295,7 +1611,7 @@ func file_initializer_proto_init() {
 			}
 		}
 		file_initializer_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitStatus); i {
+			switch v := v.(*FromBackupInitializer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1307,6 +1623,18 @@ func file_initializer_proto_init() {
 			}
 		}
 		file_initializer_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initializer_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FileDownloadInitializer_FileInfo); i {
 			case 0:
 				return &v.state
@@ -1327,14 +1655,15 @@ func file_initializer_proto_init() {
 		(*WorkspaceInitializer_Composite)(nil),
 		(*WorkspaceInitializer_Download)(nil),
 		(*WorkspaceInitializer_Backup)(nil),
+		(*WorkspaceInitializer_Oci)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_initializer_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   12,
+			NumEnums:      4,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
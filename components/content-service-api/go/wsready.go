@@ -38,6 +38,18 @@ type InitializerMetric struct {
 
 	// Size of the data that was initialized in bytes
 	Size uint64 `json:"size"`
+
+	// Filter is the partial clone filter used by a Git initializer, if any
+	Filter string `json:"filter,omitempty"`
+
+	// FetchedBytes is the amount of data a Git initializer fetched from the remote
+	FetchedBytes uint64 `json:"fetchedBytes,omitempty"`
+
+	// LFSBytes is the size of the Git LFS objects a Git initializer downloaded
+	LFSBytes uint64 `json:"lfsBytes,omitempty"`
+
+	// SubmoduleDuration is the time a Git initializer spent updating submodules
+	SubmoduleDuration time.Duration `json:"submoduleDuration,omitempty"`
 }
 
 type InitializerMetrics []InitializerMetric
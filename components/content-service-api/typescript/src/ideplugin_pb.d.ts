@@ -39,6 +39,9 @@ export class PluginUploadURLResponse extends jspb.Message {
     getUrl(): string;
     setUrl(value: string): PluginUploadURLResponse;
 
+    getHeadersMap(): jspb.Map<string, string>;
+    clearHeadersMap(): void;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): PluginUploadURLResponse.AsObject;
     static toObject(includeInstance: boolean, msg: PluginUploadURLResponse): PluginUploadURLResponse.AsObject;
@@ -52,6 +55,8 @@ export class PluginUploadURLResponse extends jspb.Message {
 export namespace PluginUploadURLResponse {
     export type AsObject = {
         url: string,
+
+        headersMap: Array<[string, string]>,
     }
 }
 
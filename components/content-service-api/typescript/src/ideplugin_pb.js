@@ -345,7 +345,8 @@ proto.ideplugin.PluginUploadURLResponse.prototype.toObject = function(opt_includ
  */
 proto.ideplugin.PluginUploadURLResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    url: jspb.Message.getFieldWithDefault(msg, 1, "")
+    url: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    headersMap: (f = msg.getHeadersMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -386,6 +387,12 @@ proto.ideplugin.PluginUploadURLResponse.deserializeBinaryFromReader = function(m
       var value = /** @type {string} */ (reader.readString());
       msg.setUrl(value);
       break;
+    case 2:
+      var value = msg.getHeadersMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
     default:
       reader.skipField();
       break;
@@ -422,6 +429,10 @@ proto.ideplugin.PluginUploadURLResponse.serializeBinaryToWriter = function(messa
       f
     );
   }
+  f = message.getHeadersMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
 };
 
 
@@ -443,6 +454,28 @@ proto.ideplugin.PluginUploadURLResponse.prototype.setUrl = function(value) {
 };
 
 
+/**
+ * map<string, string> headers = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.ideplugin.PluginUploadURLResponse.prototype.getHeadersMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.ideplugin.PluginUploadURLResponse} returns this
+ */
+proto.ideplugin.PluginUploadURLResponse.prototype.clearHeadersMap = function() {
+  this.getHeadersMap().clear();
+  return this;};
+
+
 
 
 
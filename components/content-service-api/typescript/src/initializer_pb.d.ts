@@ -49,6 +49,11 @@ export class WorkspaceInitializer extends jspb.Message {
     getBackup(): FromBackupInitializer | undefined;
     setBackup(value?: FromBackupInitializer): WorkspaceInitializer;
 
+    hasOci(): boolean;
+    clearOci(): void;
+    getOci(): OCIInitializer | undefined;
+    setOci(value?: OCIInitializer): WorkspaceInitializer;
+
     getSpecCase(): WorkspaceInitializer.SpecCase;
 
     serializeBinary(): Uint8Array;
@@ -70,6 +75,7 @@ export namespace WorkspaceInitializer {
         composite?: CompositeInitializer.AsObject,
         download?: FileDownloadInitializer.AsObject,
         backup?: FromBackupInitializer.AsObject,
+        oci?: OCIInitializer.AsObject,
     }
 
     export enum SpecCase {
@@ -81,6 +87,7 @@ export namespace WorkspaceInitializer {
         COMPOSITE = 5,
         DOWNLOAD = 6,
         BACKUP = 7,
+        OCI = 8,
     }
 
 }
@@ -160,6 +167,38 @@ export namespace FileDownloadInitializer {
 
 }
 
+export class OCIInitializer extends jspb.Message {
+    getRef(): string;
+    setRef(value: string): OCIInitializer;
+    getDigest(): string;
+    setDigest(value: string): OCIInitializer;
+    getTargetLocation(): string;
+    setTargetLocation(value: string): OCIInitializer;
+    getAuthUser(): string;
+    setAuthUser(value: string): OCIInitializer;
+    getAuthPassword(): string;
+    setAuthPassword(value: string): OCIInitializer;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): OCIInitializer.AsObject;
+    static toObject(includeInstance: boolean, msg: OCIInitializer): OCIInitializer.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: OCIInitializer, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): OCIInitializer;
+    static deserializeBinaryFromReader(message: OCIInitializer, reader: jspb.BinaryReader): OCIInitializer;
+}
+
+export namespace OCIInitializer {
+    export type AsObject = {
+        ref: string,
+        digest: string,
+        targetLocation: string,
+        authUser: string,
+        authPassword: string,
+    }
+}
+
 export class EmptyInitializer extends jspb.Message {
 
     serializeBinary(): Uint8Array;
@@ -195,6 +234,12 @@ export class GitInitializer extends jspb.Message {
     setConfig(value?: GitConfig): GitInitializer;
     getFullClone(): boolean;
     setFullClone(value: boolean): GitInitializer;
+    getCloneFilter(): string;
+    setCloneFilter(value: string): GitInitializer;
+    clearSparseCheckoutPatternsList(): void;
+    getSparseCheckoutPatternsList(): Array<string>;
+    setSparseCheckoutPatternsList(value: Array<string>): GitInitializer;
+    addSparseCheckoutPatterns(value: string, index?: number): string;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): GitInitializer.AsObject;
@@ -215,6 +260,8 @@ export namespace GitInitializer {
         checkoutLocation: string,
         config?: GitConfig.AsObject,
         fullClone: boolean,
+        cloneFilter: string,
+        sparseCheckoutPatternsList: Array<string>,
     }
 }
 
@@ -230,6 +277,18 @@ export class GitConfig extends jspb.Message {
     setAuthPassword(value: string): GitConfig;
     getAuthOts(): string;
     setAuthOts(value: string): GitConfig;
+    getLfs(): GitLFSMode;
+    setLfs(value: GitLFSMode): GitConfig;
+    clearLfsIncludeList(): void;
+    getLfsIncludeList(): Array<string>;
+    setLfsIncludeList(value: Array<string>): GitConfig;
+    addLfsInclude(value: string, index?: number): string;
+    clearLfsExcludeList(): void;
+    getLfsExcludeList(): Array<string>;
+    setLfsExcludeList(value: Array<string>): GitConfig;
+    addLfsExclude(value: string, index?: number): string;
+    getSubmodules(): GitSubmoduleMode;
+    setSubmodules(value: GitSubmoduleMode): GitConfig;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): GitConfig.AsObject;
@@ -249,6 +308,10 @@ export namespace GitConfig {
         authUser: string,
         authPassword: string,
         authOts: string,
+        lfs: GitLFSMode,
+        lfsIncludeList: Array<string>,
+        lfsExcludeList: Array<string>,
+        submodules: GitSubmoduleMode,
     }
 }
 
@@ -385,3 +448,17 @@ export enum GitAuthMethod {
     BASIC_AUTH = 1,
     BASIC_AUTH_OTS = 2,
 }
+
+export enum GitLFSMode {
+    LFS_DEFAULT = 0,
+    LFS_SKIP = 1,
+    LFS_FETCH = 2,
+    LFS_PULL = 3,
+}
+
+export enum GitSubmoduleMode {
+    SUBMODULES_RECURSIVE = 0,
+    SUBMODULES_NONE = 1,
+    SUBMODULES_TOP_LEVEL = 2,
+    SUBMODULES_SHALLOW = 3,
+}
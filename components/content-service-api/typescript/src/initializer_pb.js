@@ -30,7 +30,10 @@ goog.exportSymbol('proto.contentservice.FromBackupInitializer', null, global);
 goog.exportSymbol('proto.contentservice.GitAuthMethod', null, global);
 goog.exportSymbol('proto.contentservice.GitConfig', null, global);
 goog.exportSymbol('proto.contentservice.GitInitializer', null, global);
+goog.exportSymbol('proto.contentservice.GitLFSMode', null, global);
 goog.exportSymbol('proto.contentservice.GitStatus', null, global);
+goog.exportSymbol('proto.contentservice.GitSubmoduleMode', null, global);
+goog.exportSymbol('proto.contentservice.OCIInitializer', null, global);
 goog.exportSymbol('proto.contentservice.PrebuildInitializer', null, global);
 goog.exportSymbol('proto.contentservice.SnapshotInitializer', null, global);
 goog.exportSymbol('proto.contentservice.WorkspaceInitializer', null, global);
@@ -119,6 +122,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.contentservice.FileDownloadInitializer.FileInfo.displayName = 'proto.contentservice.FileDownloadInitializer.FileInfo';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.contentservice.OCIInitializer = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.contentservice.OCIInitializer, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.contentservice.OCIInitializer.displayName = 'proto.contentservice.OCIInitializer';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -151,7 +175,7 @@ if (goog.DEBUG && !COMPILED) {
  * @constructor
  */
 proto.contentservice.GitInitializer = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.contentservice.GitInitializer.repeatedFields_, null);
 };
 goog.inherits(proto.contentservice.GitInitializer, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -172,7 +196,7 @@ if (goog.DEBUG && !COMPILED) {
  * @constructor
  */
 proto.contentservice.GitConfig = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.contentservice.GitConfig.repeatedFields_, null);
 };
 goog.inherits(proto.contentservice.GitConfig, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -275,7 +299,7 @@ if (goog.DEBUG && !COMPILED) {
  * @private {!Array<!Array<number>>}
  * @const
  */
-proto.contentservice.WorkspaceInitializer.oneofGroups_ = [[1,2,3,4,5,6,7]];
+proto.contentservice.WorkspaceInitializer.oneofGroups_ = [[1,2,3,4,5,6,7,8]];
 
 /**
  * @enum {number}
@@ -288,7 +312,8 @@ proto.contentservice.WorkspaceInitializer.SpecCase = {
   PREBUILD: 4,
   COMPOSITE: 5,
   DOWNLOAD: 6,
-  BACKUP: 7
+  BACKUP: 7,
+  OCI: 8
 };
 
 /**
@@ -335,7 +360,8 @@ proto.contentservice.WorkspaceInitializer.toObject = function(includeInstance, m
     prebuild: (f = msg.getPrebuild()) && proto.contentservice.PrebuildInitializer.toObject(includeInstance, f),
     composite: (f = msg.getComposite()) && proto.contentservice.CompositeInitializer.toObject(includeInstance, f),
     download: (f = msg.getDownload()) && proto.contentservice.FileDownloadInitializer.toObject(includeInstance, f),
-    backup: (f = msg.getBackup()) && proto.contentservice.FromBackupInitializer.toObject(includeInstance, f)
+    backup: (f = msg.getBackup()) && proto.contentservice.FromBackupInitializer.toObject(includeInstance, f),
+    oci: (f = msg.getOci()) && proto.contentservice.OCIInitializer.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -407,6 +433,11 @@ proto.contentservice.WorkspaceInitializer.deserializeBinaryFromReader = function
       reader.readMessage(value,proto.contentservice.FromBackupInitializer.deserializeBinaryFromReader);
       msg.setBackup(value);
       break;
+    case 8:
+      var value = new proto.contentservice.OCIInitializer;
+      reader.readMessage(value,proto.contentservice.OCIInitializer.deserializeBinaryFromReader);
+      msg.setOci(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -492,6 +523,14 @@ proto.contentservice.WorkspaceInitializer.serializeBinaryToWriter = function(mes
       proto.contentservice.FromBackupInitializer.serializeBinaryToWriter
     );
   }
+  f = message.getOci();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      proto.contentservice.OCIInitializer.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -754,6 +793,43 @@ proto.contentservice.WorkspaceInitializer.prototype.hasBackup = function() {
 };
 
 
+/**
+ * optional OCIInitializer oci = 8;
+ * @return {?proto.contentservice.OCIInitializer}
+ */
+proto.contentservice.WorkspaceInitializer.prototype.getOci = function() {
+  return /** @type{?proto.contentservice.OCIInitializer} */ (
+    jspb.Message.getWrapperField(this, proto.contentservice.OCIInitializer, 8));
+};
+
+
+/**
+ * @param {?proto.contentservice.OCIInitializer|undefined} value
+ * @return {!proto.contentservice.WorkspaceInitializer} returns this
+*/
+proto.contentservice.WorkspaceInitializer.prototype.setOci = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 8, proto.contentservice.WorkspaceInitializer.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.contentservice.WorkspaceInitializer} returns this
+ */
+proto.contentservice.WorkspaceInitializer.prototype.clearOci = function() {
+  return this.setOci(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.contentservice.WorkspaceInitializer.prototype.hasOci = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
 
 /**
  * List of repeated fields within this message type.
@@ -1297,6 +1373,256 @@ proto.contentservice.FileDownloadInitializer.prototype.setTargetLocation = funct
 
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.contentservice.OCIInitializer.prototype.toObject = function(opt_includeInstance) {
+  return proto.contentservice.OCIInitializer.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.contentservice.OCIInitializer} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.contentservice.OCIInitializer.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    ref: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    digest: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    targetLocation: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    authUser: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    authPassword: jspb.Message.getFieldWithDefault(msg, 5, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.contentservice.OCIInitializer}
+ */
+proto.contentservice.OCIInitializer.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.contentservice.OCIInitializer;
+  return proto.contentservice.OCIInitializer.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.contentservice.OCIInitializer} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.contentservice.OCIInitializer}
+ */
+proto.contentservice.OCIInitializer.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRef(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDigest(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTargetLocation(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAuthUser(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAuthPassword(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.contentservice.OCIInitializer.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.contentservice.OCIInitializer.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.contentservice.OCIInitializer} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.contentservice.OCIInitializer.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRef();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDigest();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getTargetLocation();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getAuthUser();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getAuthPassword();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string ref = 1;
+ * @return {string}
+ */
+proto.contentservice.OCIInitializer.prototype.getRef = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.OCIInitializer} returns this
+ */
+proto.contentservice.OCIInitializer.prototype.setRef = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string digest = 2;
+ * @return {string}
+ */
+proto.contentservice.OCIInitializer.prototype.getDigest = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.OCIInitializer} returns this
+ */
+proto.contentservice.OCIInitializer.prototype.setDigest = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string target_location = 3;
+ * @return {string}
+ */
+proto.contentservice.OCIInitializer.prototype.getTargetLocation = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.OCIInitializer} returns this
+ */
+proto.contentservice.OCIInitializer.prototype.setTargetLocation = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string auth_user = 4;
+ * @return {string}
+ */
+proto.contentservice.OCIInitializer.prototype.getAuthUser = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.OCIInitializer} returns this
+ */
+proto.contentservice.OCIInitializer.prototype.setAuthUser = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string auth_password = 5;
+ * @return {string}
+ */
+proto.contentservice.OCIInitializer.prototype.getAuthPassword = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.OCIInitializer} returns this
+ */
+proto.contentservice.OCIInitializer.prototype.setAuthPassword = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+
+
+
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -1396,6 +1722,13 @@ proto.contentservice.EmptyInitializer.serializeBinaryToWriter = function(message
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.contentservice.GitInitializer.repeatedFields_ = [9];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1433,7 +1766,9 @@ proto.contentservice.GitInitializer.toObject = function(includeInstance, msg) {
     cloneTaget: jspb.Message.getFieldWithDefault(msg, 4, ""),
     checkoutLocation: jspb.Message.getFieldWithDefault(msg, 5, ""),
     config: (f = msg.getConfig()) && proto.contentservice.GitConfig.toObject(includeInstance, f),
-    fullClone: jspb.Message.getBooleanFieldWithDefault(msg, 7, false)
+    fullClone: jspb.Message.getBooleanFieldWithDefault(msg, 7, false),
+    cloneFilter: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    sparseCheckoutPatternsList: (f = jspb.Message.getRepeatedField(msg, 9)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -1499,6 +1834,14 @@ proto.contentservice.GitInitializer.deserializeBinaryFromReader = function(msg,
       var value = /** @type {boolean} */ (reader.readBool());
       msg.setFullClone(value);
       break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCloneFilter(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addSparseCheckoutPatterns(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1578,6 +1921,20 @@ proto.contentservice.GitInitializer.serializeBinaryToWriter = function(message,
       f
     );
   }
+  f = message.getCloneFilter();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+  f = message.getSparseCheckoutPatternsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      9,
+      f
+    );
+  }
 };
 
 
@@ -1726,6 +2083,68 @@ proto.contentservice.GitInitializer.prototype.setFullClone = function(value) {
 };
 
 
+/**
+ * optional string clone_filter = 8;
+ * @return {string}
+ */
+proto.contentservice.GitInitializer.prototype.getCloneFilter = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.setCloneFilter = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
+};
+
+
+/**
+ * repeated string sparse_checkout_patterns = 9;
+ * @return {!Array<string>}
+ */
+proto.contentservice.GitInitializer.prototype.getSparseCheckoutPatternsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 9));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.setSparseCheckoutPatternsList = function(value) {
+  return jspb.Message.setField(this, 9, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.addSparseCheckoutPatterns = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 9, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.clearSparseCheckoutPatternsList = function() {
+  return this.setSparseCheckoutPatternsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.contentservice.GitConfig.repeatedFields_ = [7,8];
 
 
 
@@ -1762,7 +2181,11 @@ proto.contentservice.GitConfig.toObject = function(includeInstance, msg) {
     authentication: jspb.Message.getFieldWithDefault(msg, 2, 0),
     authUser: jspb.Message.getFieldWithDefault(msg, 3, ""),
     authPassword: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    authOts: jspb.Message.getFieldWithDefault(msg, 5, "")
+    authOts: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    lfs: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    lfsIncludeList: (f = jspb.Message.getRepeatedField(msg, 7)) == null ? undefined : f,
+    lfsExcludeList: (f = jspb.Message.getRepeatedField(msg, 8)) == null ? undefined : f,
+    submodules: jspb.Message.getFieldWithDefault(msg, 9, 0)
   };
 
   if (includeInstance) {
@@ -1821,6 +2244,22 @@ proto.contentservice.GitConfig.deserializeBinaryFromReader = function(msg, reade
       var value = /** @type {string} */ (reader.readString());
       msg.setAuthOts(value);
       break;
+    case 6:
+      var value = /** @type {!proto.contentservice.GitLFSMode} */ (reader.readEnum());
+      msg.setLfs(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addLfsInclude(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addLfsExclude(value);
+      break;
+    case 9:
+      var value = /** @type {!proto.contentservice.GitSubmoduleMode} */ (reader.readEnum());
+      msg.setSubmodules(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1882,6 +2321,34 @@ proto.contentservice.GitConfig.serializeBinaryToWriter = function(message, write
       f
     );
   }
+  f = message.getLfs();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      6,
+      f
+    );
+  }
+  f = message.getLfsIncludeList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      7,
+      f
+    );
+  }
+  f = message.getLfsExcludeList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      8,
+      f
+    );
+  }
+  f = message.getSubmodules();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      9,
+      f
+    );
+  }
 };
 
 
@@ -1979,6 +2446,116 @@ proto.contentservice.GitConfig.prototype.setAuthOts = function(value) {
 };
 
 
+/**
+ * optional GitLFSMode lfs = 6;
+ * @return {!proto.contentservice.GitLFSMode}
+ */
+proto.contentservice.GitConfig.prototype.getLfs = function() {
+  return /** @type {!proto.contentservice.GitLFSMode} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {!proto.contentservice.GitLFSMode} value
+ * @return {!proto.contentservice.GitConfig} returns this
+ */
+proto.contentservice.GitConfig.prototype.setLfs = function(value) {
+  return jspb.Message.setProto3EnumField(this, 6, value);
+};
+
+
+/**
+ * repeated string lfs_include = 7;
+ * @return {!Array<string>}
+ */
+proto.contentservice.GitConfig.prototype.getLfsIncludeList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 7));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.contentservice.GitConfig} returns this
+ */
+proto.contentservice.GitConfig.prototype.setLfsIncludeList = function(value) {
+  return jspb.Message.setField(this, 7, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.contentservice.GitConfig} returns this
+ */
+proto.contentservice.GitConfig.prototype.addLfsInclude = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 7, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.contentservice.GitConfig} returns this
+ */
+proto.contentservice.GitConfig.prototype.clearLfsIncludeList = function() {
+  return this.setLfsIncludeList([]);
+};
+
+
+/**
+ * repeated string lfs_exclude = 8;
+ * @return {!Array<string>}
+ */
+proto.contentservice.GitConfig.prototype.getLfsExcludeList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 8));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.contentservice.GitConfig} returns this
+ */
+proto.contentservice.GitConfig.prototype.setLfsExcludeList = function(value) {
+  return jspb.Message.setField(this, 8, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.contentservice.GitConfig} returns this
+ */
+proto.contentservice.GitConfig.prototype.addLfsExclude = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 8, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.contentservice.GitConfig} returns this
+ */
+proto.contentservice.GitConfig.prototype.clearLfsExcludeList = function() {
+  return this.setLfsExcludeList([]);
+};
+
+
+/**
+ * optional GitSubmoduleMode submodules = 9;
+ * @return {!proto.contentservice.GitSubmoduleMode}
+ */
+proto.contentservice.GitConfig.prototype.getSubmodules = function() {
+  return /** @type {!proto.contentservice.GitSubmoduleMode} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {!proto.contentservice.GitSubmoduleMode} value
+ * @return {!proto.contentservice.GitConfig} returns this
+ */
+proto.contentservice.GitConfig.prototype.setSubmodules = function(value) {
+  return jspb.Message.setProto3EnumField(this, 9, value);
+};
+
+
 
 
 
@@ -2933,4 +3510,24 @@ proto.contentservice.GitAuthMethod = {
   BASIC_AUTH_OTS: 2
 };
 
+/**
+ * @enum {number}
+ */
+proto.contentservice.GitLFSMode = {
+  LFS_DEFAULT: 0,
+  LFS_SKIP: 1,
+  LFS_FETCH: 2,
+  LFS_PULL: 3
+};
+
+/**
+ * @enum {number}
+ */
+proto.contentservice.GitSubmoduleMode = {
+  SUBMODULES_RECURSIVE: 0,
+  SUBMODULES_NONE: 1,
+  SUBMODULES_TOP_LEVEL: 2,
+  SUBMODULES_SHALLOW: 3
+};
+
 goog.object.extend(exports, proto.contentservice);
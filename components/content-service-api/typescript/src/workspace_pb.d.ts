@@ -38,6 +38,8 @@ export namespace WorkspaceDownloadURLRequest {
 export class WorkspaceDownloadURLResponse extends jspb.Message {
     getUrl(): string;
     setUrl(value: string): WorkspaceDownloadURLResponse;
+    getManifestUrl(): string;
+    setManifestUrl(value: string): WorkspaceDownloadURLResponse;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): WorkspaceDownloadURLResponse.AsObject;
@@ -52,6 +54,7 @@ export class WorkspaceDownloadURLResponse extends jspb.Message {
 export namespace WorkspaceDownloadURLResponse {
     export type AsObject = {
         url: string,
+        manifestUrl: string,
     }
 }
 
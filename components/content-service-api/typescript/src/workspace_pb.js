@@ -345,7 +345,8 @@ proto.contentservice.WorkspaceDownloadURLResponse.prototype.toObject = function(
  */
 proto.contentservice.WorkspaceDownloadURLResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    url: jspb.Message.getFieldWithDefault(msg, 1, "")
+    url: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    manifestUrl: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -386,6 +387,10 @@ proto.contentservice.WorkspaceDownloadURLResponse.deserializeBinaryFromReader =
       var value = /** @type {string} */ (reader.readString());
       msg.setUrl(value);
       break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setManifestUrl(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -422,6 +427,13 @@ proto.contentservice.WorkspaceDownloadURLResponse.serializeBinaryToWriter = func
       f
     );
   }
+  f = message.getManifestUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
@@ -443,6 +455,24 @@ proto.contentservice.WorkspaceDownloadURLResponse.prototype.setUrl = function(va
 };
 
 
+/**
+ * optional string manifest_url = 2;
+ * @return {string}
+ */
+proto.contentservice.WorkspaceDownloadURLResponse.prototype.getManifestUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.WorkspaceDownloadURLResponse} returns this
+ */
+proto.contentservice.WorkspaceDownloadURLResponse.prototype.setManifestUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
 
 
 
@@ -5,10 +5,16 @@
 package cmd
 
 import (
+	"context"
+
 	"github.com/gitpod-io/gitpod/common-go/baseserver"
 	"github.com/gitpod-io/gitpod/common-go/log"
+	db "github.com/gitpod-io/gitpod/components/gitpod-db/go"
 	"github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/retention"
 	"github.com/gitpod-io/gitpod/content-service/pkg/service"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
 	"github.com/spf13/cobra"
 )
 
@@ -22,12 +28,24 @@ var runCmd = &cobra.Command{
 
 		srv, err := baseserver.New("content-service",
 			baseserver.WithGRPC(&cfg.Service),
+			baseserver.WithHTTP(cfg.HTTP),
 			baseserver.WithVersion(Version),
 		)
 		if err != nil {
 			log.WithError(err).Fatal("Failed to create server.")
 		}
 
+		if cfg.Storage.Kind == config.LocalStorage {
+			if cfg.HTTP == nil || cfg.Storage.LocalConfig == nil {
+				log.Fatal("local storage requires the HTTP server and local storage to be configured")
+			}
+			localStorageHandler, err := storage.NewLocalStorageHandler(*cfg.Storage.LocalConfig)
+			if err != nil {
+				log.WithError(err).Fatal("Cannot create local storage handler")
+			}
+			srv.HTTPMux().Handle("/", localStorageHandler)
+		}
+
 		contentService, err := service.NewContentService(cfg.Storage)
 		if err != nil {
 			log.WithError(err).Fatalf("Cannot create content service")
@@ -58,6 +76,30 @@ var runCmd = &cobra.Command{
 		}
 		api.RegisterIDEPluginServiceServer(srv.GRPC(), idePluginService)
 
+		if cfg.Retention != nil {
+			ps, err := storage.NewPresignedAccess(&cfg.Storage)
+			if err != nil {
+				log.WithError(err).Fatal("Cannot create storage access for retention")
+			}
+			var refs retention.ReferenceChecker
+			if cfg.Retention.SnapshotMaxAge > 0 || cfg.Retention.MaxBytesPerOwner > 0 {
+				conn, err := db.Connect(db.ConnectionParamsFromEnv())
+				if err != nil {
+					log.WithError(err).Fatal("Cannot connect to the database to check snapshot references")
+				}
+				refs = &retention.DBReferenceChecker{Conn: conn}
+			}
+			retentionReconciler, err := retention.NewReconciler(*cfg.Retention, ps, refs)
+			if err != nil {
+				log.WithError(err).Fatal("Cannot create retention reconciler")
+			}
+			err = retentionReconciler.RegisterMetrics(srv.MetricsRegistry())
+			if err != nil {
+				log.WithError(err).Fatal("Cannot register retention metrics")
+			}
+			go retentionReconciler.Start(context.Background())
+		}
+
 		err = srv.ListenAndServe()
 		if err != nil {
 			log.WithError(err).Fatal("Cannot start server")
@@ -0,0 +1,135 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package archive
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"syscall"
+
+	"golang.org/x/xerrors"
+)
+
+// Manifest describes the content of a tar archive. It is recorded alongside every backup
+// so that the uploaded object and its extracted content can be verified later on.
+type Manifest struct {
+	// FileCount is the number of regular files (including hard links) in the archive
+	FileCount int64 `json:"fileCount"`
+	// TotalSize is the sum of the sizes of all regular files in the archive
+	TotalSize int64 `json:"totalSize"`
+	// ArchiveSize is the size of the archive itself in bytes
+	ArchiveSize int64 `json:"archiveSize"`
+	// Digest is the sha256 digest of the archive, e.g. sha256:abc...
+	Digest string `json:"digest,omitempty"`
+}
+
+// ComputeManifest reads the tar archive from src and produces its manifest
+func ComputeManifest(src io.Reader) (*Manifest, error) {
+	var (
+		hash = sha256.New()
+		cr   = &countingReader{R: io.TeeReader(src, hash)}
+		tr   = tar.NewReader(cr)
+		res  Manifest
+	)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, xerrors.Errorf("cannot read archive: %w", err)
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeReg:
+			res.FileCount++
+			res.TotalSize += hdr.Size
+		case tar.TypeLink:
+			res.FileCount++
+		}
+	}
+
+	// the tar reader stops at the end-of-archive marker - make sure we hash trailing padding as well
+	_, err := io.Copy(io.Discard, cr)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot read archive: %w", err)
+	}
+
+	res.ArchiveSize = cr.N
+	res.Digest = "sha256:" + hex.EncodeToString(hash.Sum(nil))
+	return &res, nil
+}
+
+// ComputeDirManifest produces the manifest of an extracted archive. Because a directory has no archive
+// representation, the ArchiveSize and Digest fields of the result are empty.
+func ComputeDirManifest(dir string) (*Manifest, error) {
+	var (
+		res    Manifest
+		inodes = make(map[uint64]struct{})
+	)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		stat, err := d.Info()
+		if err != nil {
+			return err
+		}
+		res.FileCount++
+
+		// hard links count as files but their content is only counted once, same as in the archive
+		if sys, ok := stat.Sys().(*syscall.Stat_t); ok && sys.Nlink > 1 {
+			if _, seen := inodes[sys.Ino]; seen {
+				return nil
+			}
+			inodes[sys.Ino] = struct{}{}
+		}
+		res.TotalSize += stat.Size()
+		return nil
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("cannot compute manifest of %s: %w", dir, err)
+	}
+
+	return &res, nil
+}
+
+// Compare lists the differences between this manifest and another one. Fields which are
+// empty in either manifest are not compared. An empty result means the manifests match.
+func (m *Manifest) Compare(other *Manifest) (mismatches []string) {
+	if m.FileCount != other.FileCount {
+		mismatches = append(mismatches, fmt.Sprintf("file count: expected %d, got %d", m.FileCount, other.FileCount))
+	}
+	if m.TotalSize != other.TotalSize {
+		mismatches = append(mismatches, fmt.Sprintf("total size: expected %d bytes, got %d bytes", m.TotalSize, other.TotalSize))
+	}
+	if m.ArchiveSize != 0 && other.ArchiveSize != 0 && m.ArchiveSize != other.ArchiveSize {
+		mismatches = append(mismatches, fmt.Sprintf("archive size: expected %d bytes, got %d bytes", m.ArchiveSize, other.ArchiveSize))
+	}
+	if m.Digest != "" && other.Digest != "" && m.Digest != other.Digest {
+		mismatches = append(mismatches, fmt.Sprintf("digest: expected %s, got %s", m.Digest, other.Digest))
+	}
+	return
+}
+
+type countingReader struct {
+	R io.Reader
+	N int64
+}
+
+func (c *countingReader) Read(p []byte) (n int, err error) {
+	n, err = c.R.Read(p)
+	c.N += int64(n)
+	return
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"os"
+	"testing"
+)
+
+func TestManifest(t *testing.T) {
+	type file struct {
+		Name        string
+		ContentSize int64
+		Dir         bool
+	}
+	tests := []struct {
+		Name          string
+		Files         []file
+		ExpectedCount int64
+		ExpectedSize  int64
+	}{
+		{
+			Name: "files and directories",
+			Files: []file{
+				{Name: "dir/", Dir: true},
+				{Name: "dir/file.txt", ContentSize: 1024},
+				{Name: "file2.txt", ContentSize: 42},
+			},
+			ExpectedCount: 2,
+			ExpectedSize:  1066,
+		},
+		{
+			Name:  "empty-tar",
+			Files: []file{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var (
+				buf = bytes.NewBuffer(nil)
+				tw  = tar.NewWriter(buf)
+			)
+			for _, file := range test.Files {
+				hdr := &tar.Header{
+					Name:     file.Name,
+					Size:     file.ContentSize,
+					Mode:     0644,
+					Typeflag: tar.TypeReg,
+				}
+				if file.Dir {
+					hdr.Mode = 0755
+					hdr.Typeflag = tar.TypeDir
+				}
+				err := tw.WriteHeader(hdr)
+				if err != nil {
+					t.Fatalf("cannot prepare archive: %q", err)
+				}
+				_, err = tw.Write(make([]byte, file.ContentSize))
+				if err != nil {
+					t.Fatalf("cannot prepare archive: %q", err)
+				}
+			}
+			tw.Close()
+			archive := buf.Bytes()
+
+			manifest, err := ComputeManifest(bytes.NewReader(archive))
+			if err != nil {
+				t.Fatalf("cannot compute manifest: %v", err)
+			}
+			if manifest.FileCount != test.ExpectedCount {
+				t.Errorf("expected file count %d, got %d", test.ExpectedCount, manifest.FileCount)
+			}
+			if manifest.TotalSize != test.ExpectedSize {
+				t.Errorf("expected total size %d, got %d", test.ExpectedSize, manifest.TotalSize)
+			}
+			if manifest.ArchiveSize != int64(len(archive)) {
+				t.Errorf("expected archive size %d, got %d", len(archive), manifest.ArchiveSize)
+			}
+
+			again, err := ComputeManifest(bytes.NewReader(archive))
+			if err != nil {
+				t.Fatalf("cannot compute manifest: %v", err)
+			}
+			if mismatches := manifest.Compare(again); len(mismatches) > 0 {
+				t.Errorf("expected manifests of the same archive to match: %v", mismatches)
+			}
+
+			dst, err := os.MkdirTemp("", "")
+			if err != nil {
+				t.Fatalf("cannot prepare test: %v", err)
+			}
+			defer os.RemoveAll(dst)
+
+			err = ExtractTarbal(context.Background(), bytes.NewReader(archive), dst)
+			if err != nil {
+				t.Fatalf("cannot extract tar content: %v", err)
+			}
+			extracted, err := ComputeDirManifest(dst)
+			if err != nil {
+				t.Fatalf("cannot compute directory manifest: %v", err)
+			}
+			if mismatches := manifest.Compare(extracted); len(mismatches) > 0 {
+				t.Errorf("expected extracted content to match the manifest: %v", mismatches)
+			}
+
+			err = os.WriteFile(dst+"/unexpected.txt", []byte("foo"), 0644)
+			if err != nil {
+				t.Fatalf("cannot prepare test: %v", err)
+			}
+			extracted, err = ComputeDirManifest(dst)
+			if err != nil {
+				t.Fatalf("cannot compute directory manifest: %v", err)
+			}
+			if mismatches := manifest.Compare(extracted); len(mismatches) != 2 {
+				t.Errorf("expected file count and size mismatch, got %v", mismatches)
+			}
+		})
+	}
+}
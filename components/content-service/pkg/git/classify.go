@@ -0,0 +1,89 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package git
+
+import (
+	"errors"
+	"regexp"
+)
+
+// FailureKind classifies why a Git operation failed
+type FailureKind string
+
+const (
+	// FailureUnknown is a failure we could not classify
+	FailureUnknown FailureKind = "unknown"
+	// FailureNetwork is a transient failure talking to the remote, including server-side errors
+	FailureNetwork FailureKind = "network"
+	// FailureAuth means the remote refused our credentials
+	FailureAuth FailureKind = "auth"
+	// FailureNotFound means the remote or the repository does not exist, or is not visible to us
+	FailureNotFound FailureKind = "not-found"
+)
+
+// failurePatterns map the messages git and libcurl print on failure to failure kinds. Patterns are matched
+// against the output of git as is, and git does not localise these messages. Order matters: the first match wins.
+var failurePatterns = []struct {
+	Kind     FailureKind
+	Patterns []*regexp.Regexp
+}{
+	{
+		Kind: FailureAuth,
+		Patterns: []*regexp.Regexp{
+			regexp.MustCompile(`fatal: Authentication failed for '[^']*'`),
+			regexp.MustCompile(`fatal: could not read (Username|Password) for '[^']*'`),
+			regexp.MustCompile(`The requested URL returned error: 40[13]`),
+			regexp.MustCompile(`Permission denied \(publickey[^)]*\)`),
+		},
+	},
+	{
+		Kind: FailureNotFound,
+		Patterns: []*regexp.Regexp{
+			regexp.MustCompile(`fatal: repository '[^']*' not found`),
+			regexp.MustCompile(`The requested URL returned error: 404`),
+			regexp.MustCompile(`fatal: '[^']*' does not appear to be a git repository`),
+		},
+	},
+	{
+		Kind: FailureNetwork,
+		Patterns: []*regexp.Regexp{
+			regexp.MustCompile(`Could not resolve host: `),
+			regexp.MustCompile(`Failed to connect to .* port \d+`),
+			regexp.MustCompile(`Connection refused`),
+			regexp.MustCompile(`Connection reset by peer`),
+			regexp.MustCompile(`Connection timed out`),
+			regexp.MustCompile(`Operation timed out`),
+			regexp.MustCompile(`fatal: the remote end hung up unexpectedly`),
+			regexp.MustCompile(`fatal: early EOF`),
+			regexp.MustCompile(`error: RPC failed`),
+			regexp.MustCompile(`server certificate verification failed`),
+			regexp.MustCompile(`gnutls_handshake\(\) failed`),
+			regexp.MustCompile(`OpenSSL SSL_(connect|read): `),
+			regexp.MustCompile(`The requested URL returned error: (429|5\d\d)`),
+		},
+	},
+}
+
+// ClassifyError determines the kind of failure of a Git operation
+func ClassifyError(err error) FailureKind {
+	if err == nil {
+		return ""
+	}
+
+	msg := err.Error()
+	var opErr OpFailedError
+	if errors.As(err, &opErr) {
+		msg = opErr.Output
+	}
+
+	for _, fp := range failurePatterns {
+		for _, p := range fp.Patterns {
+			if p.MatchString(msg) {
+				return fp.Kind
+			}
+		}
+	}
+	return FailureUnknown
+}
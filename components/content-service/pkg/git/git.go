@@ -7,11 +7,14 @@ package git
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -99,8 +102,55 @@ type Client struct {
 
 	// FullClone indicates whether we should do a full checkout or a shallow clone
 	FullClone bool
+
+	// Filter produces a partial clone using `git clone --filter`, e.g. blob:none. Takes precedence over FullClone.
+	Filter string
+
+	// SparseCheckoutPatterns are the directories checked out in cone mode. If empty, the whole tree is checked out.
+	SparseCheckoutPatterns []string
+
+	// LFS determines how Git LFS content is handled during clone
+	LFS LFSMode
+	// LFSInclude and LFSExclude are globs restricting which LFS objects are downloaded
+	LFSInclude []string
+	LFSExclude []string
+
+	// Submodules determines which submodules are checked out by UpdateSubmodules
+	Submodules SubmoduleMode
+
+	// ReferenceRepository is a local repository Clone borrows objects from, if it's usable.
+	// The clone is dissociated from the reference afterwards.
+	ReferenceRepository string
 }
 
+// LFSMode determines how Git LFS content is handled during clone
+type LFSMode string
+
+const (
+	// LFSDefault leaves LFS to the Git installation, i.e. LFS content is checked out if git-lfs is installed
+	LFSDefault LFSMode = ""
+	// LFSSkip checks out LFS pointer files only
+	LFSSkip LFSMode = "skip"
+	// LFSFetch downloads LFS objects into the local LFS store, but checks out pointer files only
+	LFSFetch LFSMode = "fetch"
+	// LFSPull downloads LFS objects and checks them out
+	LFSPull LFSMode = "pull"
+)
+
+// SubmoduleMode determines which submodules are checked out
+type SubmoduleMode string
+
+const (
+	// SubmodulesRecursive checks out all submodules recursively
+	SubmodulesRecursive SubmoduleMode = ""
+	// SubmodulesNone does not check out any submodule
+	SubmodulesNone SubmoduleMode = "none"
+	// SubmodulesTopLevel checks out the submodules of the repository, but not their submodules
+	SubmodulesTopLevel SubmoduleMode = "top-level"
+	// SubmodulesShallow checks out all submodules recursively with a history depth of one
+	SubmodulesShallow SubmoduleMode = "shallow"
+)
+
 // Status describes the status of a Git repo/working copy akin to "git status"
 type Status struct {
 	porcelainStatus
@@ -184,6 +234,11 @@ func (c *Client) GitWithOutput(ctx context.Context, ignoreErr *string, subcomman
 
 	env = append(env, "HOME=/home/gitpod")
 
+	if c.LFS != LFSDefault {
+		// LFS content is downloaded explicitly by FetchLFS, if at all
+		env = append(env, "GIT_LFS_SKIP_SMUDGE=1")
+	}
+
 	fullArgs = append(fullArgs, subcommand)
 	fullArgs = append(fullArgs, args...)
 
@@ -368,7 +423,7 @@ func (c *Client) Clone(ctx context.Context) (err error) {
 	now := time.Now()
 
 	defer func() {
-		log.WithField("duration", time.Since(now).String()).WithField("FullClone", c.FullClone).Info("clone repository took")
+		log.WithField("duration", time.Since(now).String()).WithField("FullClone", c.FullClone).WithField("Filter", c.Filter).Info("clone repository took")
 	}()
 
 	args := []string{"--depth=1", "--shallow-submodules", c.RemoteURI}
@@ -377,11 +432,31 @@ func (c *Client) Clone(ctx context.Context) (err error) {
 		args = []string{c.RemoteURI}
 	}
 
+	if c.Filter != "" {
+		// a partial clone fetches missing objects on demand, which does not combine well with a shallow history
+		args = []string{"--filter=" + c.Filter, c.RemoteURI}
+	}
+
+	if len(c.SparseCheckoutPatterns) > 0 {
+		args = append(args, "--sparse")
+	}
+
+	if c.ReferenceRepository != "" {
+		args = append(args, "--reference-if-able", c.ReferenceRepository, "--dissociate")
+	}
+
 	for key, value := range c.Config {
 		args = append(args, "--config")
 		args = append(args, strings.TrimSpace(key)+"="+strings.TrimSpace(value))
 	}
 
+	if len(c.LFSInclude) > 0 {
+		args = append(args, "--config", "lfs.fetchinclude="+strings.Join(c.LFSInclude, ","))
+	}
+	if len(c.LFSExclude) > 0 {
+		args = append(args, "--config", "lfs.fetchexclude="+strings.Join(c.LFSExclude, ","))
+	}
+
 	// TODO: remove workaround once https://gitlab.com/gitlab-org/gitaly/-/issues/4248 is fixed
 	if strings.Contains(c.RemoteURI, "gitlab.com") {
 		args = append(args, "--config")
@@ -390,7 +465,47 @@ func (c *Client) Clone(ctx context.Context) (err error) {
 
 	args = append(args, ".")
 
-	return c.Git(ctx, "clone", args...)
+	err = c.Git(ctx, "clone", args...)
+	if err != nil {
+		return err
+	}
+
+	if len(c.SparseCheckoutPatterns) > 0 {
+		return c.SparseCheckout(ctx)
+	}
+	return nil
+}
+
+// SparseCheckout restricts the working copy to the sparse checkout patterns
+func (c *Client) SparseCheckout(ctx context.Context) (err error) {
+	//nolint:staticcheck,ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "sparseCheckout")
+	span.SetTag("patterns", strings.Join(c.SparseCheckoutPatterns, ","))
+	defer tracing.FinishSpan(span, &err)
+
+	args := append([]string{"set", "--cone"}, c.SparseCheckoutPatterns...)
+	return c.Git(ctx, "sparse-checkout", args...)
+}
+
+// ObjectSize returns the size of the object database in bytes, i.e. the amount of data fetched from the remote
+func (c *Client) ObjectSize(ctx context.Context) (size uint64, err error) {
+	out, err := c.GitWithOutput(ctx, nil, "count-objects", "-v")
+	if err != nil {
+		return 0, err
+	}
+
+	for _, line := range strings.Split(string(out), "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok || (key != "size" && key != "size-pack") {
+			continue
+		}
+		kib, err := strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return 0, xerrors.Errorf("cannot parse %s: %w", key, err)
+		}
+		size += kib * 1024
+	}
+	return size, nil
 }
 
 // UpdateRemote performs a git fetch on the upstream remote URI
@@ -420,10 +535,62 @@ func (c *Client) UpdateSubmodules(ctx context.Context) (err error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "updateSubmodules")
 	defer tracing.FinishSpan(span, &err)
 
+	span.SetTag("submodules", c.Submodules)
+
+	var args []string
+	switch c.Submodules {
+	case SubmodulesNone:
+		return nil
+	case SubmodulesTopLevel:
+		args = []string{"update", "--init"}
+	case SubmodulesShallow:
+		args = []string{"update", "--init", "--recursive", "--depth=1"}
+	default:
+		args = []string{"update", "--init", "--recursive"}
+	}
+
 	// checkout submodules
-	// git submodule update --init --recursive
-	if err := c.Git(ctx, "submodule", "update", "--init", "--recursive"); err != nil {
+	if err := c.Git(ctx, "submodule", args...); err != nil {
 		return err
 	}
 	return nil
 }
+
+// FetchLFS downloads the LFS objects of the checked out revision as configured by the LFS mode
+func (c *Client) FetchLFS(ctx context.Context) (err error) {
+	//nolint:staticcheck,ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "fetchLFS")
+	span.SetTag("lfs", c.LFS)
+	defer tracing.FinishSpan(span, &err)
+
+	switch c.LFS {
+	case LFSFetch:
+		return c.Git(ctx, "lfs", "fetch")
+	case LFSPull:
+		return c.Git(ctx, "lfs", "pull")
+	default:
+		return nil
+	}
+}
+
+// LFSSize returns the size of the local LFS store in bytes
+func (c *Client) LFSSize() (size uint64, err error) {
+	err = filepath.WalkDir(filepath.Join(c.Location, ".git", "lfs", "objects"), func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		nfo, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += uint64(nfo.Size())
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil
+	}
+	return size, err
+}
@@ -532,3 +532,210 @@ func initFromRemote(ctx context.Context, c *Client) error {
 
 	return nil
 }
+
+func TestPartialSparseClone(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	remote, err := newGitClient(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := remote.Git(ctx, "init", "--initial-branch=main"); err != nil {
+		t.Fatal(err)
+	}
+	for _, args := range [][]string{
+		{"user.email", "foo@bar.com"},
+		{"user.name", "foo bar"},
+		{"uploadpack.allowFilter", "true"},
+	} {
+		if err := remote.Git(ctx, "config", "--local", args[0], args[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, fn := range []string{"top-level", "included/file", "excluded/file"} {
+		if err := os.MkdirAll(filepath.Join(remote.Location, filepath.Dir(fn)), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(remote.Location, fn), []byte(fn), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := remote.Git(ctx, "add", "."); err != nil {
+		t.Fatal(err)
+	}
+	if err := remote.Git(ctx, "commit", "-m", "foo"); err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := newGitClient(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.RemoteURI = "file://" + remote.Location
+	client.Filter = "blob:none"
+	client.SparseCheckoutPatterns = []string{"included"}
+	if err := client.Clone(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	for fn, exists := range map[string]bool{
+		"top-level":     true,
+		"included/file": true,
+		"excluded/file": false,
+	} {
+		_, err := os.Stat(filepath.Join(client.Location, fn))
+		if exists && err != nil {
+			t.Errorf("expected %s to be checked out: %v", fn, err)
+		}
+		if !exists && !os.IsNotExist(err) {
+			t.Errorf("expected %s not to be checked out", fn)
+		}
+	}
+
+	out, err := client.GitWithOutput(ctx, nil, "config", "remote.origin.partialclonefilter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filter := strings.TrimSpace(string(out)); filter != client.Filter {
+		t.Errorf("unexpected partial clone filter: is %q but expected %q", filter, client.Filter)
+	}
+
+	status, err := client.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(status.UncommitedFiles) != 0 || len(status.UntrackedFiles) != 0 {
+		t.Errorf("expected clean working copy, got %+v", status)
+	}
+
+	size, err := client.ObjectSize(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size == 0 {
+		t.Error("expected fetched objects to have a size")
+	}
+}
+
+func TestLFSSize(t *testing.T) {
+	tests := []struct {
+		Name         string
+		Objects      map[string]int
+		ExpectedSize uint64
+	}{
+		{
+			Name:         "no LFS store",
+			ExpectedSize: 0,
+		},
+		{
+			Name: "objects",
+			Objects: map[string]int{
+				"ab/cd/abcd0001": 10,
+				"ab/ef/abef0002": 20,
+				"12/34/12340003": 30,
+			},
+			ExpectedSize: 60,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			client := &Client{Location: t.TempDir()}
+			for fn, size := range test.Objects {
+				fn = filepath.Join(client.Location, ".git", "lfs", "objects", fn)
+				if err := os.MkdirAll(filepath.Dir(fn), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(fn, make([]byte, size), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			size, err := client.LFSSize()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if size != test.ExpectedSize {
+				t.Errorf("unexpected LFS size: is %d but expected %d", size, test.ExpectedSize)
+			}
+		})
+	}
+}
+
+func TestCloneWithReference(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	origin, err := newGitClient(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := initFromRemote(ctx, origin); err != nil {
+		t.Fatal(err)
+	}
+
+	reference := filepath.Join(t.TempDir(), "reference")
+	if err := origin.Git(ctx, "clone", "--bare", origin.RemoteURI, reference); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Name      string
+		Reference string
+	}{
+		{Name: "usable reference", Reference: reference},
+		{Name: "missing reference", Reference: filepath.Join(t.TempDir(), "does-not-exist")},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			client, err := newGitClient(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			client.RemoteURI = "file://" + origin.RemoteURI
+			client.FullClone = true
+			client.ReferenceRepository = test.Reference
+			if err := client.Clone(ctx); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := os.Stat(filepath.Join(client.Location, ".git", "objects", "info", "alternates")); !os.IsNotExist(err) {
+				t.Errorf("expected clone to be dissociated from its reference")
+			}
+			if _, err := os.Stat(filepath.Join(client.Location, "first-file")); err != nil {
+				t.Errorf("expected first-file to be checked out: %v", err)
+			}
+		})
+	}
+}
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Err      error
+		Expected FailureKind
+	}{
+		{Name: "nil", Err: nil, Expected: ""},
+		{Name: "dns", Err: OpFailedError{Output: "fatal: unable to access 'https://github.com/foo/bar.git/': Could not resolve host: github.com"}, Expected: FailureNetwork},
+		{Name: "server error", Err: OpFailedError{Output: "fatal: unable to access 'https://github.com/foo/bar.git/': The requested URL returned error: 502"}, Expected: FailureNetwork},
+		{Name: "hung up", Err: OpFailedError{Output: "fatal: the remote end hung up unexpectedly"}, Expected: FailureNetwork},
+		{Name: "auth", Err: OpFailedError{Output: "remote: Invalid username or password.\nfatal: Authentication failed for 'https://github.com/foo/bar.git/'"}, Expected: FailureAuth},
+		{Name: "forbidden", Err: OpFailedError{Output: "fatal: unable to access 'https://github.com/foo/bar.git/': The requested URL returned error: 403"}, Expected: FailureAuth},
+		{Name: "not found", Err: OpFailedError{Output: "remote: Repository not found.\nfatal: repository 'https://github.com/foo/bar.git/' not found"}, Expected: FailureNotFound},
+		{Name: "no repository", Err: OpFailedError{Output: "fatal: '/tmp/foo' does not appear to be a git repository"}, Expected: FailureNotFound},
+		{Name: "wrapped", Err: xerrors.Errorf("clone: %w", OpFailedError{Output: "fatal: early EOF"}), Expected: FailureNetwork},
+		{Name: "ssh key", Err: OpFailedError{Output: "git@github.com: Permission denied (publickey).\nfatal: Could not read from remote repository."}, Expected: FailureAuth},
+		{Name: "certificate", Err: OpFailedError{Output: "fatal: unable to access 'https://github.com/foo/bar.git/': server certificate verification failed. CAfile: none CRLfile: none"}, Expected: FailureNetwork},
+		{Name: "file not found", Err: OpFailedError{Output: "error: pathspec 'not found' did not match any file(s) known to git"}, Expected: FailureUnknown},
+		{Name: "tls in a path", Err: OpFailedError{Output: "fatal: could not create work tree dir '/workspace/tls-tools': Permission denied"}, Expected: FailureUnknown},
+		{Name: "unknown", Err: OpFailedError{Output: "fatal: No space left on device"}, Expected: FailureUnknown},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			if act := ClassifyError(test.Err); act != test.Expected {
+				t.Errorf("unexpected failure kind: is %q but expected %q", act, test.Expected)
+			}
+		})
+	}
+}
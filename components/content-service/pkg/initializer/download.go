@@ -44,6 +44,11 @@ type fileDownloadInitializer struct {
 	RetryTimeout   time.Duration
 }
 
+// Destination returns the location the initializer writes to
+func (ws *fileDownloadInitializer) Destination() string {
+	return ws.TargetLocation
+}
+
 // Run initializes the workspace
 func (ws *fileDownloadInitializer) Run(ctx context.Context, mappings []archive.IDMapping) (src csapi.WorkspaceInitSource, metrics csapi.InitializerMetrics, err error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "FileDownloadInitializer.Run")
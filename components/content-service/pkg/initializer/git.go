@@ -26,6 +26,9 @@ import (
 	"github.com/gitpod-io/gitpod/content-service/pkg/git"
 )
 
+// defaultCloneRetryTimeout is the time we retry a clone by default
+const defaultCloneRetryTimeout = 5 * time.Minute
+
 // CloneTargetMode is the target state in which we want to leave a GitInitializer
 type CloneTargetMode string
 
@@ -55,6 +58,18 @@ type GitInitializer struct {
 
 	// If true, the Git initializer will chown(gitpod) after the clone
 	Chown bool
+
+	// Mirrors are clone URLs of the repository we fall back to if the remote is unreachable
+	Mirrors []string
+
+	// CloneRetryTimeout is the time we keep retrying a clone from the remote. Defaults to five minutes.
+	// If the remote is unreachable and there are mirrors, we try each of them once before retrying the remote.
+	CloneRetryTimeout time.Duration
+}
+
+// Destination returns the location the initializer writes to
+func (ws *GitInitializer) Destination() string {
+	return ws.Location
 }
 
 // Run initializes the workspace using Git
@@ -76,6 +91,101 @@ func (ws *GitInitializer) Run(ctx context.Context, mappings []archive.IDMapping)
 		return
 	}
 
+	var mirror string
+	if len(ws.Mirrors) > 0 {
+		// don't keep the user waiting on an unreachable remote if a mirror might be reachable
+		err = ws.cloneWithRetry(ctx, true)
+		if err != nil && git.ClassifyError(err) == git.FailureNetwork {
+			mirror, err = ws.cloneFromMirror(ctx, err)
+			if err != nil {
+				// none of the mirrors worked either - keep trying the remote, after cleaning up the last attempt
+				err = os.RemoveAll(ws.Location)
+				if err == nil {
+					err = ws.cloneWithRetry(ctx, false)
+				}
+			}
+		}
+	} else {
+		err = ws.cloneWithRetry(ctx, false)
+	}
+	if err != nil {
+		err = checkGitStatus(err)
+		return src, nil, xerrors.Errorf("git initializer gitClone: %w", err)
+	}
+
+	defer func() {
+		span.SetTag("Chown", ws.Chown)
+		if !ws.Chown {
+			return
+		}
+		// TODO (aledbf): refactor to remove the need of manual chown
+		args := []string{"-R", "-L", "gitpod", ws.Location}
+		cmd := exec.Command("chown", args...)
+		res, cerr := cmd.CombinedOutput()
+		if cerr != nil && !process.IsNotChildProcess(cerr) {
+			err = git.OpFailedError{
+				Args:       args,
+				ExecErr:    cerr,
+				Output:     string(res),
+				Subcommand: "chown",
+			}
+			return
+		}
+	}()
+
+	if mirror != "" {
+		err = ws.realizeCloneTargetFromMirror(ctx, mirror)
+	} else {
+		err = ws.realizeCloneTarget(ctx)
+	}
+	if err != nil {
+		return src, nil, xerrors.Errorf("git initializer clone: %w", err)
+	}
+	if err := ws.UpdateRemote(ctx); err != nil {
+		return src, nil, xerrors.Errorf("git initializer updateRemote: %w", err)
+	}
+	if err := ws.FetchLFS(ctx); err != nil {
+		log.WithError(err).Warn("error while fetching LFS objects - continuing")
+	}
+	submoduleStart := time.Now()
+	if err := ws.UpdateSubmodules(ctx); err != nil {
+		log.WithError(err).Warn("error while updating submodules - continuing")
+	}
+	submoduleDuration := time.Since(submoduleStart)
+
+	log.WithField("stage", "init").WithField("location", ws.Location).Debug("Git operations complete")
+
+	if fsErr == nil {
+		currentSize, fsErr := getFsUsage()
+		if fsErr != nil {
+			log.WithError(fsErr).Error("could not get disk usage")
+		}
+
+		fetched, err := ws.ObjectSize(ctx)
+		if err != nil {
+			log.WithError(err).WithField("location", ws.Location).Warn("cannot determine fetched bytes")
+		}
+		lfsBytes, err := ws.LFSSize()
+		if err != nil {
+			log.WithError(err).WithField("location", ws.Location).Warn("cannot determine LFS bytes")
+		}
+
+		stats = csapi.InitializerMetrics{csapi.InitializerMetric{
+			Type:              "git",
+			Duration:          time.Since(start),
+			Size:              currentSize - initialSize,
+			Filter:            ws.Filter,
+			FetchedBytes:      fetched,
+			LFSBytes:          lfsBytes,
+			SubmoduleDuration: submoduleDuration,
+		}}
+	}
+	return
+}
+
+// cloneWithRetry clones the repository, retrying failures which might be transient with an exponential backoff.
+// If failFastOnNetwork is true, network failures are not retried either.
+func (ws *GitInitializer) cloneWithRetry(ctx context.Context, failFastOnNetwork bool) error {
 	gitClone := func() error {
 		if err := os.MkdirAll(ws.Location, 0775); err != nil {
 			log.WithError(err).WithField("location", ws.Location).Error("cannot create directory")
@@ -89,25 +199,33 @@ func (ws *GitInitializer) Run(ctx context.Context, mappings []archive.IDMapping)
 			cmd := exec.Command("chown", args...)
 			res, cerr := cmd.CombinedOutput()
 			if cerr != nil && !process.IsNotChildProcess(cerr) {
-				err = git.OpFailedError{
+				return git.OpFailedError{
 					Args:       args,
 					ExecErr:    cerr,
 					Output:     string(res),
 					Subcommand: "chown",
 				}
-				return err
 			}
 		}
 
 		log.WithField("stage", "init").WithField("location", ws.Location).Debug("Running git clone on workspace")
-		err = ws.Clone(ctx)
+		err := ws.Clone(ctx)
 		if err != nil {
 			if strings.Contains(err.Error(), "Access denied") {
-				err = &backoff.PermanentError{
+				return &backoff.PermanentError{
 					Err: fmt.Errorf("Access denied. Please check that Gitpod was given permission to access the repository"),
 				}
 			}
 
+			// retrying won't fix wrong credentials or a missing repository
+			switch git.ClassifyError(err) {
+			case git.FailureAuth, git.FailureNotFound:
+				return &backoff.PermanentError{Err: err}
+			case git.FailureNetwork:
+				if failFastOnNetwork {
+					return &backoff.PermanentError{Err: err}
+				}
+			}
 			return err
 		}
 
@@ -147,57 +265,52 @@ func (ws *GitInitializer) Run(ctx context.Context, mappings []archive.IDMapping)
 	}
 
 	b := backoff.NewExponentialBackOff()
-	b.MaxElapsedTime = 5 * time.Minute
-	if err = backoff.RetryNotify(gitClone, b, onGitCloneFailure); err != nil {
-		err = checkGitStatus(err)
-		return src, nil, xerrors.Errorf("git initializer gitClone: %w", err)
+	b.MaxElapsedTime = ws.CloneRetryTimeout
+	if b.MaxElapsedTime == 0 {
+		b.MaxElapsedTime = defaultCloneRetryTimeout
 	}
+	return backoff.RetryNotify(gitClone, backoff.WithContext(b, ctx), onGitCloneFailure)
+}
 
-	defer func() {
-		span.SetTag("Chown", ws.Chown)
-		if !ws.Chown {
-			return
-		}
-		// TODO (aledbf): refactor to remove the need of manual chown
-		args := []string{"-R", "-L", "gitpod", ws.Location}
-		cmd := exec.Command("chown", args...)
-		res, cerr := cmd.CombinedOutput()
-		if cerr != nil && !process.IsNotChildProcess(cerr) {
-			err = git.OpFailedError{
-				Args:       args,
-				ExecErr:    cerr,
-				Output:     string(res),
-				Subcommand: "chown",
-			}
-			return
-		}
-	}()
-
-	if err := ws.realizeCloneTarget(ctx); err != nil {
-		return src, nil, xerrors.Errorf("git initializer clone: %w", err)
-	}
-	if err := ws.UpdateRemote(ctx); err != nil {
-		return src, nil, xerrors.Errorf("git initializer updateRemote: %w", err)
-	}
-	if err := ws.UpdateSubmodules(ctx); err != nil {
-		log.WithError(err).Warn("error while updating submodules - continuing")
-	}
+// cloneFromMirror tries to clone the repository from each of its mirrors once after cloning from the remote failed
+// with cause. Returns the mirror the repository was cloned from, or cause if none of them worked.
+func (ws *GitInitializer) cloneFromMirror(ctx context.Context, cause error) (mirror string, err error) {
+	for _, mirror := range ws.Mirrors {
+		log.WithError(cause).WithField("location", ws.Location).WithField("mirror", mirror).Warn("cannot clone from remote - trying mirror")
 
-	log.WithField("stage", "init").WithField("location", ws.Location).Debug("Git operations complete")
+		// a failed attempt may leave a partial clone behind
+		err = os.RemoveAll(ws.Location)
+		if err != nil {
+			return "", err
+		}
 
-	if fsErr == nil {
-		currentSize, fsErr := getFsUsage()
-		if fsErr != nil {
-			log.WithError(fsErr).Error("could not get disk usage")
+		err = ws.viaMirror(mirror).cloneWithRetry(ctx, true)
+		if err == nil {
+			return mirror, nil
 		}
+		log.WithError(err).WithField("location", ws.Location).WithField("mirror", mirror).Warn("cannot clone from mirror")
+	}
+	return "", cause
+}
 
-		stats = csapi.InitializerMetrics{csapi.InitializerMetric{
-			Type:     "git",
-			Duration: time.Since(start),
-			Size:     currentSize - initialSize,
-		}}
+// realizeCloneTargetFromMirror realizes the clone target using the mirror a repository was cloned from,
+// and points origin to the actual remote afterwards so that pushes and later fetches go there.
+func (ws *GitInitializer) realizeCloneTargetFromMirror(ctx context.Context, mirror string) error {
+	err := ws.viaMirror(mirror).realizeCloneTarget(ctx)
+	if err != nil {
+		return err
 	}
-	return
+	return ws.Git(ctx, "remote", "set-url", "origin", ws.RemoteURI)
+}
+
+// viaMirror returns a copy of the initializer which talks to mirror instead of the remote.
+// Mirrors never see the user's credentials.
+func (ws *GitInitializer) viaMirror(mirror string) *GitInitializer {
+	res := *ws
+	res.RemoteURI = mirror
+	res.AuthMethod = git.NoAuth
+	res.AuthProvider = nil
+	return &res
 }
 
 func (ws *GitInitializer) isShallowRepository(ctx context.Context) bool {
@@ -272,8 +385,12 @@ func (ws *GitInitializer) realizeCloneTarget(ctx context.Context) (err error) {
 	case RemoteCommit:
 		// We did a shallow clone before, hence need to fetch the commit we are about to check out.
 		// Because we don't want to make the "git fetch" mechanism in supervisor more complicated,
-		// we'll just fetch the 20 commits right away.
-		if err := ws.Git(ctx, "fetch", "origin", ws.CloneTarget, "--depth=20"); err != nil {
+		// we'll just fetch the 20 commits right away. A partial clone is never shallow and must stay that way.
+		fetchArgs := []string{"origin", ws.CloneTarget, "--depth=20"}
+		if ws.Filter != "" {
+			fetchArgs = []string{"origin", ws.CloneTarget}
+		}
+		if err := ws.Git(ctx, "fetch", fetchArgs...); err != nil {
 			return err
 		}
 
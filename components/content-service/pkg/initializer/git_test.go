@@ -0,0 +1,133 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package initializer
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/gitpod-io/gitpod/content-service/pkg/git"
+)
+
+func TestMirrorURLs(t *testing.T) {
+	mirrors := map[string][]string{
+		"github.com": {"https://gitea.internal/github/", "https://backup.internal/gh"},
+	}
+
+	tests := []struct {
+		Name     string
+		Remote   string
+		Expected []string
+	}{
+		{
+			Name:   "mirrored host",
+			Remote: "https://github.com/gitpod-io/gitpod.git",
+			Expected: []string{
+				"https://gitea.internal/github/gitpod-io/gitpod.git",
+				"https://backup.internal/gh/gitpod-io/gitpod.git",
+			},
+		},
+		{
+			Name:   "other host",
+			Remote: "https://gitlab.com/gitpod-io/gitpod.git",
+		},
+		{
+			Name:   "scp-like remote",
+			Remote: "git@github.com:gitpod-io/gitpod.git",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			if diff := cmp.Diff(test.Expected, mirrorURLs(test.Remote, mirrors)); diff != "" {
+				t.Errorf("unexpected mirror URLs (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGitInitializerMirrorFallback(t *testing.T) {
+	mirror := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "--quiet", "--initial-branch=main"},
+		{"add", "."},
+		{"-c", "user.name=foo", "-c", "user.email=foo@bar.com", "commit", "--quiet", "-m", "foo"},
+	} {
+		if args[0] == "add" {
+			if err := os.WriteFile(filepath.Join(mirror, "README.md"), []byte("hello world"), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		cmd := exec.Command("git", args...)
+		cmd.Dir = mirror
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %s: %v: %s", strings.Join(args, " "), err, out)
+		}
+	}
+
+	tests := []struct {
+		Name          string
+		Remote        string
+		ExpectedError bool
+	}{
+		{
+			Name:   "unreachable remote",
+			Remote: "http://127.0.0.1:1/gitpod-io/gitpod.git",
+		},
+		{
+			Name:          "missing repository",
+			Remote:        "file://" + filepath.Join(t.TempDir(), "does-not-exist"),
+			ExpectedError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ws := &GitInitializer{
+				Client: git.Client{
+					Location:   filepath.Join(t.TempDir(), "workspace"),
+					RemoteURI:  test.Remote,
+					AuthMethod: git.NoAuth,
+				},
+				TargetMode:        RemoteHead,
+				Mirrors:           []string{"file://" + mirror},
+				CloneRetryTimeout: time.Hour,
+			}
+
+			// the mirror must be tried right away rather than once we gave up retrying the remote
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			_, _, err := ws.Run(ctx, nil)
+			if test.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error, got nothing")
+				}
+				if _, err := os.Stat(filepath.Join(ws.Location, "README.md")); err == nil {
+					t.Error("expected no fallback to the mirror")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := os.Stat(filepath.Join(ws.Location, "README.md")); err != nil {
+				t.Errorf("expected README.md to be checked out from the mirror: %v", err)
+			}
+			out, err := ws.GitWithOutput(context.Background(), nil, "remote", "get-url", "origin")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if origin := strings.TrimSpace(string(out)); origin != test.Remote {
+				t.Errorf("unexpected origin: is %q but expected %q", origin, test.Remote)
+			}
+		})
+	}
+}
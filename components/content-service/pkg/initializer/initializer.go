@@ -12,6 +12,7 @@ import (
 	"io"
 	"io/fs"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
@@ -59,11 +60,17 @@ func (e *EmptyInitializer) Run(ctx context.Context, mappings []archive.IDMapping
 	return csapi.WorkspaceInitFromOther, nil, nil
 }
 
-// CompositeInitializer does nothing
-type CompositeInitializer []Initializer
+// CompositeInitializer runs a set of child initializers
+type CompositeInitializer struct {
+	Children []Initializer
+
+	// MaxParallelism is the number of located children with disjoint destinations which run concurrently.
+	// If smaller than two, children run sequentially.
+	MaxParallelism int
+}
 
 // Run calls run on all child initializers
-func (e CompositeInitializer) Run(ctx context.Context, mappings []archive.IDMapping) (_ csapi.WorkspaceInitSource, _ csapi.InitializerMetrics, err error) {
+func (e *CompositeInitializer) Run(ctx context.Context, mappings []archive.IDMapping) (_ csapi.WorkspaceInitSource, _ csapi.InitializerMetrics, err error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "CompositeInitializer.Run")
 	defer tracing.FinishSpan(span, &err)
 	start := time.Now()
@@ -72,13 +79,25 @@ func (e CompositeInitializer) Run(ctx context.Context, mappings []archive.IDMapp
 		log.WithError(fsErr).Error("could not get disk usage")
 	}
 
+	span.SetTag("maxParallelism", e.MaxParallelism)
+
+	// children report their metrics in order, no matter when they finished
+	var (
+		stats    = make([]csapi.InitializerMetrics, len(e.Children))
+		overlaps = destinationsOverlap(e.Children)
+	)
+	err = runConcurrently(ctx, len(e.Children), e.MaxParallelism, overlaps, func(ctx context.Context, i int) error {
+		_, s, err := e.Children[i].Run(ctx, mappings)
+		stats[i] = s
+		return err
+	})
+	if err != nil {
+		return csapi.WorkspaceInitFromOther, nil, err
+	}
+	dropConcurrentSizes(stats, e.MaxParallelism, overlaps)
 	total := []csapi.InitializerMetric{}
-	for _, init := range e {
-		_, stats, err := init.Run(ctx, mappings)
-		if err != nil {
-			return csapi.WorkspaceInitFromOther, nil, err
-		}
-		total = append(total, stats...)
+	for _, s := range stats {
+		total = append(total, s...)
 	}
 
 	if fsErr == nil {
@@ -104,6 +123,16 @@ type NewFromRequestOpts struct {
 	// Git content is forced to the Gitpod user. All other content (backup, prebuild, snapshot) will already
 	// have the correct user.
 	ForceGitpodUserForGit bool
+
+	// MaxParallelism is the number of child initializers of composite and prebuild initializers
+	// which may run concurrently. If smaller than two, children run sequentially.
+	MaxParallelism int
+
+	// GitReferences maps remote URIs to local reference repositories Git initializers borrow objects from
+	GitReferences map[string]string
+
+	// GitMirrors maps Git hosts to base URLs of mirrors Git initializers fall back to if the host is unreachable
+	GitMirrors map[string][]string
 }
 
 // NewFromRequest picks the initializer from the request but does not execute it.
@@ -126,13 +155,16 @@ func NewFromRequest(ctx context.Context, loc string, rs storage.DirectDownloader
 				return nil, err
 			}
 		}
-		initializer = CompositeInitializer(initializers)
+		initializer = &CompositeInitializer{
+			Children:       initializers,
+			MaxParallelism: opts.MaxParallelism,
+		}
 	} else if ir, ok := spec.(*csapi.WorkspaceInitializer_Git); ok {
 		if ir.Git == nil {
 			return nil, status.Error(codes.InvalidArgument, "missing Git initializer spec")
 		}
 
-		initializer, err = newGitInitializer(ctx, loc, ir.Git, opts.ForceGitpodUserForGit)
+		initializer, err = newGitInitializer(ctx, loc, ir.Git, opts)
 	} else if ir, ok := spec.(*csapi.WorkspaceInitializer_Prebuild); ok {
 		if ir.Prebuild == nil {
 			return nil, status.Error(codes.InvalidArgument, "missing prebuild initializer spec")
@@ -146,15 +178,16 @@ func NewFromRequest(ctx context.Context, loc string, rs storage.DirectDownloader
 		}
 		var gits []*GitInitializer
 		for _, gi := range ir.Prebuild.Git {
-			gitinit, err := newGitInitializer(ctx, loc, gi, opts.ForceGitpodUserForGit)
+			gitinit, err := newGitInitializer(ctx, loc, gi, opts)
 			if err != nil {
 				return nil, err
 			}
 			gits = append(gits, gitinit)
 		}
 		initializer = &PrebuildInitializer{
-			Prebuild: snapshot,
-			Git:      gits,
+			Prebuild:       snapshot,
+			Git:            gits,
+			MaxParallelism: opts.MaxParallelism,
 		}
 	} else if ir, ok := spec.(*csapi.WorkspaceInitializer_Snapshot); ok {
 		initializer, err = newSnapshotInitializer(loc, rs, ir.Snapshot)
@@ -162,6 +195,8 @@ func NewFromRequest(ctx context.Context, loc string, rs storage.DirectDownloader
 		initializer, err = newFileDownloadInitializer(loc, ir.Download)
 	} else if ir, ok := spec.(*csapi.WorkspaceInitializer_Backup); ok {
 		initializer, err = newFromBackupInitializer(loc, rs, ir.Backup)
+	} else if ir, ok := spec.(*csapi.WorkspaceInitializer_Oci); ok {
+		initializer, err = newOCIInitializer(loc, ir.Oci)
 	} else {
 		initializer = &EmptyInitializer{}
 	}
@@ -249,7 +284,7 @@ func (bi *fromBackupInitializer) Run(ctx context.Context, mappings []archive.IDM
 
 // newGitInitializer creates a Git initializer based on the request.
 // Returns gRPC errors.
-func newGitInitializer(ctx context.Context, loc string, req *csapi.GitInitializer, forceGitpodUser bool) (*GitInitializer, error) {
+func newGitInitializer(ctx context.Context, loc string, req *csapi.GitInitializer, opts NewFromRequestOpts) (*GitInitializer, error) {
 	if req.Config == nil {
 		return nil, status.Error(codes.InvalidArgument, "Git initializer misses config")
 	}
@@ -268,6 +303,40 @@ func newGitInitializer(ctx context.Context, loc string, req *csapi.GitInitialize
 		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid target mode: %v", req.TargetMode))
 	}
 
+	switch req.CloneFilter {
+	case "", "blob:none", "tree:0":
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unsupported clone filter: %s", req.CloneFilter))
+	}
+
+	var lfsMode git.LFSMode
+	switch req.GetConfig().GetLfs() {
+	case csapi.GitLFSMode_LFS_DEFAULT:
+		lfsMode = git.LFSDefault
+	case csapi.GitLFSMode_LFS_SKIP:
+		lfsMode = git.LFSSkip
+	case csapi.GitLFSMode_LFS_FETCH:
+		lfsMode = git.LFSFetch
+	case csapi.GitLFSMode_LFS_PULL:
+		lfsMode = git.LFSPull
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid LFS mode: %v", req.GetConfig().GetLfs()))
+	}
+
+	var submoduleMode git.SubmoduleMode
+	switch req.GetConfig().GetSubmodules() {
+	case csapi.GitSubmoduleMode_SUBMODULES_RECURSIVE:
+		submoduleMode = git.SubmodulesRecursive
+	case csapi.GitSubmoduleMode_SUBMODULES_NONE:
+		submoduleMode = git.SubmodulesNone
+	case csapi.GitSubmoduleMode_SUBMODULES_TOP_LEVEL:
+		submoduleMode = git.SubmodulesTopLevel
+	case csapi.GitSubmoduleMode_SUBMODULES_SHALLOW:
+		submoduleMode = git.SubmodulesShallow
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid submodule mode: %v", req.GetConfig().GetSubmodules()))
+	}
+
 	var authMethod = git.BasicAuth
 	if req.Config.Authentication == csapi.GitAuthMethod_NO_AUTH {
 		authMethod = git.NoAuth
@@ -303,15 +372,45 @@ func newGitInitializer(ctx context.Context, loc string, req *csapi.GitInitialize
 			Config:            req.Config.CustomConfig,
 			AuthMethod:        authMethod,
 			AuthProvider:      authProvider,
-			RunAsGitpodUser:   forceGitpodUser,
+			RunAsGitpodUser:   opts.ForceGitpodUserForGit,
 			FullClone:         req.FullClone,
+			Filter:            req.CloneFilter,
+
+			LFS:        lfsMode,
+			LFSInclude: req.GetConfig().GetLfsInclude(),
+			LFSExclude: req.GetConfig().GetLfsExclude(),
+			Submodules: submoduleMode,
+
+			ReferenceRepository: opts.GitReferences[req.RemoteUri],
+
+			SparseCheckoutPatterns: req.SparseCheckoutPatterns,
 		},
 		TargetMode:  targetMode,
 		CloneTarget: req.CloneTaget,
 		Chown:       false,
+		Mirrors:     mirrorURLs(req.RemoteUri, opts.GitMirrors),
 	}, nil
 }
 
+// mirrorURLs produces the mirror clone URLs of a remote from a set of mirror base URLs per host,
+// e.g. https://github.com/foo/bar.git with a mirror https://gitea.internal/github becomes
+// https://gitea.internal/github/foo/bar.git.
+func mirrorURLs(remoteURI string, mirrors map[string][]string) []string {
+	if len(mirrors) == 0 {
+		return nil
+	}
+	u, err := url.Parse(remoteURI)
+	if err != nil || u.Host == "" {
+		return nil
+	}
+
+	var res []string
+	for _, m := range mirrors[u.Host] {
+		res = append(res, strings.TrimSuffix(m, "/")+u.EscapedPath())
+	}
+	return res
+}
+
 func newSnapshotInitializer(loc string, rs storage.DirectDownloader, req *csapi.SnapshotInitializer) (*SnapshotInitializer, error) {
 	return &SnapshotInitializer{
 		Location:           loc,
@@ -6,8 +6,13 @@ package initializer_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
 
 	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
@@ -93,9 +98,242 @@ func TestCompositeInitializer(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
-			comp := initializer.CompositeInitializer(test.Children)
+			comp := &initializer.CompositeInitializer{Children: test.Children}
 			src, _, err := comp.Run(context.Background(), nil)
 			test.Eval(t, src, err, test.Children)
 		})
 	}
 }
+
+type LocatedInitializerFunc struct {
+	Dst string
+	F   InitializerFunc
+}
+
+func (f *LocatedInitializerFunc) Run(ctx context.Context, mappings []archive.IDMapping) (csapi.WorkspaceInitSource, csapi.InitializerMetrics, error) {
+	return f.F(ctx, mappings)
+}
+
+func (f *LocatedInitializerFunc) Destination() string {
+	return f.Dst
+}
+
+func TestCompositeInitializerParallel(t *testing.T) {
+	type Expectation struct {
+		MaxConcurrent int
+		Order         []string
+		Metrics       []string
+		Error         string
+	}
+
+	type Child struct {
+		Dst   string
+		Delay time.Duration
+		Error string
+	}
+
+	tests := []struct {
+		Name           string
+		MaxParallelism int
+		Children       []Child
+		Expectation    Expectation
+	}{
+		{
+			Name:           "sequential by default",
+			MaxParallelism: 0,
+			Children: []Child{
+				{Dst: "/workspace/a", Delay: 20 * time.Millisecond},
+				{Dst: "/workspace/b"},
+			},
+			Expectation: Expectation{
+				MaxConcurrent: 1,
+				Order:         []string{"/workspace/a", "/workspace/b"},
+				Metrics:       []string{"/workspace/a", "/workspace/b"},
+			},
+		},
+		{
+			Name:           "disjoint children run concurrently",
+			MaxParallelism: 3,
+			Children: []Child{
+				{Dst: "/workspace/a", Delay: 60 * time.Millisecond},
+				{Dst: "/workspace/b", Delay: 40 * time.Millisecond},
+				{Dst: "/workspace/c", Delay: 20 * time.Millisecond},
+			},
+			Expectation: Expectation{
+				MaxConcurrent: 3,
+				Order:         []string{"/workspace/c", "/workspace/b", "/workspace/a"},
+				Metrics:       []string{"/workspace/a", "/workspace/b", "/workspace/c"},
+			},
+		},
+		{
+			Name:           "limit",
+			MaxParallelism: 2,
+			Children: []Child{
+				{Dst: "/workspace/a", Delay: 20 * time.Millisecond},
+				{Dst: "/workspace/b", Delay: 20 * time.Millisecond},
+				{Dst: "/workspace/c", Delay: 20 * time.Millisecond},
+				{Dst: "/workspace/d", Delay: 20 * time.Millisecond},
+			},
+			Expectation: Expectation{
+				MaxConcurrent: 2,
+				Metrics:       []string{"/workspace/a", "/workspace/b", "/workspace/c", "/workspace/d"},
+			},
+		},
+		{
+			Name:           "overlapping children run in order",
+			MaxParallelism: 3,
+			Children: []Child{
+				{Dst: "/workspace/a", Delay: 40 * time.Millisecond},
+				{Dst: "/workspace/a/sub"},
+				{Dst: "/workspace/ab", Delay: 20 * time.Millisecond},
+			},
+			Expectation: Expectation{
+				MaxConcurrent: 2,
+				Order:         []string{"/workspace/ab", "/workspace/a", "/workspace/a/sub"},
+				Metrics:       []string{"/workspace/a", "/workspace/a/sub", "/workspace/ab"},
+			},
+		},
+		{
+			Name:           "unlocated children are barriers",
+			MaxParallelism: 3,
+			Children: []Child{
+				{Dst: "/workspace/a", Delay: 20 * time.Millisecond},
+				{Dst: ""},
+				{Dst: "/workspace/b"},
+			},
+			Expectation: Expectation{
+				MaxConcurrent: 1,
+				Order:         []string{"/workspace/a", "", "/workspace/b"},
+				Metrics:       []string{"/workspace/a", "", "/workspace/b"},
+			},
+		},
+		{
+			Name:           "lowest index error wins",
+			MaxParallelism: 3,
+			Children: []Child{
+				{Dst: "/workspace/a", Delay: 40 * time.Millisecond, Error: "first"},
+				{Dst: "/workspace/b", Delay: 20 * time.Millisecond, Error: "second"},
+				{Dst: "/workspace/b/sub"},
+			},
+			Expectation: Expectation{
+				MaxConcurrent: 2,
+				Order:         []string{"/workspace/b", "/workspace/a"},
+				Error:         "first",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var (
+				mu      sync.Mutex
+				running int
+				act     Expectation
+			)
+			children := make([]initializer.Initializer, len(test.Children))
+			for i, c := range test.Children {
+				c := c
+				f := InitializerFunc(func(ctx context.Context, mappings []archive.IDMapping) (csapi.WorkspaceInitSource, csapi.InitializerMetrics, error) {
+					mu.Lock()
+					running++
+					if running > act.MaxConcurrent {
+						act.MaxConcurrent = running
+					}
+					mu.Unlock()
+
+					time.Sleep(c.Delay)
+
+					mu.Lock()
+					running--
+					act.Order = append(act.Order, c.Dst)
+					mu.Unlock()
+
+					if c.Error != "" {
+						return csapi.WorkspaceInitFromOther, nil, errors.New(c.Error)
+					}
+					return csapi.WorkspaceInitFromOther, csapi.InitializerMetrics{{Type: c.Dst}}, nil
+				})
+				if c.Dst == "" {
+					children[i] = f
+				} else {
+					children[i] = &LocatedInitializerFunc{Dst: c.Dst, F: f}
+				}
+			}
+
+			comp := &initializer.CompositeInitializer{Children: children, MaxParallelism: test.MaxParallelism}
+			_, stats, err := comp.Run(context.Background(), nil)
+			if err != nil {
+				act.Error = err.Error()
+			}
+			for _, s := range stats {
+				if s.Type == "composite" {
+					continue
+				}
+				act.Metrics = append(act.Metrics, s.Type)
+			}
+			if test.Expectation.Order == nil {
+				act.Order = nil
+			}
+
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCompositeInitializerSizes(t *testing.T) {
+	child := func(dst string) initializer.Initializer {
+		return &LocatedInitializerFunc{Dst: dst, F: func(ctx context.Context, mappings []archive.IDMapping) (csapi.WorkspaceInitSource, csapi.InitializerMetrics, error) {
+			return csapi.WorkspaceInitFromOther, csapi.InitializerMetrics{{Type: dst, Size: 100}}, nil
+		}}
+	}
+
+	tests := []struct {
+		Name           string
+		MaxParallelism int
+		Dsts           []string
+		Expected       []uint64
+	}{
+		{
+			Name:     "sequential children keep their size",
+			Dsts:     []string{"/workspace/a", "/workspace/b"},
+			Expected: []uint64{100, 100},
+		},
+		{
+			Name:           "overlapping children keep their size",
+			MaxParallelism: 2,
+			Dsts:           []string{"/workspace/a", "/workspace/a/sub"},
+			Expected:       []uint64{100, 100},
+		},
+		{
+			Name:           "concurrent children drop their size",
+			MaxParallelism: 2,
+			Dsts:           []string{"/workspace/a", "/workspace/b"},
+			Expected:       []uint64{0, 0},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			children := make([]initializer.Initializer, len(test.Dsts))
+			for i, dst := range test.Dsts {
+				children[i] = child(dst)
+			}
+			comp := &initializer.CompositeInitializer{Children: children, MaxParallelism: test.MaxParallelism}
+			_, stats, err := comp.Run(context.Background(), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var act []uint64
+			for _, s := range stats {
+				if s.Type == "composite" {
+					continue
+				}
+				act = append(act, s.Size)
+			}
+			if diff := cmp.Diff(test.Expected, act); diff != "" {
+				t.Errorf("unexpected sizes (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package initializer
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/containerd/containerd/archive/compression"
+	"github.com/containerd/containerd/remotes"
+	dockerremote "github.com/containerd/containerd/remotes/docker"
+	"github.com/distribution/reference"
+	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+)
+
+const (
+	// maxOCIManifestSize is the maximum size of a manifest or index we're willing to read
+	maxOCIManifestSize = 4 * 1024 * 1024
+
+	// mediaTypeDockerManifestList is the Docker equivalent of an OCI image index
+	mediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+
+	// dockerHubDomain is the domain of normalized Docker Hub references
+	dockerHubDomain = "docker.io"
+	// dockerHubRegistryHost is the host the resolver talks to for Docker Hub references
+	dockerHubRegistryHost = "registry-1.docker.io"
+)
+
+// OCIInitializer pulls an OCI artifact from a registry and extracts its layers into the workspace
+type OCIInitializer struct {
+	// Ref is the normalized, digested reference of the artifact
+	Ref string
+
+	// Digest is the digest of the artifact manifest. All content is verified against it.
+	Digest digest.Digest
+
+	// TargetLocation is the absolute path the layers are extracted to
+	TargetLocation string
+
+	// Resolver resolves and fetches the artifact
+	Resolver remotes.Resolver
+}
+
+// newOCIInitializer creates an OCI initializer for a request
+func newOCIInitializer(loc string, req *csapi.OCIInitializer) (*OCIInitializer, error) {
+	if req.Ref == "" {
+		return nil, xerrors.Errorf("missing OCI artifact reference")
+	}
+	ref, err := reference.ParseDockerRef(req.Ref)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid OCI artifact reference %s: %w", req.Ref, err)
+	}
+
+	var dgst digest.Digest
+	if req.Digest != "" {
+		dgst, err = digest.Parse(req.Digest)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid digest %s: %w", req.Digest, err)
+		}
+	}
+	if digested, ok := ref.(reference.Digested); ok {
+		if dgst != "" && dgst != digested.Digest() {
+			return nil, xerrors.Errorf("digest %s does not match reference %s", dgst, req.Ref)
+		}
+		dgst = digested.Digest()
+	}
+	if dgst == "" {
+		return nil, xerrors.Errorf("OCI artifact %s must be pinned by digest", req.Ref)
+	}
+	ref, err = reference.WithDigest(reference.TrimNamed(ref), dgst)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot pin OCI artifact reference: %w", err)
+	}
+
+	resolver := dockerremote.NewResolver(dockerremote.ResolverOptions{
+		Hosts: dockerremote.ConfigureDefaultRegistries(
+			dockerremote.WithAuthorizer(dockerremote.NewDockerAuthorizer(dockerremote.WithAuthCreds(
+				ociRegistryCreds(reference.Domain(ref), req.AuthUser, req.AuthPassword),
+			))),
+		),
+	})
+
+	return &OCIInitializer{
+		Ref:            ref.String(),
+		Digest:         dgst,
+		TargetLocation: filepath.Join(loc, req.TargetLocation),
+		Resolver:       resolver,
+	}, nil
+}
+
+// ociRegistryCreds scopes the credentials of an OCI initializer to the registry of its artifact.
+// Any other host the resolver talks to, e.g. one a registry redirects to, never sees them.
+func ociRegistryCreds(registry, user, pwd string) func(host string) (string, string, error) {
+	if registry == dockerHubDomain {
+		registry = dockerHubRegistryHost
+	}
+	return func(host string) (string, string, error) {
+		if host != registry {
+			return "", "", nil
+		}
+		return user, pwd, nil
+	}
+}
+
+// OCIRegistryAuth provides the credentials to pull an OCI artifact with. Empty credentials mean none.
+type OCIRegistryAuth func(ctx context.Context, ref string) (user, pwd string, err error)
+
+// AddOCIRegistryAuth provides all OCI initializers which carry no credentials with those auth returns for their artifact
+func AddOCIRegistryAuth(ctx context.Context, init *csapi.WorkspaceInitializer, auth OCIRegistryAuth) error {
+	return csapi.WalkInitializer(nil, init, func(path []string, init *csapi.WorkspaceInitializer) error {
+		spec, ok := init.Spec.(*csapi.WorkspaceInitializer_Oci)
+		if !ok || spec.Oci.AuthUser != "" || spec.Oci.AuthPassword != "" {
+			return nil
+		}
+		if _, err := reference.ParseDockerRef(spec.Oci.Ref); err != nil {
+			// newOCIInitializer reports invalid references
+			return nil
+		}
+
+		user, pwd, err := auth(ctx, spec.Oci.Ref)
+		if err != nil {
+			return xerrors.Errorf("cannot get credentials for %s: %w", spec.Oci.Ref, err)
+		}
+		spec.Oci.AuthUser, spec.Oci.AuthPassword = user, pwd
+		return nil
+	})
+}
+
+// Destination returns the location the initializer writes to
+func (ws *OCIInitializer) Destination() string {
+	return ws.TargetLocation
+}
+
+// Run initializes the workspace
+func (ws *OCIInitializer) Run(ctx context.Context, mappings []archive.IDMapping) (src csapi.WorkspaceInitSource, metrics csapi.InitializerMetrics, err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "OCIInitializer.Run")
+	span.SetTag("ref", ws.Ref)
+	defer tracing.FinishSpan(span, &err)
+	start := time.Now()
+	initialSize, fsErr := getFsUsage()
+	if fsErr != nil {
+		log.WithError(fsErr).Error("could not get disk usage")
+	}
+
+	name, desc, err := ws.Resolver.Resolve(ctx, ws.Ref)
+	if err != nil {
+		return src, nil, xerrors.Errorf("cannot resolve OCI artifact %s: %w", ws.Ref, err)
+	}
+	if desc.Digest != ws.Digest {
+		return src, nil, xerrors.Errorf("OCI artifact %s resolved to %s instead of %s", ws.Ref, desc.Digest, ws.Digest)
+	}
+	fetcher, err := ws.Resolver.Fetcher(ctx, name)
+	if err != nil {
+		return src, nil, xerrors.Errorf("cannot fetch OCI artifact %s: %w", ws.Ref, err)
+	}
+
+	manifest, err := fetchManifest(ctx, fetcher, desc)
+	if err != nil {
+		return src, nil, err
+	}
+
+	err = os.MkdirAll(ws.TargetLocation, 0755)
+	if err != nil {
+		return src, nil, xerrors.Errorf("cannot create target location: %w", err)
+	}
+
+	var fetched uint64
+	for _, layer := range manifest.Layers {
+		err = extractLayer(ctx, fetcher, layer, ws.TargetLocation, mappings)
+		if err != nil {
+			return src, nil, xerrors.Errorf("cannot extract layer %s of %s: %w", layer.Digest, ws.Ref, err)
+		}
+		fetched += uint64(layer.Size)
+	}
+
+	if fsErr == nil {
+		currentSize, fsErr := getFsUsage()
+		if fsErr != nil {
+			log.WithError(fsErr).Error("could not get disk usage")
+		}
+
+		metrics = csapi.InitializerMetrics{csapi.InitializerMetric{
+			Type:         "oci",
+			Duration:     time.Since(start),
+			Size:         currentSize - initialSize,
+			FetchedBytes: fetched,
+		}}
+	}
+
+	src = csapi.WorkspaceInitFromOther
+	return
+}
+
+// fetchManifest fetches the manifest described by desc. If desc points to an index, the manifest
+// matching the current platform (or the first one without platform) is used.
+func fetchManifest(ctx context.Context, fetcher remotes.Fetcher, desc ociv1.Descriptor) (*ociv1.Manifest, error) {
+	buf, err := fetchVerified(ctx, fetcher, desc)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot fetch manifest %s: %w", desc.Digest, err)
+	}
+
+	switch desc.MediaType {
+	case ociv1.MediaTypeImageIndex, mediaTypeDockerManifestList:
+		var idx ociv1.Index
+		err = json.Unmarshal(buf, &idx)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot unmarshal index %s: %w", desc.Digest, err)
+		}
+		for _, m := range idx.Manifests {
+			if m.Platform != nil && (m.Platform.OS != runtime.GOOS || m.Platform.Architecture != runtime.GOARCH) {
+				continue
+			}
+			return fetchManifest(ctx, fetcher, m)
+		}
+		return nil, xerrors.Errorf("index %s has no manifest for %s/%s", desc.Digest, runtime.GOOS, runtime.GOARCH)
+	default:
+		var mf ociv1.Manifest
+		err = json.Unmarshal(buf, &mf)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot unmarshal manifest %s: %w", desc.Digest, err)
+		}
+		return &mf, nil
+	}
+}
+
+// fetchVerified reads a small blob, e.g. a manifest, and verifies its digest
+func fetchVerified(ctx context.Context, fetcher remotes.Fetcher, desc ociv1.Descriptor) ([]byte, error) {
+	if desc.Size > maxOCIManifestSize {
+		return nil, xerrors.Errorf("manifest exceeds %d bytes", maxOCIManifestSize)
+	}
+
+	rc, err := fetcher.Fetch(ctx, desc)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	buf, err := io.ReadAll(io.LimitReader(rc, maxOCIManifestSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if act := digest.FromBytes(buf); act != desc.Digest {
+		return nil, xerrors.Errorf("digest mismatch: expected %s, got %s", desc.Digest, act)
+	}
+	return buf, nil
+}
+
+// extractLayer extracts a tar layer to dst while verifying its digest
+func extractLayer(ctx context.Context, fetcher remotes.Fetcher, desc ociv1.Descriptor, dst string, mappings []archive.IDMapping) (err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "extractLayer")
+	span.SetTag("digest", desc.Digest)
+	span.SetTag("mediaType", desc.MediaType)
+	defer tracing.FinishSpan(span, &err)
+
+	if !isTarLayer(desc.MediaType) {
+		return xerrors.Errorf("unsupported layer media type %s", desc.MediaType)
+	}
+
+	rc, err := fetcher.Fetch(ctx, desc)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	verifier := desc.Digest.Verifier()
+	in := io.TeeReader(rc, verifier)
+
+	layer, err := compression.DecompressStream(in)
+	if err != nil {
+		return xerrors.Errorf("cannot decompress layer: %w", err)
+	}
+	defer layer.Close()
+
+	err = archive.ExtractTarbal(ctx, layer, dst, archive.WithUIDMapping(mappings), archive.WithGIDMapping(mappings))
+	if err != nil {
+		return err
+	}
+
+	// tar may stop reading before the end of the stream, e.g. at the end-of-archive marker
+	_, err = io.Copy(io.Discard, in)
+	if err != nil {
+		return err
+	}
+	if !verifier.Verified() {
+		return xerrors.Errorf("digest mismatch")
+	}
+	return nil
+}
+
+func isTarLayer(mediaType string) bool {
+	return strings.HasPrefix(mediaType, ociv1.MediaTypeImageLayer) ||
+		strings.HasPrefix(mediaType, ociv1.MediaTypeImageLayerNonDistributable) || //nolint:staticcheck
+		strings.HasPrefix(mediaType, "application/vnd.docker.image.rootfs.")
+}
@@ -0,0 +1,309 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package initializer
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/containerd/containerd/remotes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"golang.org/x/xerrors"
+
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+)
+
+type fakeRegistry struct {
+	remotes.Resolver
+
+	Manifest ociv1.Descriptor
+	Blobs    map[digest.Digest][]byte
+}
+
+func (r *fakeRegistry) Resolve(ctx context.Context, ref string) (name string, desc ociv1.Descriptor, err error) {
+	return ref, r.Manifest, nil
+}
+
+func (r *fakeRegistry) Fetcher(ctx context.Context, ref string) (remotes.Fetcher, error) {
+	return r, nil
+}
+
+func (r *fakeRegistry) Fetch(ctx context.Context, desc ociv1.Descriptor) (io.ReadCloser, error) {
+	blob, ok := r.Blobs[desc.Digest]
+	if !ok {
+		return nil, xerrors.Errorf("%s not found", desc.Digest)
+	}
+	return io.NopCloser(bytes.NewReader(blob)), nil
+}
+
+func TestOCIInitializer(t *testing.T) {
+	layer := func(files map[string]string) []byte {
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		tw := tar.NewWriter(gz)
+		for name, content := range files {
+			err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg})
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = tw.Write([]byte(content))
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := tw.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if err := gz.Close(); err != nil {
+			t.Fatal(err)
+		}
+		return buf.Bytes()
+	}
+	registry := func(mediaType string, layers ...[]byte) *fakeRegistry {
+		res := &fakeRegistry{Blobs: make(map[digest.Digest][]byte)}
+		mf := ociv1.Manifest{MediaType: ociv1.MediaTypeImageManifest}
+		mf.SchemaVersion = 2
+		for _, l := range layers {
+			dgst := digest.FromBytes(l)
+			res.Blobs[dgst] = l
+			mf.Layers = append(mf.Layers, ociv1.Descriptor{MediaType: mediaType, Digest: dgst, Size: int64(len(l))})
+		}
+		buf, err := json.Marshal(mf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Manifest = ociv1.Descriptor{MediaType: ociv1.MediaTypeImageManifest, Digest: digest.FromBytes(buf), Size: int64(len(buf))}
+		res.Blobs[res.Manifest.Digest] = buf
+		return res
+	}
+
+	tests := []struct {
+		Name          string
+		Registry      func() *fakeRegistry
+		Digest        func(r *fakeRegistry) digest.Digest
+		ExpectedFiles map[string]string
+		ExpectedError string
+	}{
+		{
+			Name: "happy path",
+			Registry: func() *fakeRegistry {
+				return registry(ociv1.MediaTypeImageLayerGzip,
+					layer(map[string]string{"sdk/bin/tool": "tool"}),
+					layer(map[string]string{"sdk/README": "readme"}),
+				)
+			},
+			ExpectedFiles: map[string]string{
+				"sdk/bin/tool": "tool",
+				"sdk/README":   "readme",
+			},
+		},
+		{
+			Name: "manifest digest mismatch",
+			Registry: func() *fakeRegistry {
+				return registry(ociv1.MediaTypeImageLayerGzip, layer(map[string]string{"foo": "bar"}))
+			},
+			Digest:        func(r *fakeRegistry) digest.Digest { return digest.FromString("something else") },
+			ExpectedError: "resolved to",
+		},
+		{
+			Name: "tampered manifest",
+			Registry: func() *fakeRegistry {
+				r := registry(ociv1.MediaTypeImageLayerGzip, layer(map[string]string{"foo": "bar"}))
+				r.Blobs[r.Manifest.Digest] = append(r.Blobs[r.Manifest.Digest], ' ')
+				return r
+			},
+			ExpectedError: "digest mismatch",
+		},
+		{
+			Name: "tampered layer",
+			Registry: func() *fakeRegistry {
+				l := layer(map[string]string{"foo": "bar"})
+				r := registry(ociv1.MediaTypeImageLayerGzip, l)
+				r.Blobs[digest.FromBytes(l)] = layer(map[string]string{"foo": "evil"})
+				return r
+			},
+			ExpectedError: "digest mismatch",
+		},
+		{
+			Name: "unsupported layer",
+			Registry: func() *fakeRegistry {
+				return registry("application/vnd.example.dataset+json", []byte("{}"))
+			},
+			ExpectedError: "unsupported layer media type",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			reg := test.Registry()
+			dgst := reg.Manifest.Digest
+			if test.Digest != nil {
+				dgst = test.Digest(reg)
+			}
+
+			dst := t.TempDir()
+			init := &OCIInitializer{
+				Ref:            "registry.example.com/sdk@" + dgst.String(),
+				Digest:         dgst,
+				TargetLocation: filepath.Join(dst, "vendor"),
+				Resolver:       reg,
+			}
+			_, _, err := init.Run(context.Background(), nil)
+			if test.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), test.ExpectedError) {
+					t.Fatalf("expected error containing %q, got %v", test.ExpectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for fn, content := range test.ExpectedFiles {
+				act, err := os.ReadFile(filepath.Join(init.TargetLocation, fn))
+				if err != nil {
+					t.Errorf("expected %s to exist: %v", fn, err)
+					continue
+				}
+				if string(act) != content {
+					t.Errorf("unexpected content of %s: is %q but expected %q", fn, string(act), content)
+				}
+			}
+		})
+	}
+}
+
+func TestNewOCIInitializer(t *testing.T) {
+	const dgst = "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+
+	tests := []struct {
+		Name          string
+		Request       *csapi.OCIInitializer
+		ExpectedRef   string
+		ExpectedError string
+	}{
+		{
+			Name:        "digest field",
+			Request:     &csapi.OCIInitializer{Ref: "registry.example.com/sdk:v1", Digest: dgst},
+			ExpectedRef: "registry.example.com/sdk@" + dgst,
+		},
+		{
+			Name:        "digested reference",
+			Request:     &csapi.OCIInitializer{Ref: "sdk@" + dgst},
+			ExpectedRef: "docker.io/library/sdk@" + dgst,
+		},
+		{
+			Name:          "not pinned",
+			Request:       &csapi.OCIInitializer{Ref: "registry.example.com/sdk:v1"},
+			ExpectedError: "must be pinned by digest",
+		},
+		{
+			Name:          "conflicting digest",
+			Request:       &csapi.OCIInitializer{Ref: "registry.example.com/sdk@" + dgst, Digest: "sha256:" + strings.Repeat("0", 64)},
+			ExpectedError: "does not match",
+		},
+		{
+			Name:          "missing reference",
+			Request:       &csapi.OCIInitializer{Digest: dgst},
+			ExpectedError: "missing OCI artifact reference",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			init, err := newOCIInitializer("/workspace", test.Request)
+			if test.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), test.ExpectedError) {
+					t.Fatalf("expected error containing %q, got %v", test.ExpectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if init.Ref != test.ExpectedRef {
+				t.Errorf("unexpected ref: is %s but expected %s", init.Ref, test.ExpectedRef)
+			}
+		})
+	}
+}
+
+func TestOCIRegistryCreds(t *testing.T) {
+	tests := []struct {
+		Name         string
+		Registry     string
+		Host         string
+		ExpectedUser string
+	}{
+		{Name: "artifact registry", Registry: "registry.example.com", Host: "registry.example.com", ExpectedUser: "user"},
+		{Name: "other host", Registry: "registry.example.com", Host: "storage.example.com"},
+		{Name: "docker hub", Registry: "docker.io", Host: "registry-1.docker.io", ExpectedUser: "user"},
+		{Name: "docker hub domain", Registry: "docker.io", Host: "docker.io"},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			user, pwd, err := ociRegistryCreds(test.Registry, "user", "pwd")(test.Host)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if user != test.ExpectedUser {
+				t.Errorf("unexpected user: is %q but expected %q", user, test.ExpectedUser)
+			}
+			if test.ExpectedUser == "" && pwd != "" {
+				t.Errorf("expected no password for %s", test.Host)
+			}
+		})
+	}
+}
+
+func TestAddOCIRegistryAuth(t *testing.T) {
+	const dgst = "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+
+	auth := func(ctx context.Context, ref string) (user, pwd string, err error) {
+		if strings.HasPrefix(ref, "registry.example.com/") {
+			return "example", "secret", nil
+		}
+		return "", "", nil
+	}
+
+	oci := func(ref, user, pwd string) *csapi.WorkspaceInitializer {
+		return &csapi.WorkspaceInitializer{Spec: &csapi.WorkspaceInitializer_Oci{Oci: &csapi.OCIInitializer{Ref: ref, Digest: dgst, AuthUser: user, AuthPassword: pwd}}}
+	}
+	init := &csapi.WorkspaceInitializer{Spec: &csapi.WorkspaceInitializer_Composite{Composite: &csapi.CompositeInitializer{
+		Initializer: []*csapi.WorkspaceInitializer{
+			oci("registry.example.com/sdk", "", ""),
+			oci("registry.example.com/tools", "own", "creds"),
+			oci("other.example.com/sdk", "", ""),
+		},
+	}}}
+	err := AddOCIRegistryAuth(context.Background(), init, auth)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type creds struct{ User, Password string }
+	var actual []creds
+	for _, c := range init.GetComposite().Initializer {
+		actual = append(actual, creds{c.GetOci().AuthUser, c.GetOci().AuthPassword})
+	}
+	expected := []creds{
+		{"example", "secret"},
+		{"own", "creds"},
+		{"", ""},
+	}
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("unexpected credentials (-want +got):\n%s", diff)
+	}
+}
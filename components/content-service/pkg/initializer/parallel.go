@@ -0,0 +1,142 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package initializer
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+)
+
+// LocatedInitializer is an initializer which only writes below a single location.
+// Composite initializers run located children whose destinations don't overlap concurrently.
+type LocatedInitializer interface {
+	Initializer
+
+	// Destination returns the absolute path the initializer writes to
+	Destination() string
+}
+
+// errDependencyFailed marks children which did not run because a child they depend on failed
+var errDependencyFailed = errors.New("not run because a preceding initializer failed")
+
+// runConcurrently runs n tasks with at most limit of them at the same time. A task only starts once all
+// preceding tasks it overlaps with have finished successfully. If limit is smaller than two, all tasks
+// overlap and run sequentially in order.
+//
+// The returned error is the one of the failed task with the lowest index, regardless of the order in
+// which tasks actually finished.
+func runConcurrently(ctx context.Context, n, limit int, overlaps func(i, j int) bool, run func(ctx context.Context, i int) error) error {
+	if limit < 2 {
+		limit = 1
+		overlaps = func(i, j int) bool { return true }
+	}
+
+	var (
+		done = make([]chan struct{}, n)
+		errs = make([]error, n)
+		sem  = make(chan struct{}, limit)
+		wg   sync.WaitGroup
+	)
+	for i := 0; i < n; i++ {
+		done[i] = make(chan struct{})
+
+		var deps []int
+		for j := 0; j < i; j++ {
+			if overlaps(j, i) {
+				deps = append(deps, j)
+			}
+		}
+
+		wg.Add(1)
+		go func(i int, deps []int) {
+			defer wg.Done()
+			defer close(done[i])
+
+			for _, d := range deps {
+				<-done[d]
+				if errs[d] != nil {
+					errs[i] = errDependencyFailed
+					return
+				}
+			}
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			errs[i] = run(ctx, i)
+		}(i, deps)
+	}
+	wg.Wait()
+
+	var res error
+	for i, err := range errs {
+		if err == nil || err == errDependencyFailed {
+			continue
+		}
+		if res == nil {
+			res = err
+			continue
+		}
+		log.WithError(err).WithField("index", i).Warn("initializer failed")
+	}
+	return res
+}
+
+// mayRunConcurrently returns true if runConcurrently could run any two of n tasks at the same time
+func mayRunConcurrently(n, limit int, overlaps func(i, j int) bool) bool {
+	if limit < 2 {
+		return false
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			if !overlaps(j, i) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// dropConcurrentSizes clears the sizes of the metrics of tasks which may have run concurrently. Initializers
+// measure their size as the change in filesystem usage, which includes whatever their siblings wrote in the
+// meantime. The size reported by the parent still covers all of them.
+func dropConcurrentSizes(stats []csapi.InitializerMetrics, limit int, overlaps func(i, j int) bool) {
+	if !mayRunConcurrently(len(stats), limit, overlaps) {
+		return
+	}
+	for _, s := range stats {
+		for i := range s {
+			s[i].Size = 0
+		}
+	}
+}
+
+// destinationsOverlap returns true if children i and j of a composite initializer could write to
+// the same location, i.e. if either of them isn't located or one's destination contains the other's.
+func destinationsOverlap(children []Initializer) func(i, j int) bool {
+	dsts := make([]string, len(children))
+	for i, c := range children {
+		if lc, ok := c.(LocatedInitializer); ok {
+			dsts[i] = filepath.Clean(lc.Destination())
+		}
+	}
+	return func(i, j int) bool {
+		return pathsOverlap(dsts[i], dsts[j])
+	}
+}
+
+// pathsOverlap returns true if a and b are equal or one contains the other. Empty paths overlap with everything.
+func pathsOverlap(a, b string) bool {
+	if a == "" || b == "" || a == b {
+		return true
+	}
+	return strings.HasPrefix(a, strings.TrimSuffix(b, string(filepath.Separator))+string(filepath.Separator)) ||
+		strings.HasPrefix(b, strings.TrimSuffix(a, string(filepath.Separator))+string(filepath.Separator))
+}
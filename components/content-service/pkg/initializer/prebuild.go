@@ -29,6 +29,9 @@ import (
 type PrebuildInitializer struct {
 	Git      []*GitInitializer
 	Prebuild *SnapshotInitializer
+
+	// MaxParallelism is the number of Git initializers with disjoint locations which run concurrently
+	MaxParallelism int
 }
 
 // Run runs the prebuild initializer
@@ -78,11 +81,17 @@ func (p *PrebuildInitializer) Run(ctx context.Context, mappings []archive.IDMapp
 				return csapi.WorkspaceInitFromOther, nil, xerrors.Errorf("prebuild initializer: %w", err)
 			}
 
-			for _, gi := range p.Git {
-				_, s, err := gi.Run(ctx, mappings)
-				if err != nil {
-					return csapi.WorkspaceInitFromOther, nil, xerrors.Errorf("prebuild initializer: Git fallback: %w", err)
-				}
+			gitStats := make([]csapi.InitializerMetrics, len(p.Git))
+			err = runConcurrently(ctx, len(p.Git), p.MaxParallelism, p.gitLocationsOverlap, func(ctx context.Context, i int) error {
+				_, s, err := p.Git[i].Run(ctx, mappings)
+				gitStats[i] = s
+				return err
+			})
+			if err != nil {
+				return csapi.WorkspaceInitFromOther, nil, xerrors.Errorf("prebuild initializer: Git fallback: %w", err)
+			}
+			dropConcurrentSizes(gitStats, p.MaxParallelism, p.gitLocationsOverlap)
+			for _, s := range gitStats {
 				stats = append(stats, s...)
 			}
 		}
@@ -94,13 +103,16 @@ func (p *PrebuildInitializer) Run(ctx context.Context, mappings []archive.IDMapp
 	src = csapi.WorkspaceInitFromPrebuild
 
 	// make sure we're on the correct branch
-	for _, gi := range p.Git {
-
-		commitChanged, err := runGitInit(ctx, gi)
-		if err != nil {
-			return src, nil, err
-		}
-		if commitChanged {
+	commitChanged := make([]bool, len(p.Git))
+	err = runConcurrently(ctx, len(p.Git), p.MaxParallelism, p.gitLocationsOverlap, func(ctx context.Context, i int) (err error) {
+		commitChanged[i], err = runGitInit(ctx, p.Git[i])
+		return err
+	})
+	if err != nil {
+		return src, nil, err
+	}
+	for _, changed := range commitChanged {
+		if changed {
 			// head commit has changed, so it's an outdated prebuild, which we treat as other
 			src = csapi.WorkspaceInitFromOther
 		}
@@ -123,6 +135,10 @@ func (p *PrebuildInitializer) Run(ctx context.Context, mappings []archive.IDMapp
 	return
 }
 
+func (p *PrebuildInitializer) gitLocationsOverlap(i, j int) bool {
+	return pathsOverlap(filepath.Clean(p.Git[i].Location), filepath.Clean(p.Git[j].Location))
+}
+
 func clearWorkspace(location string) error {
 	files, err := filepath.Glob(filepath.Join(location, "*"))
 	if err != nil {
@@ -241,6 +241,14 @@ func (*testStorage) ObjectExists(ctx context.Context, bucket string, path string
 	return false, nil
 }
 
+func (*testStorage) ListObjects(ctx context.Context, bucket string, prefix string) ([]storage.ObjectInfo, error) {
+	return nil, nil
+}
+
+func (*testStorage) ListOwners(ctx context.Context) ([]string, error) {
+	return nil, nil
+}
+
 type roundTripFunc func(req *http.Request) *http.Response
 
 // RoundTrip .
@@ -0,0 +1,65 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package retention
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricsNamespace = "gitpod"
+	metricsSubsystem = "content_service_retention"
+)
+
+type metrics struct {
+	reclaimedBytesTotal *prometheus.CounterVec
+	deletedObjectsTotal *prometheus.CounterVec
+	runsTotal           *prometheus.CounterVec
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		reclaimedBytesTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "reclaimed_bytes_total",
+			Help:      "Bytes deleted (or, in dry-run mode, which would have been deleted) by retention rules",
+		}, []string{"rule", "dry_run"}),
+		deletedObjectsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "deleted_objects_total",
+			Help:      "Objects deleted (or, in dry-run mode, which would have been deleted) by retention rules",
+		}, []string{"rule", "dry_run"}),
+		runsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "runs_total",
+			Help:      "Retention runs by outcome",
+		}, []string{"success"}),
+	}
+}
+
+func (m *metrics) register(reg prometheus.Registerer) error {
+	for _, c := range []prometheus.Collector{m.reclaimedBytesTotal, m.deletedObjectsTotal, m.runsTotal} {
+		err := reg.Register(c)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *metrics) deleted(d Deletion, dryRun bool) {
+	dr := strconv.FormatBool(dryRun)
+	m.reclaimedBytesTotal.WithLabelValues(string(d.Rule), dr).Add(float64(d.Size))
+	m.deletedObjectsTotal.WithLabelValues(string(d.Rule), dr).Inc()
+}
+
+func (m *metrics) run(success bool) {
+	m.runsTotal.WithLabelValues(strconv.FormatBool(success)).Inc()
+}
@@ -0,0 +1,23 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package retention
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	db "github.com/gitpod-io/gitpod/components/gitpod-db/go"
+)
+
+// DBReferenceChecker looks up the prebuilds and user snapshots which reference a snapshot in the database
+type DBReferenceChecker struct {
+	Conn *gorm.DB
+}
+
+// IsReferenced implements ReferenceChecker
+func (c *DBReferenceChecker) IsReferenced(ctx context.Context, snapshotURL string) (bool, error) {
+	return db.IsSnapshotReferenced(ctx, c.Conn, snapshotURL)
+}
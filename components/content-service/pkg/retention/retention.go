@@ -0,0 +1,369 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package retention
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	"github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/logs"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+)
+
+// Rule names a retention rule
+type Rule string
+
+const (
+	// RuleSnapshotAge deletes unreferenced snapshots once they exceed their maximum age
+	RuleSnapshotAge Rule = "snapshot-age"
+	// RuleHeadlessLogAge deletes headless logs once they exceed their maximum age
+	RuleHeadlessLogAge Rule = "headless-log-age"
+	// RuleOwnerQuota deletes the oldest deletable content of an owner who exceeds their quota
+	RuleOwnerQuota Rule = "owner-quota"
+)
+
+// Deletion is an object that is deleted by a retention rule
+type Deletion struct {
+	Owner  string
+	Bucket string
+	Object string
+	Size   int64
+	Rule   Rule
+}
+
+// Report summarises a retention run
+type Report struct {
+	DryRun         bool
+	Deletions      []Deletion
+	ReclaimedBytes int64
+}
+
+// ReferenceChecker tells whether a snapshot is still referenced, e.g. by a prebuild, and must be kept.
+// Snapshots are identified by their URL, i.e. <object>@<bucket>.
+type ReferenceChecker interface {
+	IsReferenced(ctx context.Context, snapshotURL string) (bool, error)
+}
+
+// Reconciler periodically enforces retention rules on the content in storage
+type Reconciler struct {
+	Config  config.RetentionConfig
+	Storage storage.PresignedAccess
+
+	// References is consulted before a snapshot is deleted
+	References ReferenceChecker
+
+	metrics *metrics
+}
+
+// NewReconciler creates a new retention reconciler. Rules which delete snapshots require a reference checker.
+func NewReconciler(cfg config.RetentionConfig, s storage.PresignedAccess, refs ReferenceChecker) (*Reconciler, error) {
+	if time.Duration(cfg.Interval) <= 0 {
+		return nil, xerrors.Errorf("retention interval must be positive")
+	}
+	if refs == nil && (cfg.SnapshotMaxAge > 0 || cfg.MaxBytesPerOwner > 0) {
+		return nil, xerrors.Errorf("snapshotMaxAge and maxBytesPerOwner require a reference checker")
+	}
+
+	return &Reconciler{
+		Config:     cfg,
+		Storage:    s,
+		References: refs,
+		metrics:    newMetrics(),
+	}, nil
+}
+
+// RegisterMetrics registers the metrics of this reconciler
+func (r *Reconciler) RegisterMetrics(reg prometheus.Registerer) error {
+	return r.metrics.register(reg)
+}
+
+// Start runs the reconciler until the context is canceled
+func (r *Reconciler) Start(ctx context.Context) {
+	t := time.NewTicker(time.Duration(r.Config.Interval))
+	defer t.Stop()
+
+	for {
+		_, err := r.Reconcile(ctx)
+		if err != nil {
+			log.WithError(err).Error("cannot enforce storage retention")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Reconcile enforces the retention rules once for all owners
+func (r *Reconciler) Reconcile(ctx context.Context) (report *Report, err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "retention.Reconcile")
+	span.SetTag("dryRun", r.Config.DryRun)
+	defer tracing.FinishSpan(span, &err)
+	defer func() {
+		r.metrics.run(err == nil)
+	}()
+
+	owners, err := r.Storage.ListOwners(ctx)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot list owners: %w", err)
+	}
+
+	report = &Report{DryRun: r.Config.DryRun}
+	var failed int
+	for _, owner := range owners {
+		dels, err := r.reconcileOwner(ctx, owner)
+		report.Deletions = append(report.Deletions, dels...)
+		for _, d := range dels {
+			report.ReclaimedBytes += d.Size
+		}
+		if err != nil {
+			log.WithError(err).WithField("owner", owner).Warn("cannot enforce storage retention for owner")
+			failed++
+		}
+	}
+
+	log.WithField("dryRun", report.DryRun).
+		WithField("owners", len(owners)).
+		WithField("deletedObjects", len(report.Deletions)).
+		WithField("reclaimedBytes", report.ReclaimedBytes).
+		Info("enforced storage retention")
+	if failed > 0 {
+		return report, xerrors.Errorf("cannot enforce storage retention for %d of %d owners", failed, len(owners))
+	}
+	return report, nil
+}
+
+func (r *Reconciler) reconcileOwner(ctx context.Context, owner string) (deleted []Deletion, err error) {
+	bucket := r.Storage.Bucket(owner)
+	root := workspacesRoot(r.Storage, owner)
+
+	objs, err := r.Storage.ListObjects(ctx, bucket, root)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot list objects: %w", err)
+	}
+
+	dels, err := r.plan(ctx, bucket, root, objs)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Config.MaxBytesPerOwner > 0 {
+		usage, err := r.Storage.DiskUsage(ctx, bucket, root)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot compute disk usage: %w", err)
+		}
+		for _, d := range dels {
+			usage -= d.Size
+		}
+		dels = append(dels, r.planQuota(ctx, owner, bucket, root, objs, dels, usage)...)
+	}
+
+	for _, d := range dels {
+		d.Owner = owner
+		d.Bucket = bucket
+		log.WithField("owner", owner).
+			WithField("bucket", bucket).
+			WithField("object", d.Object).
+			WithField("size", d.Size).
+			WithField("rule", d.Rule).
+			WithField("dryRun", r.Config.DryRun).
+			Info("deleting object due to retention rule")
+
+		if !r.Config.DryRun {
+			err = r.Storage.DeleteObject(ctx, bucket, &storage.DeleteObjectQuery{Name: d.Object})
+			if err != nil && !xerrors.Is(err, storage.ErrNotFound) {
+				return deleted, xerrors.Errorf("cannot delete %s: %w", d.Object, err)
+			}
+		}
+		r.metrics.deleted(d, r.Config.DryRun)
+		deleted = append(deleted, d)
+	}
+	return deleted, nil
+}
+
+// plan produces the deletions of all rules except the owner quota
+func (r *Reconciler) plan(ctx context.Context, bucket, root string, objs []storage.ObjectInfo) ([]Deletion, error) {
+	var (
+		now  = time.Now()
+		dels []Deletion
+	)
+	for _, ws := range classify(root, objs) {
+		if maxAge := time.Duration(r.Config.SnapshotMaxAge); maxAge > 0 {
+			for _, obj := range ws.Snapshots {
+				if now.Sub(obj.LastModified) <= maxAge {
+					continue
+				}
+				ref, err := r.isReferenced(ctx, bucket, obj)
+				if err != nil {
+					return nil, err
+				}
+				if ref {
+					continue
+				}
+				dels = append(dels, ws.snapshotDeletions(obj, RuleSnapshotAge)...)
+			}
+		}
+
+		if maxAge := time.Duration(r.Config.HeadlessLogMaxAge); maxAge > 0 {
+			for _, obj := range ws.HeadlessLogs {
+				if now.Sub(obj.LastModified) <= maxAge {
+					continue
+				}
+				dels = append(dels, Deletion{Object: obj.Name, Size: obj.Size, Rule: RuleHeadlessLogAge})
+			}
+		}
+	}
+	return dels, nil
+}
+
+// planQuota produces the deletions required to bring the usage of an owner below their quota,
+// oldest content first. Backups, referenced snapshots and objects already planned for deletion are not considered.
+// Deleting a snapshot deletes its manifest, too.
+func (r *Reconciler) planQuota(ctx context.Context, owner, bucket, root string, objs []storage.ObjectInfo, planned []Deletion, usage int64) []Deletion {
+	if usage <= r.Config.MaxBytesPerOwner {
+		return nil
+	}
+
+	skip := make(map[string]struct{}, len(planned))
+	for _, d := range planned {
+		skip[d.Object] = struct{}{}
+	}
+
+	var (
+		candidates []storage.ObjectInfo
+		content    = make(map[string]*workspaceContent)
+	)
+	for _, ws := range classify(root, objs) {
+		for _, obj := range ws.HeadlessLogs {
+			candidates = append(candidates, obj)
+			content[obj.Name] = ws
+		}
+		for _, obj := range ws.Snapshots {
+			ref, err := r.isReferenced(ctx, bucket, obj)
+			if err != nil {
+				log.WithError(err).WithField("owner", owner).WithField("object", obj.Name).Warn("cannot check snapshot references - keeping snapshot")
+				continue
+			}
+			if !ref {
+				candidates = append(candidates, obj)
+				content[obj.Name] = ws
+			}
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].LastModified.Before(candidates[j].LastModified) })
+
+	var dels []Deletion
+	for _, obj := range candidates {
+		if usage <= r.Config.MaxBytesPerOwner {
+			break
+		}
+		if _, ok := skip[obj.Name]; ok {
+			continue
+		}
+		for _, d := range content[obj.Name].snapshotDeletions(obj, RuleOwnerQuota) {
+			dels = append(dels, d)
+			usage -= d.Size
+		}
+	}
+	if usage > r.Config.MaxBytesPerOwner {
+		log.WithField("owner", owner).WithField("usage", usage).Warn("owner exceeds storage quota but has no more deletable content")
+	}
+	return dels
+}
+
+// isReferenced tells whether a snapshot must be kept. Without a reference checker all snapshots are kept.
+func (r *Reconciler) isReferenced(ctx context.Context, bucket string, obj storage.ObjectInfo) (bool, error) {
+	if r.References == nil {
+		return true, nil
+	}
+
+	ref, err := r.References.IsReferenced(ctx, fmt.Sprintf("%s@%s", obj.Name, bucket))
+	if err != nil {
+		return false, xerrors.Errorf("cannot check references of %s: %w", obj.Name, err)
+	}
+	return ref, nil
+}
+
+// workspaceContent is the deletable content of a workspace, each sorted newest first
+type workspaceContent struct {
+	Snapshots    []storage.ObjectInfo
+	HeadlessLogs []storage.ObjectInfo
+
+	// Manifests are the manifests uploaded alongside snapshots, by the name of their snapshot object
+	Manifests map[string]storage.ObjectInfo
+}
+
+// snapshotDeletions returns the deletion of an object and, if it is a snapshot, the deletion of its manifest
+func (ws *workspaceContent) snapshotDeletions(obj storage.ObjectInfo, rule Rule) []Deletion {
+	dels := []Deletion{{Object: obj.Name, Size: obj.Size, Rule: rule}}
+	if manifest, ok := ws.Manifests[obj.Name]; ok {
+		dels = append(dels, Deletion{Object: manifest.Name, Size: manifest.Size, Rule: rule})
+	}
+	return dels
+}
+
+// classify groups objects by workspace. Objects which no rule applies to are omitted. A workspace has a single
+// backup (storage.DefaultBackup) which every backup overwrites, hence there are no older backups to delete.
+func classify(root string, objs []storage.ObjectInfo) map[string]*workspaceContent {
+	res := make(map[string]*workspaceContent)
+	for _, obj := range objs {
+		wsID, name, ok := strings.Cut(strings.TrimPrefix(obj.Name, root), "/")
+		if !ok || wsID == "" {
+			continue
+		}
+		ws, exists := res[wsID]
+		if !exists {
+			ws = &workspaceContent{Manifests: make(map[string]storage.ObjectInfo)}
+			res[wsID] = ws
+		}
+
+		switch {
+		case strings.HasPrefix(name, "snapshot-") && !strings.Contains(name, "/"):
+			ws.Snapshots = append(ws.Snapshots, obj)
+		case strings.HasPrefix(name, "instances/") && strings.Contains(name, "/"+logs.UploadedHeadlessLogPathPrefix+"/"):
+			ws.HeadlessLogs = append(ws.HeadlessLogs, obj)
+		}
+	}
+
+	for _, ws := range res {
+		for _, l := range [][]storage.ObjectInfo{ws.Snapshots, ws.HeadlessLogs} {
+			sort.Slice(l, func(i, j int) bool { return l[i].LastModified.After(l[j].LastModified) })
+		}
+	}
+
+	// snapshots are uploaded with a manifest like any backup, e.g. wssnapshot-123.json for snapshot-123.tar
+	byName := make(map[string]storage.ObjectInfo, len(objs))
+	for _, obj := range objs {
+		byName[obj.Name] = obj
+	}
+	for _, ws := range res {
+		for _, snapshot := range ws.Snapshots {
+			manifest, ok := byName[path.Join(path.Dir(snapshot.Name), storage.BackupManifestName(path.Base(snapshot.Name)))]
+			if ok {
+				ws.Manifests[snapshot.Name] = manifest
+			}
+		}
+	}
+	return res
+}
+
+// workspacesRoot returns the prefix below which all workspace content of an owner is stored.
+// Storage implementations place it at different locations, hence we derive it from a backup object name.
+func workspacesRoot(s storage.PresignedAccess, owner string) string {
+	return path.Dir(path.Dir(s.BackupObject(owner, "_", "_"))) + "/"
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package retention
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+)
+
+type fakeStorage struct {
+	storage.PresignedAccess
+
+	Objects map[string]storage.ObjectInfo
+	Deleted []string
+}
+
+func (*fakeStorage) Bucket(owner string) string { return "bucket" }
+
+func (*fakeStorage) BackupObject(owner, workspaceID, name string) string {
+	return owner + "/workspaces/" + workspaceID + "/" + name
+}
+
+func (s *fakeStorage) ListOwners(ctx context.Context) ([]string, error) {
+	return []string{"owner"}, nil
+}
+
+func (s *fakeStorage) ListObjects(ctx context.Context, bucket, prefix string) (res []storage.ObjectInfo, err error) {
+	for _, obj := range s.Objects {
+		if strings.HasPrefix(obj.Name, prefix) {
+			res = append(res, obj)
+		}
+	}
+	return res, nil
+}
+
+func (s *fakeStorage) DiskUsage(ctx context.Context, bucket, prefix string) (size int64, err error) {
+	objs, _ := s.ListObjects(ctx, bucket, prefix)
+	for _, obj := range objs {
+		size += obj.Size
+	}
+	return size, nil
+}
+
+func (s *fakeStorage) DeleteObject(ctx context.Context, bucket string, query *storage.DeleteObjectQuery) error {
+	if _, ok := s.Objects[query.Name]; !ok {
+		return storage.ErrNotFound
+	}
+	delete(s.Objects, query.Name)
+	s.Deleted = append(s.Deleted, query.Name)
+	return nil
+}
+
+type referencedSnapshots []string
+
+func (r referencedSnapshots) IsReferenced(ctx context.Context, snapshotURL string) (bool, error) {
+	for _, s := range r {
+		if s == snapshotURL {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestReconcile(t *testing.T) {
+	var (
+		now = time.Now()
+		day = 24 * time.Hour
+	)
+	objects := func() map[string]storage.ObjectInfo {
+		res := make(map[string]storage.ObjectInfo)
+		for _, obj := range []storage.ObjectInfo{
+			{Name: "owner/workspaces/ws1/full.tar", Size: 100, LastModified: now},
+			{Name: "owner/workspaces/ws1/wsfull.json", Size: 1, LastModified: now},
+			{Name: "owner/workspaces/ws1/snapshot-3.tar", Size: 300, LastModified: now.Add(-20 * day)},
+			{Name: "owner/workspaces/ws1/wssnapshot-3.json", Size: 3, LastModified: now.Add(-20 * day)},
+			{Name: "owner/workspaces/ws1/snapshot-2.tar", Size: 200, LastModified: now.Add(-30 * day)},
+			{Name: "owner/workspaces/ws1/wssnapshot-2.json", Size: 2, LastModified: now.Add(-30 * day)},
+			{Name: "owner/workspaces/ws1/snapshot-1.tar", Size: 100, LastModified: now.Add(-40 * day)},
+			{Name: "owner/workspaces/ws1/instances/i1/logs/task1", Size: 5, LastModified: now.Add(-41 * day)},
+			{Name: "owner/workspaces/ws1/instances/i2/logs/task1", Size: 5, LastModified: now},
+			{Name: "owner/workspaces/ws2/snapshot-1.tar", Size: 1000, LastModified: now.Add(-90 * day)},
+			{Name: "owner/blobs/plugin", Size: 1, LastModified: now.Add(-90 * day)},
+		} {
+			res[obj.Name] = obj
+		}
+		return res
+	}
+
+	tests := []struct {
+		Name       string
+		Config     config.RetentionConfig
+		References ReferenceChecker
+		Expected   []string
+	}{
+		{
+			Name:   "no rules",
+			Config: config.RetentionConfig{},
+		},
+		{
+			Name:       "snapshot age deletes unreferenced snapshots",
+			Config:     config.RetentionConfig{SnapshotMaxAge: util.Duration(25 * day)},
+			References: referencedSnapshots{},
+			Expected: []string{
+				"owner/workspaces/ws1/snapshot-1.tar",
+				"owner/workspaces/ws1/snapshot-2.tar",
+				"owner/workspaces/ws1/wssnapshot-2.json",
+				"owner/workspaces/ws2/snapshot-1.tar",
+			},
+		},
+		{
+			Name:   "snapshot age keeps referenced snapshots regardless of their age",
+			Config: config.RetentionConfig{SnapshotMaxAge: util.Duration(25 * day)},
+			References: referencedSnapshots{
+				"owner/workspaces/ws1/snapshot-1.tar@bucket",
+				"owner/workspaces/ws2/snapshot-1.tar@bucket",
+			},
+			Expected: []string{
+				"owner/workspaces/ws1/snapshot-2.tar",
+				"owner/workspaces/ws1/wssnapshot-2.json",
+			},
+		},
+		{
+			Name:   "headless log age",
+			Config: config.RetentionConfig{HeadlessLogMaxAge: util.Duration(7 * day)},
+			Expected: []string{
+				"owner/workspaces/ws1/instances/i1/logs/task1",
+			},
+		},
+		{
+			Name:       "owner quota deletes oldest content first",
+			Config:     config.RetentionConfig{MaxBytesPerOwner: 1600},
+			References: referencedSnapshots{"owner/workspaces/ws2/snapshot-1.tar@bucket"},
+			Expected: []string{
+				"owner/workspaces/ws1/instances/i1/logs/task1",
+				"owner/workspaces/ws1/snapshot-1.tar",
+				"owner/workspaces/ws1/snapshot-2.tar",
+				"owner/workspaces/ws1/wssnapshot-2.json",
+			},
+		},
+		{
+			Name:       "owner quota counts other rules",
+			Config:     config.RetentionConfig{MaxBytesPerOwner: 1711, HeadlessLogMaxAge: util.Duration(7 * day)},
+			References: referencedSnapshots{"owner/workspaces/ws2/snapshot-1.tar@bucket"},
+			Expected: []string{
+				"owner/workspaces/ws1/instances/i1/logs/task1",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			for _, dryRun := range []bool{true, false} {
+				s := &fakeStorage{Objects: objects()}
+				cfg := test.Config
+				cfg.Interval = util.Duration(time.Hour)
+				cfg.DryRun = dryRun
+				r, err := NewReconciler(cfg, s, test.References)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				report, err := r.Reconcile(context.Background())
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var (
+					act       []string
+					reclaimed int64
+				)
+				for _, d := range report.Deletions {
+					act = append(act, d.Object)
+					reclaimed += d.Size
+				}
+				sort.Strings(act)
+				if diff := cmp.Diff(test.Expected, act); diff != "" {
+					t.Errorf("unexpected deletions (dryRun=%v, -want +got):\n%s", dryRun, diff)
+				}
+				if reclaimed != report.ReclaimedBytes {
+					t.Errorf("unexpected reclaimed bytes: is %d but expected %d", report.ReclaimedBytes, reclaimed)
+				}
+
+				var expectedDeleted []string
+				if !dryRun {
+					expectedDeleted = test.Expected
+				}
+				sort.Strings(s.Deleted)
+				if diff := cmp.Diff(expectedDeleted, s.Deleted); diff != "" {
+					t.Errorf("unexpected deleted objects (dryRun=%v, -want +got):\n%s", dryRun, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestNewReconcilerRequiresReferences(t *testing.T) {
+	for _, cfg := range []config.RetentionConfig{
+		{Interval: util.Duration(time.Hour), SnapshotMaxAge: util.Duration(time.Hour)},
+		{Interval: util.Duration(time.Hour), MaxBytesPerOwner: 1},
+	} {
+		_, err := NewReconciler(cfg, &fakeStorage{}, nil)
+		if err == nil {
+			t.Errorf("expected an error for %+v without a reference checker", cfg)
+		}
+	}
+
+	_, err := NewReconciler(config.RetentionConfig{Interval: util.Duration(time.Hour), HeadlessLogMaxAge: util.Duration(time.Hour)}, &fakeStorage{}, nil)
+	if err != nil {
+		t.Errorf("unexpected error for a config which does not delete snapshots: %v", err)
+	}
+}
@@ -59,7 +59,7 @@ func (cs *IDEPluginService) UploadURL(ctx context.Context, req *api.PluginUpload
 		}
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
-	return &api.PluginUploadURLResponse{Url: info.URL}, nil
+	return &api.PluginUploadURLResponse{Url: info.URL, Headers: info.Headers}, nil
 }
 
 // DownloadURL provides a URL from which clients can download the content via HTTP GET.
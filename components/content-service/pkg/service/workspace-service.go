@@ -59,8 +59,23 @@ func (cs *WorkspaceService) WorkspaceDownloadURL(ctx context.Context, req *api.W
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
 
+	// backups taken before manifests were introduced do not have one - that's ok
+	var manifestURL string
+	manifestName := cs.s.BackupObject(req.OwnerId, req.WorkspaceId, storage.BackupManifestName(storage.DefaultBackup))
+	manifest, err := cs.s.SignDownload(ctx, cs.s.Bucket(req.OwnerId), manifestName, &storage.SignedURLOptions{})
+	if err == nil {
+		manifestURL = manifest.URL
+	} else if !errors.Is(err, storage.ErrNotFound) {
+		log.WithFields(log.OWI(req.OwnerId, req.WorkspaceId, "")).
+			WithField("bucket", cs.s.Bucket(req.OwnerId)).
+			WithField("blobName", manifestName).
+			WithError(err).
+			Warn("error getting SignDownload URL for backup manifest")
+	}
+
 	return &api.WorkspaceDownloadURLResponse{
-		Url: info.URL,
+		Url:         info.URL,
+		ManifestUrl: manifestURL,
 	}, nil
 }
 
@@ -89,6 +104,14 @@ func (cs *WorkspaceService) DeleteWorkspace(ctx context.Context, req *api.Delete
 		return &api.DeleteWorkspaceResponse{}, nil
 	}
 
+	// we delete the manifest first: a backup without a manifest can still be restored
+	manifestName := cs.s.BackupObject(req.OwnerId, req.WorkspaceId, storage.BackupManifestName(storage.DefaultBackup))
+	err = cs.s.DeleteObject(ctx, cs.s.Bucket(req.OwnerId), &storage.DeleteObjectQuery{Name: manifestName})
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		log.WithError(err).Error("error deleting workspace backup manifest: ", manifestName)
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+
 	blobName := cs.s.BackupObject(req.OwnerId, req.WorkspaceId, storage.DefaultBackup)
 	err = cs.s.DeleteObject(ctx, cs.s.Bucket(req.OwnerId), &storage.DeleteObjectQuery{Name: blobName})
 	if err != nil {
@@ -0,0 +1,116 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+)
+
+type fakeStorage struct {
+	storage.PresignedAccess
+
+	Objects map[string]struct{}
+}
+
+func (*fakeStorage) Bucket(owner string) string { return "bucket" }
+
+func (*fakeStorage) BackupObject(owner, workspaceID, name string) string {
+	return "workspaces/" + workspaceID + "/" + name
+}
+
+func (s *fakeStorage) DeleteObject(ctx context.Context, bucket string, query *storage.DeleteObjectQuery) error {
+	var found bool
+	for name := range s.Objects {
+		if name == query.Name || (query.Prefix != "" && strings.HasPrefix(name, query.Prefix)) {
+			delete(s.Objects, name)
+			found = true
+		}
+	}
+	if !found {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+func TestDeleteWorkspace(t *testing.T) {
+	tests := []struct {
+		Name             string
+		Objects          []string
+		IncludeSnapshots bool
+		Expectation      []string
+	}{
+		{
+			Name: "backup and manifest",
+			Objects: []string{
+				"workspaces/ws1/full.tar",
+				"workspaces/ws1/wsfull.json",
+				"workspaces/ws1/snapshot-1.tar",
+				"workspaces/ws2/full.tar",
+				"workspaces/ws2/wsfull.json",
+			},
+			Expectation: []string{
+				"workspaces/ws1/snapshot-1.tar",
+				"workspaces/ws2/full.tar",
+				"workspaces/ws2/wsfull.json",
+			},
+		},
+		{
+			Name: "backup without manifest",
+			Objects: []string{
+				"workspaces/ws1/full.tar",
+				"workspaces/ws1/trail-1.tar",
+			},
+		},
+		{
+			Name: "including snapshots",
+			Objects: []string{
+				"workspaces/ws1/full.tar",
+				"workspaces/ws1/wsfull.json",
+				"workspaces/ws1/snapshot-1.tar",
+				"workspaces/ws2/full.tar",
+			},
+			IncludeSnapshots: true,
+			Expectation: []string{
+				"workspaces/ws2/full.tar",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			s := &fakeStorage{Objects: make(map[string]struct{})}
+			for _, obj := range test.Objects {
+				s.Objects[obj] = struct{}{}
+			}
+			svc := &WorkspaceService{s: s}
+
+			_, err := svc.DeleteWorkspace(context.Background(), &api.DeleteWorkspaceRequest{
+				OwnerId:          "owner",
+				WorkspaceId:      "ws1",
+				IncludeSnapshots: test.IncludeSnapshots,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var act []string
+			for obj := range s.Objects {
+				act = append(act, obj)
+			}
+			sort.Strings(act)
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected remaining objects (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,710 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blockblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/sas"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/service"
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	config "github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+)
+
+var _ DirectAccess = &DirectAzureStorage{}
+var _ PresignedAccess = &presignedAzureStorage{}
+
+// ValidateAzureConfig checks if the Azure Blob Storage config is valid
+func ValidateAzureConfig(c *config.AzureConfig) error {
+	return validation.ValidateStruct(c,
+		validation.Field(&c.AccountName, validation.Required),
+		validation.Field(&c.AccountKey, validation.Required),
+	)
+}
+
+// addAzureParamsFromMounts allows for the account key to be read from a file
+func addAzureParamsFromMounts(c *config.AzureConfig) error {
+	if c.AccountKeyFile != "" {
+		value, err := os.ReadFile(c.AccountKeyFile)
+		if err != nil {
+			return err
+		}
+		c.AccountKey = strings.TrimSpace(string(value))
+	}
+	return nil
+}
+
+// NewAzureClient produces a new Azure Blob Storage client based on this configuration
+func NewAzureClient(c *config.AzureConfig) (*service.Client, error) {
+	if c.ParallelUpload == 0 {
+		c.ParallelUpload = 1
+	}
+
+	err := addAzureParamsFromMounts(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// now that we have all the information complete, validate if we're good to go
+	err = ValidateAzureConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	cred, err := service.NewSharedKeyCredential(c.AccountName, c.AccountKey)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := c.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://%s.blob.core.windows.net/", c.AccountName)
+	}
+	return service.NewClientWithSharedKeyCredential(endpoint, cred, nil)
+}
+
+// newDirectAzureAccess provides direct access to the remote storage system
+func newDirectAzureAccess(cfg config.AzureConfig) (*DirectAzureStorage, error) {
+	err := addAzureParamsFromMounts(&cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = ValidateAzureConfig(&cfg); err != nil {
+		return nil, err
+	}
+	return &DirectAzureStorage{AzureConfig: cfg}, nil
+}
+
+// DirectAzureStorage implements Azure Blob Storage as remote storage backend
+type DirectAzureStorage struct {
+	Username      string
+	WorkspaceName string
+	InstanceID    string
+	AzureConfig   config.AzureConfig
+
+	client *service.Client
+
+	// ObjectAccess just exists so that we can swap out the stream access during testing
+	ObjectAccess func(ctx context.Context, btk, obj string) (io.ReadCloser, error)
+}
+
+// Validate checks if the Azure storage is configured properly
+func (rs *DirectAzureStorage) Validate() error {
+	err := ValidateAzureConfig(&rs.AzureConfig)
+	if err != nil {
+		return err
+	}
+
+	return validation.ValidateStruct(rs,
+		validation.Field(&rs.Username, validation.Required),
+		validation.Field(&rs.WorkspaceName, validation.Required),
+	)
+}
+
+// Init initializes the remote storage - call this before calling anything else on the interface
+func (rs *DirectAzureStorage) Init(ctx context.Context, owner, workspace, instance string) (err error) {
+	rs.Username = owner
+	rs.WorkspaceName = workspace
+	rs.InstanceID = instance
+
+	err = rs.Validate()
+	if err != nil {
+		return err
+	}
+
+	cl, err := NewAzureClient(&rs.AzureConfig)
+	if err != nil {
+		return err
+	}
+	rs.client = cl
+
+	if rs.ObjectAccess == nil {
+		rs.ObjectAccess = rs.defaultObjectAccess
+	}
+
+	return nil
+}
+
+func (rs *DirectAzureStorage) defaultObjectAccess(ctx context.Context, bkt, obj string) (io.ReadCloser, error) {
+	if rs.client == nil {
+		return nil, xerrors.Errorf("no Azure client available - did you call Init()?")
+	}
+
+	resp, err := rs.client.NewContainerClient(bkt).NewBlobClient(obj).DownloadStream(ctx, nil)
+	if err != nil {
+		return nil, translateAzureError(err)
+	}
+
+	return resp.Body, nil
+}
+
+// EnsureExists makes sure that the remote storage location exists and can be up- or downloaded from
+func (rs *DirectAzureStorage) EnsureExists(ctx context.Context) (err error) {
+	return azureEnsureExists(ctx, rs.client, rs.bucketName())
+}
+
+func azureEnsureExists(ctx context.Context, client *service.Client, containerName string) (err error) {
+	//nolint:staticcheck,ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "azure.EnsureExists")
+	defer tracing.FinishSpan(span, &err)
+
+	if client == nil {
+		return xerrors.Errorf("no Azure client available - did you call Init()?")
+	}
+
+	_, err = client.NewContainerClient(containerName).GetProperties(ctx, nil)
+	if err == nil {
+		// container exists already - we're fine
+		return nil
+	}
+	if !errors.Is(translateAzureError(err), ErrNotFound) {
+		return err
+	}
+
+	log.WithField("containerName", containerName).Debug("Creating container")
+	_, err = client.CreateContainer(ctx, containerName, nil)
+	if bloberror.HasCode(err, bloberror.ContainerAlreadyExists) {
+		// Looks like we had a container creation race and lost.
+		// That's ok - at least the container exists now.
+		return nil
+	}
+	if err != nil {
+		return xerrors.Errorf("cannot create container: %w", err)
+	}
+
+	return nil
+}
+
+func (rs *DirectAzureStorage) download(ctx context.Context, destination string, bkt string, obj string, mappings []archive.IDMapping) (found bool, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "download")
+	span.SetTag("bucket", bkt)
+	span.SetTag("object", obj)
+	defer tracing.FinishSpan(span, &err)
+
+	rc, err := rs.ObjectAccess(ctx, bkt, obj)
+	if rc == nil {
+		if errors.Is(err, ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	defer rc.Close()
+
+	err = extractTarbal(ctx, destination, rc, mappings)
+	if err != nil {
+		return true, err
+	}
+
+	return true, nil
+}
+
+// Download takes the latest state from the remote storage and downloads it to a local path
+func (rs *DirectAzureStorage) Download(ctx context.Context, destination string, name string, mappings []archive.IDMapping) (bool, error) {
+	return rs.download(ctx, destination, rs.bucketName(), rs.objectName(name), mappings)
+}
+
+// DownloadSnapshot downloads a snapshot. The snapshot name is expected to be one produced by Qualify
+func (rs *DirectAzureStorage) DownloadSnapshot(ctx context.Context, destination string, name string, mappings []archive.IDMapping) (bool, error) {
+	bkt, obj, err := ParseSnapshotName(name)
+	if err != nil {
+		return false, err
+	}
+
+	return rs.download(ctx, destination, bkt, obj, mappings)
+}
+
+// ListObjects returns all objects found with the given prefix. Returns an empty list if the bucket does not exuist (yet).
+func (rs *DirectAzureStorage) ListObjects(ctx context.Context, prefix string) (objects []string, err error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	err = azureListBlobs(ctx, rs.client.NewContainerClient(rs.bucketName()), prefix, func(item *container.BlobItem) {
+		objects = append(objects, *item.Name)
+	})
+	if errors.Is(err, ErrNotFound) {
+		// container does not exist: nothing to list
+		return nil, nil
+	}
+	if err != nil {
+		return nil, xerrors.Errorf("cannot list objects: %w", err)
+	}
+	return objects, nil
+}
+
+// Qualify fully qualifies a snapshot name so that it can be downloaded using DownloadSnapshot
+func (rs *DirectAzureStorage) Qualify(name string) string {
+	return fmt.Sprintf("%s@%s", rs.objectName(name), rs.bucketName())
+}
+
+// UploadInstance takes all files from a local location and uploads it to the per-instance remote storage
+func (rs *DirectAzureStorage) UploadInstance(ctx context.Context, source string, name string, opts ...UploadOption) (bucket, object string, err error) {
+	if rs.InstanceID == "" {
+		return "", "", xerrors.Errorf("instanceID is required to comput object name")
+	}
+	return rs.Upload(ctx, source, InstanceObjectName(rs.InstanceID, name), opts...)
+}
+
+// Upload takes all files from a local location and uploads it to the remote storage
+func (rs *DirectAzureStorage) Upload(ctx context.Context, source string, name string, opts ...UploadOption) (bucket, obj string, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DirectUpload")
+	defer tracing.FinishSpan(span, &err)
+
+	options, err := GetUploadOptions(opts)
+	if err != nil {
+		err = xerrors.Errorf("cannot get options: %w", err)
+		return
+	}
+
+	if rs.client == nil {
+		err = xerrors.Errorf("no Azure client available - did you call Init()?")
+		return
+	}
+
+	f, err := os.Open(source)
+	if err != nil {
+		err = xerrors.Errorf("cannot open file for uploading: %w", err)
+		return
+	}
+	defer f.Close()
+
+	bucket = rs.bucketName()
+	obj = rs.objectName(name)
+	span.LogKV("bucket", bucket)
+	span.LogKV("obj", obj)
+	span.LogKV("account", rs.AzureConfig.AccountName)
+
+	err = azureEnsureExists(ctx, rs.client, bucket)
+	if err != nil {
+		return
+	}
+
+	var headers *blob.HTTPHeaders
+	if options.ContentType != "" {
+		headers = &blob.HTTPHeaders{BlobContentType: &options.ContentType}
+	}
+	_, err = rs.client.NewContainerClient(bucket).NewBlockBlobClient(obj).UploadFile(ctx, f, &blockblob.UploadFileOptions{
+		Concurrency: uint16(rs.AzureConfig.ParallelUpload),
+		Metadata:    annotationsToAzureMetadata(options.Annotations),
+		HTTPHeaders: headers,
+	})
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// ObjectSize returns the size of an object uploaded using Upload
+func (rs *DirectAzureStorage) ObjectSize(ctx context.Context, name string) (size int64, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DirectObjectSize")
+	defer tracing.FinishSpan(span, &err)
+
+	if rs.client == nil {
+		return 0, xerrors.Errorf("no Azure client available - did you call Init()?")
+	}
+
+	props, err := rs.client.NewContainerClient(rs.bucketName()).NewBlobClient(rs.objectName(name)).GetProperties(ctx, nil)
+	if err != nil {
+		return 0, translateAzureError(err)
+	}
+	if props.ContentLength == nil {
+		return 0, nil
+	}
+
+	return *props.ContentLength, nil
+}
+
+func azureContainerName(ownerID, containerName string) string {
+	if containerName != "" {
+		return containerName
+	}
+
+	return fmt.Sprintf("gitpod-user-%s", ownerID)
+}
+
+func azureWorkspaceBackupObjectName(ownerID, workspaceID, name string) string {
+	return path.Join(ownerID, "workspaces", workspaceID, name)
+}
+
+// Bucket provides the bucket name for a particular user
+func (rs *DirectAzureStorage) Bucket(ownerID string) string {
+	return azureContainerName(ownerID, rs.AzureConfig.ContainerName)
+}
+
+// BackupObject returns a backup's object name that a direct downloader would download
+func (rs *DirectAzureStorage) BackupObject(name string) string {
+	return rs.objectName(name)
+}
+
+func (rs *DirectAzureStorage) bucketName() string {
+	return azureContainerName(rs.Username, rs.AzureConfig.ContainerName)
+}
+
+func (rs *DirectAzureStorage) objectName(name string) string {
+	var username string
+	if rs.AzureConfig.ContainerName != "" {
+		username = rs.Username
+	}
+	return azureWorkspaceBackupObjectName(username, rs.WorkspaceName, name)
+}
+
+func newPresignedAzureAccess(cfg config.AzureConfig) (*presignedAzureStorage, error) {
+	cl, err := NewAzureClient(&cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &presignedAzureStorage{client: cl, AzureConfig: cfg}, nil
+}
+
+type presignedAzureStorage struct {
+	client      *service.Client
+	AzureConfig config.AzureConfig
+}
+
+// EnsureExists makes sure that the remote storage location exists and can be up- or downloaded from
+func (s *presignedAzureStorage) EnsureExists(ctx context.Context, bucket string) (err error) {
+	return azureEnsureExists(ctx, s.client, bucket)
+}
+
+// DiskUsage gives the total objects size of objects that have the given prefix
+func (s *presignedAzureStorage) DiskUsage(ctx context.Context, bucket string, prefix string) (size int64, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "azure.DiskUsage")
+	defer tracing.FinishSpan(span, &err)
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	var total int64
+	err = azureListBlobs(ctx, s.client.NewContainerClient(bucket), prefix, func(item *container.BlobItem) {
+		if item.Properties != nil && item.Properties.ContentLength != nil {
+			total += *item.Properties.ContentLength
+		}
+	})
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// SignDownload describes an object for download - if the object is not found, ErrNotFound is returned
+func (s *presignedAzureStorage) SignDownload(ctx context.Context, bucket, object string, options *SignedURLOptions) (info *DownloadInfo, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "azure.SignDownload")
+	defer func() {
+		if err == ErrNotFound {
+			span.LogKV("found", false)
+			tracing.FinishSpan(span, nil)
+			return
+		}
+
+		tracing.FinishSpan(span, &err)
+	}()
+
+	blb := s.client.NewContainerClient(bucket).NewBlobClient(object)
+	props, err := blb.GetProperties(ctx, nil)
+	if err != nil {
+		return nil, translateAzureError(err)
+	}
+	url, err := blb.GetSASURL(sas.BlobPermissions{Read: true}, time.Now().Add(30*time.Minute), nil)
+	if err != nil {
+		return nil, translateAzureError(err)
+	}
+
+	var (
+		contentType string
+		size        int64
+	)
+	if props.ContentType != nil {
+		contentType = *props.ContentType
+	}
+	if props.ContentLength != nil {
+		size = *props.ContentLength
+	}
+
+	return &DownloadInfo{
+		Meta: ObjectMeta{
+			ContentType:        contentType,
+			OCIMediaType:       azureMetadataAnnotation(props.Metadata, ObjectAnnotationOCIContentType),
+			Digest:             azureMetadataAnnotation(props.Metadata, ObjectAnnotationDigest),
+			UncompressedDigest: azureMetadataAnnotation(props.Metadata, ObjectAnnotationUncompressedDigest),
+		},
+		Size: size,
+		URL:  url,
+	}, nil
+}
+
+// SignUpload describes an object for upload. Azure requires clients to send the blob type
+// when uploading to the returned URL, hence the header is part of the upload info.
+func (s *presignedAzureStorage) SignUpload(ctx context.Context, bucket, obj string, options *SignedURLOptions) (info *UploadInfo, err error) {
+	//nolint:ineffassign,staticcheck
+	span, ctx := opentracing.StartSpanFromContext(ctx, "azure.SignUpload")
+	defer tracing.FinishSpan(span, &err)
+
+	url, err := s.client.NewContainerClient(bucket).NewBlobClient(obj).GetSASURL(sas.BlobPermissions{Create: true, Write: true}, time.Now().Add(30*time.Minute), nil)
+	if err != nil {
+		return nil, translateAzureError(err)
+	}
+	return &UploadInfo{
+		URL: url,
+		Headers: map[string]string{
+			"x-ms-blob-type": string(blob.BlobTypeBlockBlob),
+		},
+	}, nil
+}
+
+// DeleteObject deletes objects in the given bucket specified by the given query
+func (s *presignedAzureStorage) DeleteObject(ctx context.Context, bucket string, query *DeleteObjectQuery) (err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "azure.DeleteObject")
+	defer tracing.FinishSpan(span, &err)
+
+	cnt := s.client.NewContainerClient(bucket)
+	if query.Name != "" {
+		_, err = cnt.NewBlobClient(query.Name).Delete(ctx, nil)
+		if err != nil {
+			log.WithField("bucket", bucket).WithField("object", query.Name).Error(err)
+			return translateAzureError(err)
+		}
+		return nil
+	}
+	if query.Prefix == "" {
+		return nil
+	}
+
+	var names []string
+	err = azureListBlobs(ctx, cnt, query.Prefix, func(item *container.BlobItem) {
+		names = append(names, *item.Name)
+	})
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		_, removeErr := cnt.NewBlobClient(name).Delete(ctx, nil)
+		if removeErr != nil && !errors.Is(translateAzureError(removeErr), ErrNotFound) {
+			err = removeErr
+			log.WithField("bucket", bucket).WithField("object", name).Error(err)
+		}
+	}
+	return translateAzureError(err)
+}
+
+// DeleteBucket deletes a bucket. If all users share a single container, only the user's content is deleted.
+func (s *presignedAzureStorage) DeleteBucket(ctx context.Context, userID, bucket string) (err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "azure.DeleteBucket")
+	defer tracing.FinishSpan(span, &err)
+
+	if s.AzureConfig.ContainerName != "" {
+		if userID == "" {
+			return xerrors.Errorf("userID is required to delete content from a shared container")
+		}
+		return s.DeleteObject(ctx, bucket, &DeleteObjectQuery{Prefix: userID + "/"})
+	}
+
+	_, err = s.client.DeleteContainer(ctx, bucket, nil)
+	if err != nil {
+		return translateAzureError(err)
+	}
+	return nil
+}
+
+// ObjectHash gets a hash value of an object
+func (s *presignedAzureStorage) ObjectHash(ctx context.Context, bucket string, obj string) (hash string, err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "azure.ObjectHash")
+	defer tracing.FinishSpan(span, &err)
+
+	props, err := s.client.NewContainerClient(bucket).NewBlobClient(obj).GetProperties(ctx, nil)
+	if err != nil {
+		return "", translateAzureError(err)
+	}
+	if props.ETag == nil {
+		return "", nil
+	}
+	return string(*props.ETag), nil
+}
+
+// ObjectExists tells whether the given object exists or not
+func (s *presignedAzureStorage) ObjectExists(ctx context.Context, bucket, obj string) (exists bool, err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "azure.ObjectExists")
+	defer tracing.FinishSpan(span, &err)
+
+	_, err = s.client.NewContainerClient(bucket).NewBlobClient(obj).GetProperties(ctx, nil)
+	if errors.Is(translateAzureError(err), ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Bucket provides the bucket name for a particular user
+func (s *presignedAzureStorage) Bucket(ownerID string) string {
+	return azureContainerName(ownerID, s.AzureConfig.ContainerName)
+}
+
+// BlobObject returns a blob's object name
+func (s *presignedAzureStorage) BlobObject(userID, name string) (string, error) {
+	return blobObjectName(name)
+}
+
+// BackupObject returns a backup's object name that a direct downloader would download
+func (s *presignedAzureStorage) BackupObject(ownerID string, workspaceID, name string) string {
+	var username string
+	if s.AzureConfig.ContainerName != "" {
+		username = ownerID
+	}
+	return azureWorkspaceBackupObjectName(username, workspaceID, name)
+}
+
+// InstanceObject returns a instance's object name that a direct downloader would download
+func (s *presignedAzureStorage) InstanceObject(ownerID string, workspaceID string, instanceID string, name string) string {
+	return s.BackupObject(ownerID, workspaceID, InstanceObjectName(instanceID, name))
+}
+
+// ListObjects returns all objects in the bucket that have the given prefix. Returns an empty list if the bucket does not exist.
+func (s *presignedAzureStorage) ListObjects(ctx context.Context, bucket string, prefix string) (objects []ObjectInfo, err error) {
+	err = azureListBlobs(ctx, s.client.NewContainerClient(bucket), prefix, func(item *container.BlobItem) {
+		if item.Name == nil {
+			return
+		}
+		obj := ObjectInfo{Name: *item.Name}
+		if item.Properties != nil {
+			if item.Properties.ContentLength != nil {
+				obj.Size = *item.Properties.ContentLength
+			}
+			if item.Properties.LastModified != nil {
+				obj.LastModified = *item.Properties.LastModified
+			}
+		}
+		objects = append(objects, obj)
+	})
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+// ListOwners returns the IDs of all users who own content in the storage
+func (s *presignedAzureStorage) ListOwners(ctx context.Context) (owners []string, err error) {
+	if s.AzureConfig.ContainerName != "" {
+		// all content lives in a single container, prefixed with the owner ID
+		pager := s.client.NewContainerClient(s.AzureConfig.ContainerName).NewListBlobsHierarchyPager("/", nil)
+		for pager.More() {
+			page, err := pager.NextPage(ctx)
+			if err != nil {
+				return nil, translateAzureError(err)
+			}
+			for _, p := range page.Segment.BlobPrefixes {
+				if p.Name != nil {
+					owners = append(owners, strings.TrimSuffix(*p.Name, "/"))
+				}
+			}
+		}
+		return owners, nil
+	}
+
+	prefix := azureContainerName("", "")
+	pager := s.client.NewListContainersPager(&service.ListContainersOptions{Prefix: &prefix})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, translateAzureError(err)
+		}
+		for _, cnt := range page.ContainerItems {
+			if cnt.Name != nil {
+				owners = append(owners, strings.TrimPrefix(*cnt.Name, prefix))
+			}
+		}
+	}
+	return owners, nil
+}
+
+func azureListBlobs(ctx context.Context, cnt *container.Client, prefix string, fn func(item *container.BlobItem)) error {
+	var opts container.ListBlobsFlatOptions
+	if prefix != "" {
+		opts.Prefix = &prefix
+	}
+
+	pager := cnt.NewListBlobsFlatPager(&opts)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return translateAzureError(err)
+		}
+		for _, item := range page.Segment.BlobItems {
+			fn(item)
+		}
+	}
+	return nil
+}
+
+// annotationsToAzureMetadata translates object annotations to Azure metadata. Azure metadata names
+// must be valid C# identifiers, hence we cannot use the dashes of our annotation names.
+func annotationsToAzureMetadata(annotations map[string]string) map[string]*string {
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	res := make(map[string]*string, len(annotations))
+	for k, v := range annotations {
+		res[strings.ReplaceAll(k, "-", "_")] = &v
+	}
+	return res
+}
+
+// azureMetadataAnnotation finds an annotation in Azure metadata. The casing of metadata names is not preserved.
+func azureMetadataAnnotation(md map[string]*string, annotation string) string {
+	name := strings.ReplaceAll(annotation, "-", "_")
+	for k, v := range md {
+		if strings.EqualFold(k, name) && v != nil {
+			return *v
+		}
+	}
+	return ""
+}
+
+func translateAzureError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if bloberror.HasCode(err, bloberror.BlobNotFound, bloberror.ContainerNotFound, bloberror.ResourceNotFound) {
+		return ErrNotFound
+	}
+
+	var rerr *azcore.ResponseError
+	if errors.As(err, &rerr) && rerr.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+
+	return err
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"context"
+	"testing"
+
+	config "github.com/gitpod-io/gitpod/content-service/api/config"
+)
+
+func TestAzureBackupObject(t *testing.T) {
+	tests := []struct {
+		Name                 string
+		ContainerNameConfig  string
+		Username             string
+		Workspace            string
+		ObjectName           string
+		ExpectedContainer    string
+		ExpectedBackupObject string
+	}{
+		{
+			Name:                 "no dedicated container",
+			Username:             "test-user",
+			Workspace:            "gitpodio-gitpod-2cx8z8e643x",
+			ObjectName:           "backup.tar",
+			ExpectedContainer:    "gitpod-user-test-user",
+			ExpectedBackupObject: "workspaces/gitpodio-gitpod-2cx8z8e643x/backup.tar",
+		},
+		{
+			Name:                 "with dedicated container",
+			ContainerNameConfig:  "root-container",
+			Username:             "test-user",
+			Workspace:            "gitpodio-gitpod-2cx8z8e643x",
+			ObjectName:           "backup.tar",
+			ExpectedContainer:    "root-container",
+			ExpectedBackupObject: "test-user/workspaces/gitpodio-gitpod-2cx8z8e643x/backup.tar",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			cfg := config.AzureConfig{
+				AccountName:   "fake",
+				AccountKey:    "ZmFrZS1rZXk=",
+				Endpoint:      "http://localhost:10000/fake",
+				ContainerName: test.ContainerNameConfig,
+			}
+			azure, err := newDirectAzureAccess(cfg)
+			if err != nil {
+				t.Fatalf("failed to create azure access: '%v'", err)
+			}
+			err = azure.Init(context.Background(), test.Username, test.Workspace, "fa9aa2af-b6de-45fc-8b48-534bb440429f")
+			if err != nil {
+				t.Fatalf("failed to init azure access: '%v'", err)
+			}
+
+			if actual := azure.Bucket(test.Username); actual != test.ExpectedContainer {
+				t.Fatalf("[azure] unexpected container name: is '%s' but expected '%s'", actual, test.ExpectedContainer)
+			}
+			if actual := azure.BackupObject(test.ObjectName); actual != test.ExpectedBackupObject {
+				t.Fatalf("[azure] unexpected backup object name: is '%s' but expected '%s'", actual, test.ExpectedBackupObject)
+			}
+
+			presignedAzure, err := newPresignedAzureAccess(cfg)
+			if err != nil {
+				t.Fatalf("failed to create presigned azure access: '%v'", err)
+			}
+			if actual := presignedAzure.Bucket(test.Username); actual != test.ExpectedContainer {
+				t.Fatalf("[presigned azure] unexpected container name: is '%s' but expected '%s'", actual, test.ExpectedContainer)
+			}
+			if actual := presignedAzure.BackupObject(test.Username, test.Workspace, test.ObjectName); actual != test.ExpectedBackupObject {
+				t.Fatalf("[presigned azure] unexpected backup object name: is '%s' but expected '%s'", actual, test.ExpectedBackupObject)
+			}
+		})
+	}
+}
+
+func TestAzureMetadataAnnotations(t *testing.T) {
+	md := annotationsToAzureMetadata(map[string]string{
+		ObjectAnnotationDigest: "sha256:foo",
+	})
+	if _, ok := md["gitpod_digest"]; !ok {
+		t.Fatalf("expected annotation to be translated to a valid metadata name, got %v", md)
+	}
+
+	// Azure does not preserve the casing of metadata names
+	bar := "sha256:bar"
+	actual := azureMetadataAnnotation(map[string]*string{"Gitpod_digest": &bar}, ObjectAnnotationDigest)
+	if actual != bar {
+		t.Fatalf("unexpected annotation value: is '%s' but expected '%s'", actual, bar)
+	}
+}
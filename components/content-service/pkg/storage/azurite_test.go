@@ -0,0 +1,205 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package storage_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	config "github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+)
+
+const (
+	// azuriteAccountName and azuriteAccountKey are the well-known development credentials of the Azurite emulator
+	azuriteAccountName = "devstoreaccount1"
+	azuriteAccountKey  = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+)
+
+type azurePresignedAccess struct {
+	storage.PresignedAccess
+}
+
+func (a azurePresignedAccess) ForTestCreateObj(ctx context.Context, bucket, path, content string) error {
+	err := a.EnsureExists(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	nfo, err := a.SignUpload(ctx, bucket, path, &storage.SignedURLOptions{})
+	if err != nil {
+		return err
+	}
+	return putObject(nfo, content)
+}
+
+func (a azurePresignedAccess) ForTestReset(ctx context.Context) error {
+	// deleting a container takes a while on Azure, so we delete its content instead
+	objs, err := a.ListObjects(ctx, "test-bucket", "")
+	if err != nil {
+		return err
+	}
+	for _, obj := range objs {
+		err = a.DeleteObject(ctx, "test-bucket", &storage.DeleteObjectQuery{Name: obj.Name})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newAzuriteStorage produces a storage config for the Azurite emulator at AZURITE_ENDPOINT (or its default address)
+// and skips the test if the emulator is not available.
+func newAzuriteStorage(t *testing.T) *config.StorageConfig {
+	endpoint := os.Getenv("AZURITE_ENDPOINT")
+	if endpoint == "" {
+		endpoint = "http://127.0.0.1:10000/" + azuriteAccountName
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		t.Fatalf("invalid AZURITE_ENDPOINT: %v", err)
+	}
+	conn, err := net.DialTimeout("tcp", u.Host, time.Second)
+	if err != nil {
+		t.Skipf("Azurite is not available at %s: %v", endpoint, err)
+	}
+	conn.Close()
+
+	return &config.StorageConfig{
+		Stage: config.StageDevStaging,
+		Kind:  config.AzureStorage,
+		AzureConfig: &config.AzureConfig{
+			AccountName: azuriteAccountName,
+			AccountKey:  azuriteAccountKey,
+			Endpoint:    endpoint,
+		},
+	}
+}
+
+func TestAzurePresignedHappyPath(t *testing.T) {
+	cfg := newAzuriteStorage(t)
+
+	ps, err := storage.NewPresignedAccess(cfg)
+	if err != nil {
+		t.Fatalf("cannot create presigned access: %v", err)
+	}
+	SuiteTestPresignedAccess(t, azurePresignedAccess{PresignedAccess: ps})
+}
+
+func TestAzurePresignedURLs(t *testing.T) {
+	cfg := newAzuriteStorage(t)
+	ctx := context.Background()
+
+	ps, err := storage.NewPresignedAccess(cfg)
+	if err != nil {
+		t.Fatalf("cannot create presigned access: %v", err)
+	}
+
+	var (
+		bucket  = fmt.Sprintf("test-presigned-%d", time.Now().UnixNano())
+		obj     = "foo/bar.txt"
+		content = "hello world"
+	)
+	failOnErr(t, ps.EnsureExists(ctx, bucket))
+	t.Cleanup(func() { _ = ps.DeleteBucket(context.Background(), "", bucket) })
+
+	up, err := ps.SignUpload(ctx, bucket, obj, &storage.SignedURLOptions{})
+	failOnErr(t, err)
+	if len(up.Headers) == 0 {
+		t.Fatal("expected upload headers")
+	}
+	err = putObject(&storage.UploadInfo{URL: up.URL}, content)
+	if err == nil {
+		t.Error("expected upload without the required headers to fail")
+	}
+	failOnErr(t, putObject(up, content))
+
+	down, err := ps.SignDownload(ctx, bucket, obj, &storage.SignedURLOptions{})
+	failOnErr(t, err)
+	if down.Size != int64(len(content)) {
+		t.Errorf("unexpected object size: is %d but expected %d", down.Size, len(content))
+	}
+
+	resp, err := http.Get(down.URL)
+	failOnErr(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	failOnErr(t, err)
+	if resp.StatusCode != http.StatusOK || string(body) != content {
+		t.Errorf("unexpected download: status %d, body %q", resp.StatusCode, string(body))
+	}
+
+	resp, err = http.Get(strings.Replace(down.URL, "bar.txt", "baz.txt", 1))
+	failOnErr(t, err)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("expected the download URL to be restricted to its object, got status %d", resp.StatusCode)
+	}
+}
+
+func TestAzureBackupRoundtrip(t *testing.T) {
+	cfg := newAzuriteStorage(t)
+	ctx := context.Background()
+
+	var (
+		owner     = fmt.Sprintf("test-user-%d", time.Now().UnixNano())
+		workspace = "gitpodio-gitpod-2cx8z8e643x"
+	)
+	da, err := storage.NewDirectAccess(cfg)
+	failOnErr(t, err)
+	failOnErr(t, da.Init(ctx, owner, workspace, "fa9aa2af-b6de-45fc-8b48-534bb440429f"))
+	failOnErr(t, da.EnsureExists(ctx))
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	failOnErr(t, tw.WriteHeader(&tar.Header{Name: "hello.txt", Mode: 0644, Size: 5, Typeflag: tar.TypeReg}))
+	_, err = tw.Write([]byte("hello"))
+	failOnErr(t, err)
+	failOnErr(t, tw.Close())
+	src := filepath.Join(t.TempDir(), "backup.tar")
+	failOnErr(t, os.WriteFile(src, buf.Bytes(), 0644))
+
+	bkt, _, err := da.Upload(ctx, src, storage.DefaultBackup, storage.WithAnnotations(map[string]string{
+		storage.ObjectAnnotationDigest: "sha256:foo",
+	}))
+	failOnErr(t, err)
+
+	ps, err := storage.NewPresignedAccess(cfg)
+	failOnErr(t, err)
+	t.Cleanup(func() { _ = ps.DeleteBucket(context.Background(), owner, bkt) })
+
+	dst := t.TempDir()
+	found, err := da.Download(ctx, dst, storage.DefaultBackup, nil)
+	failOnErr(t, err)
+	if !found {
+		t.Fatal("expected backup to be found")
+	}
+	content, err := os.ReadFile(filepath.Join(dst, "hello.txt"))
+	failOnErr(t, err)
+	if string(content) != "hello" {
+		t.Errorf("unexpected content: is %q but expected %q", string(content), "hello")
+	}
+
+	nfo, err := ps.SignDownload(ctx, bkt, ps.BackupObject(owner, workspace, storage.DefaultBackup), &storage.SignedURLOptions{})
+	failOnErr(t, err)
+	if nfo.Meta.Digest != "sha256:foo" {
+		t.Errorf("unexpected digest: is %q but expected %q", nfo.Meta.Digest, "sha256:foo")
+	}
+
+	_, err = ps.SignDownload(ctx, bkt, ps.BackupObject(owner, workspace, "does-not-exist.tar"), &storage.SignedURLOptions{})
+	if err != storage.ErrNotFound {
+		t.Errorf("expected ErrNotFound for a missing object, got %v", err)
+	}
+}
@@ -429,6 +429,27 @@ func (rs *DirectGCPStorage) Upload(ctx context.Context, source string, name stri
 	return
 }
 
+// ObjectSize returns the size of an object uploaded using Upload
+func (rs *DirectGCPStorage) ObjectSize(ctx context.Context, name string) (size int64, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "GCloudBucketRemotegcpStorage.ObjectSize")
+	defer tracing.FinishSpan(span, &err)
+
+	if rs.client == nil {
+		return 0, xerrors.Errorf("no gcloud client available - did you call Init()?")
+	}
+
+	attrs, err := rs.client.Bucket(rs.bucketName()).Object(rs.objectName(name)).Attrs(ctx)
+	if errors.Is(err, gcpstorage.ErrBucketNotExist) || errors.Is(err, gcpstorage.ErrObjectNotExist) {
+		return 0, ErrNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return attrs.Size, nil
+}
+
 func (rs *DirectGCPStorage) bucketName() string {
 	return gcpBucketName(rs.Stage, rs.Username)
 }
@@ -805,3 +826,60 @@ func (p *PresignedGCPStorage) BackupObject(ownerID string, workspaceID string, n
 func (p *PresignedGCPStorage) InstanceObject(ownerID string, workspaceID string, instanceID string, name string) string {
 	return p.BackupObject(ownerID, workspaceID, InstanceObjectName(instanceID, name))
 }
+
+// ListObjects returns all objects in the bucket that have the given prefix. Returns an empty list if the bucket does not exist.
+func (p *PresignedGCPStorage) ListObjects(ctx context.Context, bucket string, prefix string) (objects []ObjectInfo, err error) {
+	client, err := newGCPClient(ctx, p.config)
+	if err != nil {
+		return nil, err
+	}
+	//nolint:staticcheck
+	defer client.Close()
+
+	it := client.Bucket(bucket).Objects(ctx, &gcpstorage.Query{
+		Prefix: prefix,
+	})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if errors.Is(err, gcpstorage.ErrBucketNotExist) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, ObjectInfo{
+			Name:         attrs.Name,
+			Size:         attrs.Size,
+			LastModified: attrs.Updated,
+		})
+	}
+	return objects, nil
+}
+
+// ListOwners returns the IDs of all users who own content in the storage
+func (p *PresignedGCPStorage) ListOwners(ctx context.Context) (owners []string, err error) {
+	client, err := newGCPClient(ctx, p.config)
+	if err != nil {
+		return nil, err
+	}
+	//nolint:staticcheck
+	defer client.Close()
+
+	prefix := gcpBucketName(p.stage, "")
+	it := client.Buckets(ctx, p.config.Project)
+	it.Prefix = prefix
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		owners = append(owners, strings.TrimPrefix(attrs.Name, prefix))
+	}
+	return owners, nil
+}
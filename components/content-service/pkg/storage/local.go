@@ -0,0 +1,946 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	config "github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+)
+
+var _ DirectAccess = &DirectLocalStorage{}
+var _ PresignedAccess = &presignedLocalStorage{}
+
+const (
+	// LocalStorageExpiresParam is the query parameter of a presigned local storage URL which holds its expiry as unix timestamp
+	LocalStorageExpiresParam = "X-Gitpod-Expires"
+	// LocalStorageSignatureParam is the query parameter of a presigned local storage URL which holds its signature
+	LocalStorageSignatureParam = "X-Gitpod-Signature"
+
+	localBucketsDir    = "buckets"
+	localMetaDir       = "meta"
+	localUploadPattern = ".upload-*"
+)
+
+// ValidateLocalConfig checks if the local storage config is valid
+func ValidateLocalConfig(c *config.LocalConfig) error {
+	return validation.ValidateStruct(c,
+		validation.Field(&c.Path, validation.Required),
+	)
+}
+
+// validatePresignedLocalConfig checks if the local storage config is valid for producing presigned URLs
+func validatePresignedLocalConfig(c *config.LocalConfig) error {
+	err := ValidateLocalConfig(c)
+	if err != nil {
+		return err
+	}
+	return validation.ValidateStruct(c,
+		validation.Field(&c.BaseURL, validation.Required),
+		validation.Field(&c.SigningKey, validation.Required),
+	)
+}
+
+// addLocalParamsFromMounts allows for the signing key to be read from a file
+func addLocalParamsFromMounts(c *config.LocalConfig) error {
+	if c.SigningKeyFile != "" {
+		value, err := os.ReadFile(c.SigningKeyFile)
+		if err != nil {
+			return err
+		}
+		c.SigningKey = strings.TrimSpace(string(value))
+	}
+	return nil
+}
+
+// newDirectLocalAccess provides direct access to the local storage directory
+func newDirectLocalAccess(cfg config.LocalConfig) (*DirectLocalStorage, error) {
+	err := addLocalParamsFromMounts(&cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = ValidateLocalConfig(&cfg); err != nil {
+		return nil, err
+	}
+	return &DirectLocalStorage{LocalConfig: cfg, store: &localStore{Root: cfg.Path}}, nil
+}
+
+// DirectLocalStorage implements a local directory as remote storage backend
+type DirectLocalStorage struct {
+	Username      string
+	WorkspaceName string
+	InstanceID    string
+	LocalConfig   config.LocalConfig
+
+	store *localStore
+}
+
+// Validate checks if the local storage is configured properly
+func (rs *DirectLocalStorage) Validate() error {
+	err := ValidateLocalConfig(&rs.LocalConfig)
+	if err != nil {
+		return err
+	}
+
+	return validation.ValidateStruct(rs,
+		validation.Field(&rs.Username, validation.Required),
+		validation.Field(&rs.WorkspaceName, validation.Required),
+	)
+}
+
+// Init initializes the remote storage - call this before calling anything else on the interface
+func (rs *DirectLocalStorage) Init(ctx context.Context, owner, workspace, instance string) (err error) {
+	rs.Username = owner
+	rs.WorkspaceName = workspace
+	rs.InstanceID = instance
+
+	err = rs.Validate()
+	if err != nil {
+		return err
+	}
+
+	if rs.store == nil {
+		rs.store = &localStore{Root: rs.LocalConfig.Path}
+	}
+	return nil
+}
+
+// EnsureExists makes sure that the remote storage location exists and can be up- or downloaded from
+func (rs *DirectLocalStorage) EnsureExists(ctx context.Context) (err error) {
+	//nolint:staticcheck,ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "local.EnsureExists")
+	defer tracing.FinishSpan(span, &err)
+
+	if rs.store == nil {
+		return xerrors.Errorf("no local store available - did you call Init()?")
+	}
+	return rs.store.EnsureBucket(rs.bucketName())
+}
+
+func (rs *DirectLocalStorage) download(ctx context.Context, destination string, bkt string, obj string, mappings []archive.IDMapping) (found bool, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "download")
+	span.SetTag("bucket", bkt)
+	span.SetTag("object", obj)
+	defer tracing.FinishSpan(span, &err)
+
+	if rs.store == nil {
+		return false, xerrors.Errorf("no local store available - did you call Init()?")
+	}
+
+	f, _, err := rs.store.Open(bkt, obj)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	err = extractTarbal(ctx, destination, f, mappings)
+	if err != nil {
+		return true, err
+	}
+
+	return true, nil
+}
+
+// Download takes the latest state from the remote storage and downloads it to a local path
+func (rs *DirectLocalStorage) Download(ctx context.Context, destination string, name string, mappings []archive.IDMapping) (bool, error) {
+	return rs.download(ctx, destination, rs.bucketName(), rs.objectName(name), mappings)
+}
+
+// DownloadSnapshot downloads a snapshot. The snapshot name is expected to be one produced by Qualify
+func (rs *DirectLocalStorage) DownloadSnapshot(ctx context.Context, destination string, name string, mappings []archive.IDMapping) (bool, error) {
+	bkt, obj, err := ParseSnapshotName(name)
+	if err != nil {
+		return false, err
+	}
+
+	return rs.download(ctx, destination, bkt, obj, mappings)
+}
+
+// ListObjects returns all objects found with the given prefix. Returns an empty list if the bucket does not exuist (yet).
+func (rs *DirectLocalStorage) ListObjects(ctx context.Context, prefix string) (objects []string, err error) {
+	if rs.store == nil {
+		return nil, xerrors.Errorf("no local store available - did you call Init()?")
+	}
+
+	objs, err := rs.store.List(rs.bucketName(), prefix)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot list objects: %w", err)
+	}
+	for _, obj := range objs {
+		objects = append(objects, obj.Name)
+	}
+	return objects, nil
+}
+
+// Qualify fully qualifies a snapshot name so that it can be downloaded using DownloadSnapshot
+func (rs *DirectLocalStorage) Qualify(name string) string {
+	return fmt.Sprintf("%s@%s", rs.objectName(name), rs.bucketName())
+}
+
+// UploadInstance takes all files from a local location and uploads it to the per-instance remote storage
+func (rs *DirectLocalStorage) UploadInstance(ctx context.Context, source string, name string, opts ...UploadOption) (bucket, object string, err error) {
+	if rs.InstanceID == "" {
+		return "", "", xerrors.Errorf("instanceID is required to comput object name")
+	}
+	return rs.Upload(ctx, source, InstanceObjectName(rs.InstanceID, name), opts...)
+}
+
+// Upload takes all files from a local location and uploads it to the remote storage
+func (rs *DirectLocalStorage) Upload(ctx context.Context, source string, name string, opts ...UploadOption) (bucket, obj string, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DirectUpload")
+	defer tracing.FinishSpan(span, &err)
+
+	options, err := GetUploadOptions(opts)
+	if err != nil {
+		err = xerrors.Errorf("cannot get options: %w", err)
+		return
+	}
+
+	if rs.store == nil {
+		err = xerrors.Errorf("no local store available - did you call Init()?")
+		return
+	}
+
+	f, err := os.Open(source)
+	if err != nil {
+		err = xerrors.Errorf("cannot read file: %w", err)
+		return
+	}
+	defer f.Close()
+
+	bucket = rs.bucketName()
+	obj = rs.objectName(name)
+	span.LogKV("bucket", bucket)
+	span.LogKV("obj", obj)
+	err = rs.store.Put(bucket, obj, f, &localObjectMeta{
+		ContentType: options.ContentType,
+		Annotations: options.Annotations,
+	})
+	return
+}
+
+// ObjectSize returns the size of an object uploaded using Upload
+func (rs *DirectLocalStorage) ObjectSize(ctx context.Context, name string) (size int64, err error) {
+	if rs.store == nil {
+		return 0, xerrors.Errorf("no local store available - did you call Init()?")
+	}
+
+	stat, err := rs.store.Stat(rs.bucketName(), rs.objectName(name))
+	if err != nil {
+		return 0, err
+	}
+	return stat.Size(), nil
+}
+
+// Bucket provides the bucket name for a particular user
+func (rs *DirectLocalStorage) Bucket(ownerID string) string {
+	return localBucketName(ownerID)
+}
+
+// BackupObject returns a backup's object name that a direct downloader would download
+func (rs *DirectLocalStorage) BackupObject(name string) string {
+	return rs.objectName(name)
+}
+
+func (rs *DirectLocalStorage) bucketName() string {
+	return localBucketName(rs.Username)
+}
+
+func (rs *DirectLocalStorage) objectName(name string) string {
+	return localWorkspaceBackupObjectName(rs.WorkspaceName, name)
+}
+
+func localBucketName(ownerID string) string {
+	return fmt.Sprintf("gitpod-user-%s", ownerID)
+}
+
+func localWorkspaceBackupObjectName(workspaceID, name string) string {
+	return path.Join("workspaces", workspaceID, name)
+}
+
+func newPresignedLocalAccess(cfg config.LocalConfig) (*presignedLocalStorage, error) {
+	err := addLocalParamsFromMounts(&cfg)
+	if err != nil {
+		return nil, err
+	}
+	err = validatePresignedLocalConfig(&cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := newLocalURLSigner(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &presignedLocalStorage{
+		LocalConfig: cfg,
+		store:       &localStore{Root: cfg.Path},
+		signer:      signer,
+	}, nil
+}
+
+type presignedLocalStorage struct {
+	LocalConfig config.LocalConfig
+
+	store  *localStore
+	signer *localURLSigner
+}
+
+// EnsureExists makes sure that the remote storage location exists and can be up- or downloaded from
+func (s *presignedLocalStorage) EnsureExists(ctx context.Context, bucket string) (err error) {
+	return s.store.EnsureBucket(bucket)
+}
+
+// DiskUsage gives the total objects size of objects that have the given prefix
+func (s *presignedLocalStorage) DiskUsage(ctx context.Context, bucket string, prefix string) (size int64, err error) {
+	//nolint:ineffassign,staticcheck
+	span, ctx := opentracing.StartSpanFromContext(ctx, "local.DiskUsage")
+	defer tracing.FinishSpan(span, &err)
+
+	objs, err := s.store.List(bucket, prefix)
+	if err != nil {
+		return 0, err
+	}
+	for _, obj := range objs {
+		size += obj.Size
+	}
+	return size, nil
+}
+
+// SignDownload describes an object for download - if the object is not found, ErrNotFound is returned
+func (s *presignedLocalStorage) SignDownload(ctx context.Context, bucket, object string, options *SignedURLOptions) (info *DownloadInfo, err error) {
+	//nolint:ineffassign,staticcheck
+	span, ctx := opentracing.StartSpanFromContext(ctx, "local.SignDownload")
+	defer func() {
+		if err == ErrNotFound {
+			span.LogKV("found", false)
+			tracing.FinishSpan(span, nil)
+			return
+		}
+
+		tracing.FinishSpan(span, &err)
+	}()
+
+	stat, err := s.store.Stat(bucket, object)
+	if err != nil {
+		return nil, err
+	}
+	meta, err := s.store.Meta(bucket, object)
+	if err != nil {
+		return nil, err
+	}
+	url, err := s.signer.Sign(http.MethodGet, bucket, object, time.Now().Add(30*time.Minute))
+	if err != nil {
+		return nil, err
+	}
+
+	return &DownloadInfo{
+		Meta: ObjectMeta{
+			ContentType:        meta.ContentType,
+			OCIMediaType:       meta.Annotations[ObjectAnnotationOCIContentType],
+			Digest:             meta.Annotations[ObjectAnnotationDigest],
+			UncompressedDigest: meta.Annotations[ObjectAnnotationUncompressedDigest],
+		},
+		Size: stat.Size(),
+		URL:  url,
+	}, nil
+}
+
+// SignUpload describes an object for upload
+func (s *presignedLocalStorage) SignUpload(ctx context.Context, bucket, obj string, options *SignedURLOptions) (info *UploadInfo, err error) {
+	url, err := s.signer.Sign(http.MethodPut, bucket, obj, time.Now().Add(30*time.Minute))
+	if err != nil {
+		return nil, err
+	}
+	return &UploadInfo{URL: url}, nil
+}
+
+// DeleteObject deletes objects in the given bucket specified by the given query
+func (s *presignedLocalStorage) DeleteObject(ctx context.Context, bucket string, query *DeleteObjectQuery) (err error) {
+	//nolint:ineffassign,staticcheck
+	span, ctx := opentracing.StartSpanFromContext(ctx, "local.DeleteObject")
+	defer tracing.FinishSpan(span, &err)
+
+	if query.Name != "" {
+		return s.store.Remove(bucket, query.Name)
+	}
+	if query.Prefix != "" {
+		objs, err := s.store.List(bucket, strings.TrimPrefix(query.Prefix, "/"))
+		if err != nil {
+			return err
+		}
+		for _, obj := range objs {
+			err = s.store.Remove(bucket, obj.Name)
+			if err != nil && !errors.Is(err, ErrNotFound) {
+				log.WithField("bucket", bucket).WithField("object", obj.Name).Error(err)
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// DeleteBucket deletes a bucket
+func (s *presignedLocalStorage) DeleteBucket(ctx context.Context, userID, bucket string) (err error) {
+	//nolint:ineffassign,staticcheck
+	span, ctx := opentracing.StartSpanFromContext(ctx, "local.DeleteBucket")
+	defer tracing.FinishSpan(span, &err)
+
+	return s.store.RemoveBucket(bucket)
+}
+
+// ObjectHash gets a hash value of an object
+func (s *presignedLocalStorage) ObjectHash(ctx context.Context, bucket string, obj string) (hash string, err error) {
+	//nolint:ineffassign,staticcheck
+	span, ctx := opentracing.StartSpanFromContext(ctx, "local.ObjectHash")
+	defer tracing.FinishSpan(span, &err)
+
+	f, _, err := s.store.Open(bucket, obj)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// ObjectExists tells whether the given object exists or not
+func (s *presignedLocalStorage) ObjectExists(ctx context.Context, bucket, obj string) (exists bool, err error) {
+	_, err = s.store.Stat(bucket, obj)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Bucket provides the bucket name for a particular user
+func (s *presignedLocalStorage) Bucket(ownerID string) string {
+	return localBucketName(ownerID)
+}
+
+// BlobObject returns a blob's object name
+func (s *presignedLocalStorage) BlobObject(userID, name string) (string, error) {
+	return blobObjectName(name)
+}
+
+// BackupObject returns a backup's object name that a direct downloader would download
+func (s *presignedLocalStorage) BackupObject(ownerID string, workspaceID string, name string) string {
+	return localWorkspaceBackupObjectName(workspaceID, name)
+}
+
+// InstanceObject returns a instance's object name that a direct downloader would download
+func (s *presignedLocalStorage) InstanceObject(ownerID string, workspaceID string, instanceID string, name string) string {
+	return s.BackupObject(ownerID, workspaceID, InstanceObjectName(instanceID, name))
+}
+
+// ListObjects returns all objects in the bucket that have the given prefix. Returns an empty list if the bucket does not exist.
+func (s *presignedLocalStorage) ListObjects(ctx context.Context, bucket string, prefix string) ([]ObjectInfo, error) {
+	objs, err := s.store.List(bucket, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]ObjectInfo, 0, len(objs))
+	for _, obj := range objs {
+		res = append(res, ObjectInfo(obj))
+	}
+	return res, nil
+}
+
+// ListOwners returns the IDs of all users who own content in the storage
+func (s *presignedLocalStorage) ListOwners(ctx context.Context) (owners []string, err error) {
+	buckets, err := s.store.Buckets()
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := localBucketName("")
+	for _, bkt := range buckets {
+		if strings.HasPrefix(bkt, prefix) {
+			owners = append(owners, strings.TrimPrefix(bkt, prefix))
+		}
+	}
+	return owners, nil
+}
+
+// NewLocalStorageHandler produces the HTTP handler which serves the presigned URLs of the local storage backend.
+// The handler expects to receive requests on the path of the configured base URL.
+func NewLocalStorageHandler(cfg config.LocalConfig) (http.Handler, error) {
+	err := addLocalParamsFromMounts(&cfg)
+	if err != nil {
+		return nil, err
+	}
+	err = validatePresignedLocalConfig(&cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := newLocalURLSigner(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &localStorageHandler{
+		store:  &localStore{Root: cfg.Path},
+		signer: signer,
+	}, nil
+}
+
+type localStorageHandler struct {
+	store  *localStore
+	signer *localURLSigner
+}
+
+func (h *localStorageHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bucket, obj, ok := h.signer.ParsePath(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	method := r.Method
+	switch method {
+	case http.MethodHead:
+		// a URL signed for download can be used to check for an object as well
+		method = http.MethodGet
+	case http.MethodGet, http.MethodPut:
+	default:
+		w.Header().Set("Allow", "GET, HEAD, PUT")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	err := h.signer.Verify(method, bucket, obj, r.URL.Query(), time.Now())
+	if err != nil {
+		log.WithError(err).WithField("bucket", bucket).WithField("object", obj).Debug("rejected local storage request")
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	if method == http.MethodPut {
+		err = h.store.Put(bucket, obj, r.Body, &localObjectMeta{ContentType: r.Header.Get("Content-Type")})
+		if err != nil {
+			log.WithError(err).WithField("bucket", bucket).WithField("object", obj).Error("cannot store object")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		return
+	}
+
+	f, meta, err := h.store.Open(bucket, obj)
+	if errors.Is(err, ErrNotFound) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		log.WithError(err).WithField("bucket", bucket).WithField("object", obj).Error("cannot open object")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+
+	stat, err := f.Stat()
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if meta.ContentType != "" {
+		w.Header().Set("Content-Type", meta.ContentType)
+	}
+	http.ServeContent(w, r, path.Base(obj), stat.ModTime(), f)
+}
+
+// localURLSigner produces and verifies HMAC-signed, expiring URLs for local storage objects
+type localURLSigner struct {
+	BaseURL *url.URL
+	Key     []byte
+}
+
+func newLocalURLSigner(cfg config.LocalConfig) (*localURLSigner, error) {
+	base, err := url.Parse(cfg.BaseURL)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid local storage base URL: %w", err)
+	}
+	base.Path = strings.TrimSuffix(base.Path, "/")
+	return &localURLSigner{BaseURL: base, Key: []byte(cfg.SigningKey)}, nil
+}
+
+// Sign produces a URL which grants the given method on an object until the URL expires
+func (s *localURLSigner) Sign(method, bucket, obj string, expires time.Time) (string, error) {
+	_, err := localObjectPath("", bucket, obj)
+	if err != nil {
+		return "", err
+	}
+
+	exp := expires.Unix()
+	q := url.Values{}
+	q.Set(LocalStorageExpiresParam, strconv.FormatInt(exp, 10))
+	q.Set(LocalStorageSignatureParam, hex.EncodeToString(s.signature(method, bucket, obj, exp)))
+
+	u := *s.BaseURL
+	u.Path = s.BaseURL.Path + "/" + bucket + "/" + obj
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// Verify checks that the query of a request carries a valid signature for the method and object
+func (s *localURLSigner) Verify(method, bucket, obj string, query url.Values, now time.Time) error {
+	exp, err := strconv.ParseInt(query.Get(LocalStorageExpiresParam), 10, 64)
+	if err != nil {
+		return xerrors.Errorf("invalid expiry: %w", err)
+	}
+	sig, err := hex.DecodeString(query.Get(LocalStorageSignatureParam))
+	if err != nil {
+		return xerrors.Errorf("invalid signature: %w", err)
+	}
+	if !hmac.Equal(sig, s.signature(method, bucket, obj, exp)) {
+		return xerrors.Errorf("signature mismatch")
+	}
+	if now.Unix() > exp {
+		return xerrors.Errorf("URL expired")
+	}
+	return nil
+}
+
+// ParsePath extracts bucket and object from the path of a URL produced by Sign
+func (s *localURLSigner) ParsePath(p string) (bucket, obj string, ok bool) {
+	p, ok = strings.CutPrefix(p, s.BaseURL.Path+"/")
+	if !ok {
+		return "", "", false
+	}
+	bucket, obj, ok = strings.Cut(p, "/")
+	if !ok || bucket == "" || obj == "" {
+		return "", "", false
+	}
+	return bucket, obj, true
+}
+
+func (s *localURLSigner) signature(method, bucket, obj string, expires int64) []byte {
+	mac := hmac.New(sha256.New, s.Key)
+	fmt.Fprintf(mac, "%s\n%s/%s\n%d", method, bucket, obj, expires)
+	return mac.Sum(nil)
+}
+
+// localObjectMeta is stored alongside every object of the local storage
+type localObjectMeta struct {
+	ContentType string            `json:"contentType,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type localObject struct {
+	Name         string
+	Size         int64
+	LastModified time.Time
+}
+
+// localStore stores objects in a directory. Objects live in <root>/buckets/<bucket>/<object>,
+// their metadata in <root>/meta/<bucket>/<object>.
+type localStore struct {
+	Root string
+}
+
+// localObjectPath produces the path of an object below root, making sure the object cannot escape its bucket
+func localObjectPath(root, bucket, obj string) (string, error) {
+	if bucket == "" || bucket == "." || bucket == ".." || strings.ContainsAny(bucket, `/\`) {
+		return "", xerrors.Errorf("invalid bucket name: %s", bucket)
+	}
+	clean := path.Clean("/" + obj)
+	if clean == "/" || strings.HasSuffix(obj, "/") {
+		return "", xerrors.Errorf("invalid object name: %s", obj)
+	}
+	return filepath.Join(root, bucket, filepath.FromSlash(clean)), nil
+}
+
+func (s *localStore) bucketPath(bucket string) (string, error) {
+	p, err := localObjectPath(filepath.Join(s.Root, localBucketsDir), bucket, "x")
+	if err != nil {
+		return "", err
+	}
+	return filepath.Dir(p), nil
+}
+
+func (s *localStore) objectPath(bucket, obj string) (string, error) {
+	return localObjectPath(filepath.Join(s.Root, localBucketsDir), bucket, obj)
+}
+
+func (s *localStore) metaPath(bucket, obj string) (string, error) {
+	return localObjectPath(filepath.Join(s.Root, localMetaDir), bucket, obj)
+}
+
+// EnsureBucket creates the bucket if it does not exist yet
+func (s *localStore) EnsureBucket(bucket string) error {
+	p, err := s.bucketPath(bucket)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(p, 0755)
+	if err != nil {
+		return xerrors.Errorf("cannot create bucket: %w", err)
+	}
+	return nil
+}
+
+// Put stores an object. The object becomes visible only once it was written completely.
+func (s *localStore) Put(bucket, obj string, src io.Reader, meta *localObjectMeta) error {
+	op, err := s.objectPath(bucket, obj)
+	if err != nil {
+		return err
+	}
+	mp, err := s.metaPath(bucket, obj)
+	if err != nil {
+		return err
+	}
+
+	mc, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	err = writeFileAtomically(mp, bytes.NewReader(mc))
+	if err != nil {
+		return xerrors.Errorf("cannot write object metadata: %w", err)
+	}
+	err = writeFileAtomically(op, src)
+	if err != nil {
+		return xerrors.Errorf("cannot write object: %w", err)
+	}
+	return nil
+}
+
+// Open opens an object for reading - if the object is not found, ErrNotFound is returned
+func (s *localStore) Open(bucket, obj string) (*os.File, *localObjectMeta, error) {
+	op, err := s.objectPath(bucket, obj)
+	if err != nil {
+		return nil, nil, err
+	}
+	meta, err := s.Meta(bucket, obj)
+	if err != nil {
+		return nil, nil, err
+	}
+	f, err := os.Open(op)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, meta, nil
+}
+
+// Meta returns the metadata of an object. Objects without metadata yield empty metadata.
+func (s *localStore) Meta(bucket, obj string) (*localObjectMeta, error) {
+	mp, err := s.metaPath(bucket, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var res localObjectMeta
+	mc, err := os.ReadFile(mp)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(mc, &res)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot parse object metadata: %w", err)
+	}
+	return &res, nil
+}
+
+// Stat returns the file info of an object - if the object is not found, ErrNotFound is returned
+func (s *localStore) Stat(bucket, obj string) (os.FileInfo, error) {
+	op, err := s.objectPath(bucket, obj)
+	if err != nil {
+		return nil, err
+	}
+	stat, err := os.Stat(op)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if stat.IsDir() {
+		return nil, ErrNotFound
+	}
+	return stat, nil
+}
+
+// List returns all objects with the given prefix. Returns an empty list if the bucket does not exist.
+func (s *localStore) List(bucket, prefix string) ([]localObject, error) {
+	bp, err := s.bucketPath(bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []localObject
+	err = filepath.WalkDir(bp, func(p string, d fs.DirEntry, err error) error {
+		if errors.Is(err, fs.ErrNotExist) && p == bp {
+			return filepath.SkipAll
+		}
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if ok, _ := filepath.Match(localUploadPattern, d.Name()); ok {
+			// upload in progress
+			return nil
+		}
+
+		rel, err := filepath.Rel(bp, p)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+		if !strings.HasPrefix(name, prefix) {
+			return nil
+		}
+
+		stat, err := d.Info()
+		if err != nil {
+			return err
+		}
+		res = append(res, localObject{Name: name, Size: stat.Size(), LastModified: stat.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Buckets returns the names of all buckets
+func (s *localStore) Buckets() ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(s.Root, localBucketsDir))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var res []string
+	for _, e := range entries {
+		if e.IsDir() {
+			res = append(res, e.Name())
+		}
+	}
+	return res, nil
+}
+
+// Remove deletes an object - if the object is not found, ErrNotFound is returned
+func (s *localStore) Remove(bucket, obj string) error {
+	op, err := s.objectPath(bucket, obj)
+	if err != nil {
+		return err
+	}
+	mp, err := s.metaPath(bucket, obj)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(op)
+	if errors.Is(err, fs.ErrNotExist) {
+		return ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+	err = os.Remove(mp)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// RemoveBucket deletes a bucket and all its objects
+func (s *localStore) RemoveBucket(bucket string) error {
+	bp, err := s.bucketPath(bucket)
+	if err != nil {
+		return err
+	}
+	mp, err := localObjectPath(filepath.Join(s.Root, localMetaDir), bucket, "x")
+	if err != nil {
+		return err
+	}
+
+	err = os.RemoveAll(bp)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(filepath.Dir(mp))
+}
+
+func writeFileAtomically(dst string, src io.Reader) (err error) {
+	err = os.MkdirAll(filepath.Dir(dst), 0755)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(dst), localUploadPattern)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			os.Remove(f.Name())
+		}
+	}()
+
+	_, err = io.Copy(f, src)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+	err = os.Chmod(f.Name(), 0644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), dst)
+}
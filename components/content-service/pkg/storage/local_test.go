@@ -0,0 +1,292 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package storage_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	config "github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+)
+
+type localPresignedAccess struct {
+	storage.PresignedAccess
+
+	root string
+}
+
+func (l localPresignedAccess) ForTestCreateObj(ctx context.Context, bucket, path, content string) error {
+	nfo, err := l.SignUpload(ctx, bucket, path, &storage.SignedURLOptions{})
+	if err != nil {
+		return err
+	}
+	return putObject(nfo, content)
+}
+
+func (l localPresignedAccess) ForTestReset(ctx context.Context) error {
+	entries, err := os.ReadDir(l.root)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		err = os.RemoveAll(filepath.Join(l.root, e.Name()))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newLocalStorage(t *testing.T) *config.StorageConfig {
+	cfg := &config.StorageConfig{
+		Stage: config.StageDevStaging,
+		Kind:  config.LocalStorage,
+		LocalConfig: &config.LocalConfig{
+			Path:       t.TempDir(),
+			SigningKey: "test-key",
+		},
+	}
+
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	cfg.LocalConfig.BaseURL = srv.URL + "/storage"
+
+	handler, err := storage.NewLocalStorageHandler(*cfg.LocalConfig)
+	if err != nil {
+		t.Fatalf("cannot create local storage handler: %v", err)
+	}
+	mux.Handle("/storage/", handler)
+
+	return cfg
+}
+
+func TestLocalPresignedHappyPath(t *testing.T) {
+	cfg := newLocalStorage(t)
+
+	ps, err := storage.NewPresignedAccess(cfg)
+	if err != nil {
+		t.Fatalf("cannot create presigned access: %v", err)
+	}
+	SuiteTestPresignedAccess(t, localPresignedAccess{PresignedAccess: ps, root: cfg.LocalConfig.Path})
+}
+
+func TestLocalPresignedURLs(t *testing.T) {
+	cfg := newLocalStorage(t)
+	ctx := context.Background()
+
+	ps, err := storage.NewPresignedAccess(cfg)
+	if err != nil {
+		t.Fatalf("cannot create presigned access: %v", err)
+	}
+
+	const (
+		bucket  = "test-bucket"
+		obj     = "foo/bar.txt"
+		content = "hello world"
+	)
+	up, err := ps.SignUpload(ctx, bucket, obj, &storage.SignedURLOptions{})
+	failOnErr(t, err)
+	failOnErr(t, putObject(up, content))
+
+	down, err := ps.SignDownload(ctx, bucket, obj, &storage.SignedURLOptions{})
+	failOnErr(t, err)
+	if down.Size != int64(len(content)) {
+		t.Errorf("unexpected object size: is %d but expected %d", down.Size, len(content))
+	}
+
+	tamper := func(u, param, value string) string {
+		pu, err := url.Parse(u)
+		failOnErr(t, err)
+		q := pu.Query()
+		q.Set(param, value)
+		pu.RawQuery = q.Encode()
+		return pu.String()
+	}
+
+	tests := []struct {
+		Name           string
+		Method         string
+		URL            string
+		ExpectedStatus int
+		ExpectedBody   string
+	}{
+		{
+			Name:           "download",
+			Method:         http.MethodGet,
+			URL:            down.URL,
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   content,
+		},
+		{
+			Name:           "head",
+			Method:         http.MethodHead,
+			URL:            down.URL,
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "upload URL cannot download",
+			Method:         http.MethodGet,
+			URL:            up.URL,
+			ExpectedStatus: http.StatusForbidden,
+		},
+		{
+			Name:           "download URL cannot upload",
+			Method:         http.MethodPut,
+			URL:            down.URL,
+			ExpectedStatus: http.StatusForbidden,
+		},
+		{
+			Name:           "other object",
+			Method:         http.MethodGet,
+			URL:            strings.Replace(down.URL, "bar.txt", "baz.txt", 1),
+			ExpectedStatus: http.StatusForbidden,
+		},
+		{
+			Name:           "extended expiry",
+			Method:         http.MethodGet,
+			URL:            tamper(down.URL, storage.LocalStorageExpiresParam, "99999999999"),
+			ExpectedStatus: http.StatusForbidden,
+		},
+		{
+			Name:           "missing signature",
+			Method:         http.MethodGet,
+			URL:            tamper(down.URL, storage.LocalStorageSignatureParam, ""),
+			ExpectedStatus: http.StatusForbidden,
+		},
+		{
+			Name:           "unsupported method",
+			Method:         http.MethodDelete,
+			URL:            down.URL,
+			ExpectedStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			req, err := http.NewRequest(test.Method, test.URL, nil)
+			failOnErr(t, err)
+			resp, err := http.DefaultClient.Do(req)
+			failOnErr(t, err)
+			defer resp.Body.Close()
+
+			if resp.StatusCode != test.ExpectedStatus {
+				t.Fatalf("unexpected status: is %d but expected %d", resp.StatusCode, test.ExpectedStatus)
+			}
+			if test.ExpectedBody == "" {
+				return
+			}
+			body, err := io.ReadAll(resp.Body)
+			failOnErr(t, err)
+			if string(body) != test.ExpectedBody {
+				t.Errorf("unexpected body: is %q but expected %q", string(body), test.ExpectedBody)
+			}
+		})
+	}
+}
+
+func TestLocalBackupRoundtrip(t *testing.T) {
+	cfg := newLocalStorage(t)
+	ctx := context.Background()
+
+	const (
+		owner     = "test-user"
+		workspace = "gitpodio-gitpod-2cx8z8e643x"
+	)
+	da, err := storage.NewDirectAccess(cfg)
+	failOnErr(t, err)
+	failOnErr(t, da.Init(ctx, owner, workspace, "fa9aa2af-b6de-45fc-8b48-534bb440429f"))
+	failOnErr(t, da.EnsureExists(ctx))
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	failOnErr(t, tw.WriteHeader(&tar.Header{Name: "hello.txt", Mode: 0644, Size: 5, Typeflag: tar.TypeReg}))
+	_, err = tw.Write([]byte("hello"))
+	failOnErr(t, err)
+	failOnErr(t, tw.Close())
+	src := filepath.Join(t.TempDir(), "backup.tar")
+	failOnErr(t, os.WriteFile(src, buf.Bytes(), 0644))
+
+	bkt, obj, err := da.Upload(ctx, src, storage.DefaultBackup, storage.WithAnnotations(map[string]string{
+		storage.ObjectAnnotationDigest: "sha256:foo",
+	}))
+	failOnErr(t, err)
+
+	size, err := da.ObjectSize(ctx, storage.DefaultBackup)
+	failOnErr(t, err)
+	if size != int64(buf.Len()) {
+		t.Errorf("unexpected object size: is %d but expected %d", size, buf.Len())
+	}
+
+	objs, err := da.ListObjects(ctx, "workspaces/")
+	failOnErr(t, err)
+	if len(objs) != 1 || objs[0] != obj {
+		t.Errorf("unexpected objects: is %v but expected [%s]", objs, obj)
+	}
+
+	dst := t.TempDir()
+	found, err := da.Download(ctx, dst, storage.DefaultBackup, nil)
+	failOnErr(t, err)
+	if !found {
+		t.Fatal("expected backup to be found")
+	}
+	content, err := os.ReadFile(filepath.Join(dst, "hello.txt"))
+	failOnErr(t, err)
+	if string(content) != "hello" {
+		t.Errorf("unexpected content: is %q but expected %q", string(content), "hello")
+	}
+
+	found, err = da.Download(ctx, t.TempDir(), "does-not-exist.tar", nil)
+	failOnErr(t, err)
+	if found {
+		t.Error("expected missing backup not to be found")
+	}
+
+	ps, err := storage.NewPresignedAccess(cfg)
+	failOnErr(t, err)
+	if actual := ps.Bucket(owner); actual != bkt {
+		t.Errorf("unexpected bucket: is %s but expected %s", actual, bkt)
+	}
+	nfo, err := ps.SignDownload(ctx, bkt, ps.BackupObject(owner, workspace, storage.DefaultBackup), &storage.SignedURLOptions{})
+	failOnErr(t, err)
+	if nfo.Meta.Digest != "sha256:foo" {
+		t.Errorf("unexpected digest: is %q but expected %q", nfo.Meta.Digest, "sha256:foo")
+	}
+
+	failOnErr(t, ps.DeleteBucket(ctx, owner, bkt))
+	_, err = da.ObjectSize(ctx, storage.DefaultBackup)
+	if err != storage.ErrNotFound {
+		t.Errorf("expected ErrNotFound after deleting the bucket, got %v", err)
+	}
+}
+
+func putObject(nfo *storage.UploadInfo, content string) error {
+	req, err := http.NewRequest(http.MethodPut, nfo.URL, strings.NewReader(content))
+	if err != nil {
+		return err
+	}
+	for k, v := range nfo.Headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("cannot upload object: %s", resp.Status)
+	}
+	return nil
+}
@@ -312,6 +312,24 @@ func (rs *DirectMinIOStorage) Upload(ctx context.Context, source string, name st
 	return
 }
 
+// ObjectSize returns the size of an object uploaded using Upload
+func (rs *DirectMinIOStorage) ObjectSize(ctx context.Context, name string) (size int64, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DirectObjectSize")
+	defer tracing.FinishSpan(span, &err)
+
+	if rs.client == nil {
+		return 0, xerrors.Errorf("no minio client available - did you call Init()?")
+	}
+
+	stat, err := rs.client.StatObject(ctx, rs.bucketName(), rs.objectName(name), minio.StatObjectOptions{})
+	if err != nil {
+		return 0, translateMinioError(err)
+	}
+
+	return stat.Size, nil
+}
+
 func minioBucketName(ownerID, bucketName string) string {
 	if bucketName != "" {
 		return bucketName
@@ -549,6 +567,55 @@ func (s *presignedMinIOStorage) InstanceObject(ownerID string, workspaceID strin
 	return s.BackupObject(ownerID, workspaceID, InstanceObjectName(instanceID, name))
 }
 
+// ListObjects returns all objects in the bucket that have the given prefix. Returns an empty list if the bucket does not exist.
+func (s *presignedMinIOStorage) ListObjects(ctx context.Context, bucket string, prefix string) (objects []ObjectInfo, err error) {
+	for object := range s.client.ListObjects(ctx, bucket, minio.ListObjectsOptions{
+		Prefix:    prefix,
+		Recursive: true,
+	}) {
+		if object.Err != nil {
+			if translateMinioError(object.Err) == ErrNotFound {
+				return nil, nil
+			}
+			return nil, object.Err
+		}
+		objects = append(objects, ObjectInfo{
+			Name:         object.Key,
+			Size:         object.Size,
+			LastModified: object.LastModified,
+		})
+	}
+	return objects, nil
+}
+
+// ListOwners returns the IDs of all users who own content in the storage
+func (s *presignedMinIOStorage) ListOwners(ctx context.Context) (owners []string, err error) {
+	if s.MinIOConfig.BucketName != "" {
+		// all content lives in a single bucket, prefixed with the owner ID
+		for object := range s.client.ListObjects(ctx, s.MinIOConfig.BucketName, minio.ListObjectsOptions{}) {
+			if object.Err != nil {
+				return nil, object.Err
+			}
+			if strings.HasSuffix(object.Key, "/") {
+				owners = append(owners, strings.TrimSuffix(object.Key, "/"))
+			}
+		}
+		return owners, nil
+	}
+
+	buckets, err := s.client.ListBuckets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	prefix := minioBucketName("", "")
+	for _, bkt := range buckets {
+		if strings.HasPrefix(bkt.Name, prefix) {
+			owners = append(owners, strings.TrimPrefix(bkt.Name, prefix))
+		}
+	}
+	return owners, nil
+}
+
 func translateMinioError(err error) error {
 	if err == nil {
 		return nil
@@ -156,6 +156,36 @@ func (mr *MockPresignedAccessMockRecorder) InstanceObject(arg0, arg1, arg2, arg3
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceObject", reflect.TypeOf((*MockPresignedAccess)(nil).InstanceObject), arg0, arg1, arg2, arg3)
 }
 
+// ListObjects mocks base method.
+func (m *MockPresignedAccess) ListObjects(arg0 context.Context, arg1, arg2 string) ([]storage.ObjectInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjects", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]storage.ObjectInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjects indicates an expected call of ListObjects.
+func (mr *MockPresignedAccessMockRecorder) ListObjects(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjects", reflect.TypeOf((*MockPresignedAccess)(nil).ListObjects), arg0, arg1, arg2)
+}
+
+// ListOwners mocks base method.
+func (m *MockPresignedAccess) ListOwners(arg0 context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOwners", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOwners indicates an expected call of ListOwners.
+func (mr *MockPresignedAccessMockRecorder) ListOwners(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOwners", reflect.TypeOf((*MockPresignedAccess)(nil).ListOwners), arg0)
+}
+
 // ObjectExists mocks base method.
 func (m *MockPresignedAccess) ObjectExists(arg0 context.Context, arg1, arg2 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -340,6 +370,21 @@ func (mr *MockDirectAccessMockRecorder) ListObjects(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjects", reflect.TypeOf((*MockDirectAccess)(nil).ListObjects), arg0, arg1)
 }
 
+// ObjectSize mocks base method.
+func (m *MockDirectAccess) ObjectSize(arg0 context.Context, arg1 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObjectSize", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ObjectSize indicates an expected call of ObjectSize.
+func (mr *MockDirectAccessMockRecorder) ObjectSize(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectSize", reflect.TypeOf((*MockDirectAccess)(nil).ObjectSize), arg0, arg1)
+}
+
 // Qualify mocks base method.
 func (m *MockDirectAccess) Qualify(arg0 string) string {
 	m.ctrl.T.Helper()
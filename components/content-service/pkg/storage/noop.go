@@ -55,6 +55,11 @@ func (rs *DirectNoopStorage) Upload(ctx context.Context, source string, name str
 	return "", "", nil
 }
 
+// ObjectSize always returns ErrNotFound
+func (rs *DirectNoopStorage) ObjectSize(ctx context.Context, name string) (int64, error) {
+	return 0, ErrNotFound
+}
+
 // Bucket returns an empty string
 func (rs *DirectNoopStorage) Bucket(string) string {
 	return ""
@@ -129,3 +134,13 @@ func (*PresignedNoopStorage) BackupObject(ownerID string, workspaceID string, na
 func (*PresignedNoopStorage) InstanceObject(ownerID string, workspaceID string, instanceID string, name string) string {
 	return ""
 }
+
+// ListObjects returns an empty list
+func (*PresignedNoopStorage) ListObjects(ctx context.Context, bucket string, prefix string) ([]ObjectInfo, error) {
+	return nil, nil
+}
+
+// ListOwners returns an empty list
+func (*PresignedNoopStorage) ListOwners(ctx context.Context) ([]string, error) {
+	return nil, nil
+}
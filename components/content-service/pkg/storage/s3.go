@@ -174,6 +174,46 @@ func (rs *PresignedS3Storage) InstanceObject(ownerID string, workspaceID string,
 	return rs.BackupObject(ownerID, workspaceID, InstanceObjectName(instanceID, name))
 }
 
+// ListObjects implements PresignedAccess
+func (rs *PresignedS3Storage) ListObjects(ctx context.Context, bucket string, prefix string) (objects []ObjectInfo, err error) {
+	pager := s3.NewListObjectsV2Paginator(rs.client, &s3.ListObjectsV2Input{
+		Bucket: &rs.Config.Bucket,
+		Prefix: aws.String(prefix),
+	})
+	for pager.HasMorePages() {
+		resp, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range resp.Contents {
+			objects = append(objects, ObjectInfo{
+				Name:         aws.ToString(e.Key),
+				Size:         aws.ToInt64(e.Size),
+				LastModified: aws.ToTime(e.LastModified),
+			})
+		}
+	}
+	return objects, nil
+}
+
+// ListOwners implements PresignedAccess
+func (rs *PresignedS3Storage) ListOwners(ctx context.Context) (owners []string, err error) {
+	pager := s3.NewListObjectsV2Paginator(rs.client, &s3.ListObjectsV2Input{
+		Bucket:    &rs.Config.Bucket,
+		Delimiter: aws.String("/"),
+	})
+	for pager.HasMorePages() {
+		resp, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range resp.CommonPrefixes {
+			owners = append(owners, strings.TrimSuffix(aws.ToString(p.Prefix), "/"))
+		}
+	}
+	return owners, nil
+}
+
 // ObjectExists implements PresignedAccess
 func (rs *PresignedS3Storage) ObjectExists(ctx context.Context, bucket string, path string) (bool, error) {
 	_, err := rs.client.GetObjectAttributes(ctx, &s3.GetObjectAttributesInput{
@@ -441,6 +481,26 @@ func (s3st *s3Storage) Upload(ctx context.Context, source string, name string, o
 	return
 }
 
+// ObjectSize implements DirectAccess
+func (s3st *s3Storage) ObjectSize(ctx context.Context, name string) (size int64, err error) {
+	resp, err := s3st.client.GetObjectAttributes(ctx, &s3.GetObjectAttributesInput{
+		Bucket:           aws.String(s3st.Config.Bucket),
+		Key:              aws.String(s3st.objectName(name)),
+		ObjectAttributes: []types.ObjectAttributes{types.ObjectAttributesObjectSize},
+	})
+
+	var nsk *types.NoSuchKey
+	if errors.As(err, &nsk) {
+		return 0, ErrNotFound
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return *resp.ObjectSize, nil
+}
+
 // UploadInstance implements DirectAccess
 func (s3st *s3Storage) UploadInstance(ctx context.Context, source string, name string, opts ...UploadOption) (bucket string, obj string, err error) {
 	if s3st.InstanceID == "" {
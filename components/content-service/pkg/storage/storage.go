@@ -10,7 +10,10 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"path"
 	"regexp"
+	"strings"
+	"time"
 
 	"golang.org/x/xerrors"
 
@@ -93,6 +96,12 @@ type PresignedAccess interface {
 
 	// InstanceObject returns a instance's object name that a direct downloader would download
 	InstanceObject(ownerID string, workspaceID string, instanceID string, name string) string
+
+	// ListObjects returns all objects in the bucket that have the given prefix. Returns an empty list if the bucket does not exist.
+	ListObjects(ctx context.Context, bucket string, prefix string) ([]ObjectInfo, error)
+
+	// ListOwners returns the IDs of all users who own content in the storage
+	ListOwners(ctx context.Context) ([]string, error)
 }
 
 // ObjectMeta describtes the metadata of a remote object
@@ -103,6 +112,13 @@ type ObjectMeta struct {
 	UncompressedDigest string
 }
 
+// ObjectInfo describes a stored object
+type ObjectInfo struct {
+	Name         string
+	Size         int64
+	LastModified time.Time
+}
+
 // DownloadInfo describes an object for download
 type DownloadInfo struct {
 	Meta ObjectMeta
@@ -113,6 +129,8 @@ type DownloadInfo struct {
 // UploadInfo describes an object for upload
 type UploadInfo struct {
 	URL string
+	// Headers are HTTP headers clients must send when uploading to URL
+	Headers map[string]string
 }
 
 // DeleteObjectQuery specifies objects to delete, either by an exact name or prefix
@@ -161,6 +179,9 @@ type DirectAccess interface {
 
 	// UploadInstance takes all files from a local location and uploads it to the remote storage
 	UploadInstance(ctx context.Context, source string, name string, options ...UploadOption) (bucket, obj string, err error)
+
+	// ObjectSize returns the size of an object uploaded using Upload - if the object is not found, ErrNotFound is returned
+	ObjectSize(ctx context.Context, name string) (size int64, err error)
 }
 
 // UploadOptions configure remote storage upload
@@ -234,6 +255,16 @@ func NewDirectAccess(c *config.StorageConfig) (DirectAccess, error) {
 		return newDirectS3Access(s3.NewFromConfig(*cfg), S3Config{
 			Bucket: c.S3Config.Bucket,
 		}), nil
+	case config.AzureStorage:
+		if c.AzureConfig == nil {
+			return nil, xerrors.Errorf("missing Azure storage config")
+		}
+		return newDirectAzureAccess(*c.AzureConfig)
+	case config.LocalStorage:
+		if c.LocalConfig == nil {
+			return nil, xerrors.Errorf("missing local storage config")
+		}
+		return newDirectLocalAccess(*c.LocalConfig)
 	default:
 		return &DirectNoopStorage{}, nil
 	}
@@ -260,6 +291,16 @@ func NewPresignedAccess(c *config.StorageConfig) (PresignedAccess, error) {
 		return NewPresignedS3Access(s3.NewFromConfig(*cfg), S3Config{
 			Bucket: c.S3Config.Bucket,
 		}), nil
+	case config.AzureStorage:
+		if c.AzureConfig == nil {
+			return nil, xerrors.Errorf("missing Azure storage config")
+		}
+		return newPresignedAzureAccess(*c.AzureConfig)
+	case config.LocalStorage:
+		if c.LocalConfig == nil {
+			return nil, xerrors.Errorf("missing local storage config")
+		}
+		return newPresignedLocalAccess(*c.LocalConfig)
 	default:
 		log.Warnf("falling back to noop presigned storage access. Is this intentional? (storage kind: %s)", c.Kind)
 		return &PresignedNoopStorage{}, nil
@@ -305,6 +346,12 @@ func blobObjectName(name string) (string, error) {
 	return fmt.Sprintf("blobs/%s", name), nil
 }
 
+// BackupManifestName returns the name of the manifest uploaded alongside the backup with the given name,
+// e.g. wsfull.json for full.tar
+func BackupManifestName(backupName string) string {
+	return "ws" + strings.TrimSuffix(backupName, path.Ext(backupName)) + ".json"
+}
+
 func InstanceObjectName(instanceID, name string) string {
 	return fmt.Sprintf("instances/%s/%s", instanceID, name)
 }
@@ -72,3 +72,23 @@ func equivalentError(e1 error, e2 error) bool {
 	}
 	return false
 }
+
+func TestBackupManifestName(t *testing.T) {
+	tests := []struct {
+		Input    string
+		Expected string
+	}{
+		{Input: DefaultBackup, Expected: DefaultBackupManifest},
+		{Input: "snapshot-1234.tar", Expected: "wssnapshot-1234.json"},
+		{Input: "noext", Expected: "wsnoext.json"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Input, func(t *testing.T) {
+			actual := BackupManifestName(test.Input)
+			if actual != test.Expected {
+				t.Fatalf("unexpected manifest name: is '%s' but expected '%s'", actual, test.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package db
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PrebuiltWorkspace represents the underlying DB object
+type PrebuiltWorkspace struct {
+	ID               uuid.UUID `gorm:"primary_key;column:id;type:char;size:36;" json:"id"`
+	CloneURL         string    `gorm:"column:cloneURL;type:varchar;size:255;" json:"cloneURL"`
+	Commit           string    `gorm:"column:commit;type:varchar;size:255;" json:"commit"`
+	ProjectID        string    `gorm:"column:projectId;type:char;size:36;" json:"projectId"`
+	Branch           string    `gorm:"column:branch;type:varchar;size:255;" json:"branch"`
+	State            string    `gorm:"column:state;type:varchar;size:255;" json:"state"`
+	BuildWorkspaceID string    `gorm:"column:buildWorkspaceId;type:char;size:36;" json:"buildWorkspaceId"`
+	Snapshot         string    `gorm:"column:snapshot;type:varchar;size:255;" json:"snapshot"`
+	Error            string    `gorm:"column:error;type:varchar;size:255;" json:"error"`
+
+	CreationTime time.Time `gorm:"column:creationTime;type:timestamp;default:CURRENT_TIMESTAMP(6);" json:"creationTime"`
+	LastModified time.Time `gorm:"column:_lastModified;type:timestamp;default:CURRENT_TIMESTAMP(6);" json:"_lastModified"`
+
+	// deleted is reserved for use by periodic deleter
+	_ bool `gorm:"column:deleted;type:tinyint;default:0;" json:"deleted"`
+}
+
+// TableName sets the insert table name for this struct type
+func (d *PrebuiltWorkspace) TableName() string {
+	return "d_b_prebuilt_workspace"
+}
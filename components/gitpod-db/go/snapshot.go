@@ -0,0 +1,59 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Snapshot represents the underlying DB object
+type Snapshot struct {
+	ID                  uuid.UUID `gorm:"primary_key;column:id;type:char;size:36;" json:"id"`
+	OriginalWorkspaceID string    `gorm:"column:originalWorkspaceId;type:char;size:36;" json:"originalWorkspaceId"`
+	// BucketID is the URL of the snapshot in storage, i.e. <object>@<bucket>
+	BucketID string `gorm:"column:bucketId;type:varchar;size:255;" json:"bucketId"`
+	State    string `gorm:"column:state;type:varchar;size:255;" json:"state"`
+	Message  string `gorm:"column:message;type:varchar;size:255;" json:"message"`
+
+	CreationTime  time.Time   `gorm:"column:creationTime;type:timestamp;default:CURRENT_TIMESTAMP(6);" json:"creationTime"`
+	AvailableTime VarcharTime `gorm:"column:availableTime;type:varchar;size:255;" json:"availableTime"`
+}
+
+// TableName sets the insert table name for this struct type
+func (d *Snapshot) TableName() string {
+	return "d_b_snapshot"
+}
+
+// IsSnapshotReferenced tells whether a prebuild or a user snapshot refers to the snapshot with the given URL (<object>@<bucket>).
+// Prebuilds which were deleted do not count.
+func IsSnapshotReferenced(ctx context.Context, conn *gorm.DB, snapshotURL string) (bool, error) {
+	var prebuilds int64
+	tx := conn.WithContext(ctx).
+		Model(&PrebuiltWorkspace{}).
+		Where("snapshot = ?", snapshotURL).
+		Where("deleted = ?", 0).
+		Count(&prebuilds)
+	if tx.Error != nil {
+		return false, fmt.Errorf("failed to count prebuilds referencing snapshot %s: %w", snapshotURL, tx.Error)
+	}
+	if prebuilds > 0 {
+		return true, nil
+	}
+
+	var snapshots int64
+	tx = conn.WithContext(ctx).
+		Model(&Snapshot{}).
+		Where("bucketId = ?", snapshotURL).
+		Count(&snapshots)
+	if tx.Error != nil {
+		return false, fmt.Errorf("failed to count user snapshots referencing snapshot %s: %w", snapshotURL, tx.Error)
+	}
+	return snapshots > 0, nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package db_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	db "github.com/gitpod-io/gitpod/components/gitpod-db/go"
+	"github.com/gitpod-io/gitpod/components/gitpod-db/go/dbtest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsSnapshotReferenced(t *testing.T) {
+	conn := dbtest.ConnectForTests(t)
+	ctx := context.Background()
+
+	snapshotURL := func() string {
+		return fmt.Sprintf("workspaces/%s/snapshot-%s.tar@gitpod-user-test", dbtest.GenerateWorkspaceID(), uuid.NewString())
+	}
+
+	prebuild := db.PrebuiltWorkspace{
+		ID:               uuid.New(),
+		CloneURL:         "https://github.com/gitpod-io/gitpod.git",
+		Commit:           "586f22ecaeeb3b4796fd92f9ae1ca3512ca1e330",
+		State:            "available",
+		BuildWorkspaceID: dbtest.GenerateWorkspaceID(),
+		Snapshot:         snapshotURL(),
+	}
+	require.NoError(t, conn.Create(&prebuild).Error)
+	t.Cleanup(func() {
+		require.NoError(t, conn.Where("id = ?", prebuild.ID).Delete(&db.PrebuiltWorkspace{}).Error)
+	})
+
+	deletedPrebuild := prebuild
+	deletedPrebuild.ID = uuid.New()
+	deletedPrebuild.Snapshot = snapshotURL()
+	require.NoError(t, conn.Create(&deletedPrebuild).Error)
+	require.NoError(t, conn.Model(&db.PrebuiltWorkspace{}).Where("id = ?", deletedPrebuild.ID).Update("deleted", 1).Error)
+	t.Cleanup(func() {
+		require.NoError(t, conn.Where("id = ?", deletedPrebuild.ID).Delete(&db.PrebuiltWorkspace{}).Error)
+	})
+
+	snapshot := db.Snapshot{
+		ID:                  uuid.New(),
+		OriginalWorkspaceID: dbtest.GenerateWorkspaceID(),
+		BucketID:            snapshotURL(),
+		State:               "available",
+	}
+	require.NoError(t, conn.Create(&snapshot).Error)
+	t.Cleanup(func() {
+		require.NoError(t, conn.Where("id = ?", snapshot.ID).Delete(&db.Snapshot{}).Error)
+	})
+
+	for _, test := range []struct {
+		Name        string
+		SnapshotURL string
+		Expected    bool
+	}{
+		{Name: "prebuild", SnapshotURL: prebuild.Snapshot, Expected: true},
+		{Name: "deleted prebuild", SnapshotURL: deletedPrebuild.Snapshot, Expected: false},
+		{Name: "user snapshot", SnapshotURL: snapshot.BucketID, Expected: true},
+		{Name: "unreferenced", SnapshotURL: snapshotURL(), Expected: false},
+	} {
+		t.Run(test.Name, func(t *testing.T) {
+			ref, err := db.IsSnapshotReferenced(ctx, conn, test.SnapshotURL)
+			require.NoError(t, err)
+			require.Equal(t, test.Expected, ref)
+		})
+	}
+}
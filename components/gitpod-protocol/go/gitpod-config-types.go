@@ -31,6 +31,16 @@ type CoreDump struct {
 type Env struct {
 }
 
+// GitClone Configure how the repository is cloned into the workspace.
+type GitClone struct {
+
+	// Produce a partial clone which fetches file contents (`blob:none`) or trees (`tree:0`) on demand.
+	Filter string `yaml:"filter,omitempty" json:"filter,omitempty"`
+
+	// Directories to check out. If empty, the entire repository is checked out.
+	SparseCheckout []string `yaml:"sparseCheckout,omitempty" json:"sparseCheckout,omitempty"`
+}
+
 // Github Configures Gitpod's GitHub app (deprecated)
 type Github struct {
 
@@ -56,6 +66,9 @@ type GitpodConfig struct {
 	// Experimental network configuration in workspaces (deprecated). Enabled by default
 	ExperimentalNetwork bool `yaml:"experimentalNetwork,omitempty" json:"experimentalNetwork,omitempty"`
 
+	// Configure how the repository is cloned into the workspace.
+	GitClone *GitClone `yaml:"gitClone,omitempty" json:"gitClone,omitempty"`
+
 	// Git config values should be provided in pairs. E.g. `core.autocrlf: input`. See https://git-scm.com/docs/git-config#_values.
 	GitConfig map[string]string `yaml:"gitConfig,omitempty" json:"gitConfig,omitempty"`
 
@@ -873,6 +873,7 @@ export interface WorkspaceConfig {
     checkoutLocation?: string;
     workspaceLocation?: string;
     gitConfig?: { [config: string]: string };
+    gitClone?: GitCloneConfig;
     github?: GithubAppConfig;
     vscode?: VSCodeConfig;
     jetbrains?: JetBrainsConfig;
@@ -900,6 +901,11 @@ export interface WorkspaceConfig {
     _featureFlags?: NamedWorkspaceFeatureFlag[];
 }
 
+export interface GitCloneConfig {
+    filter?: "blob:none" | "tree:0";
+    sparseCheckout?: string[];
+}
+
 export interface GithubAppConfig {
     prebuilds?: GithubAppPrebuildConfig;
 }
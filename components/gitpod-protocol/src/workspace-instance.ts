@@ -180,6 +180,9 @@ export interface WorkspaceInstanceConditions {
 
     // stopped_by_request is true if the workspace was stopped using a StopWorkspace call
     stoppedByRequest?: boolean;
+
+    // paused is true if the processes of the running workspace are frozen. The workspace resumes once it's used again.
+    paused?: boolean;
 }
 
 // AdmissionLevel describes who can access a workspace instance and its ports.
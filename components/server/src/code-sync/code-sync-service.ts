@@ -352,6 +352,7 @@ export class CodeSyncService {
                 method: "PUT",
                 body: content,
                 headers: {
+                    ...Object.fromEntries(urlResponse.getHeadersMap().getEntryList()),
                     "content-length": req.headers["content-length"] || String(content.length),
                     "content-type": contentType,
                 },
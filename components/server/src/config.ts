@@ -22,7 +22,13 @@ import { IRateLimiterOptions } from "rate-limiter-flexible";
 export const Config = Symbol("Config");
 export type Config = Omit<
     ConfigSerialized,
-    "hostUrl" | "stripeSecretsFile" | "stripeConfigFile" | "linkedInSecretsFile" | "patSigningKeyFile" | "auth"
+    | "hostUrl"
+    | "stripeSecretsFile"
+    | "stripeConfigFile"
+    | "linkedInSecretsFile"
+    | "patSigningKeyFile"
+    | "workspaceManagerWebhookSecretFile"
+    | "auth"
 > & {
     hostUrl: GitpodHostUrl;
     workspaceDefaults: WorkspaceDefaults;
@@ -32,6 +38,8 @@ export type Config = Omit<
     inactivityPeriodForReposInDays?: number;
 
     patSigningKey: string;
+    // Key the workspace lifecycle webhooks of ws-manager are signed with. Unset if the webhooks are disabled.
+    workspaceManagerWebhookSecret?: string;
     admin: {
         loginKey?: string;
         // Absolute file path pointing to a file which contains admin credentials, encoded as JSON.
@@ -259,6 +267,12 @@ export interface ConfigSerialized {
      */
     patSigningKeyFile?: string;
 
+    /**
+     * Path to the file containing the key the workspace lifecycle webhooks of ws-manager are signed with.
+     * ws-manager uses them to ask for scheduled workspaces to be started ahead of their window.
+     */
+    workspaceManagerWebhookSecretFile?: string;
+
     auth: {
         pki: AuthPKIConfig;
         session: {
@@ -389,6 +403,17 @@ export namespace ConfigFile {
             }
         }
 
+        let workspaceManagerWebhookSecret: string | undefined;
+        if (config.workspaceManagerWebhookSecretFile) {
+            try {
+                workspaceManagerWebhookSecret = fs
+                    .readFileSync(filePathTelepresenceAware(config.workspaceManagerWebhookSecretFile), "utf-8")
+                    .trim();
+            } catch (error) {
+                log.error("Could not load ws-manager webhook secret", error);
+            }
+        }
+
         const authPKI: Config["auth"]["pki"] = {
             signing: {
                 id: config.auth.pki.signing.id,
@@ -419,6 +444,7 @@ export namespace ConfigFile {
             },
             inactivityPeriodForReposInDays,
             patSigningKey,
+            workspaceManagerWebhookSecret,
             admin: {
                 ...config.admin,
                 credentialsPath: config.admin.credentialsPath,
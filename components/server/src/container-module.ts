@@ -115,6 +115,7 @@ import { GitTokenScopeGuesser } from "./workspace/git-token-scope-guesser";
 import { GitTokenValidator } from "./workspace/git-token-validator";
 import { GitpodServerImpl } from "./workspace/gitpod-server-impl";
 import { HeadlessLogController } from "./workspace/headless-log-controller";
+import { WorkspacePrewarmController } from "./workspace/workspace-prewarm-controller";
 import { HeadlessLogService } from "./workspace/headless-log-service";
 import { ImageSourceProvider } from "./workspace/image-source-provider";
 import { ImageBuildPrefixContextParser } from "./workspace/imagebuild-prefix-context-parser";
@@ -283,6 +284,7 @@ export const productionContainerModule = new ContainerModule(
 
         bind(HeadlessLogService).toSelf().inSingletonScope();
         bind(HeadlessLogController).toSelf().inSingletonScope();
+        bind(WorkspacePrewarmController).toSelf().inSingletonScope();
 
         bind(OrganizationService).toSelf().inSingletonScope();
         bind(LazyOrganizationService).toFactory((ctx) => {
@@ -52,6 +52,7 @@ import {
 } from "./workspace/headless-log-service";
 import { runWithRequestContext } from "./util/request-context";
 import { AnalyticsController } from "./analytics-controller";
+import { WorkspacePrewarmController } from "./workspace/workspace-prewarm-controller";
 import { ProbesApp } from "./liveness/probes";
 
 const MONITORING_PORT = 9500;
@@ -104,6 +105,7 @@ export class Server {
         @inject(RedisSubscriber) private readonly redisSubscriber: RedisSubscriber,
         @inject(AnalyticsController) private readonly analyticsController: AnalyticsController,
         @inject(ProbesApp) private readonly probesApp: ProbesApp,
+        @inject(WorkspacePrewarmController) private readonly workspacePrewarmController: WorkspacePrewarmController,
     ) {}
 
     public async init(app: express.Application) {
@@ -347,6 +349,12 @@ export class Server {
 
         log.info("Registered Bitbucket Server app at " + BitbucketServerApp.path);
         app.use(BitbucketServerApp.path, this.bitbucketServerApp.router);
+
+        // Authorization: signed by ws-manager
+        if (this.config.workspaceManagerWebhookSecret) {
+            log.info("Registered ws-manager webhook at " + WorkspacePrewarmController.path);
+            app.use(WorkspacePrewarmController.path, this.workspacePrewarmController.router);
+        }
     }
 
     public async start(port: number) {
@@ -28,7 +28,7 @@ import {
     CachingIDEPluginClientProvider,
     CachingWorkspaceServiceClientProvider,
 } from "../util/content-service-sugar";
-import { StorageClient } from "./storage-client";
+import { SignedUploadUrl, StorageClient } from "./storage-client";
 
 @injectable()
 export class ContentServiceStorageClient implements StorageClient {
@@ -95,7 +95,7 @@ export class ContentServiceStorageClient implements StorageClient {
         return response.toObject().url;
     }
 
-    public async createPluginUploadUrl(bucket: string, objectPath: string): Promise<string> {
+    public async createPluginUploadUrl(bucket: string, objectPath: string): Promise<SignedUploadUrl> {
         const request = new PluginUploadURLRequest();
         request.setBucket(bucket);
         request.setName(objectPath);
@@ -110,7 +110,10 @@ export class ContentServiceStorageClient implements StorageClient {
                 }
             });
         });
-        return decodeURI(response.toObject().url);
+        return {
+            url: decodeURI(response.getUrl()),
+            headers: Object.fromEntries(response.getHeadersMap().getEntryList()),
+        };
     }
 
     public async createPluginDownloadUrl(bucket: string, objectPath: string): Promise<string> {
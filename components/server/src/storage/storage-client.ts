@@ -6,6 +6,12 @@
 
 export const StorageClient = Symbol("StorageClient");
 
+export interface SignedUploadUrl {
+    url: string;
+    // headers are HTTP headers clients must send when uploading to the URL
+    headers: { [key: string]: string };
+}
+
 export interface StorageClient {
     // deleteUserContent deletes the bucket of a user
     deleteUserContent(ownerId: string): Promise<void>;
@@ -16,7 +22,8 @@ export interface StorageClient {
     // createWorkspaceContentDownloadUrl creates a signed URL from which one can download workspace content
     createWorkspaceContentDownloadUrl(ownerId: string, workspaceId: string): Promise<string>;
 
-    createPluginUploadUrl(bucket: string, objectPath: string): Promise<string>;
+    // createPluginUploadUrl creates a signed URL to which one can upload a plugin, sending the returned headers along
+    createPluginUploadUrl(bucket: string, objectPath: string): Promise<SignedUploadUrl>;
     createPluginDownloadUrl(bucket: string, objectPath: string): Promise<string>;
 
     // getHash produces a hash of the of storage object
@@ -5,7 +5,7 @@
  */
 
 import { injectable } from "inversify";
-import { StorageClient } from "../../storage/storage-client";
+import { SignedUploadUrl, StorageClient } from "../../storage/storage-client";
 
 @injectable()
 export class StorageClientMock implements StorageClient {
@@ -18,7 +18,7 @@ export class StorageClientMock implements StorageClient {
     createWorkspaceContentDownloadUrl(ownerId: string, workspaceId: string): Promise<string> {
         throw new Error("Method not implemented.");
     }
-    createPluginUploadUrl(bucket: string, objectPath: string): Promise<string> {
+    createPluginUploadUrl(bucket: string, objectPath: string): Promise<SignedUploadUrl> {
         throw new Error("Method not implemented.");
     }
     createPluginDownloadUrl(bucket: string, objectPath: string): Promise<string> {
@@ -0,0 +1,41 @@
+/**
+ * Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+ * Licensed under the GNU Affero General Public License (AGPL).
+ * See License.AGPL.txt in the project root for license information.
+ */
+
+import "reflect-metadata";
+
+import { suite, test } from "@testdeck/mocha";
+import { expect } from "chai";
+import { verifySignature } from "./workspace-prewarm-controller";
+
+// produced by Sign of components/ws-manager-mk2/pkg/webhook
+const body = Buffer.from(`{"type":"workspace.prewarm"}`);
+const timestamp = "1774861200";
+const signature = "sha256=15b96d0f51d8e0155364db82c73f4b14f35f300539aefbbe4685af1c7bbd53a2";
+const now = 1774861200 * 1000;
+
+@suite
+class TestWorkspacePrewarmController {
+    @test
+    public testValidSignature() {
+        expect(verifySignature("secret", timestamp, signature, body, now)).to.be.true;
+    }
+
+    @test
+    public testInvalidSignature() {
+        expect(verifySignature("other", timestamp, signature, body, now)).to.be.false;
+        expect(verifySignature("secret", timestamp, signature, Buffer.from(`{"type":"other"}`), now)).to.be.false;
+        expect(verifySignature("secret", timestamp, signature.replace("sha256=", "sha1="), body, now)).to.be.false;
+        expect(verifySignature("secret", timestamp, undefined, body, now)).to.be.false;
+    }
+
+    @test
+    public testReplay() {
+        expect(verifySignature("secret", timestamp, signature, body, now + 6 * 60 * 1000)).to.be.false;
+        expect(verifySignature("secret", undefined, signature, body, now)).to.be.false;
+    }
+}
+
+module.exports = new TestWorkspacePrewarmController();
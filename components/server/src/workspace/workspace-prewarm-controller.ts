@@ -0,0 +1,158 @@
+/**
+ * Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+ * Licensed under the GNU Affero General Public License (AGPL).
+ * See License.AGPL.txt in the project root for license information.
+ */
+
+import express from "express";
+import * as crypto from "crypto";
+import { inject, injectable } from "inversify";
+import { UserDB, WorkspaceDB } from "@gitpod/gitpod-db/lib";
+import { log } from "@gitpod/gitpod-protocol/lib/util/logging";
+import { TraceContext } from "@gitpod/gitpod-protocol/lib/util/tracing";
+import { Config } from "../config";
+import { WorkspaceService } from "./workspace-service";
+import { runWithSubjectId } from "../util/request-context";
+import { SubjectId } from "../auth/subject-id";
+
+/**
+ * The subset of the workspace lifecycle events of ws-manager we act upon.
+ * See components/ws-manager-mk2/pkg/webhook for the full format.
+ */
+interface WorkspaceManagerEvent {
+    id: string;
+    type: string;
+    workspace: {
+        workspaceId: string;
+    };
+    window?: string;
+}
+
+const SIGNATURE_PREFIX = "sha256=";
+// how old the timestamp of an event may be before we reject it
+const SIGNATURE_TOLERANCE_SECONDS = 5 * 60;
+
+/**
+ * Receives the workspace lifecycle webhooks of ws-manager. ws-manager cannot start the workspaces of schedules by
+ * itself, as only we know the instance, owner token and secrets a workspace needs. Instead it asks us to start them
+ * through a workspace.prewarm event, and we start the workspace like the user would.
+ */
+@injectable()
+export class WorkspacePrewarmController {
+    public static path = "/ws-manager/webhook";
+
+    constructor(
+        @inject(Config) private readonly config: Config,
+        @inject(WorkspaceDB) private readonly workspaceDB: WorkspaceDB,
+        @inject(UserDB) private readonly userDB: UserDB,
+        @inject(WorkspaceService) private readonly workspaceService: WorkspaceService,
+    ) {}
+
+    get router(): express.Router {
+        const router = express.Router();
+        router.post("/", async (req, res) => {
+            const secret = this.config.workspaceManagerWebhookSecret;
+            if (!secret) {
+                res.sendStatus(404);
+                return;
+            }
+
+            const rawBody: Buffer | undefined = (req as any).rawBody;
+            if (
+                !rawBody ||
+                !verifySignature(
+                    secret,
+                    req.header("X-Gitpod-Webhook-Timestamp"),
+                    req.header("X-Gitpod-Webhook-Signature"),
+                    rawBody,
+                )
+            ) {
+                res.sendStatus(401);
+                return;
+            }
+
+            const event = req.body as WorkspaceManagerEvent;
+            if (event.type !== "workspace.prewarm") {
+                // we're only interested in prewarm events, but must not make ws-manager retry the others
+                res.sendStatus(200);
+                return;
+            }
+
+            try {
+                await this.prewarm(event);
+                res.sendStatus(200);
+            } catch (err) {
+                log.error({ workspaceId: event.workspace?.workspaceId }, "cannot prewarm scheduled workspace", err, {
+                    eventId: event.id,
+                    window: event.window,
+                });
+                // ws-manager retries the event
+                res.sendStatus(500);
+            }
+        });
+        return router;
+    }
+
+    private async prewarm(event: WorkspaceManagerEvent): Promise<void> {
+        const workspace = await this.workspaceDB.findById(event.workspace.workspaceId);
+        if (!workspace || workspace.softDeleted || workspace.type !== "regular") {
+            log.warn({ workspaceId: event.workspace.workspaceId }, "ignoring prewarm event for unknown workspace", {
+                eventId: event.id,
+            });
+            return;
+        }
+        const owner = await this.userDB.findUserById(workspace.ownerId);
+        if (!owner || owner.blocked) {
+            log.warn({ workspaceId: workspace.id }, "ignoring prewarm event for workspace without active owner", {
+                eventId: event.id,
+            });
+            return;
+        }
+
+        const span = TraceContext.startSpan("WorkspacePrewarmController.prewarm", {});
+        span.setTag("workspaceId", workspace.id);
+        span.setTag("window", event.window);
+        try {
+            // startWorkspace does nothing if the workspace is running already, which makes repeated events harmless
+            const result = await runWithSubjectId(SubjectId.fromUserId(owner.id), () =>
+                this.workspaceService.startWorkspace({ span }, owner, workspace.id),
+            );
+            log.info(
+                { userId: owner.id, workspaceId: workspace.id, instanceId: result.instanceID },
+                "prewarmed scheduled workspace",
+                { window: event.window },
+            );
+        } catch (err) {
+            TraceContext.setError({ span }, err);
+            throw err;
+        } finally {
+            span.finish();
+        }
+    }
+}
+
+/**
+ * verifySignature checks the signature ws-manager sends along with every event, i.e. the hex encoded
+ * HMAC-SHA256 of "<timestamp>.<body>". Events signed too long ago are rejected to prevent replays.
+ */
+export function verifySignature(
+    secret: string,
+    timestamp: string | undefined,
+    signature: string | undefined,
+    body: Buffer,
+    now: number = Date.now(),
+): boolean {
+    if (!timestamp || !signature || !signature.startsWith(SIGNATURE_PREFIX)) {
+        return false;
+    }
+    const sent = Number.parseInt(timestamp, 10);
+    if (Number.isNaN(sent) || Math.abs(now / 1000 - sent) > SIGNATURE_TOLERANCE_SECONDS) {
+        return false;
+    }
+
+    const expected = Buffer.from(
+        SIGNATURE_PREFIX + crypto.createHmac("sha256", secret).update(`${sent}.`).update(body).digest("hex"),
+    );
+    const actual = Buffer.from(signature);
+    return expected.length === actual.length && crypto.timingSafeEqual(expected, actual);
+}
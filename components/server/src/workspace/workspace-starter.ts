@@ -2029,6 +2029,13 @@ export class WorkspaceStarter {
                 }
             }
         }
+        const gitClone = workspace.config.gitClone;
+        if (!!gitClone?.filter) {
+            result.setCloneFilter(gitClone.filter);
+        }
+        if (!!gitClone?.sparseCheckout && gitClone.sparseCheckout.length > 0) {
+            result.setSparseCheckoutPatternsList(gitClone.sparseCheckout);
+        }
         result.setConfig(gitConfig);
         result.setCheckoutLocation(context.checkoutLocation || context.repository.name);
         if (!!cloneTarget) {
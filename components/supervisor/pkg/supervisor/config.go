@@ -303,6 +303,16 @@ type WorkspaceConfig struct {
 	// WorkspaceInstanceID is the instance ID of the workspace
 	WorkspaceInstanceID string `env:"GITPOD_INSTANCE_ID"`
 
+	// TraceContext is the span context of the request which started the workspace.
+	// Supervisor adds its readiness to that trace.
+	TraceContext string `env:"GITPOD_TRACE_CONTEXT"`
+
+	// TraceCollectorEndpoint is the Jaeger collector supervisor reports its spans to
+	TraceCollectorEndpoint string `env:"GITPOD_TRACE_COLLECTOR_ENDPOINT"`
+
+	// TraceAgentHostPort is the Jaeger agent supervisor reports its spans to if there's no collector endpoint
+	TraceAgentHostPort string `env:"GITPOD_TRACE_AGENT_HOST_PORT"`
+
 	// GitpodHost points to the Gitpod API server we're to talk to
 	GitpodHost string `env:"GITPOD_HOST"`
 
@@ -6,7 +6,13 @@ package supervisor
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
 	"sync"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
@@ -22,6 +28,12 @@ const (
 	NotifierMaxPendingNotifications   = 120
 	SubscriberMaxPendingNotifications = 100
 	SubscriberMaxSubscriptions        = 10
+
+	// managerNotificationPort is the port ws-manager sends notifications to. Unlike the API endpoint, it serves
+	// nothing but informational notifications, so that the network policy can open it to ws-manager.
+	managerNotificationPort = 22998
+	// managerNotificationMaxBody is the largest notification we accept from ws-manager
+	managerNotificationMaxBody = 4096
 )
 
 // NewNotificationService creates a new notification service.
@@ -256,3 +268,61 @@ func isActionAllowed(action string, req *api.NotifyRequest) bool {
 	}
 	return false
 }
+
+// startManagerNotificationEndpoint serves the notifications ws-manager sends, e.g. to warn the user that a workspace
+// is about to be stopped according to its schedule. Such notifications have no actions, hence ws-manager cannot
+// wait for or learn anything about the user through this endpoint.
+func startManagerNotificationEndpoint(ctx context.Context, wg *sync.WaitGroup, notifications *NotificationService) {
+	defer wg.Done()
+	defer log.Debug("startManagerNotificationEndpoint shutdown")
+
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", managerNotificationPort))
+	if err != nil {
+		log.WithError(err).Error("cannot start manager notification endpoint")
+		return
+	}
+
+	routes := http.NewServeMux()
+	routes.Handle("/notify", managerNotificationHandler(notifications))
+	srv := &http.Server{
+		Handler:           routes,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+
+	err = srv.Serve(l)
+	if err != nil && err != http.ErrServerClosed {
+		log.WithError(err).Error("manager notification endpoint stopped")
+	}
+}
+
+func managerNotificationHandler(notifications *NotificationService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var req struct {
+			Message string `json:"message"`
+		}
+		err := json.NewDecoder(io.LimitReader(r.Body, managerNotificationMaxBody)).Decode(&req)
+		if err != nil || req.Message == "" {
+			http.Error(w, "invalid notification", http.StatusBadRequest)
+			return
+		}
+
+		_, err = notifications.Notify(r.Context(), &api.NotifyRequest{
+			Level:   api.NotifyRequest_WARNING,
+			Message: req.Message,
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+}
@@ -7,6 +7,9 @@ package supervisor
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -263,3 +266,50 @@ func Test(t *testing.T) {
 		wg.Wait()
 	})
 }
+
+func TestManagerNotificationHandler(t *testing.T) {
+	tests := []struct {
+		Name           string
+		Method         string
+		Body           string
+		ExpectedStatus int
+		ExpectedNotify bool
+	}{
+		{Name: "warning", Method: http.MethodPost, Body: `{"message":"stopping soon"}`, ExpectedStatus: http.StatusOK, ExpectedNotify: true},
+		{Name: "actions are ignored", Method: http.MethodPost, Body: `{"message":"stopping soon","actions":["ok"]}`, ExpectedStatus: http.StatusOK, ExpectedNotify: true},
+		{Name: "empty message", Method: http.MethodPost, Body: `{}`, ExpectedStatus: http.StatusBadRequest},
+		{Name: "invalid body", Method: http.MethodPost, Body: `stopping soon`, ExpectedStatus: http.StatusBadRequest},
+		{Name: "wrong method", Method: http.MethodGet, ExpectedStatus: http.StatusMethodNotAllowed},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			notificationService := NewNotificationService()
+			rec := httptest.NewRecorder()
+			managerNotificationHandler(notificationService).ServeHTTP(rec, httptest.NewRequest(test.Method, "/notify", strings.NewReader(test.Body)))
+			if rec.Code != test.ExpectedStatus {
+				t.Fatalf("expected status %d, got %d", test.ExpectedStatus, rec.Code)
+			}
+
+			subscriber := NewSubscribeServer()
+			subscriber.resps = make(chan *api.SubscribeResponse, 10)
+			go func() {
+				_ = notificationService.Subscribe(&api.SubscribeRequest{}, subscriber)
+			}()
+			defer subscriber.cancel()
+
+			select {
+			case resp := <-subscriber.resps:
+				if !test.ExpectedNotify {
+					t.Fatalf("unexpected notification: %v", resp.Request)
+				}
+				if resp.Request.Level != api.NotifyRequest_WARNING || resp.Request.Message != "stopping soon" || len(resp.Request.Actions) != 0 {
+					t.Errorf("unexpected notification: %v", resp.Request)
+				}
+			case <-time.After(100 * time.Millisecond):
+				if test.ExpectedNotify {
+					t.Fatal("expected a notification")
+				}
+			}
+		})
+	}
+}
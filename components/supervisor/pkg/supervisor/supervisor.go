@@ -39,6 +39,7 @@ import (
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	grpcruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"github.com/opentracing/opentracing-go"
 	"github.com/prometheus/common/route"
 	"github.com/soheilhy/cmux"
 	"golang.org/x/crypto/ssh"
@@ -53,6 +54,7 @@ import (
 	"github.com/gitpod-io/gitpod/common-go/experiments"
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/common-go/pprof"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
 	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	"github.com/gitpod-io/gitpod/content-service/pkg/executor"
 	"github.com/gitpod-io/gitpod/content-service/pkg/git"
@@ -189,6 +191,13 @@ func Run(options ...RunOption) {
 		return
 	}
 
+	if cfg.TraceContext != "" {
+		// the workspace does not get the JAEGER_* env of ws-manager, but where to report to
+		if closer := tracing.Init("supervisor", tracing.WithReporterEndpoint(cfg.TraceCollectorEndpoint, cfg.TraceAgentHostPort)); closer != nil {
+			defer closer.Close()
+		}
+	}
+
 	var (
 		ideReady                       = newIDEReadyState(&cfg.IDE)
 		desktopIdeReady *ideReadyState = nil
@@ -262,7 +271,7 @@ func Run(options ...RunOption) {
 
 	ctx, cancel := context.WithCancel(context.Background())
 
-	internalPorts := []uint32{uint32(cfg.IDEPort), uint32(cfg.APIEndpointPort), uint32(cfg.SSHPort)}
+	internalPorts := []uint32{uint32(cfg.IDEPort), uint32(cfg.APIEndpointPort), uint32(cfg.SSHPort), managerNotificationPort}
 	if cfg.GetDesktopIDE() != nil {
 		internalPorts = append(internalPorts, desktopIDEPort)
 	}
@@ -309,7 +318,7 @@ func Run(options ...RunOption) {
 		internalPorts...,
 	)
 
-	topService := NewTopService()
+	topService := NewTopService(notificationService)
 	if !opts.RunGP {
 		topService.Observe(ctx)
 	}
@@ -458,6 +467,11 @@ func Run(options ...RunOption) {
 	wg.Add(1)
 	go startSSHServer(ctx, cfg, &wg)
 
+	if !cfg.isHeadless() {
+		wg.Add(1)
+		go startManagerNotificationEndpoint(ctx, &wg, notificationService)
+	}
+
 	wg.Add(1)
 	tasksSuccessChan := make(chan taskSuccess, 1)
 	go taskManager.Run(ctx, &wg, tasksSuccessChan)
@@ -1329,6 +1343,7 @@ func isBlacklistedEnvvar(name string) bool {
 	prefixBlacklist := []string{
 		"THEIA_SUPERVISOR_",
 		"GITPOD_TOKENS",
+		"GITPOD_TRACE_",
 		// The following vars are meant to filter out the kubernetes-injected env vars that we do not know how to turn of (yet)
 		"KUBERNETES_SERVICE",
 		"KUBERNETES_PORT",
@@ -1946,6 +1961,7 @@ func analyseConfigChanges(ctx context.Context, wscfg *Config, w analytics.Writer
 }
 
 func trackReadiness(ctx context.Context, w analytics.Writer, cfg *Config, cstate *InMemoryContentState, ideReady *ideReadyState, desktopIdeReady *ideReadyState) {
+	start := time.Now()
 	trackFn := func(cfg *Config, kind string) {
 		w.Track(analytics.TrackMessage{
 			Identity: analytics.Identity{UserID: cfg.OwnerId},
@@ -1957,6 +1973,13 @@ func trackReadiness(ctx context.Context, w analytics.Writer, cfg *Config, cstate
 				"timestamp":   time.Now().UnixMilli(),
 			},
 		})
+
+		if cfg.TraceContext != "" {
+			span := opentracing.StartSpan("SupervisorReadiness", tracing.ChildOfTraceID(cfg.TraceContext), opentracing.StartTime(start))
+			span.SetTag("kind", kind)
+			span.SetTag("instanceId", cfg.WorkspaceInstanceID)
+			span.Finish()
+		}
 	}
 	const (
 		readinessKindContent    = "content"
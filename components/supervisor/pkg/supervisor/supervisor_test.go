@@ -148,6 +148,8 @@ func TestIsBlacklistedEnvvar(t *testing.T) {
 		{Name: "deprecated theia envvars", Input: "THEIA_SUPERVISOR_FOOBAR", Expectation: true},
 		{Name: "gitpod tokens", Input: "GITPOD_TOKENS", Expectation: true},
 		{Name: "gitpod tokens child", Input: "GITPOD_TOKENS_GITHUB", Expectation: true},
+		{Name: "gitpod trace context", Input: "GITPOD_TRACE_CONTEXT", Expectation: true},
+		{Name: "gitpod trace collector", Input: "GITPOD_TRACE_COLLECTOR_ENDPOINT", Expectation: true},
 		{Name: "kubernetes services", Input: "KUBERNETES_SERVICE_FOOBAR", Expectation: true},
 		{Name: "kubernetes service ports", Input: "KUBERNETES_PORT_FOOBAR", Expectation: true},
 		{Name: "something with spaces", Input: "   I_DO_NOT_UNDERSTAND", Expectation: true},
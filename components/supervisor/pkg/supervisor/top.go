@@ -24,17 +24,26 @@ import (
 	daemonapi "github.com/gitpod-io/gitpod/ws-daemon/api"
 )
 
+// memoryNotificationInterval is the minimum time between two low memory notifications
+const memoryNotificationInterval = 30 * time.Minute
+
 type TopService struct {
 	data      *api.ResourcesStatusResponse
 	ready     chan struct{}
 	readyOnce sync.Once
 	top       func(ctx context.Context) (*api.ResourcesStatusResponse, error)
+
+	notifications      *NotificationService
+	lastMemoryNotified time.Time
 }
 
-func NewTopService() *TopService {
+// NewTopService creates a new top service. If notifications is not nil, the user is notified
+// when the workspace runs low on memory.
+func NewTopService(notifications *NotificationService) *TopService {
 	log.Debug("gitpod top service: initialized")
 	return &TopService{
-		top: Top,
+		top:           Top,
+		notifications: notifications,
 	}
 }
 
@@ -54,6 +63,7 @@ func (t *TopService) Observe(ctx context.Context) {
 			data, err := t.top(ctx)
 			if err == nil {
 				delay = minReconnectionDelay
+				t.notifyMemorySeverity(ctx, t.data, data)
 				t.data = data
 
 				t.readyOnce.Do(func() {
@@ -76,6 +86,31 @@ func (t *TopService) Observe(ctx context.Context) {
 	}()
 }
 
+// notifyMemorySeverity notifies the user when the memory severity of the workspace turns to danger,
+// e.g. because ws-daemon found the workspace to be under sustained memory pressure.
+func (t *TopService) notifyMemorySeverity(ctx context.Context, prev, cur *api.ResourcesStatusResponse) {
+	if t.notifications == nil || cur.GetMemory().GetSeverity() != api.ResourceStatusSeverity_danger {
+		return
+	}
+	if prev.GetMemory().GetSeverity() == api.ResourceStatusSeverity_danger {
+		return
+	}
+	if time.Since(t.lastMemoryNotified) < memoryNotificationInterval {
+		return
+	}
+	t.lastMemoryNotified = time.Now()
+
+	go func() {
+		_, err := t.notifications.Notify(ctx, &api.NotifyRequest{
+			Level:   api.NotifyRequest_WARNING,
+			Message: "Your workspace is running out of memory. Stop processes you no longer need, otherwise some of them might get killed or the workspace might be stopped.",
+		})
+		if err != nil && ctx.Err() == nil {
+			log.WithError(err).Warn("cannot notify about low memory")
+		}
+	}()
+}
+
 func calcSeverity(value int64) api.ResourceStatusSeverity {
 	switch {
 	case value >= 95:
@@ -127,11 +162,17 @@ func Top(ctx context.Context) (*api.ResourcesStatusResponse, error) {
 		cpuPercentage := int64((float64(resp.Resources.Cpu.Used) / float64(resp.Resources.Cpu.Limit)) * 100)
 		memoryPercentage := int64((float64(resp.Resources.Memory.Used) / float64(resp.Resources.Memory.Limit)) * 100)
 
+		memorySeverity := calcSeverity(memoryPercentage)
+		if resp.Resources.Memory.Pressure >= daemonapi.MemoryPressure_MEMORY_PRESSURE_WARNING {
+			// ws-daemon could not relieve the memory pressure by reclaiming memory
+			memorySeverity = api.ResourceStatusSeverity_danger
+		}
+
 		return &api.ResourcesStatusResponse{
 			Memory: &api.ResourceStatus{
 				Limit:    resp.Resources.Memory.Limit,
 				Used:     resp.Resources.Memory.Used,
-				Severity: calcSeverity(memoryPercentage),
+				Severity: memorySeverity,
 			},
 			Cpu: &api.ResourceStatus{
 				Limit:    resp.Resources.Cpu.Limit,
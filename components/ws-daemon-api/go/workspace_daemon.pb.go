@@ -69,6 +69,63 @@ func (FSShiftMethod) EnumDescriptor() ([]byte, []int) {
 	return file_workspace_daemon_proto_rawDescGZIP(), []int{0}
 }
 
+// MemoryPressure describes how ws-daemon classifies the memory pressure of a workspace.
+type MemoryPressure int32
+
+const (
+	// the workspace is not under sustained memory pressure
+	MemoryPressure_MEMORY_PRESSURE_NONE MemoryPressure = 0
+	// ws-daemon has lowered memory.high to make the kernel reclaim memory
+	MemoryPressure_MEMORY_PRESSURE_RECLAIM MemoryPressure = 1
+	// reclaiming did not relieve the pressure, the user should free up memory
+	MemoryPressure_MEMORY_PRESSURE_WARNING MemoryPressure = 2
+	// the workspace has been flagged as candidate for being stopped
+	MemoryPressure_MEMORY_PRESSURE_CRITICAL MemoryPressure = 3
+)
+
+// Enum value maps for MemoryPressure.
+var (
+	MemoryPressure_name = map[int32]string{
+		0: "MEMORY_PRESSURE_NONE",
+		1: "MEMORY_PRESSURE_RECLAIM",
+		2: "MEMORY_PRESSURE_WARNING",
+		3: "MEMORY_PRESSURE_CRITICAL",
+	}
+	MemoryPressure_value = map[string]int32{
+		"MEMORY_PRESSURE_NONE":     0,
+		"MEMORY_PRESSURE_RECLAIM":  1,
+		"MEMORY_PRESSURE_WARNING":  2,
+		"MEMORY_PRESSURE_CRITICAL": 3,
+	}
+)
+
+func (x MemoryPressure) Enum() *MemoryPressure {
+	p := new(MemoryPressure)
+	*p = x
+	return p
+}
+
+func (x MemoryPressure) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemoryPressure) Descriptor() protoreflect.EnumDescriptor {
+	return file_workspace_daemon_proto_enumTypes[1].Descriptor()
+}
+
+func (MemoryPressure) Type() protoreflect.EnumType {
+	return &file_workspace_daemon_proto_enumTypes[1]
+}
+
+func (x MemoryPressure) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemoryPressure.Descriptor instead.
+func (MemoryPressure) EnumDescriptor() ([]byte, []int) {
+	return file_workspace_daemon_proto_rawDescGZIP(), []int{1}
+}
+
 type PrepareForUserNSRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1208,8 +1265,9 @@ type Memory struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Used  int64 `protobuf:"varint,1,opt,name=used,proto3" json:"used,omitempty"`
-	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Used     int64          `protobuf:"varint,1,opt,name=used,proto3" json:"used,omitempty"`
+	Limit    int64          `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Pressure MemoryPressure `protobuf:"varint,3,opt,name=pressure,proto3,enum=iws.MemoryPressure" json:"pressure,omitempty"`
 }
 
 func (x *Memory) Reset() {
@@ -1258,6 +1316,13 @@ func (x *Memory) GetLimit() int64 {
 	return 0
 }
 
+func (x *Memory) GetPressure() MemoryPressure {
+	if x != nil {
+		return x.Pressure
+	}
+	return MemoryPressure_MEMORY_PRESSURE_NONE
+}
+
 type WriteIDMappingRequest_Mapping struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1410,79 +1475,91 @@ var file_workspace_daemon_proto_rawDesc = []byte{
 	0x22, 0x2f, 0x0a, 0x03, 0x43, 0x70, 0x75, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c,
 	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x22, 0x32, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x74, 0x22, 0x63, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x75,
 	0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x12,
 	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2a, 0x22, 0x0a, 0x0d, 0x46, 0x53, 0x53, 0x68, 0x69, 0x66, 0x74,
-	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x48, 0x49, 0x46, 0x54, 0x46,
-	0x53, 0x10, 0x00, 0x22, 0x04, 0x08, 0x01, 0x10, 0x01, 0x32, 0x99, 0x07, 0x0a, 0x12, 0x49, 0x6e,
-	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x51, 0x0a, 0x10, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x73,
-	0x65, 0x72, 0x4e, 0x53, 0x12, 0x1c, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x61,
-	0x72, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65,
-	0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x49, 0x44, 0x4d, 0x61,
-	0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x57, 0x72, 0x69, 0x74,
-	0x65, 0x49, 0x44, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x49, 0x44, 0x4d,
-	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x4b, 0x0a, 0x0e, 0x45, 0x76, 0x61, 0x63, 0x75, 0x61, 0x74, 0x65, 0x43, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x12, 0x1a, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x45, 0x76, 0x61, 0x63, 0x75, 0x61, 0x74,
-	0x65, 0x43, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x69, 0x77, 0x73, 0x2e, 0x45, 0x76, 0x61, 0x63, 0x75, 0x61, 0x74, 0x65, 0x43, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a,
-	0x09, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x12, 0x15, 0x2e, 0x69, 0x77, 0x73,
-	0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f,
-	0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0a, 0x55,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x12, 0x16, 0x2e, 0x69, 0x77, 0x73, 0x2e,
-	0x55, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x17, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x55, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72,
-	0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a,
-	0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x79, 0x73, 0x66, 0x73, 0x12, 0x15, 0x2e, 0x69, 0x77, 0x73,
-	0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f,
-	0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x55,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x79, 0x73, 0x66, 0x73, 0x12, 0x16, 0x2e, 0x69, 0x77, 0x73,
-	0x2e, 0x55, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x55, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50,
-	0x72, 0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a,
-	0x08, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x66, 0x73, 0x12, 0x14, 0x2e, 0x69, 0x77, 0x73, 0x2e,
-	0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x15, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x66, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x09, 0x55, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x4e, 0x66, 0x73, 0x12, 0x15, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x55, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x4e, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x69,
-	0x77, 0x73, 0x2e, 0x55, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x08, 0x54, 0x65, 0x61, 0x72, 0x64, 0x6f,
-	0x77, 0x6e, 0x12, 0x14, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x54, 0x65, 0x61, 0x72, 0x64, 0x6f, 0x77,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x54,
-	0x65, 0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x4b, 0x0a, 0x0e, 0x57, 0x69, 0x70, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x61, 0x72, 0x64,
-	0x6f, 0x77, 0x6e, 0x12, 0x1a, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x57, 0x69, 0x70, 0x69, 0x6e, 0x67,
-	0x54, 0x65, 0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1b, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x57, 0x69, 0x70, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x61, 0x72,
-	0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b,
-	0x0a, 0x0e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x50, 0x61, 0x69, 0x72, 0x56, 0x65, 0x74, 0x68, 0x73,
-	0x12, 0x1a, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x50, 0x61, 0x69, 0x72,
-	0x56, 0x65, 0x74, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x69,
-	0x77, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x50, 0x61, 0x69, 0x72, 0x56, 0x65, 0x74, 0x68,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x57,
-	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x2e, 0x69,
-	0x77, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x57, 0x6f,
-	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x60, 0x0a, 0x14, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a,
-	0x0d, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19,
-	0x2e, 0x69, 0x77, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x69, 0x77, 0x73, 0x2e,
-	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x69, 0x74, 0x70, 0x6f, 0x64, 0x2d, 0x69, 0x6f, 0x2f,
-	0x67, 0x69, 0x74, 0x70, 0x6f, 0x64, 0x2f, 0x77, 0x73, 0x2d, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
-	0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2f, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x52, 0x08, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x2a, 0x22, 0x0a, 0x0d, 0x46, 0x53, 0x53, 0x68, 0x69, 0x66,
+	0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x48, 0x49, 0x46, 0x54,
+	0x46, 0x53, 0x10, 0x00, 0x22, 0x04, 0x08, 0x01, 0x10, 0x01, 0x2a, 0x82, 0x01, 0x0a, 0x0e, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12, 0x18, 0x0a,
+	0x14, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x53, 0x55, 0x52, 0x45,
+	0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x45, 0x4d, 0x4f, 0x52,
+	0x59, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x53, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x4c, 0x41,
+	0x49, 0x4d, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x50,
+	0x52, 0x45, 0x53, 0x53, 0x55, 0x52, 0x45, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10,
+	0x02, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x50, 0x52, 0x45, 0x53,
+	0x53, 0x55, 0x52, 0x45, 0x5f, 0x43, 0x52, 0x49, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x03, 0x32,
+	0x99, 0x07, 0x0a, 0x12, 0x49, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x10, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72,
+	0x65, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x53, 0x12, 0x1c, 0x2e, 0x69, 0x77, 0x73,
+	0x2e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x4e,
+	0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x50,
+	0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x53, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x49, 0x44, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x2e, 0x69, 0x77,
+	0x73, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x49, 0x44, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x49, 0x44, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e, 0x45, 0x76, 0x61, 0x63, 0x75, 0x61,
+	0x74, 0x65, 0x43, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1a, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x45,
+	0x76, 0x61, 0x63, 0x75, 0x61, 0x74, 0x65, 0x43, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x45, 0x76, 0x61, 0x63, 0x75,
+	0x61, 0x74, 0x65, 0x43, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x09, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x63,
+	0x12, 0x15, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x63,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x4d, 0x6f,
+	0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x3f, 0x0a, 0x0a, 0x55, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x12,
+	0x16, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x55, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x63,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x55, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x79, 0x73, 0x66, 0x73,
+	0x12, 0x15, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x63,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x4d, 0x6f,
+	0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x40, 0x0a, 0x0b, 0x55, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x79, 0x73, 0x66, 0x73,
+	0x12, 0x16, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x55, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f,
+	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x55,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x08, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x66, 0x73, 0x12,
+	0x14, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x66, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x4d, 0x6f, 0x75, 0x6e,
+	0x74, 0x4e, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c,
+	0x0a, 0x09, 0x55, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x66, 0x73, 0x12, 0x15, 0x2e, 0x69, 0x77,
+	0x73, 0x2e, 0x55, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x55, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
+	0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x08,
+	0x54, 0x65, 0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x14, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x54,
+	0x65, 0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x69, 0x77, 0x73, 0x2e, 0x54, 0x65, 0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e, 0x57, 0x69, 0x70, 0x69, 0x6e,
+	0x67, 0x54, 0x65, 0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x1a, 0x2e, 0x69, 0x77, 0x73, 0x2e,
+	0x57, 0x69, 0x70, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x57, 0x69, 0x70, 0x69,
+	0x6e, 0x67, 0x54, 0x65, 0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x50, 0x61, 0x69,
+	0x72, 0x56, 0x65, 0x74, 0x68, 0x73, 0x12, 0x1a, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x53, 0x65, 0x74,
+	0x75, 0x70, 0x50, 0x61, 0x69, 0x72, 0x56, 0x65, 0x74, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x50, 0x61,
+	0x69, 0x72, 0x56, 0x65, 0x74, 0x68, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x48, 0x0a, 0x0d, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x19, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x69, 0x77, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x60, 0x0a, 0x14, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x69, 0x77, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2b, 0x5a,
+	0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x69, 0x74, 0x70,
+	0x6f, 0x64, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x69, 0x74, 0x70, 0x6f, 0x64, 0x2f, 0x77, 0x73, 0x2d,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -1497,76 +1574,78 @@ func file_workspace_daemon_proto_rawDescGZIP() []byte {
 	return file_workspace_daemon_proto_rawDescData
 }
 
-var file_workspace_daemon_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_workspace_daemon_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_workspace_daemon_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_workspace_daemon_proto_goTypes = []interface{}{
 	(FSShiftMethod)(0),                    // 0: iws.FSShiftMethod
-	(*PrepareForUserNSRequest)(nil),       // 1: iws.PrepareForUserNSRequest
-	(*PrepareForUserNSResponse)(nil),      // 2: iws.PrepareForUserNSResponse
-	(*WriteIDMappingResponse)(nil),        // 3: iws.WriteIDMappingResponse
-	(*WriteIDMappingRequest)(nil),         // 4: iws.WriteIDMappingRequest
-	(*EvacuateCGroupRequest)(nil),         // 5: iws.EvacuateCGroupRequest
-	(*EvacuateCGroupResponse)(nil),        // 6: iws.EvacuateCGroupResponse
-	(*MountProcRequest)(nil),              // 7: iws.MountProcRequest
-	(*MountProcResponse)(nil),             // 8: iws.MountProcResponse
-	(*UmountProcRequest)(nil),             // 9: iws.UmountProcRequest
-	(*UmountProcResponse)(nil),            // 10: iws.UmountProcResponse
-	(*MountNfsRequest)(nil),               // 11: iws.MountNfsRequest
-	(*MountNfsResponse)(nil),              // 12: iws.MountNfsResponse
-	(*UmountNfsRequest)(nil),              // 13: iws.UmountNfsRequest
-	(*UmountNfsResponse)(nil),             // 14: iws.UmountNfsResponse
-	(*TeardownRequest)(nil),               // 15: iws.TeardownRequest
-	(*TeardownResponse)(nil),              // 16: iws.TeardownResponse
-	(*WipingTeardownRequest)(nil),         // 17: iws.WipingTeardownRequest
-	(*WipingTeardownResponse)(nil),        // 18: iws.WipingTeardownResponse
-	(*SetupPairVethsRequest)(nil),         // 19: iws.SetupPairVethsRequest
-	(*SetupPairVethsResponse)(nil),        // 20: iws.SetupPairVethsResponse
-	(*WorkspaceInfoRequest)(nil),          // 21: iws.WorkspaceInfoRequest
-	(*WorkspaceInfoResponse)(nil),         // 22: iws.WorkspaceInfoResponse
-	(*Resources)(nil),                     // 23: iws.Resources
-	(*Cpu)(nil),                           // 24: iws.Cpu
-	(*Memory)(nil),                        // 25: iws.Memory
-	(*WriteIDMappingRequest_Mapping)(nil), // 26: iws.WriteIDMappingRequest.Mapping
+	(MemoryPressure)(0),                   // 1: iws.MemoryPressure
+	(*PrepareForUserNSRequest)(nil),       // 2: iws.PrepareForUserNSRequest
+	(*PrepareForUserNSResponse)(nil),      // 3: iws.PrepareForUserNSResponse
+	(*WriteIDMappingResponse)(nil),        // 4: iws.WriteIDMappingResponse
+	(*WriteIDMappingRequest)(nil),         // 5: iws.WriteIDMappingRequest
+	(*EvacuateCGroupRequest)(nil),         // 6: iws.EvacuateCGroupRequest
+	(*EvacuateCGroupResponse)(nil),        // 7: iws.EvacuateCGroupResponse
+	(*MountProcRequest)(nil),              // 8: iws.MountProcRequest
+	(*MountProcResponse)(nil),             // 9: iws.MountProcResponse
+	(*UmountProcRequest)(nil),             // 10: iws.UmountProcRequest
+	(*UmountProcResponse)(nil),            // 11: iws.UmountProcResponse
+	(*MountNfsRequest)(nil),               // 12: iws.MountNfsRequest
+	(*MountNfsResponse)(nil),              // 13: iws.MountNfsResponse
+	(*UmountNfsRequest)(nil),              // 14: iws.UmountNfsRequest
+	(*UmountNfsResponse)(nil),             // 15: iws.UmountNfsResponse
+	(*TeardownRequest)(nil),               // 16: iws.TeardownRequest
+	(*TeardownResponse)(nil),              // 17: iws.TeardownResponse
+	(*WipingTeardownRequest)(nil),         // 18: iws.WipingTeardownRequest
+	(*WipingTeardownResponse)(nil),        // 19: iws.WipingTeardownResponse
+	(*SetupPairVethsRequest)(nil),         // 20: iws.SetupPairVethsRequest
+	(*SetupPairVethsResponse)(nil),        // 21: iws.SetupPairVethsResponse
+	(*WorkspaceInfoRequest)(nil),          // 22: iws.WorkspaceInfoRequest
+	(*WorkspaceInfoResponse)(nil),         // 23: iws.WorkspaceInfoResponse
+	(*Resources)(nil),                     // 24: iws.Resources
+	(*Cpu)(nil),                           // 25: iws.Cpu
+	(*Memory)(nil),                        // 26: iws.Memory
+	(*WriteIDMappingRequest_Mapping)(nil), // 27: iws.WriteIDMappingRequest.Mapping
 }
 var file_workspace_daemon_proto_depIdxs = []int32{
 	0,  // 0: iws.PrepareForUserNSResponse.fs_shift:type_name -> iws.FSShiftMethod
-	26, // 1: iws.WriteIDMappingRequest.mapping:type_name -> iws.WriteIDMappingRequest.Mapping
-	23, // 2: iws.WorkspaceInfoResponse.resources:type_name -> iws.Resources
-	24, // 3: iws.Resources.cpu:type_name -> iws.Cpu
-	25, // 4: iws.Resources.memory:type_name -> iws.Memory
-	1,  // 5: iws.InWorkspaceService.PrepareForUserNS:input_type -> iws.PrepareForUserNSRequest
-	4,  // 6: iws.InWorkspaceService.WriteIDMapping:input_type -> iws.WriteIDMappingRequest
-	5,  // 7: iws.InWorkspaceService.EvacuateCGroup:input_type -> iws.EvacuateCGroupRequest
-	7,  // 8: iws.InWorkspaceService.MountProc:input_type -> iws.MountProcRequest
-	9,  // 9: iws.InWorkspaceService.UmountProc:input_type -> iws.UmountProcRequest
-	7,  // 10: iws.InWorkspaceService.MountSysfs:input_type -> iws.MountProcRequest
-	9,  // 11: iws.InWorkspaceService.UmountSysfs:input_type -> iws.UmountProcRequest
-	11, // 12: iws.InWorkspaceService.MountNfs:input_type -> iws.MountNfsRequest
-	13, // 13: iws.InWorkspaceService.UmountNfs:input_type -> iws.UmountNfsRequest
-	15, // 14: iws.InWorkspaceService.Teardown:input_type -> iws.TeardownRequest
-	17, // 15: iws.InWorkspaceService.WipingTeardown:input_type -> iws.WipingTeardownRequest
-	19, // 16: iws.InWorkspaceService.SetupPairVeths:input_type -> iws.SetupPairVethsRequest
-	21, // 17: iws.InWorkspaceService.WorkspaceInfo:input_type -> iws.WorkspaceInfoRequest
-	21, // 18: iws.WorkspaceInfoService.WorkspaceInfo:input_type -> iws.WorkspaceInfoRequest
-	2,  // 19: iws.InWorkspaceService.PrepareForUserNS:output_type -> iws.PrepareForUserNSResponse
-	3,  // 20: iws.InWorkspaceService.WriteIDMapping:output_type -> iws.WriteIDMappingResponse
-	6,  // 21: iws.InWorkspaceService.EvacuateCGroup:output_type -> iws.EvacuateCGroupResponse
-	8,  // 22: iws.InWorkspaceService.MountProc:output_type -> iws.MountProcResponse
-	10, // 23: iws.InWorkspaceService.UmountProc:output_type -> iws.UmountProcResponse
-	8,  // 24: iws.InWorkspaceService.MountSysfs:output_type -> iws.MountProcResponse
-	10, // 25: iws.InWorkspaceService.UmountSysfs:output_type -> iws.UmountProcResponse
-	12, // 26: iws.InWorkspaceService.MountNfs:output_type -> iws.MountNfsResponse
-	14, // 27: iws.InWorkspaceService.UmountNfs:output_type -> iws.UmountNfsResponse
-	16, // 28: iws.InWorkspaceService.Teardown:output_type -> iws.TeardownResponse
-	18, // 29: iws.InWorkspaceService.WipingTeardown:output_type -> iws.WipingTeardownResponse
-	20, // 30: iws.InWorkspaceService.SetupPairVeths:output_type -> iws.SetupPairVethsResponse
-	22, // 31: iws.InWorkspaceService.WorkspaceInfo:output_type -> iws.WorkspaceInfoResponse
-	22, // 32: iws.WorkspaceInfoService.WorkspaceInfo:output_type -> iws.WorkspaceInfoResponse
-	19, // [19:33] is the sub-list for method output_type
-	5,  // [5:19] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	27, // 1: iws.WriteIDMappingRequest.mapping:type_name -> iws.WriteIDMappingRequest.Mapping
+	24, // 2: iws.WorkspaceInfoResponse.resources:type_name -> iws.Resources
+	25, // 3: iws.Resources.cpu:type_name -> iws.Cpu
+	26, // 4: iws.Resources.memory:type_name -> iws.Memory
+	1,  // 5: iws.Memory.pressure:type_name -> iws.MemoryPressure
+	2,  // 6: iws.InWorkspaceService.PrepareForUserNS:input_type -> iws.PrepareForUserNSRequest
+	5,  // 7: iws.InWorkspaceService.WriteIDMapping:input_type -> iws.WriteIDMappingRequest
+	6,  // 8: iws.InWorkspaceService.EvacuateCGroup:input_type -> iws.EvacuateCGroupRequest
+	8,  // 9: iws.InWorkspaceService.MountProc:input_type -> iws.MountProcRequest
+	10, // 10: iws.InWorkspaceService.UmountProc:input_type -> iws.UmountProcRequest
+	8,  // 11: iws.InWorkspaceService.MountSysfs:input_type -> iws.MountProcRequest
+	10, // 12: iws.InWorkspaceService.UmountSysfs:input_type -> iws.UmountProcRequest
+	12, // 13: iws.InWorkspaceService.MountNfs:input_type -> iws.MountNfsRequest
+	14, // 14: iws.InWorkspaceService.UmountNfs:input_type -> iws.UmountNfsRequest
+	16, // 15: iws.InWorkspaceService.Teardown:input_type -> iws.TeardownRequest
+	18, // 16: iws.InWorkspaceService.WipingTeardown:input_type -> iws.WipingTeardownRequest
+	20, // 17: iws.InWorkspaceService.SetupPairVeths:input_type -> iws.SetupPairVethsRequest
+	22, // 18: iws.InWorkspaceService.WorkspaceInfo:input_type -> iws.WorkspaceInfoRequest
+	22, // 19: iws.WorkspaceInfoService.WorkspaceInfo:input_type -> iws.WorkspaceInfoRequest
+	3,  // 20: iws.InWorkspaceService.PrepareForUserNS:output_type -> iws.PrepareForUserNSResponse
+	4,  // 21: iws.InWorkspaceService.WriteIDMapping:output_type -> iws.WriteIDMappingResponse
+	7,  // 22: iws.InWorkspaceService.EvacuateCGroup:output_type -> iws.EvacuateCGroupResponse
+	9,  // 23: iws.InWorkspaceService.MountProc:output_type -> iws.MountProcResponse
+	11, // 24: iws.InWorkspaceService.UmountProc:output_type -> iws.UmountProcResponse
+	9,  // 25: iws.InWorkspaceService.MountSysfs:output_type -> iws.MountProcResponse
+	11, // 26: iws.InWorkspaceService.UmountSysfs:output_type -> iws.UmountProcResponse
+	13, // 27: iws.InWorkspaceService.MountNfs:output_type -> iws.MountNfsResponse
+	15, // 28: iws.InWorkspaceService.UmountNfs:output_type -> iws.UmountNfsResponse
+	17, // 29: iws.InWorkspaceService.Teardown:output_type -> iws.TeardownResponse
+	19, // 30: iws.InWorkspaceService.WipingTeardown:output_type -> iws.WipingTeardownResponse
+	21, // 31: iws.InWorkspaceService.SetupPairVeths:output_type -> iws.SetupPairVethsResponse
+	23, // 32: iws.InWorkspaceService.WorkspaceInfo:output_type -> iws.WorkspaceInfoResponse
+	23, // 33: iws.WorkspaceInfoService.WorkspaceInfo:output_type -> iws.WorkspaceInfoResponse
+	20, // [20:34] is the sub-list for method output_type
+	6,  // [6:20] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_workspace_daemon_proto_init() }
@@ -1893,7 +1972,7 @@ func file_workspace_daemon_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_workspace_daemon_proto_rawDesc,
-			NumEnums:      1,
+			NumEnums:      2,
 			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   2,
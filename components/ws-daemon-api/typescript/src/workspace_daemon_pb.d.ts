@@ -542,6 +542,8 @@ export class Memory extends jspb.Message {
     setUsed(value: number): Memory;
     getLimit(): number;
     setLimit(value: number): Memory;
+    getPressure(): MemoryPressure;
+    setPressure(value: MemoryPressure): Memory;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): Memory.AsObject;
@@ -557,9 +559,17 @@ export namespace Memory {
     export type AsObject = {
         used: number,
         limit: number,
+        pressure: MemoryPressure,
     }
 }
 
 export enum FSShiftMethod {
     SHIFTFS = 0,
 }
+
+export enum MemoryPressure {
+    MEMORY_PRESSURE_NONE = 0,
+    MEMORY_PRESSURE_RECLAIM = 1,
+    MEMORY_PRESSURE_WARNING = 2,
+    MEMORY_PRESSURE_CRITICAL = 3,
+}
@@ -26,6 +26,7 @@ goog.exportSymbol('proto.iws.EvacuateCGroupRequest', null, global);
 goog.exportSymbol('proto.iws.EvacuateCGroupResponse', null, global);
 goog.exportSymbol('proto.iws.FSShiftMethod', null, global);
 goog.exportSymbol('proto.iws.Memory', null, global);
+goog.exportSymbol('proto.iws.MemoryPressure', null, global);
 goog.exportSymbol('proto.iws.MountNfsRequest', null, global);
 goog.exportSymbol('proto.iws.MountNfsResponse', null, global);
 goog.exportSymbol('proto.iws.MountProcRequest', null, global);
@@ -4100,7 +4101,8 @@ proto.iws.Memory.prototype.toObject = function(opt_includeInstance) {
 proto.iws.Memory.toObject = function(includeInstance, msg) {
   var f, obj = {
     used: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    limit: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    limit: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    pressure: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -4145,6 +4147,10 @@ proto.iws.Memory.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {number} */ (reader.readInt64());
       msg.setLimit(value);
       break;
+    case 3:
+      var value = /** @type {!proto.iws.MemoryPressure} */ (reader.readEnum());
+      msg.setPressure(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -4188,6 +4194,13 @@ proto.iws.Memory.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getPressure();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -4227,6 +4240,24 @@ proto.iws.Memory.prototype.setLimit = function(value) {
 };
 
 
+/**
+ * optional MemoryPressure pressure = 3;
+ * @return {!proto.iws.MemoryPressure}
+ */
+proto.iws.Memory.prototype.getPressure = function() {
+  return /** @type {!proto.iws.MemoryPressure} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {!proto.iws.MemoryPressure} value
+ * @return {!proto.iws.Memory} returns this
+ */
+proto.iws.Memory.prototype.setPressure = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
 /**
  * @enum {number}
  */
@@ -4234,4 +4265,14 @@ proto.iws.FSShiftMethod = {
   SHIFTFS: 0
 };
 
+/**
+ * @enum {number}
+ */
+proto.iws.MemoryPressure = {
+  MEMORY_PRESSURE_NONE: 0,
+  MEMORY_PRESSURE_RECLAIM: 1,
+  MEMORY_PRESSURE_WARNING: 2,
+  MEMORY_PRESSURE_CRITICAL: 3
+};
+
 goog.object.extend(exports, proto.iws);
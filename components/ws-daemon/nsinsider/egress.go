@@ -0,0 +1,206 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package main
+
+import (
+	"encoding/json"
+	"net"
+
+	"github.com/google/nftables"
+	"github.com/google/nftables/binaryutil"
+	"github.com/google/nftables/expr"
+	"golang.org/x/sys/unix"
+	"golang.org/x/xerrors"
+)
+
+const (
+	egressTable       = "gitpod-egress"
+	egressChain       = "egress"
+	egressDropCounter = "ws-egress-drop-stats"
+)
+
+// egressPolicy is the resolved egress policy ws-daemon passes to setup-egress-policy
+type egressPolicy struct {
+	DefaultAccept bool         `json:"defaultAccept"`
+	Rules         []egressRule `json:"rules,omitempty"`
+}
+
+type egressRule struct {
+	Accept   bool     `json:"accept"`
+	Networks []string `json:"networks,omitempty"`
+	Ports    []uint16 `json:"ports,omitempty"`
+}
+
+// setupEgressPolicy replaces the egress rules of the current network namespace with policy.
+// The drop counter survives updates of the policy.
+func setupEgressPolicy(serialized string) error {
+	var policy egressPolicy
+	err := json.Unmarshal([]byte(serialized), &policy)
+	if err != nil {
+		return xerrors.Errorf("cannot unmarshal egress policy: %w", err)
+	}
+
+	nftcon := nftables.Conn{}
+
+	// nft add table inet gitpod-egress
+	table := nftcon.AddTable(&nftables.Table{
+		Family: nftables.TableFamilyINet,
+		Name:   egressTable,
+	})
+
+	// nft add chain inet gitpod-egress egress { type filter hook postrouting priority 0 \; }
+	chain := nftcon.AddChain(&nftables.Chain{
+		Table:    table,
+		Name:     egressChain,
+		Type:     nftables.ChainTypeFilter,
+		Hooknum:  nftables.ChainHookPostrouting,
+		Priority: nftables.ChainPriorityFilter,
+	})
+
+	// nft add counter inet gitpod-egress ws-egress-drop-stats
+	nftcon.AddObject(&nftables.CounterObj{
+		Table: table,
+		Name:  egressDropCounter,
+	})
+
+	// the flush and the new rules are applied in the same transaction
+	nftcon.FlushChain(chain)
+
+	addRule := func(exprs ...expr.Any) {
+		nftcon.AddRule(&nftables.Rule{Table: table, Chain: chain, Exprs: exprs})
+	}
+
+	// oifname "lo" accept
+	addRule(
+		&expr.Meta{Key: expr.MetaKeyOIFNAME, Register: 1},
+		&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: ifname("lo")},
+		&expr.Verdict{Kind: expr.VerdictAccept},
+	)
+
+	// ct state established,related accept
+	addRule(
+		&expr.Ct{Key: expr.CtKeySTATE, Register: 1},
+		&expr.Bitwise{
+			DestRegister:   1,
+			SourceRegister: 1,
+			Len:            4,
+			Mask:           binaryutil.NativeEndian.PutUint32(expr.CtStateBitESTABLISHED | expr.CtStateBitRELATED),
+			Xor:            binaryutil.NativeEndian.PutUint32(0),
+		},
+		&expr.Cmp{Op: expr.CmpOpNeq, Register: 1, Data: []byte{0, 0, 0, 0}},
+		&expr.Verdict{Kind: expr.VerdictAccept},
+	)
+
+	for i, rule := range policy.Rules {
+		networks := []*net.IPNet{nil}
+		if len(rule.Networks) > 0 {
+			networks = networks[:0]
+			for _, n := range rule.Networks {
+				_, ipnet, err := net.ParseCIDR(n)
+				if err != nil {
+					return xerrors.Errorf("rule %d: %w", i, err)
+				}
+				networks = append(networks, ipnet)
+			}
+		}
+
+		protocols := []byte{0}
+		var ports *nftables.Set
+		if len(rule.Ports) > 0 {
+			protocols = []byte{unix.IPPROTO_TCP, unix.IPPROTO_UDP}
+			ports = &nftables.Set{
+				Anonymous: true,
+				Constant:  true,
+				Table:     table,
+				KeyType:   nftables.TypeInetService,
+			}
+			elements := make([]nftables.SetElement, 0, len(rule.Ports))
+			for _, p := range rule.Ports {
+				elements = append(elements, nftables.SetElement{Key: binaryutil.BigEndian.PutUint16(p)})
+			}
+			err = nftcon.AddSet(ports, elements)
+			if err != nil {
+				return xerrors.Errorf("rule %d: %w", i, err)
+			}
+		}
+
+		for _, network := range networks {
+			for _, proto := range protocols {
+				var exprs []expr.Any
+				if network != nil {
+					exprs = append(exprs, matchDestination(network)...)
+				}
+				if ports != nil {
+					exprs = append(exprs,
+						// meta l4proto tcp|udp
+						&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: 1},
+						&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{proto}},
+						// th dport { ... }
+						&expr.Payload{DestRegister: 1, Base: expr.PayloadBaseTransportHeader, Offset: 2, Len: 2},
+						&expr.Lookup{SourceRegister: 1, SetName: ports.Name, SetID: ports.ID},
+					)
+				}
+				addRule(append(exprs, verdict(rule.Accept)...)...)
+			}
+		}
+	}
+	addRule(verdict(policy.DefaultAccept)...)
+
+	if err := nftcon.Flush(); err != nil {
+		return xerrors.Errorf("failed to apply egress policy: %v", err)
+	}
+	return nil
+}
+
+// matchDestination produces the expressions matching packets sent to network, e.g. ip daddr 10.0.0.0/8
+func matchDestination(network *net.IPNet) []expr.Any {
+	var (
+		family byte   = unix.NFPROTO_IPV6
+		offset uint32 = 24
+		ip            = network.IP.To16()
+		mask          = []byte(network.Mask)
+	)
+	if ip4 := network.IP.To4(); ip4 != nil {
+		family, offset, ip = unix.NFPROTO_IPV4, 16, ip4
+		if len(mask) == net.IPv6len {
+			mask = mask[12:]
+		}
+	}
+
+	return []expr.Any{
+		// meta nfproto ipv4|ipv6
+		&expr.Meta{Key: expr.MetaKeyNFPROTO, Register: 1},
+		&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{family}},
+		// ip daddr & mask == network
+		&expr.Payload{DestRegister: 1, Base: expr.PayloadBaseNetworkHeader, Offset: offset, Len: uint32(len(ip))},
+		&expr.Bitwise{
+			DestRegister:   1,
+			SourceRegister: 1,
+			Len:            uint32(len(ip)),
+			Mask:           mask,
+			Xor:            make([]byte, len(ip)),
+		},
+		&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: ip.Mask(network.Mask)},
+	}
+}
+
+// verdict produces the expressions accepting a packet, or counting and dropping it
+func verdict(accept bool) []expr.Any {
+	if accept {
+		return []expr.Any{&expr.Verdict{Kind: expr.VerdictAccept}}
+	}
+	return []expr.Any{
+		// counter name "ws-egress-drop-stats"
+		&expr.Objref{Type: 1, Name: egressDropCounter},
+		&expr.Verdict{Kind: expr.VerdictDrop},
+	}
+}
+
+// ifname pads an interface name to the size the kernel compares it with
+func ifname(n string) []byte {
+	b := make([]byte, unix.IFNAMSIZ)
+	copy(b, n)
+	return b
+}
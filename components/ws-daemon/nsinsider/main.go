@@ -610,6 +610,20 @@ func main() {
 					return nil
 				},
 			},
+			{
+				Name:  "setup-egress-policy",
+				Usage: "set up the network egress policy",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "policy",
+						Usage:    "JSON serialized egress policy",
+						Required: true,
+					},
+				},
+				Action: func(c *cli.Context) error {
+					return setupEgressPolicy(c.String("policy"))
+				},
+			},
 		},
 	}
 
@@ -0,0 +1,353 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cgroup
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	cgroups "github.com/gitpod-io/gitpod/common-go/cgroups/v2"
+	"github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/xerrors"
+)
+
+// MemoryPressureConfig configures how ws-daemon reacts to workspaces which are under sustained memory pressure
+type MemoryPressureConfig struct {
+	Enabled bool `json:"enabled"`
+	// Interval is the period in which the memory pressure of a workspace is sampled
+	Interval util.Duration `json:"interval"`
+	// Threshold is the share of time (0-1) tasks of a workspace may stall on memory during an interval
+	// before the workspace is considered to be under memory pressure.
+	Threshold float64 `json:"threshold"`
+	// SustainedIntervals is the number of consecutive intervals the pressure has to persist (or be gone)
+	// before we escalate (or relax) our response.
+	SustainedIntervals int `json:"sustainedIntervals"`
+	// HighRatio is the fraction (0-1, exclusive) of its current memory usage that memory.high is lowered to
+	// when the workspace is first found to be under memory pressure. Defaults to 0.8.
+	HighRatio float64 `json:"highRatio,omitempty"`
+	// ReclaimDuration is how long memory.high stays lowered before it is lifted again and the pressure
+	// is re-evaluated. Defaults to the interval.
+	ReclaimDuration util.Duration `json:"reclaimDuration,omitempty"`
+}
+
+// defaultMemoryHighRatio is the HighRatio used if none is configured
+const defaultMemoryHighRatio = 0.8
+
+// withDefaults returns the config with defaults for unset fields, or an error if the config is invalid
+func (c MemoryPressureConfig) withDefaults() (MemoryPressureConfig, error) {
+	if c.HighRatio == 0 {
+		c.HighRatio = defaultMemoryHighRatio
+	}
+	if c.HighRatio < 0 || c.HighRatio >= 1 {
+		return c, xerrors.Errorf("highRatio must be greater than 0 and less than 1, is %v", c.HighRatio)
+	}
+	if c.Interval <= 0 {
+		c.Interval = util.Duration(10 * time.Second)
+	}
+	if c.ReclaimDuration <= 0 {
+		c.ReclaimDuration = c.Interval
+	}
+	return c, nil
+}
+
+// MemoryPressureLevel describes how far we've escalated our response to the memory pressure of a workspace
+type MemoryPressureLevel int
+
+const (
+	// MemoryPressureNone means the workspace is not under sustained memory pressure
+	MemoryPressureNone MemoryPressureLevel = iota
+	// MemoryPressureReclaim means we have lowered memory.high to trigger reclaim
+	MemoryPressureReclaim
+	// MemoryPressureWarning means reclaim did not help and the user should be notified
+	MemoryPressureWarning
+	// MemoryPressureCritical means the Workspace resource has been annotated so that ws-manager can stop the workspace
+	MemoryPressureCritical
+)
+
+func (l MemoryPressureLevel) String() string {
+	switch l {
+	case MemoryPressureNone:
+		return "none"
+	case MemoryPressureReclaim:
+		return "reclaim"
+	case MemoryPressureWarning:
+		return "warning"
+	case MemoryPressureCritical:
+		return "critical"
+	default:
+		return "unknown"
+	}
+}
+
+// WorkspaceAnnotator sets annotations on the Workspace resource of a workspace instance
+type WorkspaceAnnotator interface {
+	// AnnotateWorkspace sets the annotation key to value. A nil value removes the annotation.
+	AnnotateWorkspace(ctx context.Context, instanceID, key string, value *string) error
+}
+
+// MemoryPressureGovernor watches the memory PSI of workspaces and escalates its response
+// if a workspace remains under memory pressure: first it lowers memory.high to make the kernel
+// reclaim memory, then it reports the pressure so that supervisor can notify the user and finally
+// it annotates the Workspace resource, so that ws-manager stops the workspace under the highest pressure
+// on the node before the OOM killer picks a random victim.
+type MemoryPressureGovernor struct {
+	Annotator WorkspaceAnnotator
+
+	mu     sync.RWMutex
+	config MemoryPressureConfig
+	levels map[string]MemoryPressureLevel
+
+	transitions *prometheus.CounterVec
+}
+
+func NewMemoryPressureGovernor(config MemoryPressureConfig, annotator WorkspaceAnnotator, prom prometheus.Registerer) (*MemoryPressureGovernor, error) {
+	config, err := config.withDefaults()
+	if err != nil {
+		return nil, err
+	}
+
+	g := &MemoryPressureGovernor{
+		Annotator: annotator,
+		config:    config,
+		levels:    make(map[string]MemoryPressureLevel),
+
+		transitions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "memory_pressure_transitions_total",
+			Help: "Number of times a workspace entered a memory pressure level",
+		}, []string{"level"}),
+	}
+
+	prom.MustRegister(g.transitions)
+
+	return g, nil
+}
+
+func (g *MemoryPressureGovernor) Name() string  { return "memory-pressure-v2" }
+func (g *MemoryPressureGovernor) Type() Version { return Version2 }
+
+// Update changes the configuration of the governor. Changes take effect on the next interval.
+// An invalid config is rejected and the previous one stays in effect.
+func (g *MemoryPressureGovernor) Update(config MemoryPressureConfig) error {
+	config, err := config.withDefaults()
+	if err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.config = config
+	log.WithField("config", config).Info("updating memory pressure config")
+	return nil
+}
+
+// Level returns the memory pressure level of a workspace instance
+func (g *MemoryPressureGovernor) Level(instanceID string) MemoryPressureLevel {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.levels[instanceID]
+}
+
+func (g *MemoryPressureGovernor) Apply(ctx context.Context, opts *PluginOptions) error {
+	g.mu.RLock()
+	enabled := g.config.Enabled
+	g.mu.RUnlock()
+	if !enabled {
+		return nil
+	}
+
+	fullPath := filepath.Join(opts.BasePath, opts.CgroupPath)
+	if _, err := os.Stat(fullPath); err != nil {
+		return err
+	}
+	memory := cgroups.NewMemoryController(fullPath)
+
+	go func() {
+		owi := log.OWI("", "", opts.InstanceId)
+		defer func() {
+			g.mu.Lock()
+			delete(g.levels, opts.InstanceId)
+			g.mu.Unlock()
+		}()
+
+		var (
+			tracker  pressureTracker
+			interval = g.interval()
+			last     time.Time
+			lastPSI  uint64
+		)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
+
+			g.mu.RLock()
+			cfg := g.config
+			g.mu.RUnlock()
+			interval = g.interval()
+
+			psi, err := memory.PSI()
+			if os.IsNotExist(err) {
+				return
+			}
+			if err != nil {
+				log.WithError(err).WithFields(owi).Warn("could not retrieve memory psi")
+				continue
+			}
+			now := time.Now()
+			if last.IsZero() || psi.Some < lastPSI {
+				last, lastPSI = now, psi.Some
+				continue
+			}
+			pressure := float64(psi.Some-lastPSI) / float64(now.Sub(last).Microseconds())
+			last, lastPSI = now, psi.Some
+
+			prev := tracker.Level
+			if !tracker.Observe(pressure, cfg) {
+				continue
+			}
+
+			log.WithFields(owi).WithField("pressure", pressure).WithField("from", prev.String()).WithField("to", tracker.Level.String()).Info("workspace memory pressure level changed")
+			g.transitions.WithLabelValues(tracker.Level.String()).Inc()
+			g.mu.Lock()
+			g.levels[opts.InstanceId] = tracker.Level
+			g.mu.Unlock()
+
+			err = g.respond(ctx, memory, fullPath, opts.InstanceId, prev, tracker.Level, pressure, cfg)
+			if err != nil {
+				log.WithError(err).WithFields(owi).Warn("cannot respond to memory pressure")
+			}
+			if tracker.Level == MemoryPressureReclaim {
+				// the stalls caused by our reclaim must not count towards the pressure of the workspace
+				last = time.Time{}
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (g *MemoryPressureGovernor) interval() time.Duration {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return time.Duration(g.config.Interval)
+}
+
+func (g *MemoryPressureGovernor) respond(ctx context.Context, memory *cgroups.Memory, fullPath, instanceID string, from, to MemoryPressureLevel, pressure float64, cfg MemoryPressureConfig) error {
+	switch {
+	case to == MemoryPressureNone:
+		// reclaim restored memory.high already
+		if from >= MemoryPressureCritical && g.Annotator != nil {
+			return g.Annotator.AnnotateWorkspace(ctx, instanceID, kubernetes.WorkspaceMemoryPressureAnnotation, nil)
+		}
+	case to == MemoryPressureReclaim:
+		return reclaim(ctx, memory, fullPath, cfg)
+	case to == MemoryPressureCritical && g.Annotator != nil:
+		value := strconv.FormatFloat(pressure, 'f', 2, 64)
+		return g.Annotator.AnnotateWorkspace(ctx, instanceID, kubernetes.WorkspaceMemoryPressureAnnotation, &value)
+	}
+	return nil
+}
+
+// reclaim lowers memory.high below the current usage of a workspace to make the kernel reclaim memory.
+// Throttled at memory.high, the workspace stalls on memory itself, hence we restore the limit it had
+// before after the reclaim duration.
+func reclaim(ctx context.Context, memory *cgroups.Memory, fullPath string, cfg MemoryPressureConfig) error {
+	original, err := readMemoryHigh(fullPath)
+	if err != nil {
+		return err
+	}
+	current, err := memory.Current()
+	if err != nil {
+		return xerrors.Errorf("cannot read memory.current: %w", err)
+	}
+	high := uint64(float64(current) * cfg.HighRatio)
+	if original != "max" {
+		if limit, err := strconv.ParseUint(original, 10, 64); err == nil && limit <= high {
+			// the workspace is limited more tightly than we would limit it already
+			return nil
+		}
+	}
+	err = writeMemoryHigh(fullPath, strconv.FormatUint(high, 10))
+	if err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Duration(cfg.ReclaimDuration)):
+	}
+	return writeMemoryHigh(fullPath, original)
+}
+
+func readMemoryHigh(cgroupPath string) (string, error) {
+	value, err := os.ReadFile(filepath.Join(cgroupPath, "memory.high"))
+	if err != nil {
+		return "", xerrors.Errorf("cannot read memory.high: %w", err)
+	}
+	return strings.TrimSpace(string(value)), nil
+}
+
+func writeMemoryHigh(cgroupPath, value string) error {
+	err := os.WriteFile(filepath.Join(cgroupPath, "memory.high"), []byte(value), 0644)
+	if err != nil {
+		return xerrors.Errorf("cannot write memory.high: %w", err)
+	}
+	return nil
+}
+
+// pressureTracker escalates the memory pressure level by one step for every SustainedIntervals
+// consecutive intervals above the threshold, and drops back to MemoryPressureNone once the
+// pressure has stayed below the threshold for as long.
+type pressureTracker struct {
+	Level MemoryPressureLevel
+
+	// streak counts consecutive intervals above (positive) or below (negative) the threshold
+	streak int
+}
+
+// Observe records the pressure of an interval and reports whether the level changed
+func (t *pressureTracker) Observe(pressure float64, cfg MemoryPressureConfig) (changed bool) {
+	sustained := cfg.SustainedIntervals
+	if sustained < 1 {
+		sustained = 1
+	}
+
+	if pressure >= cfg.Threshold {
+		if t.streak < 0 {
+			t.streak = 0
+		}
+		t.streak++
+		if t.streak >= sustained && t.Level < MemoryPressureCritical {
+			t.Level++
+			t.streak = 0
+			return true
+		}
+		return false
+	}
+
+	if t.streak > 0 {
+		t.streak = 0
+	}
+	t.streak--
+	if -t.streak >= sustained && t.Level > MemoryPressureNone {
+		t.Level = MemoryPressureNone
+		t.streak = 0
+		return true
+	}
+	return false
+}
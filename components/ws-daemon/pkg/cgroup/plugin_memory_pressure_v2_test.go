@@ -0,0 +1,189 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cgroup
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	cgroups "github.com/gitpod-io/gitpod/common-go/cgroups/v2"
+	"github.com/gitpod-io/gitpod/common-go/util"
+)
+
+func TestPressureTracker(t *testing.T) {
+	cfg := MemoryPressureConfig{
+		Threshold:          0.2,
+		SustainedIntervals: 2,
+	}
+
+	tests := []struct {
+		Name         string
+		Observations []float64
+		Expectation  []MemoryPressureLevel
+	}{
+		{
+			Name:         "no pressure",
+			Observations: []float64{0, 0.1, 0.19, 0},
+			Expectation:  []MemoryPressureLevel{MemoryPressureNone, MemoryPressureNone, MemoryPressureNone, MemoryPressureNone},
+		},
+		{
+			Name:         "short spike",
+			Observations: []float64{0.5, 0.1, 0.5, 0.1},
+			Expectation:  []MemoryPressureLevel{MemoryPressureNone, MemoryPressureNone, MemoryPressureNone, MemoryPressureNone},
+		},
+		{
+			Name:         "sustained pressure escalates",
+			Observations: []float64{0.3, 0.3, 0.3, 0.3, 0.3, 0.3, 0.3, 0.3},
+			Expectation: []MemoryPressureLevel{
+				MemoryPressureNone, MemoryPressureReclaim,
+				MemoryPressureReclaim, MemoryPressureWarning,
+				MemoryPressureWarning, MemoryPressureCritical,
+				MemoryPressureCritical, MemoryPressureCritical,
+			},
+		},
+		{
+			Name:         "relaxes once pressure is gone",
+			Observations: []float64{0.3, 0.3, 0.3, 0.3, 0.1, 0.3, 0.1, 0.1},
+			Expectation: []MemoryPressureLevel{
+				MemoryPressureNone, MemoryPressureReclaim,
+				MemoryPressureReclaim, MemoryPressureWarning,
+				MemoryPressureWarning, MemoryPressureWarning,
+				MemoryPressureWarning, MemoryPressureNone,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var (
+				tracker pressureTracker
+				act     []MemoryPressureLevel
+			)
+			for _, p := range test.Observations {
+				tracker.Observe(p, cfg)
+				act = append(act, tracker.Level)
+			}
+
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected levels (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMemoryPressureConfigDefaults(t *testing.T) {
+	tests := []struct {
+		Name          string
+		Config        MemoryPressureConfig
+		Expectation   MemoryPressureConfig
+		ExpectedError bool
+	}{
+		{
+			Name: "defaults",
+			Expectation: MemoryPressureConfig{
+				Interval:        util.Duration(10 * time.Second),
+				HighRatio:       defaultMemoryHighRatio,
+				ReclaimDuration: util.Duration(10 * time.Second),
+			},
+		},
+		{
+			Name:   "reclaim duration defaults to interval",
+			Config: MemoryPressureConfig{Interval: util.Duration(time.Second), HighRatio: 0.5},
+			Expectation: MemoryPressureConfig{
+				Interval:        util.Duration(time.Second),
+				HighRatio:       0.5,
+				ReclaimDuration: util.Duration(time.Second),
+			},
+		},
+		{
+			Name:          "negative high ratio",
+			Config:        MemoryPressureConfig{HighRatio: -0.5},
+			ExpectedError: true,
+		},
+		{
+			Name:          "high ratio of one",
+			Config:        MemoryPressureConfig{HighRatio: 1},
+			ExpectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act, err := test.Config.withDefaults()
+			if test.ExpectedError {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected config (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReclaimRestoresMemoryHigh(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Original string
+		Lowered  string
+		Restored string
+	}{
+		{Name: "unlimited", Original: "max\n", Lowered: "800", Restored: "max"},
+		{Name: "limited", Original: "2000\n", Lowered: "800", Restored: "2000"},
+		{Name: "limited below reclaim", Original: "500\n", Lowered: "500\n", Restored: "500\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			cgroupPath := t.TempDir()
+			err := os.WriteFile(filepath.Join(cgroupPath, "memory.current"), []byte("1000\n"), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = os.WriteFile(filepath.Join(cgroupPath, "memory.high"), []byte(test.Original), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+			memoryHigh := func() string {
+				c, _ := os.ReadFile(filepath.Join(cgroupPath, "memory.high"))
+				return string(c)
+			}
+
+			done := make(chan error)
+			go func() {
+				done <- reclaim(context.Background(), cgroups.NewMemoryController(cgroupPath), cgroupPath, MemoryPressureConfig{
+					HighRatio:       0.8,
+					ReclaimDuration: util.Duration(200 * time.Millisecond),
+				})
+			}()
+
+			var lowered bool
+			for i := 0; i < 20 && !lowered; i++ {
+				lowered = memoryHigh() == test.Lowered
+				time.Sleep(5 * time.Millisecond)
+			}
+			if !lowered {
+				t.Errorf("expected memory.high to be lowered to %q, is %q", test.Lowered, memoryHigh())
+			}
+
+			if err := <-done; err != nil {
+				t.Fatal(err)
+			}
+			if h := memoryHigh(); h != test.Restored {
+				t.Errorf("expected memory.high to be restored to %q after reclaim, is %q", test.Restored, h)
+			}
+		})
+	}
+}
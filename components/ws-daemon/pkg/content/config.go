@@ -11,6 +11,7 @@ import (
 	"github.com/gitpod-io/gitpod/common-go/util"
 	cntntcfg "github.com/gitpod-io/gitpod/content-service/api/config"
 	"github.com/gitpod-io/gitpod/ws-daemon/api"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/gitcache"
 	"golang.org/x/xerrors"
 )
 
@@ -39,6 +40,9 @@ type Config struct {
 
 	// Initializer configures the isolated content initializer runtime
 	Initializer InitializerConfig `json:"initializer"`
+
+	// GitReferenceCache configures the node-local cache of reference repositories Git initializers clone against
+	GitReferenceCache gitcache.Config `json:"gitReferenceCache,omitempty"`
 }
 
 type BackupConfig struct {
@@ -88,4 +92,27 @@ type InitializerConfig struct {
 
 	// Args are additional arguments to pass to the CI runtime
 	Args []string `json:"args"`
+
+	// MaxParallelism is the number of child initializers with disjoint locations, e.g. additional
+	// repositories, which are initialized concurrently. If smaller than two, children run sequentially.
+	MaxParallelism int `json:"maxParallelism,omitempty"`
+
+	// GitMirrors maps Git hosts to base URLs of mirrors, e.g. "github.com" to "https://gitea.internal/github".
+	// Clones fall back to the mirrors if the host is unreachable.
+	GitMirrors map[string][]string `json:"gitMirrors,omitempty"`
+
+	// RegistryAuth provides credentials to OCI initializers which carry none of their own
+	RegistryAuth RegistryAuthConfig `json:"registryAuth"`
+}
+
+type RegistryAuthConfig struct {
+	// File is a Docker config file with registry credentials, i.e. the pull secret image-builder uses
+	File string `json:"file,omitempty"`
+
+	// AllowedRegistries are the registries OCI initializers get credentials for. If empty, they get none.
+	AllowedRegistries []string `json:"allowedRegistries,omitempty"`
+
+	// InternalRegistries are the registries workspace images are pushed to. The file holds push credentials
+	// for them, which are never handed out, even if the registries are allowed.
+	InternalRegistries []string `json:"internalRegistries,omitempty"`
 }
@@ -9,10 +9,12 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"syscall"
@@ -46,6 +48,19 @@ type RunInitializerOpts struct {
 	UID uint32
 	GID uint32
 
+	// MaxParallelism is the number of child initializers which may run concurrently
+	MaxParallelism int
+
+	// GitReferences maps remote URIs to reference repositories on the node. They are mounted
+	// read-only into the initializer and used by its Git clones.
+	GitReferences map[string]string
+
+	// GitMirrors maps Git hosts to the mirrors clones fall back to
+	GitMirrors map[string][]string
+
+	// RegistryAuth provides credentials to OCI initializers which carry none
+	RegistryAuth wsinit.OCIRegistryAuth
+
 	OWI OWI
 }
 
@@ -136,6 +151,14 @@ func RunInitializer(ctx context.Context, destination string, initializer *csapi.
 		return nil, xerrors.Errorf("cannot mkdir destination: %w", err)
 	}
 
+	if opts.RegistryAuth != nil {
+		initializer = proto.Clone(initializer).(*csapi.WorkspaceInitializer)
+		err = wsinit.AddOCIRegistryAuth(ctx, initializer, opts.RegistryAuth)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	init, err := proto.Marshal(initializer)
 	if err != nil {
 		return nil, err
@@ -159,6 +182,27 @@ func RunInitializer(ctx context.Context, destination string, initializer *csapi.
 		return nil, err
 	}
 
+	// reference repositories are mounted at neutral paths, the initializer learns their remote from msgInitContent
+	var (
+		gitReferenceMounts []specs.Mount
+		gitReferences      = make(map[string]string, len(opts.GitReferences))
+	)
+	remotes := make([]string, 0, len(opts.GitReferences))
+	for remote := range opts.GitReferences {
+		remotes = append(remotes, remote)
+	}
+	sort.Strings(remotes)
+	for i, remote := range remotes {
+		dst := fmt.Sprintf("/git-references/%d", i)
+		gitReferenceMounts = append(gitReferenceMounts, specs.Mount{
+			Destination: dst,
+			Source:      opts.GitReferences[remote],
+			Type:        "bind",
+			Options:     []string{"bind", "ro", "rprivate"},
+		})
+		gitReferences[remote] = dst
+	}
+
 	msg := msgInitContent{
 		Destination:   "/dst",
 		Initializer:   init,
@@ -168,6 +212,10 @@ func RunInitializer(ctx context.Context, destination string, initializer *csapi.
 		GID:           int(opts.GID),
 		UID:           int(opts.UID),
 		OWI:           opts.OWI.Fields(),
+
+		MaxParallelism: opts.MaxParallelism,
+		GitReferences:  gitReferences,
+		GitMirrors:     opts.GitMirrors,
 	}
 	fc, err := json.MarshalIndent(msg, "", "  ")
 	if err != nil {
@@ -196,6 +244,8 @@ func RunInitializer(ctx context.Context, destination string, initializer *csapi.
 		Options:     []string{"bind", "rprivate"},
 	})
 
+	spec.Mounts = append(spec.Mounts, gitReferenceMounts...)
+
 	spec.Hostname = "content-init"
 	spec.Process.Terminal = false
 	spec.Process.NoNewPrivileges = true
@@ -387,7 +437,12 @@ func RunInitializerChild(statsFd *os.File) (err error) {
 	rs := &remoteContentStorage{RemoteContent: initmsg.RemoteContent}
 
 	dst := initmsg.Destination
-	initializer, err := wsinit.NewFromRequest(ctx, dst, rs, &req, wsinit.NewFromRequestOpts{ForceGitpodUserForGit: false})
+	initializer, err := wsinit.NewFromRequest(ctx, dst, rs, &req, wsinit.NewFromRequestOpts{
+		ForceGitpodUserForGit: false,
+		MaxParallelism:        initmsg.MaxParallelism,
+		GitReferences:         initmsg.GitReferences,
+		GitMirrors:            initmsg.GitMirrors,
+	})
 	if err != nil {
 		return err
 	}
@@ -541,6 +596,15 @@ func (rs *remoteContentStorage) UploadInstance(ctx context.Context, source strin
 	return "", "", xerrors.Errorf("not implemented")
 }
 
+// ObjectSize returns the size of a remote content object
+func (rs *remoteContentStorage) ObjectSize(ctx context.Context, name string) (int64, error) {
+	info, exists := rs.RemoteContent[name]
+	if !exists {
+		return 0, storage.ErrNotFound
+	}
+	return info.Size, nil
+}
+
 // Bucket returns an empty string
 func (rs *remoteContentStorage) Bucket(string) string {
 	return ""
@@ -568,6 +632,10 @@ type msgInitContent struct {
 	UID, GID      int
 	IDMappings    []archive.IDMapping
 
+	MaxParallelism int
+	GitReferences  map[string]string
+	GitMirrors     map[string][]string
+
 	TraceInfo string
 	OWI       map[string]interface{}
 }
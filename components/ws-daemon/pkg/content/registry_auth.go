@@ -0,0 +1,70 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package content
+
+import (
+	"context"
+	"slices"
+
+	"github.com/distribution/reference"
+
+	wsinit "github.com/gitpod-io/gitpod/content-service/pkg/initializer"
+	"github.com/gitpod-io/gitpod/image-builder/pkg/auth"
+)
+
+const (
+	// dockerHubDomain is the domain of normalized Docker Hub references
+	dockerHubDomain = "docker.io"
+	// dockerHubAuthKey is the key Docker config files store Docker Hub credentials under
+	dockerHubAuthKey = "https://index.docker.io/v1/"
+)
+
+// NewOCIRegistryAuth provides OCI initializers with the credentials of the registries explicitly allowed in the config.
+// Returns nil if no registry is allowed.
+func NewOCIRegistryAuth(cfg RegistryAuthConfig) (wsinit.OCIRegistryAuth, error) {
+	var allowed []string
+	for _, reg := range cfg.AllowedRegistries {
+		if slices.Contains(cfg.InternalRegistries, reg) {
+			continue
+		}
+		allowed = append(allowed, reg)
+	}
+	allowedAuth := auth.AllowedAuthFor{Explicit: allowed}
+	if cfg.File == "" || allowedAuth.IsAllowNone() {
+		return nil, nil
+	}
+
+	dockerCfg, err := auth.NewDockerConfigFileAuth(cfg.File)
+	if err != nil {
+		return nil, err
+	}
+	authenticator := dockerHubAuth{dockerCfg}
+
+	return func(ctx context.Context, ref string) (user, pwd string, err error) {
+		// AllowedAuthFor permits all ECR registries, which the internal ones may well be
+		pref, err := reference.ParseNormalizedNamed(ref)
+		if err != nil || slices.Contains(cfg.InternalRegistries, reference.Domain(pref)) {
+			return "", "", nil
+		}
+
+		res, err := allowedAuth.GetAuthFor(ctx, authenticator, ref)
+		if err != nil || res == nil {
+			return "", "", err
+		}
+		return res.Username, res.Password, nil
+	}, nil
+}
+
+// dockerHubAuth looks up Docker Hub credentials under the key Docker config files store them under
+type dockerHubAuth struct {
+	auth.RegistryAuthenticator
+}
+
+func (a dockerHubAuth) Authenticate(ctx context.Context, registry string) (*auth.Authentication, error) {
+	if registry == dockerHubDomain {
+		registry = dockerHubAuthKey
+	}
+	return a.RegistryAuthenticator.Authenticate(ctx, registry)
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package content_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/content"
+)
+
+func TestNewOCIRegistryAuth(t *testing.T) {
+	fn := filepath.Join(t.TempDir(), "config.json")
+	err := os.WriteFile(fn, []byte(`{"auths":{
+		"registry.example.com": {"auth": "ZXhhbXBsZTpzZWNyZXQ="},
+		"registry.gitpod.example.com": {"username": "push", "password": "pushsecret"},
+		"https://index.docker.io/v1/": {"username": "hub", "password": "hubsecret"}
+	}}`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Name         string
+		Config       content.RegistryAuthConfig
+		Ref          string
+		ExpectedUser string
+	}{
+		{
+			Name:         "allowed registry",
+			Config:       content.RegistryAuthConfig{AllowedRegistries: []string{"registry.example.com"}},
+			Ref:          "registry.example.com/sdk",
+			ExpectedUser: "example",
+		},
+		{
+			Name:         "docker hub",
+			Config:       content.RegistryAuthConfig{AllowedRegistries: []string{"docker.io"}},
+			Ref:          "sdk",
+			ExpectedUser: "hub",
+		},
+		{
+			Name:   "registry not allowed",
+			Config: content.RegistryAuthConfig{AllowedRegistries: []string{"docker.io"}},
+			Ref:    "registry.example.com/sdk",
+		},
+		{
+			Name: "internal registry",
+			Config: content.RegistryAuthConfig{
+				AllowedRegistries:  []string{"registry.example.com", "registry.gitpod.example.com"},
+				InternalRegistries: []string{"registry.gitpod.example.com"},
+			},
+			Ref: "registry.gitpod.example.com/workspace-images",
+		},
+		{
+			Name: "internal ECR registry",
+			Config: content.RegistryAuthConfig{
+				AllowedRegistries:  []string{"registry.example.com"},
+				InternalRegistries: []string{"123456789012.dkr.ecr.eu-west-1.amazonaws.com"},
+			},
+			Ref: "123456789012.dkr.ecr.eu-west-1.amazonaws.com/workspace-images",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			test.Config.File = fn
+			auth, err := content.NewOCIRegistryAuth(test.Config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if auth == nil {
+				t.Fatal("expected registry auth")
+			}
+
+			user, pwd, err := auth(context.Background(), test.Ref)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if user != test.ExpectedUser {
+				t.Errorf("unexpected user: is %q but expected %q", user, test.ExpectedUser)
+			}
+			if test.ExpectedUser == "" && pwd != "" {
+				t.Errorf("expected no password for %s", test.Ref)
+			}
+		})
+	}
+
+	t.Run("nothing allowed", func(t *testing.T) {
+		auth, err := content.NewOCIRegistryAuth(content.RegistryAuthConfig{
+			File:               fn,
+			AllowedRegistries:  []string{"registry.gitpod.example.com"},
+			InternalRegistries: []string{"registry.gitpod.example.com"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if auth != nil {
+			t.Error("expected no registry auth")
+		}
+	})
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controller
+
+import (
+	"context"
+
+	glog "github.com/gitpod-io/gitpod/common-go/log"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/gitcache"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/internal/session"
+)
+
+// gitInitializers returns all Git initializers of a workspace initializer
+func gitInitializers(init *csapi.WorkspaceInitializer) []*csapi.GitInitializer {
+	var res []*csapi.GitInitializer
+	_ = csapi.WalkInitializer(nil, init, func(path []string, init *csapi.WorkspaceInitializer) error {
+		if gi := init.GetGit(); gi != nil {
+			res = append(res, gi)
+		}
+		return nil
+	})
+	return res
+}
+
+// acquireGitReferences looks up the reference repositories for the Git initializers of a workspace.
+// The repositories are kept until release is called.
+func (wso *DefaultWorkspaceOperations) acquireGitReferences(options InitOptions) (references map[string]string, release func()) {
+	if wso.gitCache == nil {
+		return nil, func() {}
+	}
+
+	var releases []func()
+	references = make(map[string]string)
+	for _, gi := range gitInitializers(options.Initializer) {
+		if _, exists := references[gi.RemoteUri]; exists {
+			continue
+		}
+
+		key := gitcache.Key(gi.RemoteUri, gitcache.AuthScope(gi.Config, options.Meta.Owner))
+		path, rel, ok := wso.gitCache.Acquire(key)
+		if !ok {
+			continue
+		}
+		references[gi.RemoteUri] = path
+		releases = append(releases, rel)
+	}
+
+	return references, func() {
+		for _, rel := range releases {
+			rel()
+		}
+	}
+}
+
+// warmupGitReferences adds the remotes of the full clones of a workspace to the Git reference cache. Shallow
+// and partial clones could not use the reference and are skipped, as are remotes whose credentials were only
+// good for a single clone. Failures are not fatal to the workspace, e.g. if it is disposed while its
+// repositories are still being fetched.
+func (wso *DefaultWorkspaceOperations) warmupGitReferences(ctx context.Context, ws *session.Workspace, options InitOptions) {
+	if wso.gitCache == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, wso.gitCache.WarmupTimeout)
+	defer cancel()
+
+	for _, gi := range gitInitializers(options.Initializer) {
+		if !gi.FullClone || gi.CloneFilter != "" {
+			continue
+		}
+
+		remote := gitcache.Remote{URI: gi.RemoteUri}
+		switch gi.Config.GetAuthentication() {
+		case csapi.GitAuthMethod_NO_AUTH:
+		case csapi.GitAuthMethod_BASIC_AUTH:
+			remote.User = gi.Config.GetAuthUser()
+			remote.Password = gi.Config.GetAuthPassword()
+		default:
+			continue
+		}
+
+		key := gitcache.Key(gi.RemoteUri, gitcache.AuthScope(gi.Config, options.Meta.Owner))
+		err := wso.gitCache.Update(ctx, key, remote)
+		if err != nil {
+			glog.WithFields(ws.OWI()).WithError(err).WithField("remote", gi.RemoteUri).Warn("cannot add repository to Git reference cache")
+		}
+	}
+}
@@ -85,6 +85,21 @@ func (mr *MockWorkspaceOperationsMockRecorder) InitWorkspace(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitWorkspace", reflect.TypeOf((*MockWorkspaceOperations)(nil).InitWorkspace), arg0, arg1)
 }
 
+// ResizeStorageQuota mocks base method.
+func (m *MockWorkspaceOperations) ResizeStorageQuota(arg0 context.Context, arg1 string, arg2 int) (*StorageQuotaStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeStorageQuota", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*StorageQuotaStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResizeStorageQuota indicates an expected call of ResizeStorageQuota.
+func (mr *MockWorkspaceOperationsMockRecorder) ResizeStorageQuota(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeStorageQuota", reflect.TypeOf((*MockWorkspaceOperations)(nil).ResizeStorageQuota), arg0, arg1, arg2)
+}
+
 // SetupWorkspace mocks base method.
 func (m *MockWorkspaceOperations) SetupWorkspace(arg0 context.Context, arg1 string, arg2 *v1.WorkspaceImageInfo) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,47 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+	"golang.org/x/xerrors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WorkspaceAnnotator sets annotations on Workspace resources using a merge patch,
+// so that it does not conflict with ws-manager updating the resource.
+type WorkspaceAnnotator struct {
+	Client    client.Client
+	Namespace string
+}
+
+// AnnotateWorkspace sets the annotation key on the Workspace of the instance to value, or removes it if value is nil.
+func (a *WorkspaceAnnotator) AnnotateWorkspace(ctx context.Context, instanceID, key string, value *string) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]*string{key: value},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	ws := &workspacev1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      instanceID,
+			Namespace: a.Namespace,
+		},
+	}
+	err = a.Client.Patch(ctx, ws, client.RawPatch(types.MergePatchType, patch))
+	if err != nil {
+		return xerrors.Errorf("cannot annotate workspace %s: %w", instanceID, err)
+	}
+	return nil
+}
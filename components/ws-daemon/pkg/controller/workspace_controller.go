@@ -7,8 +7,10 @@ package controller
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
+	cgroups_v2 "github.com/gitpod-io/gitpod/common-go/cgroups/v2"
 	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
 	glog "github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/common-go/tracing"
@@ -45,6 +47,22 @@ var retryParams = wait.Backoff{
 	Jitter:   0.2,
 }
 
+// storageReportInterval is the period in which we check the storage use of running workspaces
+const storageReportInterval = 1 * time.Minute
+
+// storageReportMinDelta is the smallest change in storage use we report on the workspace status.
+// Larger quotas need a change of at least storageReportMinRatio of the quota.
+const (
+	storageReportMinDelta = 64 * 1024 * 1024
+	storageReportMinRatio = 0.01
+)
+
+// freezeTimeout is how long we wait for the processes of a workspace to be frozen or thawed
+const freezeTimeout = 30 * time.Second
+
+// pauseContainerTimeout is how long we wait for the container of a workspace we are about to freeze or thaw
+const pauseContainerTimeout = 10 * time.Second
+
 type WorkspaceControllerOpts struct {
 	NodeName         string
 	ContentConfig    content.Config
@@ -63,9 +81,18 @@ type WorkspaceController struct {
 	secretNamespace         string
 	recorder                record.EventRecorder
 	runtime                 container.Runtime
+	cgroupBasePath          string
+
+	// lastStorageReport holds the storageReport we last made for a workspace
+	lastStorageReport sync.Map
 }
 
-func NewWorkspaceController(c client.Client, recorder record.EventRecorder, nodeName, secretNamespace string, maxConcurrentReconciles int, ops WorkspaceOperations, reg prometheus.Registerer, runtime container.Runtime) (*WorkspaceController, error) {
+type storageReport struct {
+	Time  time.Time
+	Quota int
+}
+
+func NewWorkspaceController(c client.Client, recorder record.EventRecorder, nodeName, secretNamespace string, maxConcurrentReconciles int, ops WorkspaceOperations, reg prometheus.Registerer, runtime container.Runtime, cgroupBasePath string) (*WorkspaceController, error) {
 	metrics := newWorkspaceMetrics()
 	reg.Register(metrics)
 
@@ -78,6 +105,7 @@ func NewWorkspaceController(c client.Client, recorder record.EventRecorder, node
 		secretNamespace:         secretNamespace,
 		recorder:                recorder,
 		runtime:                 runtime,
+		cgroupBasePath:          cgroupBasePath,
 	}, nil
 }
 
@@ -133,7 +161,8 @@ func (wsc *WorkspaceController) Reconcile(ctx context.Context, req ctrl.Request)
 		return result, err
 	}
 
-	if workspace.Status.Phase == workspacev1.WorkspacePhaseRunning {
+	if workspace.Status.Phase == workspacev1.WorkspacePhaseRunning ||
+		workspace.Status.Phase == workspacev1.WorkspacePhasePaused {
 		result, err = wsc.handleWorkspaceRunning(ctx, &workspace, req)
 		return result, err
 	}
@@ -178,8 +207,11 @@ func (wsc *WorkspaceController) handleWorkspaceInit(ctx context.Context, ws *wor
 			return ctrl.Result{}, fmt.Errorf("failed to prepare initializer: %w", err)
 		}
 
+		// content init is part of the workspace's startup trace
+		initSpan := opentracing.StartSpan("InitWorkspace", tracing.ChildOfTraceID(ws.Annotations[wsk8s.WorkspaceTraceContextAnnotation]), opentracing.FollowsFrom(span.Context()))
+		tracing.ApplyOWI(initSpan, ws.OWI())
 		initStart := time.Now()
-		stats, failure, initErr := wsc.operations.InitWorkspace(ctx, InitOptions{
+		stats, failure, initErr := wsc.operations.InitWorkspace(opentracing.ContextWithSpan(ctx, initSpan), InitOptions{
 			Meta: WorkspaceMeta{
 				Owner:       ws.Spec.Ownership.Owner,
 				WorkspaceID: ws.Spec.Ownership.WorkspaceID,
@@ -190,6 +222,8 @@ func (wsc *WorkspaceController) handleWorkspaceInit(ctx context.Context, ws *wor
 			StorageQuota: ws.Spec.StorageQuota,
 		})
 
+		tracing.FinishSpan(initSpan, &initErr)
+
 		initMetrics := initializerMetricsFromInitializerStats(stats)
 		err = retry.RetryOnConflict(retryParams, func() error {
 			if err := wsc.Get(ctx, req.NamespacedName, ws); err != nil {
@@ -235,8 +269,13 @@ func initializerMetricsFromInitializerStats(stats *csapi.InitializerMetrics) *wo
 		switch metric.Type {
 		case "git":
 			result.Git = &workspacev1.InitializerStepMetric{
-				Duration: &metav1.Duration{Duration: metric.Duration},
-				Size:     metric.Size,
+				Duration:     &metav1.Duration{Duration: metric.Duration},
+				Size:         metric.Size,
+				FetchedBytes: metric.FetchedBytes,
+				LFSBytes:     metric.LFSBytes,
+			}
+			if metric.SubmoduleDuration > 0 {
+				result.Git.SubmoduleDuration = &metav1.Duration{Duration: metric.SubmoduleDuration}
 			}
 		case "fileDownload":
 			result.FileDownload = &workspacev1.InitializerStepMetric{
@@ -306,13 +345,190 @@ func (wsc *WorkspaceController) handleWorkspaceRunning(ctx context.Context, ws *
 			glog.WithFields(ws.OWI()).WithField("workspace", req.NamespacedName).WithField("imageInfo", glog.TrustedValueWrap{Value: imageInfo}).Info("updated image info")
 		}
 	}
-	return ctrl.Result{}, wsc.operations.SetupWorkspace(ctx, ws.Name, imageInfo)
+	err = wsc.operations.SetupWorkspace(ctx, ws.Name, imageInfo)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	err = wsc.reconcilePause(ctx, ws, req, ws.Spec.Paused)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return wsc.reconcileStorageQuota(ctx, ws, req)
+}
+
+// reconcilePause freezes or thaws the processes of the workspace container, and reports the result
+// using the Paused condition. Frozen processes keep their memory, but use no CPU.
+func (wsc *WorkspaceController) reconcilePause(ctx context.Context, ws *workspacev1.Workspace, req ctrl.Request, paused bool) (err error) {
+	if paused == ws.IsConditionTrue(workspacev1.WorkspaceConditionPaused) {
+		return nil
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "reconcilePause")
+	defer tracing.FinishSpan(span, &err)
+
+	var id container.ID
+	if paused || ws.IsConditionTrue(workspacev1.WorkspaceConditionContainerRunning) {
+		waitCtx, cancel := context.WithTimeout(ctx, pauseContainerTimeout)
+		id, err = wsc.runtime.WaitForContainer(waitCtx, ws.Name)
+		cancel()
+		if err != nil && paused {
+			return fmt.Errorf("failed to wait for container: %w", err)
+		}
+	}
+	if id == "" {
+		// the frozen processes went away with the container - there is nothing left to thaw
+		glog.WithFields(ws.OWI()).Info("workspace container is gone, not thawing it")
+	} else {
+		err = wsc.freeze(ctx, ws, id, paused)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = retry.RetryOnConflict(retryParams, func() error {
+		if err := wsc.Get(ctx, req.NamespacedName, ws); err != nil {
+			return err
+		}
+
+		status := metav1.ConditionFalse
+		if paused {
+			status = metav1.ConditionTrue
+		}
+		ws.Status.SetCondition(workspacev1.NewWorkspaceConditionPaused("", status))
+		return wsc.Status().Update(ctx, ws)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update pause status: %w", err)
+	}
+
+	glog.WithFields(ws.OWI()).WithField("paused", paused).Info("changed pause state of workspace")
+	return nil
+}
+
+// freeze freezes or thaws the processes of the workspace container
+func (wsc *WorkspaceController) freeze(ctx context.Context, ws *workspacev1.Workspace, id container.ID, paused bool) error {
+	cgroupPath, err := wsc.runtime.ContainerCGroupPath(ctx, id)
+	if err != nil {
+		return fmt.Errorf("cannot get cgroup path of container: %w", err)
+	}
+
+	freezeCtx, cancel := context.WithTimeout(ctx, freezeTimeout)
+	defer cancel()
+	freezer := cgroups_v2.NewFreezerWithMount(wsc.cgroupBasePath, cgroupPath)
+	if paused {
+		err = freezer.Freeze(freezeCtx)
+	} else {
+		err = freezer.Thaw(freezeCtx)
+	}
+	if err != nil {
+		operation := "Pause"
+		if !paused {
+			operation = "Resume"
+		}
+		wsc.emitEvent(ws, operation, err)
+		if paused {
+			// don't leave the workspace partially frozen - we try again on the next reconcile
+			_ = freezer.Thaw(ctx)
+		}
+		return fmt.Errorf("cannot freeze or thaw workspace: %w", err)
+	}
+	return nil
+}
+
+// reconcileStorageQuota applies changes to the storage quota of a running workspace and regularly checks
+// its storage use. We do not check the use on every reconcile, and only write it to the workspace status
+// if it changed meaningfully, as each status update triggers another reconcile for every controller watching workspaces.
+func (wsc *WorkspaceController) reconcileStorageQuota(ctx context.Context, ws *workspacev1.Workspace, req ctrl.Request) (result ctrl.Result, err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "reconcileStorageQuota")
+	defer tracing.FinishSpan(span, &err)
+
+	size := ws.Spec.StorageQuota
+	if size == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	if last, ok := wsc.lastStorageReport.Load(ws.Name); ok && last.(storageReport).Quota == size {
+		if due := time.Until(last.(storageReport).Time.Add(storageReportInterval)); due > 0 {
+			return ctrl.Result{RequeueAfter: due}, nil
+		}
+	}
+
+	quota, err := wsc.operations.ResizeStorageQuota(ctx, ws.Name, size)
+	if err != nil {
+		wsc.emitEvent(ws, "Storage quota resize", err)
+		return ctrl.Result{}, err
+	}
+	if quota == nil {
+		// the node does not enforce storage quota
+		return ctrl.Result{}, nil
+	}
+	if quota.Rejected != "" && !ws.IsConditionTrue(workspacev1.WorkspaceConditionStorageQuotaRejected) {
+		wsc.recorder.Event(ws, corev1.EventTypeWarning, "StorageQuotaRejected", quota.Rejected)
+	}
+
+	err = retry.RetryOnConflict(retryParams, func() error {
+		if err := wsc.Get(ctx, req.NamespacedName, ws); err != nil {
+			return err
+		}
+		if !storageStatusChanged(ws, quota) {
+			return nil
+		}
+
+		ws.Status.Storage.QuotaLimit = quota.Limit
+		ws.Status.Storage.QuotaUsed = quota.Used
+		if quota.Rejected != "" {
+			ws.Status.SetCondition(workspacev1.NewWorkspaceConditionStorageQuotaRejected(quota.Rejected, metav1.ConditionTrue))
+		} else if ws.IsConditionTrue(workspacev1.WorkspaceConditionStorageQuotaRejected) {
+			ws.Status.SetCondition(workspacev1.NewWorkspaceConditionStorageQuotaRejected("", metav1.ConditionFalse))
+		}
+		return wsc.Status().Update(ctx, ws)
+	})
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to update storage status: %w", err)
+	}
+	wsc.lastStorageReport.Store(ws.Name, storageReport{Time: time.Now(), Quota: size})
+
+	// a rejected resize is retried once we report the usage again, as space might have been freed up by then
+	return ctrl.Result{RequeueAfter: storageReportInterval}, nil
+}
+
+// storageStatusChanged returns true if the storage status of a workspace no longer reflects the quota
+func storageStatusChanged(ws *workspacev1.Workspace, quota *StorageQuotaStatus) bool {
+	if ws.Status.Storage.QuotaLimit != quota.Limit {
+		return true
+	}
+	if (quota.Rejected != "") != ws.IsConditionTrue(workspacev1.WorkspaceConditionStorageQuotaRejected) {
+		return true
+	}
+
+	delta := quota.Used - ws.Status.Storage.QuotaUsed
+	if delta < 0 {
+		delta = -delta
+	}
+	minDelta := int64(float64(quota.Limit) * storageReportMinRatio)
+	if minDelta < storageReportMinDelta {
+		minDelta = storageReportMinDelta
+	}
+	return delta >= minDelta
 }
 
 func (wsc *WorkspaceController) handleWorkspaceStop(ctx context.Context, ws *workspacev1.Workspace, req ctrl.Request) (result ctrl.Result, err error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "handleWorkspaceStop")
 	defer tracing.FinishSpan(span, &err)
 
+	wsc.lastStorageReport.Delete(ws.Name)
+
+	if ws.IsConditionTrue(workspacev1.WorkspaceConditionPaused) {
+		// frozen processes do not react to signals, the workspace container could not stop.
+		// Should the container be gone already, this merely clears the Paused condition.
+		err = wsc.reconcilePause(ctx, ws, req, false)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
 	if ws.IsConditionTrue(workspacev1.WorkspaceConditionPodRejected) {
 		// edge case only exercised for rejected workspace pods
 		if ws.IsConditionPresent(workspacev1.WorkspaceConditionStateWiped) {
@@ -6,6 +6,7 @@ package controller
 
 import (
 	"fmt"
+	"testing"
 	"time"
 
 	"github.com/aws/smithy-go/ptr"
@@ -266,3 +267,94 @@ func markContentReady(ws *workspacev1.Workspace) {
 		}
 	})
 }
+
+func TestStorageStatusChanged(t *testing.T) {
+	const gib = 1024 * 1024 * 1024
+	rejected := []metav1.Condition{{
+		Type:   string(workspacev1.WorkspaceConditionStorageQuotaRejected),
+		Status: metav1.ConditionTrue,
+	}}
+
+	tests := []struct {
+		Name       string
+		Status     workspacev1.StorageStatus
+		Conditions []metav1.Condition
+		Quota      StorageQuotaStatus
+		Expected   bool
+	}{
+		{
+			Name:     "unchanged",
+			Status:   workspacev1.StorageStatus{QuotaLimit: 10 * gib, QuotaUsed: gib},
+			Quota:    StorageQuotaStatus{Limit: 10 * gib, Used: gib},
+			Expected: false,
+		},
+		{
+			Name:     "small growth",
+			Status:   workspacev1.StorageStatus{QuotaLimit: 10 * gib, QuotaUsed: gib},
+			Quota:    StorageQuotaStatus{Limit: 10 * gib, Used: gib + 10*1024*1024},
+			Expected: false,
+		},
+		{
+			Name:     "growth below ratio of large quota",
+			Status:   workspacev1.StorageStatus{QuotaLimit: 100 * gib, QuotaUsed: gib},
+			Quota:    StorageQuotaStatus{Limit: 100 * gib, Used: gib + 512*1024*1024},
+			Expected: false,
+		},
+		{
+			Name:     "large growth",
+			Status:   workspacev1.StorageStatus{QuotaLimit: 10 * gib, QuotaUsed: gib},
+			Quota:    StorageQuotaStatus{Limit: 10 * gib, Used: 2 * gib},
+			Expected: true,
+		},
+		{
+			Name:     "large shrink",
+			Status:   workspacev1.StorageStatus{QuotaLimit: 10 * gib, QuotaUsed: 2 * gib},
+			Quota:    StorageQuotaStatus{Limit: 10 * gib, Used: gib},
+			Expected: true,
+		},
+		{
+			Name:     "first report",
+			Quota:    StorageQuotaStatus{Limit: 10 * gib},
+			Expected: true,
+		},
+		{
+			Name:     "limit changed",
+			Status:   workspacev1.StorageStatus{QuotaLimit: 10 * gib, QuotaUsed: gib},
+			Quota:    StorageQuotaStatus{Limit: 20 * gib, Used: gib},
+			Expected: true,
+		},
+		{
+			Name:     "rejected",
+			Status:   workspacev1.StorageStatus{QuotaLimit: 10 * gib, QuotaUsed: gib},
+			Quota:    StorageQuotaStatus{Limit: 10 * gib, Used: gib, Rejected: "no space"},
+			Expected: true,
+		},
+		{
+			Name:       "still rejected",
+			Status:     workspacev1.StorageStatus{QuotaLimit: 10 * gib, QuotaUsed: gib},
+			Conditions: rejected,
+			Quota:      StorageQuotaStatus{Limit: 10 * gib, Used: gib, Rejected: "no space"},
+			Expected:   false,
+		},
+		{
+			Name:       "no longer rejected",
+			Status:     workspacev1.StorageStatus{QuotaLimit: 10 * gib, QuotaUsed: gib},
+			Conditions: rejected,
+			Quota:      StorageQuotaStatus{Limit: 10 * gib, Used: gib},
+			Expected:   true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ws := &workspacev1.Workspace{
+				Status: workspacev1.WorkspaceStatus{
+					Storage:    test.Status,
+					Conditions: test.Conditions,
+				},
+			}
+			if actual := storageStatusChanged(ws, &test.Quota); actual != test.Expected {
+				t.Errorf("unexpected result: is %v but expected %v", actual, test.Expected)
+			}
+		})
+	}
+}
@@ -23,8 +23,11 @@ import (
 	"github.com/gitpod-io/gitpod/content-service/pkg/logs"
 	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/content"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/diskguard"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/dispatch"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/gitcache"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/internal/session"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/quota"
 	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
 	"github.com/opentracing/opentracing-go"
 	"github.com/prometheus/client_golang/prometheus"
@@ -78,6 +81,8 @@ type WorkspaceOperations interface {
 	Snapshot(ctx context.Context, instanceID, snapshotName string) (err error)
 	// Setup ensures that the workspace has been setup
 	SetupWorkspace(ctx context.Context, instanceID string, imageInfo *workspacev1.WorkspaceImageInfo) error
+	// ResizeStorageQuota changes the storage quota of a running workspace if that is safe, and reports its usage
+	ResizeStorageQuota(ctx context.Context, instanceID string, size int) (*StorageQuotaStatus, error)
 }
 
 type DefaultWorkspaceOperations struct {
@@ -86,6 +91,10 @@ type DefaultWorkspaceOperations struct {
 	backupWorkspaceLimiter chan struct{}
 	metrics                *Metrics
 	dispatch               *dispatch.Dispatch
+	gitCache               *gitcache.Cache
+	registryAuth           wsinit.OCIRegistryAuth
+	xfs                    *quota.XFS
+	storageGuard           *diskguard.Guard
 }
 
 var _ WorkspaceOperations = (*DefaultWorkspaceOperations)(nil)
@@ -103,6 +112,16 @@ type InitOptions struct {
 	StorageQuota int
 }
 
+// StorageQuotaStatus describes the storage quota enforced on a running workspace
+type StorageQuotaStatus struct {
+	// Limit is the quota in bytes
+	Limit int64
+	// Used is the number of bytes used by the workspace
+	Used int64
+	// Rejected explains why the requested quota was not applied. Empty if it was applied.
+	Rejected string
+}
+
 type BackupOptions struct {
 	Meta              WorkspaceMeta
 	BackupLogs        bool
@@ -111,15 +130,29 @@ type BackupOptions struct {
 	SkipBackupContent bool
 }
 
-func NewWorkspaceOperations(config content.Config, provider *WorkspaceProvider, reg prometheus.Registerer, dispatch *dispatch.Dispatch) (WorkspaceOperations, error) {
+func NewWorkspaceOperations(config content.Config, provider *WorkspaceProvider, reg prometheus.Registerer, dispatch *dispatch.Dispatch, xfs *quota.XFS, storageGuard *diskguard.Guard) (WorkspaceOperations, error) {
 	waitingTimeHist, waitingTimeoutCounter, err := registerConcurrentBackupMetrics(reg, "_mk2")
 	if err != nil {
 		return nil, err
 	}
 
+	var gitCache *gitcache.Cache
+	if config.GitReferenceCache.Enabled {
+		gitCache, err = gitcache.New(config.GitReferenceCache, reg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	registryAuth, err := content.NewOCIRegistryAuth(config.Initializer.RegistryAuth)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot load registry auth: %w", err)
+	}
+
 	return &DefaultWorkspaceOperations{
-		config:   config,
-		provider: provider,
+		config:       config,
+		provider:     provider,
+		registryAuth: registryAuth,
 		metrics: &Metrics{
 			BackupWaitingTimeHist:       waitingTimeHist,
 			BackupWaitingTimeoutCounter: waitingTimeoutCounter,
@@ -127,6 +160,9 @@ func NewWorkspaceOperations(config content.Config, provider *WorkspaceProvider,
 		// we permit five concurrent backups at any given time, hence the five in the channel
 		backupWorkspaceLimiter: make(chan struct{}, 5),
 		dispatch:               dispatch,
+		gitCache:               gitCache,
+		xfs:                    xfs,
+		storageGuard:           storageGuard,
 	}, nil
 }
 
@@ -155,8 +191,11 @@ func (wso *DefaultWorkspaceOperations) InitWorkspace(ctx context.Context, option
 	// Initialize workspace.
 	// FWB workspaces initialize without the help of ws-daemon, but using their supervisor or the registry-facade.
 	opts := content.RunInitializerOpts{
-		Command: wso.config.Initializer.Command,
-		Args:    wso.config.Initializer.Args,
+		Command:        wso.config.Initializer.Command,
+		Args:           wso.config.Initializer.Args,
+		MaxParallelism: wso.config.Initializer.MaxParallelism,
+		GitMirrors:     wso.config.Initializer.GitMirrors,
+		RegistryAuth:   wso.registryAuth,
 		// This is a bit of a hack as it makes hard assumptions about the nature of the UID mapping.
 		// Also, we cannot do this in wsinit because we're dropping all the privileges that would be
 		// required for this operation.
@@ -175,6 +214,10 @@ func (wso *DefaultWorkspaceOperations) InitWorkspace(ctx context.Context, option
 		},
 	}
 
+	gitReferences, releaseGitReferences := wso.acquireGitReferences(options)
+	defer releaseGitReferences()
+	opts.GitReferences = gitReferences
+
 	err = ensureCleanSlate(ws.Location)
 	if err != nil {
 		glog.WithFields(ws.OWI()).Warnf("cannot ensure clean slate for workspace %s (this might break content init): %v", ws.InstanceID, err)
@@ -193,6 +236,17 @@ func (wso *DefaultWorkspaceOperations) InitWorkspace(ctx context.Context, option
 
 	glog.WithFields(ws.OWI()).Debug("content init done")
 
+	if options.Headless && wso.gitCache != nil && wso.dispatch != nil {
+		// prebuilds warm the cache in the background so that their tasks don't wait for it. The request
+		// context ends with content init, hence the warmup is bound to the workspace's handlers instead.
+		started := wso.dispatch.Go(options.Meta.InstanceID, func(ctx context.Context) {
+			wso.warmupGitReferences(ctx, ws, options)
+		})
+		if !started {
+			glog.WithFields(ws.OWI()).Debug("workspace container not known yet, not warming up Git reference cache")
+		}
+	}
+
 	return stats, "", nil
 }
 
@@ -298,6 +352,68 @@ func (wso *DefaultWorkspaceOperations) DeleteWorkspace(ctx context.Context, inst
 	return nil
 }
 
+func (wso *DefaultWorkspaceOperations) ResizeStorageQuota(ctx context.Context, instanceID string, size int) (res *StorageQuotaStatus, err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ResizeStorageQuota")
+	defer tracing.FinishSpan(span, &err)
+
+	ws, err := wso.provider.GetAndConnect(ctx, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot find workspace %s during ResizeStorageQuota: %w", instanceID, err)
+	}
+	if wso.xfs == nil || ws.XFSProjectID == 0 {
+		// no quota is enforced on this workspace
+		return nil, nil
+	}
+
+	used, err := wso.xfs.GetUsage(ws.XFSProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get storage usage of workspace %s: %w", instanceID, err)
+	}
+
+	var (
+		current = quota.Size(ws.StorageQuota)
+		desired = quota.Size(size)
+	)
+	res = &StorageQuotaStatus{
+		Limit: int64(current),
+		Used:  int64(used),
+	}
+	if desired == current {
+		return res, nil
+	}
+
+	if desired < used {
+		res.Rejected = fmt.Sprintf("cannot shrink the storage quota to %s because the workspace already uses %s", desired, used)
+		return res, nil
+	}
+	if desired > current && wso.storageGuard != nil {
+		headroom, err := wso.storageGuard.Headroom()
+		if err != nil {
+			return nil, fmt.Errorf("cannot determine available disk space: %w", err)
+		}
+		if uint64(desired-current) > headroom {
+			res.Rejected = fmt.Sprintf("cannot grow the storage quota to %s because the node has only %s of disk space left", desired, quota.Size(headroom))
+			return res, nil
+		}
+	}
+
+	err = wso.xfs.SetLimit(ws.XFSProjectID, desired, true)
+	if err != nil {
+		return nil, fmt.Errorf("cannot set storage quota of workspace %s: %w", instanceID, err)
+	}
+
+	// the quota is re-installed from the session when ws-daemon restarts
+	ws.StorageQuota = size
+	err = ws.Persist()
+	if err != nil {
+		glog.WithError(err).WithFields(ws.OWI()).Warn("cannot persist storage quota")
+	}
+	glog.WithFields(ws.OWI()).WithField("from", current).WithField("to", desired).Info("resized storage quota")
+
+	res.Limit = int64(desired)
+	return res, nil
+}
+
 func (wso *DefaultWorkspaceOperations) WipeWorkspace(ctx context.Context, instanceID string) error {
 	log := log.New().WithContext(ctx)
 
@@ -499,6 +615,7 @@ func (wso *DefaultWorkspaceOperations) uploadWorkspaceContent(ctx context.Contex
 	var (
 		tmpf     *os.File
 		tmpfSize int64
+		manifest *archive.Manifest
 	)
 
 	defer func() {
@@ -521,7 +638,6 @@ func (wso *DefaultWorkspaceOperations) uploadWorkspaceContent(ctx context.Contex
 		}()
 
 		var opts []archive.TarOption
-		opts = append(opts)
 		mappings := []archive.IDMapping{
 			{ContainerID: 0, HostID: wsinit.GitpodUID, Size: 1},
 			{ContainerID: 1, HostID: 100000, Size: 65534},
@@ -551,27 +667,73 @@ func (wso *DefaultWorkspaceOperations) uploadWorkspaceContent(ctx context.Contex
 		tmpfSize = stat.Size()
 		glog.WithField("size", tmpfSize).WithField("location", tmpf.Name()).WithFields(sess.OWI()).Debug("created temp file for workspace backup upload")
 
+		manifest, err = archive.ComputeManifest(tmpf)
+		if err != nil {
+			return
+		}
+		if manifest.ArchiveSize != tmpfSize {
+			err = xerrors.Errorf("archive manifest covers %d bytes but archive has %d bytes", manifest.ArchiveSize, tmpfSize)
+			return
+		}
+
 		return
 	})
 	if err != nil {
 		return xerrors.Errorf("cannot create archive: %w", err)
 	}
 
+	opts = append(opts, storage.WithAnnotations(map[string]string{
+		storage.ObjectAnnotationDigest: manifest.Digest,
+	}))
 	err = retryIfErr(ctx, wso.config.Backup.Attempts, glog.WithFields(sess.OWI()).WithField("op", "upload layer"), func(ctx context.Context) (err error) {
 		_, _, err = rs.Upload(ctx, tmpf.Name(), backupName, opts...)
 		if err != nil {
 			return
 		}
 
+		// make sure what ended up in the remote storage is what we meant to upload
+		size, err := rs.ObjectSize(ctx, backupName)
+		if err != nil {
+			return xerrors.Errorf("cannot verify uploaded backup: %w", err)
+		}
+		if size != manifest.ArchiveSize {
+			return xerrors.Errorf("uploaded backup has %d bytes but expected %d bytes", size, manifest.ArchiveSize)
+		}
+
 		return
 	})
 	if err != nil {
 		return xerrors.Errorf("cannot upload workspace content: %w", err)
 	}
 
+	err = wso.uploadBackupManifest(ctx, sess, rs, backupName, manifest)
+	if err != nil {
+		return xerrors.Errorf("cannot upload workspace content manifest: %w", err)
+	}
+
 	return nil
 }
 
+// uploadBackupManifest uploads the manifest of a backup alongside the backup itself so that it can be verified later on
+func (wso *DefaultWorkspaceOperations) uploadBackupManifest(ctx context.Context, sess *session.Workspace, rs storage.DirectAccess, backupName string, manifest *archive.Manifest) error {
+	mf, err := os.CreateTemp(wso.config.TmpDir, fmt.Sprintf("wsbkp-%s-*.json", sess.InstanceID))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(mf.Name())
+
+	err = json.NewEncoder(mf).Encode(manifest)
+	mf.Close()
+	if err != nil {
+		return err
+	}
+
+	return retryIfErr(ctx, wso.config.Backup.Attempts, glog.WithFields(sess.OWI()).WithField("op", "upload manifest"), func(ctx context.Context) (err error) {
+		_, _, err = rs.Upload(ctx, mf.Name(), storage.BackupManifestName(backupName), storage.WithContentType("application/json"))
+		return
+	})
+}
+
 func (wso *DefaultWorkspaceOperations) writeImageInfo(_ context.Context, ws *session.Workspace, imageInfo *workspacev1.WorkspaceImageInfo) error {
 	if imageInfo == nil {
 		return nil
@@ -23,6 +23,8 @@ type Workspace struct {
 	Usage       CPUTime
 	QoS         int
 	Annotations map[string]string
+	// Owner is the organization the workspace belongs to
+	Owner string
 }
 
 type WorkspaceHistory struct {
@@ -173,6 +175,16 @@ func (d *Distributor) Tick(dt time.Duration) (DistributorDebug, error) {
 		}, err
 	}
 
+	histories := make([]*WorkspaceHistory, 0, len(wsOrder))
+	for _, id := range wsOrder {
+		histories = append(histories, d.History[id])
+	}
+	for _, l := range []ResourceLimiter{d.Limiter, d.BurstLimiter} {
+		if pl, ok := l.(PreparingLimiter); ok {
+			pl.Prepare(histories, dt)
+		}
+	}
+
 	// enforce limits
 	var burstBandwidth Bandwidth
 	for _, id := range wsOrder {
@@ -354,6 +366,14 @@ func CompositeLimiter(limiters ...ResourceLimiter) ResourceLimiter {
 	}
 }
 
+func (cl *compositeLimiter) Prepare(workspaces []*WorkspaceHistory, dt time.Duration) {
+	for _, limiter := range cl.limiters {
+		if pl, ok := limiter.(PreparingLimiter); ok {
+			pl.Prepare(workspaces, dt)
+		}
+	}
+}
+
 func (cl *compositeLimiter) Limit(wsh *WorkspaceHistory) (Bandwidth, error) {
 	var errs []error
 	for _, limiter := range cl.limiters {
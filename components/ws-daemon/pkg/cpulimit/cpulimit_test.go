@@ -93,6 +93,15 @@ func (s *SpikyConsumer) Rate(t time.Duration) cpulimit.Bandwidth {
 	return s.Consumer.Rate(t)
 }
 
+// OwnedConsumer is a consumer which belongs to an organization
+type OwnedConsumer struct {
+	Consumer
+
+	Org string
+}
+
+func (o OwnedConsumer) Owner() string { return o.Org }
+
 type RecordedConsumer struct {
 	Id  string               `json:"id"`
 	Qos int                  `json:"qos"`
@@ -212,11 +221,16 @@ func (n *Node) Tick(totalT, dt time.Duration) {
 func (n *Node) Source(context.Context) ([]cpulimit.Workspace, error) {
 	var res []cpulimit.Workspace
 	for id, w := range n.State {
+		var owner string
+		if o, ok := w.Consumer.(interface{ Owner() string }); ok {
+			owner = o.Owner()
+		}
 		res = append(res, cpulimit.Workspace{
 			ID:          id,
 			NrThrottled: w.Throttled,
 			Usage:       w.Usage,
 			QoS:         w.Consumer.QoS(),
+			Owner:       owner,
 		})
 	}
 	return res, nil
@@ -330,6 +344,34 @@ func TestProdBehaviour(t *testing.T) {
 	runSimulation(t, node, dist)
 }
 
+func TestFairShareLimitsPrebuildFlood(t *testing.T) {
+	var cs []Consumer
+	for i := 0; i < 30; i++ {
+		cs = append(cs, OwnedConsumer{Consumer: SteadyConsumer{id: fmt.Sprintf("p%02d", i), rate: 4000}, Org: "flood"})
+	}
+	for i := 0; i < 3; i++ {
+		cs = append(cs, OwnedConsumer{Consumer: SteadyConsumer{id: fmt.Sprintf("u%02d", i), rate: 1500}, Org: "other"})
+	}
+	node := NewNode(cs...)
+
+	limiter := cpulimit.NewFairShareLimiter(defaultLimit, nil, totalCapacity, cpulimit.FairShareConfig{})
+	dist := cpulimit.NewDistributor(node.Source, node.Sink,
+		limiter,
+		cpulimit.NewFairShareLimiter(defaultBreakoutLimit, limiter, totalCapacity, cpulimit.FairShareConfig{}),
+		totalCapacity,
+	)
+	runSimulation(t, node, dist)
+
+	// the other organization must be able to use the CPU it asks for
+	for _, c := range cs[30:] {
+		usage := time.Duration(node.State[c.ID()].Usage)
+		expected := time.Duration(cpulimit.Bandwidth(1500).Integrate(testDuration)) * 9 / 10
+		if usage < expected {
+			t.Errorf("%s was starved: used %v CPU time, expected at least %v", c.ID(), usage, expected)
+		}
+	}
+}
+
 func runSimulation(t *testing.T, node *Node, dist *cpulimit.Distributor) {
 	f, err := os.OpenFile(fmt.Sprintf("sim_%s.csv", t.Name()), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0744)
 	if err != nil {
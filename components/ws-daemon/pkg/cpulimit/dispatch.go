@@ -33,6 +33,9 @@ type Config struct {
 
 	ControlPeriod  util.Duration `json:"controlPeriod"`
 	CGroupBasePath string        `json:"cgroupBasePath"`
+
+	// FairShare divides the total bandwidth among organizations before dividing it among their workspaces
+	FairShare FairShareConfig `json:"fairShare"`
 }
 
 // NewDispatchListener creates a new resource governer dispatch listener
@@ -65,9 +68,19 @@ func NewDispatchListener(cfg *Config, prom prometheus.Registerer) *DispatchListe
 	}
 
 	if cfg.Enabled {
+		var (
+			limiter      = CompositeLimiter(AnnotationLimiter(kubernetes.WorkspaceCpuMinLimitAnnotation), FixedLimiter(BandwidthFromQuantity(d.Config.Limit)))
+			burstLimiter = CompositeLimiter(AnnotationLimiter(kubernetes.WorkspaceCpuBurstLimitAnnotation), FixedLimiter(BandwidthFromQuantity(d.Config.BurstLimit)))
+		)
+		if cfg.FairShare.Enabled {
+			// the min limit annotation guarantees a workspace its bandwidth, and bursting never
+			// limits a workspace below its regular fair share
+			limiter = NewFairShareLimiter(limiter, AnnotationLimiter(kubernetes.WorkspaceCpuMinLimitAnnotation), BandwidthFromQuantity(d.Config.TotalBandwidth), cfg.FairShare)
+			burstLimiter = NewFairShareLimiter(burstLimiter, limiter, BandwidthFromQuantity(d.Config.TotalBandwidth), cfg.FairShare)
+		}
 		dist := NewDistributor(d.source, d.sink,
-			CompositeLimiter(AnnotationLimiter(kubernetes.WorkspaceCpuMinLimitAnnotation), FixedLimiter(BandwidthFromQuantity(d.Config.Limit))),
-			CompositeLimiter(AnnotationLimiter(kubernetes.WorkspaceCpuBurstLimitAnnotation), FixedLimiter(BandwidthFromQuantity(d.Config.BurstLimit))),
+			limiter,
+			burstLimiter,
 			BandwidthFromQuantity(d.Config.TotalBandwidth),
 		)
 		go dist.Run(context.Background(), time.Duration(d.Config.ControlPeriod))
@@ -104,6 +117,7 @@ type workspace struct {
 	OWI         logrus.Fields
 	HardLimit   ResourceLimiter
 	Annotations map[string]string
+	Owner       string
 
 	lastThrottled uint64
 }
@@ -143,6 +157,7 @@ func (d *DispatchListener) source(context.Context) ([]Workspace, error) {
 			NrThrottled: throttled,
 			Usage:       usage,
 			Annotations: w.Annotations,
+			Owner:       w.Owner,
 		})
 	}
 	return res, nil
@@ -196,6 +211,7 @@ func (d *DispatchListener) WorkspaceAdded(ctx context.Context, ws *dispatch.Work
 		CFS:         controller,
 		OWI:         ws.OWI(),
 		Annotations: ws.Pod.Annotations,
+		Owner:       ws.Pod.Labels[kubernetes.TeamLabel],
 	}
 
 	dispatch.GetDispatchWaitGroup(ctx).Add(1)
@@ -0,0 +1,270 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cpulimit
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// FairShareConfig configures the hierarchical distribution of CPU bandwidth among organizations
+type FairShareConfig struct {
+	Enabled bool `json:"enabled"`
+	// Weights are the relative shares of CPU bandwidth organizations receive when the node is contended
+	Weights map[string]uint `json:"weights,omitempty"`
+	// DefaultWeight is the weight of organizations which are not listed in Weights. Defaults to 1.
+	DefaultWeight uint `json:"defaultWeight,omitempty"`
+}
+
+// fairShareHeadroom is the factor by which we expect a workspace which was not throttled to
+// exceed its last observed CPU use within the next control period
+const fairShareHeadroom = 1.2
+
+// minFairShare is the least bandwidth a workspace is limited to, no matter how contended the node is.
+// An idle workspace must be able to make progress until the next control period raises its share.
+const minFairShare = Bandwidth(100)
+
+// PreparingLimiter is a ResourceLimiter which needs to see all workspaces of a distributor tick
+// before it can limit any one of them.
+type PreparingLimiter interface {
+	ResourceLimiter
+
+	// Prepare is called once per tick with all workspaces before Limit is called for any of them
+	Prepare(workspaces []*WorkspaceHistory, dt time.Duration)
+}
+
+var _ PreparingLimiter = (*FairShareLimiter)(nil)
+var _ PreparingLimiter = (*compositeLimiter)(nil)
+
+// NewFairShareLimiter produces a limiter which first divides the total bandwidth among organizations
+// according to their weights, and then among the workspaces of each organization. The limit of a workspace
+// never exceeds what the underlying limiter gives it, and never falls below what the floor limiter (if any)
+// gives it or minFairShare.
+//
+// Bandwidth an organization or workspace does not use is shared among the others, i.e. the weights
+// only come into play if the node is contended.
+func NewFairShareLimiter(limiter, floor ResourceLimiter, totalBandwidth Bandwidth, cfg FairShareConfig) *FairShareLimiter {
+	defaultWeight := cfg.DefaultWeight
+	if defaultWeight == 0 {
+		defaultWeight = 1
+	}
+	return &FairShareLimiter{
+		Limiter:        limiter,
+		Floor:          floor,
+		TotalBandwidth: totalBandwidth,
+		Weights:        cfg.Weights,
+		DefaultWeight:  defaultWeight,
+		lastUsage:      make(map[string]CPUTime),
+		limits:         make(map[string]Bandwidth),
+	}
+}
+
+// FairShareLimiter limits workspaces to their fair share of the node's CPU bandwidth
+type FairShareLimiter struct {
+	Limiter ResourceLimiter
+	// Floor is the limit the fair share of a workspace never falls below, e.g. its guaranteed minimum
+	Floor          ResourceLimiter
+	TotalBandwidth Bandwidth
+	Weights        map[string]uint
+	DefaultWeight  uint
+
+	mu        sync.Mutex
+	lastUsage map[string]CPUTime
+	limits    map[string]Bandwidth
+}
+
+// Prepare computes the fair share of all workspaces
+func (l *FairShareLimiter) Prepare(workspaces []*WorkspaceHistory, dt time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	type member struct {
+		ID                   string
+		Limit, Demand, Floor Bandwidth
+		Alloc, Residue       Bandwidth
+	}
+	var (
+		orgs     = make(map[string][]*member)
+		orgOrder []string
+		seen     = make(map[string]struct{}, len(workspaces))
+	)
+	for _, ws := range workspaces {
+		if ws.LastUpdate == nil {
+			continue
+		}
+		seen[ws.ID] = struct{}{}
+
+		limit, err := l.Limiter.Limit(ws)
+		if err != nil {
+			continue
+		}
+
+		// Workspaces which were throttled (or we know nothing about yet) are assumed to want all they can get.
+		// All others want what they used last time plus some headroom.
+		demand := limit
+		if prev, ok := l.lastUsage[ws.ID]; ok && !ws.Throttled() {
+			rate, err := BandwithFromUsage(prev, ws.LastUpdate.Usage, dt)
+			if err == nil && Bandwidth(float64(rate)*fairShareHeadroom) < limit {
+				demand = Bandwidth(float64(rate) * fairShareHeadroom)
+			}
+		}
+		l.lastUsage[ws.ID] = ws.LastUpdate.Usage
+
+		floor := minFairShare
+		if l.Floor != nil {
+			if f, err := l.Floor.Limit(ws); err == nil && f > floor {
+				floor = f
+			}
+		}
+		if floor > limit {
+			floor = limit
+		}
+
+		// workspaces without an organization form an organization of their own
+		org := ws.LastUpdate.Owner
+		if org == "" {
+			org = "workspace/" + ws.ID
+		}
+		if _, exists := orgs[org]; !exists {
+			orgOrder = append(orgOrder, org)
+		}
+		orgs[org] = append(orgs[org], &member{ID: ws.ID, Limit: limit, Demand: demand, Floor: floor})
+	}
+	for id := range l.lastUsage {
+		if _, ok := seen[id]; !ok {
+			delete(l.lastUsage, id)
+		}
+	}
+	sort.Strings(orgOrder)
+
+	weights := make([]uint, len(orgOrder))
+	for i, org := range orgOrder {
+		w, ok := l.Weights[org]
+		if !ok {
+			w = l.DefaultWeight
+		}
+		weights[i] = w
+	}
+
+	// distribute divides bandwidth among the organizations and then among their workspaces,
+	// never giving a workspace more than want(member).
+	distribute := func(bandwidth Bandwidth, want func(m *member) Bandwidth, give func(m *member, b Bandwidth)) {
+		orgDemand := make([]Bandwidth, len(orgOrder))
+		for i, org := range orgOrder {
+			for _, m := range orgs[org] {
+				orgDemand[i] += want(m)
+			}
+		}
+		for i, orgShare := range weightedFill(bandwidth, orgDemand, weights) {
+			members := orgs[orgOrder[i]]
+			demand := make([]Bandwidth, len(members))
+			equal := make([]uint, len(members))
+			for j, m := range members {
+				demand[j] = want(m)
+				equal[j] = 1
+			}
+			for j, share := range weightedFill(orgShare, demand, equal) {
+				give(members[j], share)
+			}
+		}
+	}
+
+	// first we satisfy the actual demand of workspaces in a fair manner ...
+	var allocated Bandwidth
+	distribute(l.TotalBandwidth,
+		func(m *member) Bandwidth { return m.Demand },
+		func(m *member, b Bandwidth) {
+			m.Alloc = b
+			allocated += b
+		},
+	)
+	// ... and then hand out whatever is left up to the limit of each workspace
+	if allocated < l.TotalBandwidth {
+		distribute(l.TotalBandwidth-allocated,
+			func(m *member) Bandwidth { return m.Limit - m.Alloc },
+			func(m *member, b Bandwidth) { m.Alloc += b },
+		)
+	}
+
+	// Workspaces which used little last time may want more this time. Until the next period lets them
+	// ask for it, they're limited to their floor at least.
+	l.limits = make(map[string]Bandwidth, len(workspaces))
+	for _, members := range orgs {
+		for _, m := range members {
+			alloc := m.Alloc
+			if alloc < m.Floor {
+				alloc = m.Floor
+			}
+			l.limits[m.ID] = alloc
+		}
+	}
+}
+
+// Limit returns the fair share of the workspace computed during Prepare. Workspaces we have not
+// prepared for are limited by the underlying limiter.
+func (l *FairShareLimiter) Limit(wsh *WorkspaceHistory) (Bandwidth, error) {
+	l.mu.Lock()
+	limit, ok := l.limits[wsh.ID]
+	l.mu.Unlock()
+	if ok {
+		return limit, nil
+	}
+
+	return l.Limiter.Limit(wsh)
+}
+
+// weightedFill divides total among consumers according to their weights, but never gives a consumer more
+// than its demand. Bandwidth one consumer does not need is divided among the others (weighted max-min fairness).
+func weightedFill(total Bandwidth, demand []Bandwidth, weights []uint) []Bandwidth {
+	var (
+		res    = make([]Bandwidth, len(demand))
+		active = make([]int, 0, len(demand))
+	)
+	for i, d := range demand {
+		if d > 0 && weights[i] > 0 {
+			active = append(active, i)
+		}
+	}
+
+	remaining := total
+	for len(active) > 0 && remaining > 0 {
+		var totalWeight uint
+		for _, i := range active {
+			totalWeight += weights[i]
+		}
+
+		// satisfy everyone whose outstanding demand is below their share and start over with what's left
+		var (
+			stillActive = active[:0:0]
+			satisfied   bool
+		)
+		for _, i := range active {
+			share := Bandwidth(uint64(remaining) * uint64(weights[i]) / uint64(totalWeight))
+			if outstanding := demand[i] - res[i]; outstanding <= share {
+				res[i] += outstanding
+				satisfied = true
+				continue
+			}
+			stillActive = append(stillActive, i)
+		}
+		if satisfied {
+			remaining = total
+			for _, r := range res {
+				remaining -= r
+			}
+			active = stillActive
+			continue
+		}
+
+		// nobody can be satisfied - everyone gets their share
+		for _, i := range active {
+			res[i] += Bandwidth(uint64(remaining) * uint64(weights[i]) / uint64(totalWeight))
+		}
+		break
+	}
+
+	return res
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/cpulimit"
 	"github.com/google/go-cmp/cmp"
@@ -76,3 +77,131 @@ func TestUnmarshalBucket(t *testing.T) {
 		})
 	}
 }
+
+func TestFairShareLimiter(t *testing.T) {
+	type org struct {
+		Name       string
+		Workspaces int
+	}
+	tests := []struct {
+		Desc          string
+		Orgs          []org
+		Weights       map[string]uint
+		ExpectedLimit map[string]cpulimit.Bandwidth
+	}{
+		{
+			Desc:          "uncontended",
+			Orgs:          []org{{"a", 2}, {"b", 1}},
+			ExpectedLimit: map[string]cpulimit.Bandwidth{"a": 2000, "b": 2000},
+		},
+		{
+			Desc:          "prebuild flood",
+			Orgs:          []org{{"a", 30}, {"b", 2}},
+			ExpectedLimit: map[string]cpulimit.Bandwidth{"a": 266, "b": 2000},
+		},
+		{
+			Desc:          "weighted",
+			Orgs:          []org{{"a", 10}, {"b", 10}},
+			Weights:       map[string]uint{"a": 3},
+			ExpectedLimit: map[string]cpulimit.Bandwidth{"a": 900, "b": 300},
+		},
+		{
+			Desc:          "workspaces without organization",
+			Orgs:          []org{{"a", 20}, {"", 2}},
+			ExpectedLimit: map[string]cpulimit.Bandwidth{"a": 400, "": 2000},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Desc, func(t *testing.T) {
+			limiter := cpulimit.NewFairShareLimiter(cpulimit.FixedLimiter(2000), nil, 12000, cpulimit.FairShareConfig{Weights: test.Weights})
+
+			var wss []*cpulimit.WorkspaceHistory
+			for _, o := range test.Orgs {
+				for i := 0; i < o.Workspaces; i++ {
+					wss = append(wss, &cpulimit.WorkspaceHistory{
+						ID: fmt.Sprintf("%s%02d", o.Name, i),
+						LastUpdate: &cpulimit.Workspace{
+							Owner: o.Name,
+						},
+					})
+				}
+			}
+			limiter.Prepare(wss, 10*time.Second)
+
+			act := make(map[string]cpulimit.Bandwidth)
+			for _, ws := range wss {
+				limit, err := limiter.Limit(ws)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if prev, ok := act[ws.LastUpdate.Owner]; ok && prev != limit {
+					t.Errorf("workspaces of %q have different limits: %d and %d", ws.LastUpdate.Owner, prev, limit)
+				}
+				act[ws.LastUpdate.Owner] = limit
+			}
+			if diff := cmp.Diff(test.ExpectedLimit, act); diff != "" {
+				t.Errorf("unexpected limits (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFairShareLimiterIdleWorkspaceBecomesBusy(t *testing.T) {
+	const (
+		dt      = 10 * time.Second
+		minimum = cpulimit.Bandwidth(1500)
+	)
+	var (
+		limiter = cpulimit.NewFairShareLimiter(cpulimit.FixedLimiter(2000), cpulimit.AnnotationLimiter("min"), 12000, cpulimit.FairShareConfig{})
+		wss     []*cpulimit.WorkspaceHistory
+	)
+	for i := 0; i < 10; i++ {
+		wss = append(wss, &cpulimit.WorkspaceHistory{ID: fmt.Sprintf("busy%02d", i)})
+	}
+	idle := &cpulimit.WorkspaceHistory{ID: "idle"}
+	guaranteed := &cpulimit.WorkspaceHistory{ID: "guaranteed"}
+	wss = append(wss, idle, guaranteed)
+
+	// tick updates all workspaces, the busy ones are throttled while using all they can
+	tick := func(i int, idleUsage cpulimit.CPUTime, idleThrottled bool) {
+		for _, ws := range wss {
+			w := cpulimit.Workspace{ID: ws.ID, Owner: "busy", Usage: cpulimit.CPUTime(i) * cpulimit.CPUTime(2*time.Second), NrThrottled: uint64(i)}
+			switch ws {
+			case idle:
+				w.Owner, w.Usage, w.NrThrottled = "other", idleUsage, 0
+				if idleThrottled {
+					w.NrThrottled = uint64(i)
+				}
+			case guaranteed:
+				w.Owner, w.Usage, w.NrThrottled = "other", 0, 0
+				w.Annotations = map[string]string{"min": "1500m"}
+			}
+			ws.Update(w)
+		}
+		limiter.Prepare(wss, dt)
+	}
+	limit := func(ws *cpulimit.WorkspaceHistory) cpulimit.Bandwidth {
+		l, err := limiter.Limit(ws)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return l
+	}
+
+	tick(1, 0, false)
+	tick(2, 0, false)
+	if l := limit(idle); l == 0 {
+		t.Error("idle workspace must never be limited to zero")
+	}
+	if l := limit(guaranteed); l < minimum {
+		t.Errorf("idle workspace with a minimum got %d, expected at least %d", l, minimum)
+	}
+
+	// the idle workspace becomes busy and is throttled at its small share
+	tick(3, cpulimit.CPUTime(time.Second), true)
+	tick(4, cpulimit.CPUTime(2*time.Second), true)
+	if l := limit(idle); l != 2000 {
+		t.Errorf("formerly idle workspace got %d, expected its full limit of 2000", l)
+	}
+}
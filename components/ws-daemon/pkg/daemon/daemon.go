@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -27,6 +28,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-daemon/api"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/cgroup"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/container"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/content"
@@ -90,6 +92,30 @@ func NewDaemon(config Config) (*Daemon, error) {
 		return nil, xerrors.Errorf("NODENAME env var isn't set")
 	}
 
+	var mgr manager.Manager
+
+	mgr, err = ctrl.NewManager(restCfg, ctrl.Options{
+		Scheme:                 scheme,
+		HealthProbeBindAddress: "0",
+		Metrics: metricsserver.Options{
+			// Disable the metrics server.
+			// We only need access to the reconciliation loop feature.
+			BindAddress: "0",
+		},
+		Cache: cache.Options{
+			DefaultNamespaces: map[string]cache.Config{
+				config.Runtime.KubernetesNamespace: {},
+				config.Runtime.SecretsNamespace:    {},
+			},
+		},
+		WebhookServer: webhook.NewServer(webhook.Options{
+			Port: 9443,
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	markUnmountFallback, err := NewMarkUnmountFallback(wrappedReg)
 	if err != nil {
 		return nil, err
@@ -105,6 +131,14 @@ func NewDaemon(config Config) (*Daemon, error) {
 		return nil, err
 	}
 
+	memoryPressure, err := cgroup.NewMemoryPressureGovernor(config.MemoryPressure, &controller.WorkspaceAnnotator{
+		Client:    mgr.GetClient(),
+		Namespace: config.Runtime.KubernetesNamespace,
+	}, wrappedReg)
+	if err != nil {
+		return nil, err
+	}
+
 	cgroupPlugins, err := cgroup.NewPluginHost(config.CPULimit.CGroupBasePath,
 		&cgroup.FuseDeviceEnablerV2{},
 		cgroupV2IOLimiter,
@@ -134,6 +168,7 @@ func NewDaemon(config Config) (*Daemon, error) {
 		},
 		procV2Plugin,
 		cgroup.NewPSIMetrics(wrappedReg),
+		memoryPressure,
 	)
 	if err != nil {
 		return nil, err
@@ -159,40 +194,35 @@ func NewDaemon(config Config) (*Daemon, error) {
 		listener = append(listener, netlimiter)
 	}
 
+	egressEnforcer := netlimit.NewEgressEnforcer(config.EgressPolicy, wrappedReg)
+	if config.EgressPolicy.Enabled {
+		listener = append(listener, egressEnforcer)
+	}
+
+	bandwidthLimiter := netlimit.NewBandwidthLimiter(config.NetBandwidth, wrappedReg)
+	if config.NetBandwidth.Enabled {
+		listener = append(listener, bandwidthLimiter)
+	}
+
 	var configReloader CompositeConfigReloader
 	configReloader = append(configReloader, ConfigReloaderFunc(func(ctx context.Context, config *Config) error {
 		cgroupV2IOLimiter.Update(config.IOLimit.WriteBWPerSecond.Value(), config.IOLimit.ReadBWPerSecond.Value(), config.IOLimit.WriteIOPS, config.IOLimit.ReadIOPS)
 		procV2Plugin.Update(config.ProcLimit)
+		if err := memoryPressure.Update(config.MemoryPressure); err != nil {
+			log.WithError(err).Error("invalid memory pressure config, keeping the previous one")
+		}
 		if config.NetLimit.Enabled {
 			netlimiter.Update(config.NetLimit)
 		}
+		if config.EgressPolicy.Enabled {
+			egressEnforcer.Update(config.EgressPolicy)
+		}
+		if config.NetBandwidth.Enabled {
+			bandwidthLimiter.Update(config.NetBandwidth)
+		}
 		return nil
 	}))
 
-	var mgr manager.Manager
-
-	mgr, err = ctrl.NewManager(restCfg, ctrl.Options{
-		Scheme:                 scheme,
-		HealthProbeBindAddress: "0",
-		Metrics: metricsserver.Options{
-			// Disable the metrics server.
-			// We only need access to the reconciliation loop feature.
-			BindAddress: "0",
-		},
-		Cache: cache.Options{
-			DefaultNamespaces: map[string]cache.Config{
-				config.Runtime.KubernetesNamespace: {},
-				config.Runtime.SecretsNamespace:    {},
-			},
-		},
-		WebhookServer: webhook.NewServer(webhook.Options{
-			Port: 9443,
-		}),
-	})
-	if err != nil {
-		return nil, err
-	}
-
 	contentCfg := config.Content
 
 	xfs, err := quota.NewXFS(contentCfg.WorkingArea)
@@ -206,6 +236,10 @@ func NewDaemon(config Config) (*Daemon, error) {
 		&iws.Uidmapper{Config: config.Uidmapper, Runtime: containerRuntime},
 		xfs,
 		config.CPULimit.CGroupBasePath,
+		func(instanceID string) api.MemoryPressure {
+			// the memory pressure levels map onto the API enum one by one
+			return api.MemoryPressure(memoryPressure.Level(instanceID))
+		},
 	)
 
 	dsptch, err := dispatch.NewDispatch(containerRuntime, clientset, config.Runtime.KubernetesNamespace, nodename, listener...)
@@ -213,13 +247,23 @@ func NewDaemon(config Config) (*Daemon, error) {
 		return nil, err
 	}
 
-	workspaceOps, err := controller.NewWorkspaceOperations(contentCfg, controller.NewWorkspaceProvider(contentCfg.WorkingArea, hooks), wrappedReg, dsptch)
+	dsk := diskguard.FromConfig(config.DiskSpaceGuard, clientset, nodename)
+
+	// storage quota of running workspaces may only grow as far as the disk guard of the working area permits
+	storageGuard := &diskguard.Guard{Path: contentCfg.WorkingArea}
+	for _, g := range dsk {
+		if filepath.Clean(g.Path) == filepath.Clean(contentCfg.WorkingArea) {
+			storageGuard = g
+		}
+	}
+
+	workspaceOps, err := controller.NewWorkspaceOperations(contentCfg, controller.NewWorkspaceProvider(contentCfg.WorkingArea, hooks), wrappedReg, dsptch, xfs, storageGuard)
 	if err != nil {
 		return nil, err
 	}
 
 	wsctrl, err := controller.NewWorkspaceController(
-		mgr.GetClient(), mgr.GetEventRecorderFor("workspace"), nodename, config.Runtime.SecretsNamespace, config.WorkspaceController.MaxConcurrentReconciles, workspaceOps, wrappedReg, containerRuntime)
+		mgr.GetClient(), mgr.GetEventRecorderFor("workspace"), nodename, config.Runtime.SecretsNamespace, config.WorkspaceController.MaxConcurrentReconciles, workspaceOps, wrappedReg, containerRuntime, config.CPULimit.CGroupBasePath)
 	if err != nil {
 		return nil, err
 	}
@@ -238,8 +282,6 @@ func NewDaemon(config Config) (*Daemon, error) {
 	housekeeping := controller.NewHousekeeping(contentCfg.WorkingArea, 5*time.Minute)
 	go housekeeping.Start(context.Background())
 
-	dsk := diskguard.FromConfig(config.DiskSpaceGuard, clientset, nodename)
-
 	return &Daemon{
 		Config:          config,
 		dispatch:        dsptch,
@@ -90,6 +90,19 @@ func (g *Guard) Start() {
 	}
 }
 
+// Headroom returns the number of bytes which can still be allocated on the guarded disk
+// before the node would be considered under disk pressure.
+func (g *Guard) Headroom() (uint64, error) {
+	bvail, err := getAvailableBytes(g.Path)
+	if err != nil {
+		return 0, err
+	}
+	if bvail <= g.MinBytesAvail {
+		return 0, nil
+	}
+	return bvail - g.MinBytesAvail, nil
+}
+
 // setLabel adds or removes the label from the node
 func (g *Guard) setLabel(label string, add bool) error {
 	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
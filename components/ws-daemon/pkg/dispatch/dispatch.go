@@ -215,6 +215,26 @@ func (d *Dispatch) DisposeWorkspace(ctx context.Context, instanceID string) {
 	delete(d.ctxs, instanceID)
 }
 
+// Go runs fn in the background as part of the handlers of a workspace. The context of fn is canceled once
+// the workspace is disposed or its pod is deleted, and DisposeWorkspace waits for fn to return.
+// Go does not run fn and returns false if the workspace container is not known (yet).
+func (d *Dispatch) Go(instanceID string, fn func(ctx context.Context)) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	state, ok := d.ctxs[instanceID]
+	if !ok || !state.WorkspaceAdded {
+		return false
+	}
+
+	state.HandlerWaitGroup.Add(1)
+	go func() {
+		defer state.HandlerWaitGroup.Done()
+		fn(state.Context)
+	}()
+	return true
+}
+
 func disposedKey(instanceID string, pod *corev1.Pod) string {
 	return fmt.Sprintf("%s-%s", instanceID, pod.CreationTimestamp.String())
 }
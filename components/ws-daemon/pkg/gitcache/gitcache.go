@@ -0,0 +1,391 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package gitcache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/util"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+)
+
+const (
+	// tmpPrefix marks reference repositories which are still being created
+	tmpPrefix = ".tmp-"
+
+	// defaultWarmupTimeout is the time we spend adding a repository to the cache by default
+	defaultWarmupTimeout = 5 * time.Minute
+
+	// defaultAllowedProtocols are the Git transports we fetch reference repositories with. Local
+	// paths are not among them, as remote URIs are chosen by users and the fetch runs on the node.
+	defaultAllowedProtocols = "http:https"
+)
+
+// Config configures the node-local Git reference repository cache
+type Config struct {
+	Enabled bool `json:"enabled"`
+
+	// Location is the directory the reference repositories are kept in
+	Location string `json:"location"`
+
+	// MaxBytes is the disk space the cache may use. Least recently used repositories are evicted beyond that.
+	MaxBytes uint64 `json:"maxBytes"`
+
+	// WarmupTimeout is the maximum time we spend adding a prebuild's repository to the cache.
+	// Defaults to five minutes.
+	WarmupTimeout util.Duration `json:"warmupTimeout,omitempty"`
+}
+
+// Key produces the cache key of a remote. Repositories are only shared between
+// clones with the same remote URI and auth scope (see AuthScope).
+func Key(remoteURI, scope string) string {
+	h := sha256.New()
+	h.Write([]byte(remoteURI))
+	h.Write([]byte{0})
+	h.Write([]byte(scope))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// AuthScope determines whom a repository cloned using cfg may be shared with. Reference repositories are
+// never shared between owners, as we cannot tell whether a remote which needs no authentication is public.
+func AuthScope(cfg *csapi.GitConfig, owner string) string {
+	return "owner/" + owner + "/" + cfg.GetAuthentication().String()
+}
+
+// Remote is the repository a reference repository is fetched from
+type Remote struct {
+	URI string
+
+	// User and Password authenticate with the remote using HTTP basic auth if set
+	User     string
+	Password string
+}
+
+// Cache maintains bare reference repositories which Git clones can borrow objects from
+type Cache struct {
+	Location      string
+	MaxBytes      uint64
+	WarmupTimeout time.Duration
+
+	// AllowedProtocols lists the Git transports we fetch with, see GIT_ALLOW_PROTOCOL
+	AllowedProtocols string
+
+	mu      sync.Mutex
+	entries map[string]*entry
+	metrics *metrics
+}
+
+type entry struct {
+	Ready    bool
+	Updating bool
+	Users    int
+	Size     uint64
+	LastUsed time.Time
+}
+
+type metrics struct {
+	Hits      prometheus.Counter
+	Misses    prometheus.Counter
+	Evictions prometheus.Counter
+	Size      prometheus.Gauge
+}
+
+// New creates a cache and picks up the reference repositories already present in its location
+func New(cfg Config, reg prometheus.Registerer) (*Cache, error) {
+	if cfg.Location == "" {
+		return nil, xerrors.Errorf("missing Git reference cache location")
+	}
+	if cfg.MaxBytes == 0 {
+		return nil, xerrors.Errorf("Git reference cache needs maxBytes")
+	}
+	err := os.MkdirAll(cfg.Location, 0755)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot create Git reference cache location: %w", err)
+	}
+
+	m := &metrics{
+		Hits: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "git_reference_cache_hits_total",
+			Help: "total number of clones for which a reference repository was available",
+		}),
+		Misses: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "git_reference_cache_misses_total",
+			Help: "total number of clones for which no reference repository was available",
+		}),
+		Evictions: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "git_reference_cache_evictions_total",
+			Help: "total number of reference repositories evicted from the cache",
+		}),
+		Size: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "git_reference_cache_bytes",
+			Help: "disk space used by the Git reference cache",
+		}),
+	}
+	for _, c := range []prometheus.Collector{m.Hits, m.Misses, m.Evictions, m.Size} {
+		err = reg.Register(c)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot register Git reference cache metrics: %w", err)
+		}
+	}
+
+	warmupTimeout := time.Duration(cfg.WarmupTimeout)
+	if warmupTimeout == 0 {
+		warmupTimeout = defaultWarmupTimeout
+	}
+	c := &Cache{
+		Location:         cfg.Location,
+		MaxBytes:         cfg.MaxBytes,
+		WarmupTimeout:    warmupTimeout,
+		AllowedProtocols: defaultAllowedProtocols,
+		entries:          make(map[string]*entry),
+		metrics:          m,
+	}
+
+	dirs, err := os.ReadDir(cfg.Location)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot read Git reference cache location: %w", err)
+	}
+	for _, d := range dirs {
+		fn := filepath.Join(cfg.Location, d.Name())
+		if strings.HasPrefix(d.Name(), tmpPrefix) {
+			// left behind by an interrupted update
+			err = os.RemoveAll(fn)
+			if err != nil {
+				log.WithError(err).WithField("path", fn).Warn("cannot remove incomplete reference repository")
+			}
+			continue
+		}
+		if !d.IsDir() {
+			continue
+		}
+
+		nfo, err := d.Info()
+		if err != nil {
+			return nil, err
+		}
+		size, err := dirSize(fn)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot determine size of reference repository %s: %w", fn, err)
+		}
+		c.entries[d.Name()] = &entry{
+			Ready:    true,
+			Size:     size,
+			LastUsed: nfo.ModTime(),
+		}
+	}
+	c.mu.Lock()
+	victims := c.evict()
+	c.mu.Unlock()
+	c.remove(victims)
+
+	return c, nil
+}
+
+// Acquire returns the reference repository for key if there is one. The repository won't be evicted
+// until release is called. Acquire counts as a use of the repository and records a cache hit or miss.
+func (c *Cache) Acquire(key string) (path string, release func(), ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, exists := c.entries[key]
+	if !exists || !e.Ready {
+		c.metrics.Misses.Inc()
+		return "", func() {}, false
+	}
+	c.metrics.Hits.Inc()
+
+	path = filepath.Join(c.Location, key)
+	e.Users++
+	e.LastUsed = time.Now()
+	err := os.Chtimes(path, e.LastUsed, e.LastUsed)
+	if err != nil {
+		log.WithError(err).WithField("path", path).Warn("cannot record use of reference repository")
+	}
+
+	var once sync.Once
+	release = func() {
+		once.Do(func() {
+			c.mu.Lock()
+			e.Users--
+			c.mu.Unlock()
+		})
+	}
+	return path, release, true
+}
+
+// Update fetches the branches and tags of the remote into the reference repository for key and evicts
+// the least recently used repositories if the cache grew too large. We never fetch from a workspace's
+// clone, as that may contain the local refs and objects of its owner.
+func (c *Cache) Update(ctx context.Context, key string, remote Remote) (err error) {
+	c.mu.Lock()
+	e, exists := c.entries[key]
+	if !exists {
+		e = &entry{}
+		c.entries[key] = e
+	}
+	if e.Updating {
+		c.mu.Unlock()
+		return nil
+	}
+	e.Updating = true
+	ready := e.Ready
+	c.mu.Unlock()
+
+	dst := filepath.Join(c.Location, key)
+	defer func() {
+		var size uint64
+		if err == nil {
+			size, err = dirSize(dst)
+		}
+
+		c.mu.Lock()
+		e.Updating = false
+		if err == nil {
+			e.Ready = true
+			e.Size = size
+			e.LastUsed = time.Now()
+		} else if !e.Ready {
+			delete(c.entries, key)
+		}
+		victims := c.evict()
+		c.mu.Unlock()
+		c.remove(victims)
+	}()
+
+	if ready {
+		return c.fetch(ctx, dst, remote)
+	}
+
+	tmp, err := os.MkdirTemp(c.Location, tmpPrefix+key+"-")
+	if err != nil {
+		return err
+	}
+	err = os.Chmod(tmp, 0755)
+	if err != nil {
+		return err
+	}
+	err = runGit(ctx, nil, "init", "--quiet", "--bare", tmp)
+	if err == nil {
+		err = c.fetch(ctx, tmp, remote)
+	}
+	if err == nil {
+		err = os.Rename(tmp, dst)
+	}
+	if err != nil {
+		_ = os.RemoveAll(tmp)
+		return err
+	}
+	return nil
+}
+
+// fetch fetches the branches and tags of the remote into the bare repository dst
+func (c *Cache) fetch(ctx context.Context, dst string, remote Remote) error {
+	env := []string{"GIT_DIR=" + dst, "GIT_ALLOW_PROTOCOL=" + c.AllowedProtocols}
+	if remote.User != "" || remote.Password != "" {
+		// like content-service, we keep the credentials out of the command line
+		env = append(env,
+			"GIT_CONFIG_COUNT=1",
+			"GIT_CONFIG_KEY_0=credential.helper",
+			`GIT_CONFIG_VALUE_0=/bin/sh -c "echo username=$GIT_AUTH_USER; echo password=$GIT_AUTH_PASSWORD"`,
+			"GIT_AUTH_USER="+remote.User,
+			"GIT_AUTH_PASSWORD="+remote.Password,
+		)
+	}
+	return runGit(ctx, env, "fetch", "--quiet", "--prune", "--no-write-fetch-head", remote.URI, "+refs/heads/*:refs/heads/*", "+refs/tags/*:refs/tags/*")
+}
+
+func runGit(ctx context.Context, env []string, args ...string) error {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	cmd.Env = append(cmd.Env, env...)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return xerrors.Errorf("git %s: %w: %s", args[0], err, string(out))
+	}
+	return nil
+}
+
+// evict removes the least recently used repositories which are not in use from the index until
+// the cache fits into MaxBytes, and moves them out of the way for removal. Callers must hold c.mu.
+func (c *Cache) evict() (victims []string) {
+	var (
+		total      uint64
+		candidates []string
+	)
+	for key, e := range c.entries {
+		if !e.Ready {
+			continue
+		}
+		total += e.Size
+		if e.Users == 0 && !e.Updating {
+			candidates = append(candidates, key)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return c.entries[candidates[i]].LastUsed.Before(c.entries[candidates[j]].LastUsed)
+	})
+
+	for _, key := range candidates {
+		if total <= c.MaxBytes {
+			break
+		}
+		total -= c.entries[key].Size
+		delete(c.entries, key)
+		c.metrics.Evictions.Inc()
+
+		// the key may be reused before the repository is removed
+		fn := filepath.Join(c.Location, tmpPrefix+"evicted-"+key)
+		err := os.Rename(filepath.Join(c.Location, key), fn)
+		if err != nil {
+			log.WithError(err).WithField("key", key).Warn("cannot evict reference repository")
+			continue
+		}
+		victims = append(victims, fn)
+	}
+	c.metrics.Size.Set(float64(total))
+	return victims
+}
+
+// remove deletes evicted repositories from disk
+func (c *Cache) remove(victims []string) {
+	for _, fn := range victims {
+		err := os.RemoveAll(fn)
+		if err != nil {
+			log.WithError(err).WithField("path", fn).Warn("cannot remove evicted reference repository")
+		}
+	}
+}
+
+func dirSize(path string) (size uint64, err error) {
+	err = filepath.WalkDir(path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		nfo, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += uint64(nfo.Size())
+		return nil
+	})
+	return size, err
+}
@@ -0,0 +1,213 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package gitcache
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+)
+
+func newRepo(t *testing.T, content string) string {
+	loc := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "--quiet", "--initial-branch=main"},
+		{"add", "."},
+		{"-c", "user.name=foo", "-c", "user.email=foo@bar.com", "commit", "--quiet", "-m", "foo"},
+	} {
+		if args[0] == "add" {
+			if err := os.WriteFile(filepath.Join(loc, "file"), []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		git(t, loc, args...)
+	}
+	return loc
+}
+
+func git(t *testing.T, dir string, args ...string) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git %s: %v: %s", strings.Join(args, " "), err, out)
+	}
+}
+
+// newCache creates a cache which fetches from local repositories
+func newCache(t *testing.T) *Cache {
+	cache, err := New(Config{Location: t.TempDir(), MaxBytes: 1 << 30}, prometheus.NewRegistry())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache.AllowedProtocols = "file"
+	return cache
+}
+
+func TestCache(t *testing.T) {
+	ctx := context.Background()
+	cache := newCache(t)
+
+	key := Key("https://github.com/gitpod-io/gitpod.git", "")
+	if _, _, ok := cache.Acquire(key); ok {
+		t.Fatal("expected cache miss on empty cache")
+	}
+
+	src := newRepo(t, "hello world")
+	err := cache.Update(ctx, key, Remote{URI: src})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path, release, ok := cache.Acquire(key)
+	if !ok {
+		t.Fatal("expected cache hit after update")
+	}
+	defer release()
+
+	cmd := exec.Command("git", "--git-dir="+path, "rev-parse", "refs/heads/main")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("expected reference repository to contain main: %v: %s", err, out)
+	}
+
+	if hits := testutil.ToFloat64(cache.metrics.Hits); hits != 1 {
+		t.Errorf("unexpected hits: %v", hits)
+	}
+	if misses := testutil.ToFloat64(cache.metrics.Misses); misses != 1 {
+		t.Errorf("unexpected misses: %v", misses)
+	}
+
+	// entries survive restarts
+	restarted, err := New(Config{Location: cache.Location, MaxBytes: cache.MaxBytes}, prometheus.NewRegistry())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, ok := restarted.Acquire(key); !ok {
+		t.Error("expected cache hit after restart")
+	}
+}
+
+func TestCacheFetchesBranchesAndTagsOnly(t *testing.T) {
+	cache := newCache(t)
+
+	src := newRepo(t, "hello world")
+	git(t, src, "branch", "feature")
+	git(t, src, "tag", "v1")
+	// refs a workspace clone has, but which must not end up in the cache
+	git(t, src, "update-ref", "refs/stash", "HEAD")
+	git(t, src, "update-ref", "refs/remotes/origin/private", "HEAD")
+
+	err := cache.Update(context.Background(), "refs", Remote{URI: src})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path, release, ok := cache.Acquire("refs")
+	if !ok {
+		t.Fatal("expected cache hit after update")
+	}
+	defer release()
+
+	out, err := exec.Command("git", "--git-dir="+path, "for-each-ref", "--format=%(refname)").CombinedOutput()
+	if err != nil {
+		t.Fatalf("cannot list refs: %v: %s", err, out)
+	}
+	expected := []string{"refs/heads/feature", "refs/heads/main", "refs/tags/v1"}
+	if diff := cmp.Diff(expected, strings.Fields(string(out))); diff != "" {
+		t.Errorf("unexpected refs (-want +got):\n%s", diff)
+	}
+}
+
+func TestCacheRejectsLocalRemotes(t *testing.T) {
+	cache, err := New(Config{Location: t.TempDir(), MaxBytes: 1 << 30}, prometheus.NewRegistry())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := newRepo(t, "hello world")
+	for _, uri := range []string{src, "file://" + src} {
+		err = cache.Update(context.Background(), "local", Remote{URI: uri})
+		if err == nil {
+			t.Errorf("expected error when fetching from %s", uri)
+		}
+	}
+	if _, _, ok := cache.Acquire("local"); ok {
+		t.Error("expected no reference repository for local remote")
+	}
+}
+
+func TestCacheEviction(t *testing.T) {
+	ctx := context.Background()
+	cache := newCache(t)
+
+	for _, key := range []string{"a", "b", "c"} {
+		err := cache.Update(ctx, key, Remote{URI: newRepo(t, key)})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// a is in use and b was used most recently - c is the least recently used repository that's free
+	_, releaseA, _ := cache.Acquire("a")
+	_, releaseB, _ := cache.Acquire("b")
+	releaseB()
+
+	cache.mu.Lock()
+	cache.MaxBytes = cache.entries["a"].Size + cache.entries["b"].Size
+	victims := cache.evict()
+	cache.mu.Unlock()
+	cache.remove(victims)
+
+	for key, expected := range map[string]bool{"a": true, "b": true, "c": false} {
+		_, release, ok := cache.Acquire(key)
+		release()
+		if ok != expected {
+			t.Errorf("unexpected presence of %s: is %v but expected %v", key, ok, expected)
+		}
+		if _, err := os.Stat(filepath.Join(cache.Location, key)); os.IsNotExist(err) == expected {
+			t.Errorf("unexpected presence of %s on disk", key)
+		}
+	}
+
+	// nothing is evicted while in use
+	cache.mu.Lock()
+	cache.MaxBytes = 1
+	cache.evict()
+	_, stillThere := cache.entries["a"]
+	cache.mu.Unlock()
+	releaseA()
+	if !stillThere {
+		t.Error("expected repository in use not to be evicted")
+	}
+
+	if evictions := testutil.ToFloat64(cache.metrics.Evictions); evictions != 2 {
+		t.Errorf("unexpected evictions: %v", evictions)
+	}
+}
+
+func TestKey(t *testing.T) {
+	const remote = "https://github.com/gitpod-io/gitpod.git"
+
+	for _, auth := range []csapi.GitAuthMethod{csapi.GitAuthMethod_NO_AUTH, csapi.GitAuthMethod_BASIC_AUTH, csapi.GitAuthMethod_BASIC_AUTH_OTS} {
+		alice := AuthScope(&csapi.GitConfig{Authentication: auth}, "alice")
+		bob := AuthScope(&csapi.GitConfig{Authentication: auth}, "bob")
+		if Key(remote, alice) == Key(remote, bob) {
+			t.Errorf("expected clones of different owners not to share reference repositories using %s", auth)
+		}
+	}
+
+	public := AuthScope(&csapi.GitConfig{Authentication: csapi.GitAuthMethod_NO_AUTH}, "alice")
+	authenticated := AuthScope(&csapi.GitConfig{Authentication: csapi.GitAuthMethod_BASIC_AUTH}, "alice")
+	if Key(remote, public) == Key(remote, authenticated) {
+		t.Error("expected authenticated clones not to share unauthenticated reference repositories")
+	}
+}
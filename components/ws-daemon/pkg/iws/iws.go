@@ -84,8 +84,11 @@ var (
 	}
 )
 
+// MemoryPressureFunc reports the memory pressure ws-daemon observed for a workspace instance
+type MemoryPressureFunc func(instanceID string) api.MemoryPressure
+
 // ServeWorkspace establishes the IWS server for a workspace
-func ServeWorkspace(uidmapper *Uidmapper, fsshift api.FSShiftMethod, cgroupMountPoint string, workspaceCIDR string) func(ctx context.Context, ws *session.Workspace) error {
+func ServeWorkspace(uidmapper *Uidmapper, fsshift api.FSShiftMethod, cgroupMountPoint string, workspaceCIDR string, memoryPressure MemoryPressureFunc) func(ctx context.Context, ws *session.Workspace) error {
 	return func(ctx context.Context, ws *session.Workspace) (err error) {
 		span, _ := opentracing.StartSpanFromContext(ctx, "iws.ServeWorkspace")
 		defer tracing.FinishSpan(span, &err)
@@ -100,6 +103,7 @@ func ServeWorkspace(uidmapper *Uidmapper, fsshift api.FSShiftMethod, cgroupMount
 			FSShift:              fsshift,
 			CGroupMountPoint:     cgroupMountPoint,
 			WorkspaceCIDR:        workspaceCIDR,
+			MemoryPressure:       memoryPressure,
 			prepareForUserNSCond: sync.NewCond(&sync.Mutex{}),
 		}
 		err = iws.Start()
@@ -144,6 +148,8 @@ type InWorkspaceServiceServer struct {
 
 	WorkspaceCIDR string
 
+	MemoryPressure MemoryPressureFunc
+
 	srv  *grpc.Server
 	sckt io.Closer
 
@@ -1049,6 +1055,10 @@ func (wbs *InWorkspaceServiceServer) WorkspaceInfo(ctx context.Context, req *api
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
 
+	if wbs.MemoryPressure != nil {
+		resources.Memory.Pressure = wbs.MemoryPressure(wbs.Session.InstanceID)
+	}
+
 	return &api.WorkspaceInfoResponse{
 		Resources: resources,
 	}, nil
@@ -0,0 +1,317 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package netlimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netns"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/dispatch"
+)
+
+const (
+	// bandwidthControlPeriod is the interval in which we make sure the shaping is in place and collect metrics
+	bandwidthControlPeriod = 10 * time.Second
+
+	// bandwidthLatency is the maximum time a packet may wait for tokens before it's dropped
+	bandwidthLatency = 50 * time.Millisecond
+
+	// minBurst is the smallest burst we configure so that at least a full-size packet fits into the bucket
+	minBurst = 64 * 1024
+
+	// vethIf is the pod's end of the veth pair leading to the workspace, set up by SetupPairVeths.
+	// Packets sent on it are received by the workspace.
+	vethIf = "veth0"
+	// podIf is the pod's network interface. Packets sent on it leave the pod.
+	podIf = "eth0"
+)
+
+// BandwidthLimits are the network bandwidth limits of a workspace in bytes (per second)
+type BandwidthLimits struct {
+	Download uint64
+	Upload   uint64
+	Burst    uint64
+}
+
+// BandwidthLimiter shapes the network bandwidth of workspaces using a token bucket filter on the pod's
+// interfaces. Download is shaped on the veth leading to the workspace, upload on the pod's interface.
+type BandwidthLimiter struct {
+	mu         sync.Mutex
+	config     BandwidthConfig
+	workspaces map[string]*shapedWorkspace
+
+	transferredBytes *prometheus.GaugeVec
+	limitBytes       *prometheus.GaugeVec
+}
+
+type shapedWorkspace struct {
+	Annotations map[string]string
+	Update      chan struct{}
+}
+
+// NewBandwidthLimiter creates a new bandwidth limiter
+func NewBandwidthLimiter(config BandwidthConfig, prom prometheus.Registerer) *BandwidthLimiter {
+	l := &BandwidthLimiter{
+		config:     config,
+		workspaces: make(map[string]*shapedWorkspace),
+
+		transferredBytes: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "netlimit_bandwidth_transferred_bytes",
+			Help: "Number of bytes the workspace received (download) or sent (upload)",
+		}, []string{"node", "workspace", "direction"}),
+		limitBytes: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "netlimit_bandwidth_limit_bytes_per_second",
+			Help: "Network bandwidth limit of the workspace",
+		}, []string{"node", "workspace", "direction"}),
+	}
+
+	if config.Enabled {
+		prom.MustRegister(
+			l.transferredBytes,
+			l.limitBytes,
+		)
+	}
+
+	return l
+}
+
+// WorkspaceAdded starts shaping the network bandwidth of a workspace
+func (l *BandwidthLimiter) WorkspaceAdded(ctx context.Context, ws *dispatch.Workspace) error {
+	disp := dispatch.GetFromContext(ctx)
+	if disp == nil {
+		return fmt.Errorf("no dispatch available")
+	}
+
+	pid, err := disp.Runtime.ContainerPID(ctx, ws.ContainerID)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return nil
+		}
+		return fmt.Errorf("could not get pid for container %s of workspace %s", ws.ContainerID, ws.WorkspaceID)
+	}
+
+	sws := &shapedWorkspace{
+		Annotations: ws.Pod.Annotations,
+		Update:      make(chan struct{}, 1),
+	}
+	l.mu.Lock()
+	l.workspaces[ws.InstanceID] = sws
+	l.mu.Unlock()
+
+	dispatch.GetDispatchWaitGroup(ctx).Add(1)
+	go func() {
+		defer dispatch.GetDispatchWaitGroup(ctx).Done()
+
+		ticker := time.NewTicker(bandwidthControlPeriod)
+		defer ticker.Stop()
+
+		var (
+			owi      = ws.OWI()
+			nodeName = os.Getenv("NODENAME")
+		)
+		l.shape(sws, owi, nodeName, ws.Pod.Name, pid)
+		for {
+			select {
+			case <-ticker.C:
+			case <-sws.Update:
+			case <-ctx.Done():
+				for _, dir := range []string{"download", "upload"} {
+					l.transferredBytes.DeleteLabelValues(nodeName, ws.Pod.Name, dir)
+					l.limitBytes.DeleteLabelValues(nodeName, ws.Pod.Name, dir)
+				}
+
+				l.mu.Lock()
+				delete(l.workspaces, ws.InstanceID)
+				l.mu.Unlock()
+				return
+			}
+
+			l.shape(sws, owi, nodeName, ws.Pod.Name, pid)
+		}
+	}()
+
+	return nil
+}
+
+// WorkspaceUpdated picks up changed bandwidth annotations
+func (l *BandwidthLimiter) WorkspaceUpdated(ctx context.Context, ws *dispatch.Workspace) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	sws, ok := l.workspaces[ws.InstanceID]
+	if !ok {
+		return nil
+	}
+	sws.Annotations = ws.Pod.Annotations
+	notify(sws.Update)
+	return nil
+}
+
+// Update changes the default limits and applies them to all workspaces
+func (l *BandwidthLimiter) Update(config BandwidthConfig) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.config = config
+	log.WithField("config", config).Info("updating network bandwidth limits")
+
+	for _, sws := range l.workspaces {
+		notify(sws.Update)
+	}
+}
+
+func notify(c chan struct{}) {
+	select {
+	case c <- struct{}{}:
+	default:
+	}
+}
+
+// shape makes sure the pod's interfaces are shaped according to the workspace's limits and updates the metrics
+func (l *BandwidthLimiter) shape(sws *shapedWorkspace, owi logrus.Fields, nodeName, podName string, pid uint64) {
+	l.mu.Lock()
+	limits := bandwidthLimits(l.config, sws.Annotations)
+	l.mu.Unlock()
+
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	ns, err := netns.GetFromPid(int(pid))
+	if err != nil {
+		log.WithError(err).WithFields(owi).Warn("cannot get handle for network namespace")
+		return
+	}
+	defer ns.Close()
+
+	handle, err := netlink.NewHandleAt(ns)
+	if err != nil {
+		log.WithError(err).WithFields(owi).Warn("cannot establish netlink connection")
+		return
+	}
+	defer handle.Delete()
+
+	for _, dir := range []struct {
+		Name      string
+		Interface string
+		Rate      uint64
+	}{
+		{Name: "download", Interface: vethIf, Rate: limits.Download},
+		{Name: "upload", Interface: podIf, Rate: limits.Upload},
+	} {
+		link, err := handle.LinkByName(dir.Interface)
+		if errors.As(err, &netlink.LinkNotFoundError{}) {
+			// the veth only exists once the workspace has set up its network
+			continue
+		}
+		if err != nil {
+			log.WithError(err).WithFields(owi).WithField("interface", dir.Interface).Warn("cannot find network interface")
+			continue
+		}
+
+		err = applyTBF(handle, link, dir.Rate, limits.Burst)
+		if err != nil {
+			log.WithError(err).WithFields(owi).WithField("interface", dir.Interface).Warn("cannot shape network bandwidth")
+			continue
+		}
+		l.limitBytes.WithLabelValues(nodeName, podName, dir.Name).Set(float64(dir.Rate))
+	}
+
+	// Unlike the pod's interface, the veth only carries the workspace's traffic. What it sends the
+	// workspace receives and vice versa.
+	link, err := handle.LinkByName(vethIf)
+	if err != nil || link.Attrs().Statistics == nil {
+		return
+	}
+	l.transferredBytes.WithLabelValues(nodeName, podName, "download").Set(float64(link.Attrs().Statistics.TxBytes))
+	l.transferredBytes.WithLabelValues(nodeName, podName, "upload").Set(float64(link.Attrs().Statistics.RxBytes))
+}
+
+// applyTBF installs a token bucket filter with rate and burst as root qdisc of link, unless it is in place already.
+// A rate of zero removes the filter.
+func applyTBF(handle *netlink.Handle, link netlink.Link, rate, burst uint64) error {
+	qdiscs, err := handle.QdiscList(link)
+	if err != nil {
+		return err
+	}
+	var current *netlink.Tbf
+	for _, q := range qdiscs {
+		if tbf, ok := q.(*netlink.Tbf); ok && tbf.Parent == netlink.HANDLE_ROOT {
+			current = tbf
+			break
+		}
+	}
+
+	if rate == 0 {
+		if current == nil {
+			return nil
+		}
+		return handle.QdiscDel(current)
+	}
+
+	desired := newTBF(link.Attrs().Index, rate, burst)
+	if current != nil && current.Rate == desired.Rate && current.Buffer == desired.Buffer && current.Limit == desired.Limit {
+		return nil
+	}
+	return handle.QdiscReplace(desired)
+}
+
+// newTBF produces a token bucket filter qdisc which shapes to rate bytes per second and lets burst bytes pass at once
+func newTBF(linkIndex int, rate, burst uint64) *netlink.Tbf {
+	if burst < minBurst {
+		burst = minBurst
+	}
+	if burst > math.MaxInt32 {
+		burst = math.MaxInt32
+	}
+
+	return &netlink.Tbf{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: linkIndex,
+			Handle:    netlink.MakeHandle(1, 0),
+			Parent:    netlink.HANDLE_ROOT,
+		},
+		Rate:   rate,
+		Buffer: netlink.Xmittime(rate, uint32(burst)),
+		// the queue holds as many bytes as can be sent within bandwidthLatency on top of the burst
+		Limit: uint32(min(burst+rate*uint64(bandwidthLatency)/uint64(time.Second), math.MaxInt32)),
+	}
+}
+
+// bandwidthLimits determines the limits of a workspace. Annotations take precedence over the configured defaults.
+func bandwidthLimits(config BandwidthConfig, annotations map[string]string) BandwidthLimits {
+	get := func(annotation string, def resource.Quantity) uint64 {
+		if v, ok := annotations[annotation]; ok {
+			q, err := resource.ParseQuantity(v)
+			if err == nil && q.Sign() >= 0 {
+				return uint64(q.Value())
+			}
+			log.WithError(err).WithField("annotation", annotation).WithField("value", v).Warn("invalid network bandwidth annotation, using default")
+		}
+		if def.Sign() < 0 {
+			return 0
+		}
+		return uint64(def.Value())
+	}
+
+	return BandwidthLimits{
+		Download: get(kubernetes.WorkspaceNetDownloadLimitAnnotation, config.Download),
+		Upload:   get(kubernetes.WorkspaceNetUploadLimitAnnotation, config.Upload),
+		Burst:    get(kubernetes.WorkspaceNetBurstAnnotation, config.Burst),
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package netlimit
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/gitpod-io/gitpod/common-go/kubernetes"
+)
+
+func TestBandwidthLimits(t *testing.T) {
+	config := BandwidthConfig{
+		Enabled:  true,
+		Download: resource.MustParse("100Mi"),
+		Upload:   resource.MustParse("10Mi"),
+		Burst:    resource.MustParse("1Mi"),
+	}
+
+	tests := []struct {
+		Name        string
+		Annotations map[string]string
+		Expectation BandwidthLimits
+	}{
+		{
+			Name:        "defaults",
+			Expectation: BandwidthLimits{Download: 100 << 20, Upload: 10 << 20, Burst: 1 << 20},
+		},
+		{
+			Name: "class limits",
+			Annotations: map[string]string{
+				kubernetes.WorkspaceNetDownloadLimitAnnotation: "200Mi",
+				kubernetes.WorkspaceNetBurstAnnotation:         "0",
+			},
+			Expectation: BandwidthLimits{Download: 200 << 20, Upload: 10 << 20},
+		},
+		{
+			Name: "invalid annotation",
+			Annotations: map[string]string{
+				kubernetes.WorkspaceNetUploadLimitAnnotation: "lots",
+			},
+			Expectation: BandwidthLimits{Download: 100 << 20, Upload: 10 << 20, Burst: 1 << 20},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := bandwidthLimits(config, test.Annotations)
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected limits (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewTBF(t *testing.T) {
+	tbf := newTBF(1, 10<<20, 0)
+	if tbf.Rate != 10<<20 {
+		t.Errorf("unexpected rate: %d", tbf.Rate)
+	}
+	if tbf.Buffer == 0 {
+		t.Error("expected a non-zero buffer")
+	}
+	// minBurst plus 50ms worth of 10MiB/s
+	if exp := uint32(minBurst + 524288); tbf.Limit != exp {
+		t.Errorf("unexpected limit: is %d, expected %d", tbf.Limit, exp)
+	}
+}
@@ -4,9 +4,39 @@
 
 package netlimit
 
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/gitpod-io/gitpod/common-go/util"
+)
+
 type Config struct {
 	Enabled              bool  `json:"enabled"`
 	Enforce              bool  `json:"enforce"`
 	ConnectionsPerMinute int64 `json:"connectionsPerMinute"`
 	BucketSize           int64 `json:"bucketSize"`
 }
+
+// EgressConfig configures the enforcement of workspace egress policies
+type EgressConfig struct {
+	Enabled bool `json:"enabled"`
+
+	// ResolveInterval is the interval in which the DNS names of egress rules are resolved again.
+	// Defaults to five minutes.
+	ResolveInterval util.Duration `json:"resolveInterval,omitempty"`
+}
+
+// BandwidthConfig configures the default network bandwidth limits of workspaces.
+// Workspace classes can override them using pod annotations.
+type BandwidthConfig struct {
+	Enabled bool `json:"enabled"`
+
+	// Download is the rate in bytes per second a workspace can receive data with. Zero means no limit.
+	Download resource.Quantity `json:"download"`
+
+	// Upload is the rate in bytes per second a workspace can send data with. Zero means no limit.
+	Upload resource.Quantity `json:"upload"`
+
+	// Burst is the number of bytes a workspace can transfer at once beyond its rate
+	Burst resource.Quantity `json:"burst"`
+}
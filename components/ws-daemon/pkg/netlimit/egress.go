@@ -0,0 +1,314 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package netlimit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/nftables"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vishvananda/netns"
+
+	"github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/dispatch"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/nsinsider"
+	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+)
+
+const (
+	// defaultResolveInterval is the interval in which DNS names of egress rules are resolved by default
+	defaultResolveInterval = 5 * time.Minute
+
+	// egressStatsInterval is the interval in which we collect the drop counters of egress policies
+	egressStatsInterval = 30 * time.Second
+
+	// these need to match the names nsinsider's setup-egress-policy uses
+	egressTable       = "gitpod-egress"
+	egressDropCounter = "ws-egress-drop-stats"
+)
+
+// resolvedEgressPolicy is an egress policy whose DNS names have been resolved to addresses.
+// This is the policy format nsinsider's setup-egress-policy command understands.
+type resolvedEgressPolicy struct {
+	DefaultAccept bool                 `json:"defaultAccept"`
+	Rules         []resolvedEgressRule `json:"rules,omitempty"`
+}
+
+type resolvedEgressRule struct {
+	Accept bool `json:"accept"`
+	// Networks are the destination networks in CIDR notation. If empty the rule matches all destinations.
+	Networks []string `json:"networks,omitempty"`
+	// Ports are the TCP and UDP destination ports. If empty the rule matches all ports.
+	Ports []uint16 `json:"ports,omitempty"`
+}
+
+// lookupIPFunc resolves a DNS name to its addresses
+type lookupIPFunc func(ctx context.Context, host string) ([]net.IP, error)
+
+// EgressEnforcer applies the egress policy a workspace's pod is annotated with to its network namespace
+// and keeps the addresses of DNS names in the policy's rules up to date.
+type EgressEnforcer struct {
+	mu       sync.Mutex
+	enforced map[string]struct{}
+	config   EgressConfig
+	lookupIP lookupIPFunc
+
+	droppedBytes   *prometheus.GaugeVec
+	droppedPackets *prometheus.GaugeVec
+}
+
+// NewEgressEnforcer creates a new egress policy enforcer
+func NewEgressEnforcer(cfg EgressConfig, prom prometheus.Registerer) *EgressEnforcer {
+	e := &EgressEnforcer{
+		enforced: make(map[string]struct{}),
+		config:   cfg,
+		lookupIP: func(ctx context.Context, host string) ([]net.IP, error) {
+			return net.DefaultResolver.LookupIP(ctx, "ip", host)
+		},
+		droppedBytes: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "netlimit_egress_dropped_bytes",
+			Help: "Number of bytes dropped due to the workspace's egress policy",
+		}, []string{"node", "workspace"}),
+		droppedPackets: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "netlimit_egress_dropped_packets",
+			Help: "Number of packets dropped due to the workspace's egress policy",
+		}, []string{"node", "workspace"}),
+	}
+
+	if cfg.Enabled {
+		prom.MustRegister(
+			e.droppedBytes,
+			e.droppedPackets,
+		)
+	}
+
+	return e
+}
+
+// WorkspaceAdded is called when a workspace is added
+func (e *EgressEnforcer) WorkspaceAdded(ctx context.Context, ws *dispatch.Workspace) error {
+	return e.enforce(ctx, ws)
+}
+
+// WorkspaceUpdated is called when a workspace is updated
+func (e *EgressEnforcer) WorkspaceUpdated(ctx context.Context, ws *dispatch.Workspace) error {
+	return e.enforce(ctx, ws)
+}
+
+// Update changes the enforcer's configuration for subsequently enforced policies
+func (e *EgressEnforcer) Update(cfg EgressConfig) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.config = cfg
+	log.WithField("config", cfg).Info("updating egress policy configuration")
+}
+
+func (e *EgressEnforcer) enforce(ctx context.Context, ws *dispatch.Workspace) error {
+	annotation, ok := ws.Pod.Annotations[kubernetes.WorkspaceEgressPolicyAnnotation]
+	if !ok {
+		return nil
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, ok := e.enforced[ws.InstanceID]; ok {
+		return nil
+	}
+
+	var policy config.EgressPolicy
+	err := json.Unmarshal([]byte(annotation), &policy)
+	if err != nil {
+		return fmt.Errorf("cannot unmarshal egress policy of workspace %s: %w", ws.WorkspaceID, err)
+	}
+
+	disp := dispatch.GetFromContext(ctx)
+	if disp == nil {
+		return fmt.Errorf("no dispatch available")
+	}
+	pid, err := disp.Runtime.ContainerPID(ctx, ws.ContainerID)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return nil
+		}
+		return fmt.Errorf("could not get pid for container %s of workspace %s", ws.ContainerID, ws.WorkspaceID)
+	}
+
+	resolver := &egressResolver{lookupIP: e.lookupIP, known: make(map[string][]string)}
+	resolved := resolver.Resolve(ctx, ws, &policy)
+	err = applyEgressPolicy(ws, pid, resolved)
+	if err != nil {
+		if errors.Is(context.Cause(ctx), context.Canceled) {
+			return nil
+		}
+		log.WithError(err).WithFields(ws.OWI()).Error("cannot apply egress policy")
+		return err
+	}
+	e.enforced[ws.InstanceID] = struct{}{}
+
+	resolveInterval := time.Duration(e.config.ResolveInterval)
+	if resolveInterval == 0 {
+		resolveInterval = defaultResolveInterval
+	}
+
+	dispatch.GetDispatchWaitGroup(ctx).Add(1)
+	go func() {
+		defer dispatch.GetDispatchWaitGroup(ctx).Done()
+
+		resolveTicker := time.NewTicker(resolveInterval)
+		defer resolveTicker.Stop()
+		statsTicker := time.NewTicker(egressStatsInterval)
+		defer statsTicker.Stop()
+
+		nodeName := os.Getenv("NODENAME")
+		for {
+			select {
+			case <-resolveTicker.C:
+				update := resolver.Resolve(ctx, ws, &policy)
+				if update.Equal(resolved) {
+					continue
+				}
+				err := applyEgressPolicy(ws, pid, update)
+				if err != nil {
+					log.WithError(err).WithFields(ws.OWI()).Warn("cannot update egress policy")
+					continue
+				}
+				resolved = update
+
+			case <-statsTicker.C:
+				counter, err := getEgressDropCounter(pid)
+				if err != nil {
+					log.WithFields(ws.OWI()).WithError(err).Warn("could not get egress drop stats")
+					continue
+				}
+				e.droppedBytes.WithLabelValues(nodeName, ws.Pod.Name).Set(float64(counter.Bytes))
+				e.droppedPackets.WithLabelValues(nodeName, ws.Pod.Name).Set(float64(counter.Packets))
+
+			case <-ctx.Done():
+				e.droppedBytes.DeleteLabelValues(nodeName, ws.Pod.Name)
+				e.droppedPackets.DeleteLabelValues(nodeName, ws.Pod.Name)
+
+				e.mu.Lock()
+				delete(e.enforced, ws.InstanceID)
+				e.mu.Unlock()
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// egressResolver resolves the DNS names of an egress policy. If a name cannot be resolved, the addresses
+// of its last successful resolution are used.
+type egressResolver struct {
+	lookupIP lookupIPFunc
+	known    map[string][]string
+}
+
+// Resolve translates policy into the format nsinsider understands
+func (r *egressResolver) Resolve(ctx context.Context, ws *dispatch.Workspace, policy *config.EgressPolicy) *resolvedEgressPolicy {
+	res := &resolvedEgressPolicy{
+		DefaultAccept: policy.DefaultAction != config.EgressDeny,
+	}
+	for _, rule := range policy.Rules {
+		rr := resolvedEgressRule{
+			Accept:   rule.Action == config.EgressAllow,
+			Networks: append([]string{}, rule.CIDRs...),
+			Ports:    rule.Ports,
+		}
+		for _, host := range rule.Hosts {
+			rr.Networks = append(rr.Networks, r.resolve(ctx, ws, host)...)
+		}
+		if len(rr.Networks) == 0 && (len(rule.CIDRs) > 0 || len(rule.Hosts) > 0) {
+			// none of the rule's destinations exist (yet) - the rule must not match all destinations instead
+			continue
+		}
+		res.Rules = append(res.Rules, rr)
+	}
+	return res
+}
+
+func (r *egressResolver) resolve(ctx context.Context, ws *dispatch.Workspace, host string) []string {
+	ips, err := r.lookupIP(ctx, host)
+	if err != nil {
+		log.WithError(err).WithFields(ws.OWI()).WithField("host", host).Warn("cannot resolve egress rule host")
+		return r.known[host]
+	}
+
+	res := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		if ip4 := ip.To4(); ip4 != nil {
+			res = append(res, ip4.String()+"/32")
+		} else {
+			res = append(res, ip.String()+"/128")
+		}
+	}
+	sort.Strings(res)
+	r.known[host] = res
+	return res
+}
+
+// Equal returns true if both policies have the same rules
+func (p *resolvedEgressPolicy) Equal(other *resolvedEgressPolicy) bool {
+	a, _ := json.Marshal(p)
+	b, _ := json.Marshal(other)
+	return string(a) == string(b)
+}
+
+func applyEgressPolicy(ws *dispatch.Workspace, pid uint64, policy *resolvedEgressPolicy) error {
+	serialized, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+
+	return nsinsider.Nsinsider(ws.InstanceID, int(pid), func(cmd *exec.Cmd) {
+		cmd.Args = append(cmd.Args, "setup-egress-policy", "--policy", string(serialized))
+	}, nsinsider.EnterMountNS(false), nsinsider.EnterNetNS(true))
+}
+
+func getEgressDropCounter(pid uint64) (*nftables.CounterObj, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	netns, err := netns.GetFromPid(int(pid))
+	if err != nil {
+		return nil, fmt.Errorf("could not get handle for network namespace: %w", err)
+	}
+	defer netns.Close()
+
+	nftconn, err := nftables.New(nftables.WithNetNSFd(int(netns)))
+	if err != nil {
+		return nil, fmt.Errorf("could not establish netlink connection for nft: %w", err)
+	}
+
+	obj, err := nftconn.GetObject(&nftables.CounterObj{
+		Table: &nftables.Table{
+			Name:   egressTable,
+			Family: nftables.TableFamilyINet,
+		},
+		Name: egressDropCounter,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get egress drop stats: %w", err)
+	}
+
+	counter, ok := obj.(*nftables.CounterObj)
+	if !ok {
+		return nil, fmt.Errorf("could not cast counter object")
+	}
+	return counter, nil
+}
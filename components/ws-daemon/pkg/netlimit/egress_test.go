@@ -0,0 +1,69 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package netlimit
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/dispatch"
+	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+)
+
+func TestEgressResolver(t *testing.T) {
+	policy := &config.EgressPolicy{
+		DefaultAction: config.EgressDeny,
+		Rules: []config.EgressRule{
+			{Action: config.EgressDeny, CIDRs: []string{"169.254.169.254/32"}},
+			{Action: config.EgressAllow, Hosts: []string{"github.com"}, Ports: []uint16{22, 443}},
+			{Action: config.EgressAllow, Hosts: []string{"does-not-exist.example.com"}},
+			{Action: config.EgressDeny, Ports: []uint16{25}},
+		},
+	}
+
+	hosts := map[string][]net.IP{
+		"github.com": {net.ParseIP("140.82.121.4"), net.ParseIP("2606:50c0::1")},
+	}
+	r := &egressResolver{
+		lookupIP: func(ctx context.Context, host string) ([]net.IP, error) {
+			ips, ok := hosts[host]
+			if !ok {
+				return nil, errors.New("no such host")
+			}
+			return ips, nil
+		},
+		known: make(map[string][]string),
+	}
+	ws := &dispatch.Workspace{WorkspaceID: "foobar"}
+
+	expectation := &resolvedEgressPolicy{
+		Rules: []resolvedEgressRule{
+			{Networks: []string{"169.254.169.254/32"}},
+			{Accept: true, Networks: []string{"140.82.121.4/32", "2606:50c0::1/128"}, Ports: []uint16{22, 443}},
+			{Ports: []uint16{25}},
+		},
+	}
+	act := r.Resolve(context.Background(), ws, policy)
+	if diff := cmp.Diff(expectation, act); diff != "" {
+		t.Errorf("unexpected resolved policy (-want +got):\n%s", diff)
+	}
+
+	// failing lookups keep the last known addresses
+	delete(hosts, "github.com")
+	update := r.Resolve(context.Background(), ws, policy)
+	if !update.Equal(act) {
+		t.Errorf("expected policy to remain unchanged, got %+v", update)
+	}
+
+	hosts["github.com"] = []net.IP{net.ParseIP("140.82.121.3")}
+	update = r.Resolve(context.Background(), ws, policy)
+	if update.Equal(act) {
+		t.Error("expected policy to change after the addresses changed")
+	}
+}
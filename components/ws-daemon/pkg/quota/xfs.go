@@ -127,16 +127,46 @@ func (xfs *XFS) SetQuotaWithPrjId(path string, quota Size, prjID int, isHard boo
 		return 0, err
 	}
 
+	err = xfs.SetLimit(prjID, quota, isHard)
+	if err != nil {
+		return 0, err
+	}
+	return prjID, nil
+}
+
+// SetLimit changes the limit of a project which has already been set up using SetQuota or SetQuotaWithPrjId
+func (xfs *XFS) SetLimit(prjID int, quota Size, isHard bool) error {
+	var err error
 	if isHard {
 		_, err = xfs.exec(xfs.Dir, fmt.Sprintf("limit -p bhard=%d %d", quota, prjID))
 	} else {
 		_, err = xfs.exec(xfs.Dir, fmt.Sprintf("limit -p bsoft=%d %d", quota, prjID))
 	}
+	return err
+}
 
+// GetUsage returns the number of bytes used by a project
+func (xfs *XFS) GetUsage(prjID int) (Size, error) {
+	out, err := xfs.exec(xfs.Dir, fmt.Sprintf("quota -p -N -b %d", prjID))
 	if err != nil {
 		return 0, err
 	}
-	return prjID, nil
+
+	// xfs_quota reports the usage in blocks of one kilobyte, e.g.
+	//   /dev/sdb    1024      0   10485760   00 [--------] /var/gitpod/workspaces
+	for _, l := range strings.Split(out, "\n") {
+		fields := strings.Fields(l)
+		if len(fields) < 2 {
+			continue
+		}
+
+		used, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("cannot parse xfs_quota output %q: %w", l, err)
+		}
+		return Size(used) * Kilobyte, nil
+	}
+	return 0, fmt.Errorf("project %d not found in xfs_quota output", prjID)
 }
 
 // RegisterProject tells this implementation that a projectID is already in use
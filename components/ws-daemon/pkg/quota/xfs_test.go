@@ -191,3 +191,72 @@ func TestSetQuota(t *testing.T) {
 		})
 	}
 }
+
+func TestGetUsage(t *testing.T) {
+	type Expectation struct {
+		Used  Size
+		Exec  string
+		Error string
+	}
+	tests := []struct {
+		Name        string
+		Input       string
+		InputErr    error
+		Expectation Expectation
+	}{
+		{
+			Name:  "in use",
+			Input: "/dev/sdb             2048          0   10485760  00 [--------] /var/gitpod/workspaces\n",
+			Expectation: Expectation{
+				Used: 2 * Megabyte,
+				Exec: "quota -p -N -b 1000",
+			},
+		},
+		{
+			Name:  "unused",
+			Input: "/dev/sdb                0          0   10485760  00 [--------] /var/gitpod/workspaces\n",
+			Expectation: Expectation{
+				Exec: "quota -p -N -b 1000",
+			},
+		},
+		{
+			Name: "project not found",
+			Expectation: Expectation{
+				Exec:  "quota -p -N -b 1000",
+				Error: "project 1000 not found in xfs_quota output",
+			},
+		},
+		{
+			Name:     "exec failure",
+			InputErr: fmt.Errorf("exec failed"),
+			Expectation: Expectation{
+				Exec:  "quota -p -N -b 1000",
+				Error: "exec failed",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var (
+				act Expectation
+				err error
+			)
+			xfs := &XFS{
+				exec: func(dir, command string) (output string, err error) {
+					act.Exec = command
+					return test.Input, test.InputErr
+				},
+			}
+
+			act.Used, err = xfs.GetUsage(1000)
+			if err != nil {
+				act.Error = err.Error()
+			}
+
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected GetUsage (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
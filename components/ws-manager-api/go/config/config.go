@@ -125,6 +125,14 @@ type Configuration struct {
 	WorkspaceClasses map[string]*WorkspaceClass `json:"workspaceClass"`
 	// PreferredWorkspaceClass is the name of the workspace class that should be used by default
 	PreferredWorkspaceClass string `json:"preferredWorkspaceClass"`
+	// EgressPolicies are the named network egress policies workspace classes and organizations can refer to
+	EgressPolicies map[string]*EgressPolicy `json:"egressPolicies,omitempty"`
+	// OrganizationEgressPolicies maps organization IDs to the name of the egress policy their workspaces are subject to.
+	// An organization's policy takes precedence over the one of the workspace class.
+	OrganizationEgressPolicies map[string]string `json:"organizationEgressPolicies,omitempty"`
+	// OrganizationTimeouts maps organization IDs to the timeouts of their regular workspaces.
+	// An organization's timeouts take precedence over the ones of the workspace class.
+	OrganizationTimeouts map[string]*TimeoutPolicy `json:"organizationTimeouts,omitempty"`
 	// DebugWorkspacePod adds extra finalizer to workspace to prevent it from shutting down. Helps to debug.
 	DebugWorkspacePod bool `json:"debugWorkspacePod,omitempty"`
 	// WorkspaceMaxConcurrentReconciles configures the max amount of concurrent workspace reconciliations on
@@ -147,6 +155,20 @@ type Configuration struct {
 	PodRecreationMaxRetries int `json:"podRecreationMaxRetries,omitempty"`
 	// PodRecreationBackoff
 	PodRecreationBackoff util.Duration `json:"podRecreationBackoff,omitempty"`
+
+	// Preemption configures how headless workspaces make way for workspaces of higher priority when the cluster is full
+	Preemption *PreemptionConfiguration `json:"preemption,omitempty"`
+
+	// EnableWorkspaceMigration allows running regular workspaces to be moved to another node. The replacement pod needs
+	// the workspace's secrets, which are hence kept until the workspace stops instead of being removed once it runs.
+	EnableWorkspaceMigration bool `json:"enableWorkspaceMigration,omitempty"`
+
+	// Webhooks are HTTP endpoints which receive signed events about the lifecycle of workspaces
+	Webhooks []*WebhookConfiguration `json:"webhooks,omitempty"`
+
+	// Pause, if set, pauses idle regular workspaces instead of stopping them. Paused workspaces keep their processes
+	// and resume on activity.
+	Pause *PauseConfiguration `json:"pause,omitempty"`
 }
 
 type WorkspaceClass struct {
@@ -157,6 +179,92 @@ type WorkspaceClass struct {
 
 	// CreditsPerMinute is the cost per minute for this workspace class in credits
 	CreditsPerMinute float32 `json:"creditsPerMinute"`
+
+	// EgressPolicy names the egress policy workspaces of this class are subject to
+	EgressPolicy string `json:"egressPolicy,omitempty"`
+
+	// WarmPool configures a pool of pods which hold capacity for workspaces of this class
+	WarmPool *WarmPoolConfiguration `json:"warmPool,omitempty"`
+
+	// Priority is added to the priority of the workspace type for workspaces of this class
+	Priority int32 `json:"priority,omitempty"`
+
+	// Timeouts overrides the global timeouts of regular workspaces of this class
+	Timeouts *TimeoutPolicy `json:"timeouts,omitempty"`
+}
+
+// PreemptionConfiguration configures the preemption of workspaces. When a workspace cannot be scheduled for lack
+// of CPU or memory for longer than PendingTimeout, ws-manager stops the headless workspace of lowest priority below
+// its own whose node would fit the workspace once it is gone, and starts the preempted workspace again once
+// RetryDelay has passed.
+//
+// Priorities only govern preemption by ws-manager. The order in which Kubernetes schedules workspace pods
+// is determined by the priority class of the pod templates.
+type PreemptionConfiguration struct {
+	// TypePriorities is the priority of workspaces by type (Regular, Prebuild, ImageBuild). Types without a priority have priority 0.
+	TypePriorities map[string]int32 `json:"typePriorities"`
+	// PendingTimeout is how long a workspace must be unschedulable before we preempt another workspace on its behalf
+	PendingTimeout util.Duration `json:"pendingTimeout"`
+	// RetryDelay is how long we wait after a preempted workspace stopped before we start it again
+	RetryDelay util.Duration `json:"retryDelay"`
+	// MaxRetries is how often a workspace is started again after being preempted before we give up on it
+	MaxRetries int `json:"maxRetries"`
+}
+
+// PauseConfiguration configures pausing idle workspaces. A workspace is paused once it reached its inactivity
+// timeout, i.e. when it would otherwise be stopped.
+type PauseConfiguration struct {
+	// MaxDuration is how long a workspace stays paused before it is backed up and stopped as usual
+	MaxDuration util.Duration `json:"maxDuration"`
+}
+
+// WebhookConfiguration configures an HTTP endpoint which receives workspace lifecycle events. Events are POSTed as JSON,
+// signed with HMAC-SHA256, and delivered in order. An event which cannot be delivered is retried with exponential backoff
+// and eventually dead-lettered, so that it does not hold up the events after it.
+type WebhookConfiguration struct {
+	// Name identifies the endpoint in logs, metrics and dead letters
+	Name string `json:"name"`
+	// URL is where the events are POSTed to
+	URL string `json:"url"`
+	// SecretFile is the path to the file containing the key events are signed with
+	SecretFile string `json:"secretFile"`
+
+	// Events limits the endpoint to these event types (workspace.phase, workspace.condition, workspace.prewarm). Empty means all.
+	// Schedules which prewarm workspaces need an endpoint which accepts workspace.prewarm, i.e. the server.
+	Events []string `json:"events,omitempty"`
+	// Phases limits phase events to transitions into these phases (e.g. Running, Stopped). Empty means all.
+	Phases []string `json:"phases,omitempty"`
+	// Conditions limits condition events to these conditions (e.g. Timeout, BackupFailure). Empty means all.
+	Conditions []string `json:"conditions,omitempty"`
+	// WorkspaceTypes limits the endpoint to workspaces of these types (Regular, Prebuild, ImageBuild). Empty means all.
+	WorkspaceTypes []string `json:"workspaceTypes,omitempty"`
+
+	// Timeout is how long we wait for the endpoint to answer a single delivery. Defaults to 10 seconds.
+	Timeout util.Duration `json:"timeout,omitempty"`
+	// MaxAttempts is how often we try to deliver an event before dead-lettering it. Defaults to 5.
+	MaxAttempts int `json:"maxAttempts,omitempty"`
+	// RetryBackoff is how long we wait before the first retry. The wait doubles with every attempt. Defaults to 1 second.
+	RetryBackoff util.Duration `json:"retryBackoff,omitempty"`
+	// QueueSize is the number of events waiting for delivery, beyond which events are dead-lettered right away. Defaults to 1000.
+	QueueSize int `json:"queueSize,omitempty"`
+	// DeadLetterDir is the directory events which could not be delivered are written to. If empty, they are only logged.
+	DeadLetterDir string `json:"deadLetterDir,omitempty"`
+}
+
+// WarmPoolConfiguration configures the warm pool of a workspace class. Pooled pods are placeholders which
+// are scheduled like workspace pods, pull workspace images onto their node and then wait to be claimed by a
+// starting workspace. The workspace pod prefers the node of the claimed pod and takes over its capacity there,
+// but is scheduled like any other pod if that node cannot take it. Workspace pods are not started ahead of time.
+type WarmPoolConfiguration struct {
+	// Size is the number of unclaimed pods the pool maintains
+	Size int `json:"size"`
+	// PlaceholderImage is the image pooled pods run while they wait to be claimed
+	PlaceholderImage string `json:"placeholderImage"`
+	// Images are pulled onto the node of pooled pods. Workspace images are served by registry-facade under
+	// a reference of their own, but share their layers with the image they are built from. Listing the default
+	// workspace image here hence means most workspaces find the bulk of their image on the node already.
+	// Images must contain /bin/sh.
+	Images []string `json:"images,omitempty"`
 }
 
 // WorkspaceTimeoutConfiguration configures the timeout behaviour of workspaces
@@ -169,6 +277,11 @@ type WorkspaceTimeoutConfiguration struct {
 	RegularWorkspace util.Duration `json:"regularWorkspace"`
 	// MaxLifetime is the maximum lifetime of a regular workspace
 	MaxLifetime util.Duration `json:"maxLifetime"`
+	// RegularWorkspaceLimit is the longest inactivity timeout a regular workspace can be started with or extended to.
+	// Zero means no limit.
+	RegularWorkspaceLimit util.Duration `json:"regularWorkspaceLimit,omitempty"`
+	// MaxLifetimeLimit is the longest maximum lifetime a regular workspace can be started with. Zero means no limit.
+	MaxLifetimeLimit util.Duration `json:"maxLifetimeLimit,omitempty"`
 	// HeadlessWorkspace is the maximum runtime a headless workspace can have (including startup)
 	HeadlessWorkspace util.Duration `json:"headlessWorkspace"`
 	// AfterClose is the time a workspace lives after it has been marked closed
@@ -273,11 +386,104 @@ func (c *Configuration) Validate() error {
 		if err != nil {
 			return xerrors.Errorf("workspace class %s: %w", name, err)
 		}
+		if _, ok := c.EgressPolicies[class.EgressPolicy]; class.EgressPolicy != "" && !ok {
+			return xerrors.Errorf("workspace class %s: unknown egress policy \"%s\"", name, class.EgressPolicy)
+		}
+		if class.WarmPool != nil {
+			err = ozzo.ValidateStruct(class.WarmPool,
+				ozzo.Field(&class.WarmPool.Size, ozzo.Min(0)),
+				ozzo.Field(&class.WarmPool.PlaceholderImage, ozzo.Required),
+			)
+			if err != nil {
+				return xerrors.Errorf("workspace class %s: warm pool: %w", name, err)
+			}
+		}
+	}
+
+	if c.Preemption != nil {
+		err = ozzo.ValidateStruct(c.Preemption,
+			ozzo.Field(&c.Preemption.PendingTimeout, ozzo.Required),
+			ozzo.Field(&c.Preemption.MaxRetries, ozzo.Min(0)),
+		)
+		if err != nil {
+			return xerrors.Errorf("preemption: %w", err)
+		}
+	}
+
+	if c.Pause != nil {
+		err = ozzo.ValidateStruct(c.Pause,
+			ozzo.Field(&c.Pause.MaxDuration, ozzo.Required),
+		)
+		if err != nil {
+			return xerrors.Errorf("pause: %w", err)
+		}
+	}
+
+	webhooks := make(map[string]struct{}, len(c.Webhooks))
+	for i, webhook := range c.Webhooks {
+		if webhook == nil {
+			return xerrors.Errorf("webhook %d is empty", i)
+		}
+		err = ozzo.ValidateStruct(webhook,
+			ozzo.Field(&webhook.Name, ozzo.Required),
+			ozzo.Field(&webhook.URL, ozzo.Required, is.URL),
+			ozzo.Field(&webhook.SecretFile, ozzo.Required),
+			ozzo.Field(&webhook.Events, ozzo.Each(ozzo.In("workspace.phase", "workspace.condition", "workspace.prewarm"))),
+			ozzo.Field(&webhook.MaxAttempts, ozzo.Min(0)),
+			ozzo.Field(&webhook.QueueSize, ozzo.Min(0)),
+		)
+		if err != nil {
+			return xerrors.Errorf("webhook %d: %w", i, err)
+		}
+		if _, exists := webhooks[webhook.Name]; exists {
+			return xerrors.Errorf("webhook %s is configured twice", webhook.Name)
+		}
+		webhooks[webhook.Name] = struct{}{}
+	}
+
+	for name, policy := range c.EgressPolicies {
+		if policy == nil {
+			return xerrors.Errorf("egress policy %s is empty", name)
+		}
+		if err := policy.Validate(); err != nil {
+			return xerrors.Errorf("egress policy %s: %w", name, err)
+		}
+	}
+	for org, name := range c.OrganizationEgressPolicies {
+		if _, ok := c.EgressPolicies[name]; !ok {
+			return xerrors.Errorf("organization %s: unknown egress policy \"%s\"", org, name)
+		}
+	}
+
+	err = c.validateTimeoutPolicies()
+	if err != nil {
+		return err
 	}
 
 	return err
 }
 
+// WorkspaceClassRequests returns the resources the workspace container of a workspace class requests
+func (c *Configuration) WorkspaceClassRequests(class string) (corev1.ResourceList, error) {
+	cls, ok := c.WorkspaceClasses[class]
+	if !ok {
+		return nil, xerrors.Errorf("unknown workspace class: %s", class)
+	}
+	return cls.Container.Requests.ResourceList()
+}
+
+// WorkspacePriority returns the preemption priority of a workspace of the given type and class
+func (c *Configuration) WorkspacePriority(tpe, class string) int32 {
+	var prio int32
+	if c.Preemption != nil {
+		prio = c.Preemption.TypePriorities[tpe]
+	}
+	if cls, ok := c.WorkspaceClasses[class]; ok {
+		prio += cls.Priority
+	}
+	return prio
+}
+
 var validPodTemplate = ozzo.By(func(o interface{}) error {
 	s, ok := o.(string)
 	if !ok {
@@ -393,6 +599,21 @@ var validResourceLimitConfig = ozzo.By(func(o interface{}) error {
 			return xerrors.Errorf("cannot parse Storage quantity: %w", err)
 		}
 	}
+	if rc.Network != nil {
+		for name, v := range map[string]string{
+			"download": rc.Network.Download,
+			"upload":   rc.Network.Upload,
+			"burst":    rc.Network.Burst,
+		} {
+			if v == "" {
+				continue
+			}
+			_, err := resource.ParseQuantity(v)
+			if err != nil {
+				return xerrors.Errorf("cannot parse network %s quantity: %w", name, err)
+			}
+		}
+	}
 	return nil
 })
 
@@ -525,6 +746,8 @@ type ResourceLimitConfiguration struct {
 	Memory           string            `json:"memory"`
 	EphemeralStorage string            `json:"ephemeral-storage"`
 	Storage          string            `json:"storage,omitempty"`
+	// Network limits the network bandwidth of the workspace
+	Network *NetworkResourceLimit `json:"network,omitempty"`
 }
 
 func (r *ResourceLimitConfiguration) ResourceList() (corev1.ResourceList, error) {
@@ -572,6 +795,14 @@ type CpuResourceLimit struct {
 	BurstLimit string `json:"burst"`
 }
 
+// NetworkResourceLimit configures the network bandwidth of a workspace. Download and upload are quantities
+// of bytes per second, burst is the number of bytes a workspace may transfer at once beyond that rate.
+type NetworkResourceLimit struct {
+	Download string `json:"download,omitempty"`
+	Upload   string `json:"upload,omitempty"`
+	Burst    string `json:"burst,omitempty"`
+}
+
 type MaintenanceConfig struct {
 	EnabledUntil *time.Time `json:"enabledUntil"`
 }
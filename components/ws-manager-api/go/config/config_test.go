@@ -65,6 +65,103 @@ func TestValidate(t *testing.T) {
 			}),
 			Expectation: `workspace class name "not/a/valid/name" is invalid: [a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')]`,
 		},
+		{
+			Name: "invalid network limit",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].Container.Limits = &ResourceLimitConfiguration{
+					CPU:     &CpuResourceLimit{},
+					Network: &NetworkResourceLimit{Download: "lots"},
+				}
+			}),
+			Expectation: `workspace class g1-standard: limits: cannot parse network download quantity: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'.`,
+		},
+		{
+			Name: "unknown class egress policy",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].EgressPolicy = "restricted"
+			}),
+			Expectation: `workspace class g1-standard: unknown egress policy "restricted"`,
+		},
+		{
+			Name: "unknown organization egress policy",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.OrganizationEgressPolicies = map[string]string{"org": "restricted"}
+			}),
+			Expectation: `organization org: unknown egress policy "restricted"`,
+		},
+		{
+			Name: "invalid egress CIDR",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.EgressPolicies = map[string]*EgressPolicy{
+					"restricted": {Rules: []EgressRule{{Action: EgressDeny, CIDRs: []string{"10.0.0.0"}}}},
+				}
+			}),
+			Expectation: `egress policy restricted: rule 0: invalid CIDR address: 10.0.0.0`,
+		},
+		{
+			Name: "invalid egress action",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.EgressPolicies = map[string]*EgressPolicy{
+					"restricted": {DefaultAction: "reject"},
+				}
+			}),
+			Expectation: `egress policy restricted: defaultAction: unknown action "reject"`,
+		},
+		{
+			Name: "pause without maximum duration",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.Pause = &PauseConfiguration{}
+			}),
+			Expectation: `pause: maxDuration: cannot be blank.`,
+		},
+		{
+			Name: "unknown webhook event",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.Webhooks = []*WebhookConfiguration{
+					{Name: "audit", URL: "https://audit.example.com", SecretFile: "/secret", Events: []string{"workspace.deleted"}},
+				}
+			}),
+			Expectation: `webhook 0: events: (0: must be a valid value.).`,
+		},
+		{
+			Name: "duplicate webhook",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.Webhooks = []*WebhookConfiguration{
+					{Name: "audit", URL: "https://audit.example.com", SecretFile: "/secret"},
+					{Name: "audit", URL: "https://audit2.example.com", SecretFile: "/secret"},
+				}
+			}),
+			Expectation: `webhook audit is configured twice`,
+		},
+		{
+			Name: "class timeout above limit",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].Timeouts = &TimeoutPolicy{
+					RegularWorkspace:      util.Duration(2 * time.Hour),
+					RegularWorkspaceLimit: util.Duration(time.Hour),
+				}
+			}),
+			Expectation: `workspace class g1-standard: timeouts: regular workspace timeout 2h0m0s exceeds its limit 1h0m0s`,
+		},
+		{
+			Name: "organization limit below class timeout",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].Timeouts = &TimeoutPolicy{MaxLifetime: util.Duration(8 * time.Hour)}
+				c.OrganizationTimeouts = map[string]*TimeoutPolicy{
+					"org": {MaxLifetimeLimit: util.Duration(4 * time.Hour)},
+				}
+			}),
+			Expectation: `organization org: workspace class g1-standard: timeouts: maximum lifetime 8h0m0s exceeds its limit 4h0m0s`,
+		},
+		{
+			Name: "negative organization timeout",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.OrganizationTimeouts = map[string]*TimeoutPolicy{
+					"org": {AfterClose: util.Duration(-time.Minute)},
+				}
+			}),
+			Expectation: `organization org: timeouts: afterClose: must be no less than 0s.`,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
@@ -81,3 +178,104 @@ func TestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestEgressPolicyFor(t *testing.T) {
+	cfg := &Configuration{
+		WorkspaceClasses: map[string]*WorkspaceClass{
+			DefaultWorkspaceClass: {},
+			"regulated":           {EgressPolicy: "class"},
+		},
+		EgressPolicies: map[string]*EgressPolicy{
+			"class": {DefaultAction: EgressDeny},
+			"org":   {DefaultAction: EgressAllow},
+		},
+		OrganizationEgressPolicies: map[string]string{
+			"regulated-org": "org",
+		},
+	}
+
+	tests := []struct {
+		Name         string
+		Organization string
+		Class        string
+		Expectation  string
+	}{
+		{Name: "no policy", Organization: "other-org", Class: DefaultWorkspaceClass},
+		{Name: "class policy", Organization: "other-org", Class: "regulated", Expectation: "class"},
+		{Name: "organization policy", Organization: "regulated-org", Class: DefaultWorkspaceClass, Expectation: "org"},
+		{Name: "organization overrides class", Organization: "regulated-org", Class: "regulated", Expectation: "org"},
+		{Name: "no organization", Class: "regulated", Expectation: "class"},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			name, policy := cfg.EgressPolicyFor(test.Organization, test.Class)
+			if name != test.Expectation {
+				t.Errorf("unexpected egress policy: expect \"%s\", got \"%s\"", test.Expectation, name)
+			}
+			if name != "" && policy != cfg.EgressPolicies[name] {
+				t.Errorf("policy does not match its name %s", name)
+			}
+		})
+	}
+}
+
+func TestTimeoutsFor(t *testing.T) {
+	cfg := &Configuration{
+		Timeouts: WorkspaceTimeoutConfiguration{
+			RegularWorkspace: util.Duration(30 * time.Minute),
+			AfterClose:       util.Duration(2 * time.Minute),
+			MaxLifetime:      util.Duration(36 * time.Hour),
+		},
+		WorkspaceClasses: map[string]*WorkspaceClass{
+			DefaultWorkspaceClass: {},
+			"large": {Timeouts: &TimeoutPolicy{
+				RegularWorkspace:      util.Duration(15 * time.Minute),
+				RegularWorkspaceLimit: util.Duration(time.Hour),
+				MaxLifetime:           util.Duration(8 * time.Hour),
+			}},
+		},
+		OrganizationTimeouts: map[string]*TimeoutPolicy{
+			"org": {
+				RegularWorkspaceLimit: util.Duration(3 * time.Hour),
+				MaxLifetimeLimit:      util.Duration(12 * time.Hour),
+			},
+		},
+	}
+
+	tests := []struct {
+		Name         string
+		Organization string
+		Class        string
+		Expectation  TimeoutPolicy
+	}{
+		{
+			Name:        "global timeouts",
+			Class:       DefaultWorkspaceClass,
+			Expectation: TimeoutPolicy{RegularWorkspace: util.Duration(30 * time.Minute), AfterClose: util.Duration(2 * time.Minute), MaxLifetime: util.Duration(36 * time.Hour)},
+		},
+		{
+			Name:        "unknown class",
+			Class:       "unknown",
+			Expectation: TimeoutPolicy{RegularWorkspace: util.Duration(30 * time.Minute), AfterClose: util.Duration(2 * time.Minute), MaxLifetime: util.Duration(36 * time.Hour)},
+		},
+		{
+			Name:        "class timeouts",
+			Class:       "large",
+			Expectation: TimeoutPolicy{RegularWorkspace: util.Duration(15 * time.Minute), RegularWorkspaceLimit: util.Duration(time.Hour), AfterClose: util.Duration(2 * time.Minute), MaxLifetime: util.Duration(8 * time.Hour)},
+		},
+		{
+			Name:         "organization overrides class",
+			Organization: "org",
+			Class:        "large",
+			Expectation:  TimeoutPolicy{RegularWorkspace: util.Duration(15 * time.Minute), RegularWorkspaceLimit: util.Duration(3 * time.Hour), AfterClose: util.Duration(2 * time.Minute), MaxLifetime: util.Duration(8 * time.Hour), MaxLifetimeLimit: util.Duration(12 * time.Hour)},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := cfg.TimeoutsFor(test.Organization, test.Class)
+			if act != test.Expectation {
+				t.Errorf("unexpected timeouts: expect %+v, got %+v", test.Expectation, act)
+			}
+		})
+	}
+}
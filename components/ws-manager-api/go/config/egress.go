@@ -0,0 +1,106 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package config
+
+import (
+	"net"
+
+	"golang.org/x/xerrors"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// EgressAction decides what happens to outbound connections matched by an egress rule
+type EgressAction string
+
+const (
+	// EgressAllow lets connections pass
+	EgressAllow EgressAction = "allow"
+	// EgressDeny drops connections
+	EgressDeny EgressAction = "deny"
+)
+
+// EgressPolicy restricts the outbound network connections of a workspace.
+// Rules are evaluated in order, the first matching rule decides.
+type EgressPolicy struct {
+	// DefaultAction applies to connections no rule matches. Defaults to allow.
+	DefaultAction EgressAction `json:"defaultAction,omitempty"`
+	Rules         []EgressRule `json:"rules,omitempty"`
+}
+
+// EgressRule matches outbound connections by destination
+type EgressRule struct {
+	Action EgressAction `json:"action"`
+
+	// CIDRs are the destination networks this rule matches
+	CIDRs []string `json:"cidrs,omitempty"`
+
+	// Hosts are DNS names whose addresses this rule matches. ws-daemon resolves them periodically.
+	Hosts []string `json:"hosts,omitempty"`
+
+	// Ports restricts the rule to these TCP and UDP destination ports. If empty the rule matches all ports.
+	Ports []uint16 `json:"ports,omitempty"`
+}
+
+// Validate validates an egress policy
+func (p *EgressPolicy) Validate() error {
+	if err := validEgressAction(p.DefaultAction, true); err != nil {
+		return xerrors.Errorf("defaultAction: %w", err)
+	}
+	for i, r := range p.Rules {
+		if err := validEgressAction(r.Action, false); err != nil {
+			return xerrors.Errorf("rule %d: %w", i, err)
+		}
+		for _, c := range r.CIDRs {
+			if _, _, err := net.ParseCIDR(c); err != nil {
+				return xerrors.Errorf("rule %d: %w", i, err)
+			}
+		}
+		for _, h := range r.Hosts {
+			if errs := validation.IsDNS1123Subdomain(h); len(errs) > 0 {
+				return xerrors.Errorf("rule %d: host \"%s\" is invalid: %v", i, h, errs)
+			}
+		}
+		for _, port := range r.Ports {
+			if port == 0 {
+				return xerrors.Errorf("rule %d: port must not be zero", i)
+			}
+		}
+	}
+	return nil
+}
+
+func validEgressAction(a EgressAction, allowEmpty bool) error {
+	switch a {
+	case EgressAllow, EgressDeny:
+		return nil
+	case "":
+		if allowEmpty {
+			return nil
+		}
+	}
+	return xerrors.Errorf("unknown action \"%s\"", a)
+}
+
+// EgressPolicyFor returns the egress policy for workspaces of an organization and workspace class.
+// The organization's policy takes precedence over the class'. Returns an empty name if no policy applies.
+func (c *Configuration) EgressPolicyFor(organization, class string) (name string, policy *EgressPolicy) {
+	if organization != "" {
+		name = c.OrganizationEgressPolicies[organization]
+	}
+	if name == "" {
+		if cls, ok := c.WorkspaceClasses[class]; ok && cls != nil {
+			name = cls.EgressPolicy
+		}
+	}
+	if name == "" {
+		return "", nil
+	}
+
+	policy, ok := c.EgressPolicies[name]
+	if !ok {
+		return "", nil
+	}
+	return name, policy
+}
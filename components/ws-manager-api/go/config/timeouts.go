@@ -0,0 +1,126 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package config
+
+import (
+	ozzo "github.com/go-ozzo/ozzo-validation"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/util"
+)
+
+// TimeoutPolicy overrides the timeouts of regular workspaces of a workspace class or an organization.
+// Fields which are not set keep the value of the global timeouts, resp. of the workspace class.
+type TimeoutPolicy struct {
+	// RegularWorkspace is the default time a regular workspace can be without activity before it's shutdown
+	RegularWorkspace util.Duration `json:"regularWorkspace,omitempty"`
+	// RegularWorkspaceLimit is the longest inactivity timeout a workspace can be started with or extended to
+	// using SetTimeout. Zero means no limit.
+	RegularWorkspaceLimit util.Duration `json:"regularWorkspaceLimit,omitempty"`
+	// AfterClose is the default time a workspace lives after it has been marked closed
+	AfterClose util.Duration `json:"afterClose,omitempty"`
+	// MaxLifetime is the default maximum lifetime of a regular workspace
+	MaxLifetime util.Duration `json:"maxLifetime,omitempty"`
+	// MaxLifetimeLimit is the longest maximum lifetime a workspace can be started with. Zero means no limit.
+	MaxLifetimeLimit util.Duration `json:"maxLifetimeLimit,omitempty"`
+}
+
+// Validate validates a timeout policy
+func (p *TimeoutPolicy) Validate() error {
+	err := ozzo.ValidateStruct(p,
+		ozzo.Field(&p.RegularWorkspace, ozzo.Min(util.Duration(0))),
+		ozzo.Field(&p.RegularWorkspaceLimit, ozzo.Min(util.Duration(0))),
+		ozzo.Field(&p.AfterClose, ozzo.Min(util.Duration(0))),
+		ozzo.Field(&p.MaxLifetime, ozzo.Min(util.Duration(0))),
+		ozzo.Field(&p.MaxLifetimeLimit, ozzo.Min(util.Duration(0))),
+	)
+	if err != nil {
+		return err
+	}
+	if p.RegularWorkspaceLimit != 0 && p.RegularWorkspace > p.RegularWorkspaceLimit {
+		return xerrors.Errorf("regular workspace timeout %s exceeds its limit %s", p.RegularWorkspace, p.RegularWorkspaceLimit)
+	}
+	if p.MaxLifetimeLimit != 0 && p.MaxLifetime > p.MaxLifetimeLimit {
+		return xerrors.Errorf("maximum lifetime %s exceeds its limit %s", p.MaxLifetime, p.MaxLifetimeLimit)
+	}
+	return nil
+}
+
+func (p *TimeoutPolicy) override(o *TimeoutPolicy) {
+	if o == nil {
+		return
+	}
+	if o.RegularWorkspace != 0 {
+		p.RegularWorkspace = o.RegularWorkspace
+	}
+	if o.RegularWorkspaceLimit != 0 {
+		p.RegularWorkspaceLimit = o.RegularWorkspaceLimit
+	}
+	if o.AfterClose != 0 {
+		p.AfterClose = o.AfterClose
+	}
+	if o.MaxLifetime != 0 {
+		p.MaxLifetime = o.MaxLifetime
+	}
+	if o.MaxLifetimeLimit != 0 {
+		p.MaxLifetimeLimit = o.MaxLifetimeLimit
+	}
+}
+
+// TimeoutsFor returns the timeouts which apply to regular workspaces of an organization and workspace class.
+// The organization's policy takes precedence over the class', which takes precedence over the global timeouts.
+func (c *Configuration) TimeoutsFor(organization, class string) TimeoutPolicy {
+	res := TimeoutPolicy{
+		RegularWorkspace:      c.Timeouts.RegularWorkspace,
+		RegularWorkspaceLimit: c.Timeouts.RegularWorkspaceLimit,
+		AfterClose:            c.Timeouts.AfterClose,
+		MaxLifetime:           c.Timeouts.MaxLifetime,
+		MaxLifetimeLimit:      c.Timeouts.MaxLifetimeLimit,
+	}
+	if cls, ok := c.WorkspaceClasses[class]; ok && cls != nil {
+		res.override(cls.Timeouts)
+	}
+	if organization != "" {
+		res.override(c.OrganizationTimeouts[organization])
+	}
+	return res
+}
+
+func (c *Configuration) validateTimeoutPolicies() error {
+	for name, class := range c.WorkspaceClasses {
+		if class.Timeouts == nil {
+			continue
+		}
+		if err := class.Timeouts.Validate(); err != nil {
+			return xerrors.Errorf("workspace class %s: timeouts: %w", name, err)
+		}
+	}
+	for org, policy := range c.OrganizationTimeouts {
+		if policy == nil {
+			return xerrors.Errorf("organization %s: timeouts are empty", org)
+		}
+		if err := policy.Validate(); err != nil {
+			return xerrors.Errorf("organization %s: timeouts: %w", org, err)
+		}
+	}
+
+	// a policy may only override some of the timeouts, hence we check how they combine, too
+	orgs := []string{""}
+	for org := range c.OrganizationTimeouts {
+		orgs = append(orgs, org)
+	}
+	for class := range c.WorkspaceClasses {
+		for _, org := range orgs {
+			timeouts := c.TimeoutsFor(org, class)
+			if err := timeouts.Validate(); err != nil {
+				if org == "" {
+					return xerrors.Errorf("workspace class %s: timeouts: %w", class, err)
+				}
+				return xerrors.Errorf("organization %s: workspace class %s: timeouts: %w", org, class, err)
+			}
+		}
+	}
+	return nil
+}
@@ -1344,23 +1344,21 @@ func (*SetTimeoutResponse) Descriptor() ([]byte, []int) {
 	return file_core_proto_rawDescGZIP(), []int{14}
 }
 
-// ControlPortRequest exposes or un-exposes networking ports of a workspace
-type ControlPortRequest struct {
+// SetStorageQuotaRequest changes the /workspace quota of a running workspace
+type SetStorageQuotaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ID is the unique identifier of the workspace whose port to control
+	// id is the ID of the workspace
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// expose controls whether to make the port publicly available or bar if from being accessible outside of the worksapce.
-	// If true, the port will become publicly available, if false it will become inaccessible from outside the workspace.
-	Expose bool `protobuf:"varint,2,opt,name=expose,proto3" json:"expose,omitempty"`
-	// spec defines the port under control
-	Spec *PortSpec `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"`
+	// quota is the new size of the quota. Must be a valid Kubernetes quantity (e.g. 50Gi).
+	// The quota can only be shrunk down to what the workspace currently uses.
+	Quota string `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota,omitempty"`
 }
 
-func (x *ControlPortRequest) Reset() {
-	*x = ControlPortRequest{}
+func (x *SetStorageQuotaRequest) Reset() {
+	*x = SetStorageQuotaRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1368,13 +1366,13 @@ func (x *ControlPortRequest) Reset() {
 	}
 }
 
-func (x *ControlPortRequest) String() string {
+func (x *SetStorageQuotaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ControlPortRequest) ProtoMessage() {}
+func (*SetStorageQuotaRequest) ProtoMessage() {}
 
-func (x *ControlPortRequest) ProtoReflect() protoreflect.Message {
+func (x *SetStorageQuotaRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_core_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1386,41 +1384,34 @@ func (x *ControlPortRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ControlPortRequest.ProtoReflect.Descriptor instead.
-func (*ControlPortRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetStorageQuotaRequest.ProtoReflect.Descriptor instead.
+func (*SetStorageQuotaRequest) Descriptor() ([]byte, []int) {
 	return file_core_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ControlPortRequest) GetId() string {
+func (x *SetStorageQuotaRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *ControlPortRequest) GetExpose() bool {
-	if x != nil {
-		return x.Expose
-	}
-	return false
-}
-
-func (x *ControlPortRequest) GetSpec() *PortSpec {
+func (x *SetStorageQuotaRequest) GetQuota() string {
 	if x != nil {
-		return x.Spec
+		return x.Quota
 	}
-	return nil
+	return ""
 }
 
-// ControlPortResponse is the answer to a workspace port control request
-type ControlPortResponse struct {
+// SetStorageQuotaResponse is the answer to a set storage quota request
+type SetStorageQuotaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ControlPortResponse) Reset() {
-	*x = ControlPortResponse{}
+func (x *SetStorageQuotaResponse) Reset() {
+	*x = SetStorageQuotaResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1428,13 +1419,13 @@ func (x *ControlPortResponse) Reset() {
 	}
 }
 
-func (x *ControlPortResponse) String() string {
+func (x *SetStorageQuotaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ControlPortResponse) ProtoMessage() {}
+func (*SetStorageQuotaResponse) ProtoMessage() {}
 
-func (x *ControlPortResponse) ProtoReflect() protoreflect.Message {
+func (x *SetStorageQuotaResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_core_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1446,25 +1437,28 @@ func (x *ControlPortResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ControlPortResponse.ProtoReflect.Descriptor instead.
-func (*ControlPortResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetStorageQuotaResponse.ProtoReflect.Descriptor instead.
+func (*SetStorageQuotaResponse) Descriptor() ([]byte, []int) {
 	return file_core_proto_rawDescGZIP(), []int{16}
 }
 
-// TakeSnapshotRequest creates a copy of the workspace content. This copy can be used to initialize a new workspace.
-type TakeSnapshotRequest struct {
+// MigrateWorkspaceRequest moves a running workspace to another node
+type MigrateWorkspaceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ID is the unique identifier of the workspace of which to take a snapshot
+	// id is the ID of the workspace
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// return_immediately means we're not waiting until the snapshot is done but return immediately after starting it
-	ReturnImmediately bool `protobuf:"varint,2,opt,name=return_immediately,json=returnImmediately,proto3" json:"return_immediately,omitempty"`
+	// envvars are the environment variables the workspace was started with. Running workspaces have no secrets
+	// anymore, so we recreate the ones of the replacement pod from these.
+	Envvars []*EnvironmentVariable `protobuf:"bytes,2,rep,name=envvars,proto3" json:"envvars,omitempty"`
+	// sys_envvars are the system environment variables the workspace was started with
+	SysEnvvars []*EnvironmentVariable `protobuf:"bytes,3,rep,name=sys_envvars,json=sysEnvvars,proto3" json:"sys_envvars,omitempty"`
 }
 
-func (x *TakeSnapshotRequest) Reset() {
-	*x = TakeSnapshotRequest{}
+func (x *MigrateWorkspaceRequest) Reset() {
+	*x = MigrateWorkspaceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1472,13 +1466,13 @@ func (x *TakeSnapshotRequest) Reset() {
 	}
 }
 
-func (x *TakeSnapshotRequest) String() string {
+func (x *MigrateWorkspaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TakeSnapshotRequest) ProtoMessage() {}
+func (*MigrateWorkspaceRequest) ProtoMessage() {}
 
-func (x *TakeSnapshotRequest) ProtoReflect() protoreflect.Message {
+func (x *MigrateWorkspaceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_core_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1490,37 +1484,41 @@ func (x *TakeSnapshotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TakeSnapshotRequest.ProtoReflect.Descriptor instead.
-func (*TakeSnapshotRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MigrateWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*MigrateWorkspaceRequest) Descriptor() ([]byte, []int) {
 	return file_core_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *TakeSnapshotRequest) GetId() string {
+func (x *MigrateWorkspaceRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *TakeSnapshotRequest) GetReturnImmediately() bool {
+func (x *MigrateWorkspaceRequest) GetEnvvars() []*EnvironmentVariable {
 	if x != nil {
-		return x.ReturnImmediately
+		return x.Envvars
 	}
-	return false
+	return nil
 }
 
-// TakeSnapshotResponse is the answer to a take snapshot request
-type TakeSnapshotResponse struct {
+func (x *MigrateWorkspaceRequest) GetSysEnvvars() []*EnvironmentVariable {
+	if x != nil {
+		return x.SysEnvvars
+	}
+	return nil
+}
+
+// MigrateWorkspaceResponse is the answer to a migrate workspace request
+type MigrateWorkspaceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// URL is the location of the snapshot encoded such that it can be passed back to a snapshot initializer.
-	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
 }
 
-func (x *TakeSnapshotResponse) Reset() {
-	*x = TakeSnapshotResponse{}
+func (x *MigrateWorkspaceResponse) Reset() {
+	*x = MigrateWorkspaceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1528,13 +1526,13 @@ func (x *TakeSnapshotResponse) Reset() {
 	}
 }
 
-func (x *TakeSnapshotResponse) String() string {
+func (x *MigrateWorkspaceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TakeSnapshotResponse) ProtoMessage() {}
+func (*MigrateWorkspaceResponse) ProtoMessage() {}
 
-func (x *TakeSnapshotResponse) ProtoReflect() protoreflect.Message {
+func (x *MigrateWorkspaceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_core_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1546,32 +1544,29 @@ func (x *TakeSnapshotResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TakeSnapshotResponse.ProtoReflect.Descriptor instead.
-func (*TakeSnapshotResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MigrateWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*MigrateWorkspaceResponse) Descriptor() ([]byte, []int) {
 	return file_core_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *TakeSnapshotResponse) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
-}
-
-// ControlAdmissionRequest controls the admission of users to a workspace
-type ControlAdmissionRequest struct {
+// RenderWorkspacePodRequest renders the pod of a workspace, and optionally compares it to the pod the workspace
+// would get with another workspace class or ws-manager configuration
+type RenderWorkspacePodRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ID is the unique identifier of the workspace whoose admission to control
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// level is the new workspace admission level
-	Level AdmissionLevel `protobuf:"varint,2,opt,name=level,proto3,enum=wsman.AdmissionLevel" json:"level,omitempty"`
+	// request is the start workspace request to render the pod for
+	Request *StartWorkspaceRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// compare_class renders the pod a second time using this workspace class
+	CompareClass string `protobuf:"bytes,2,opt,name=compare_class,json=compareClass,proto3" json:"compare_class,omitempty"`
+	// compare_config renders the pod a second time using this ws-manager configuration (JSON, the "manager" section)
+	// instead of the one ws-manager runs with
+	CompareConfig string `protobuf:"bytes,3,opt,name=compare_config,json=compareConfig,proto3" json:"compare_config,omitempty"`
 }
 
-func (x *ControlAdmissionRequest) Reset() {
-	*x = ControlAdmissionRequest{}
+func (x *RenderWorkspacePodRequest) Reset() {
+	*x = RenderWorkspacePodRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1579,13 +1574,13 @@ func (x *ControlAdmissionRequest) Reset() {
 	}
 }
 
-func (x *ControlAdmissionRequest) String() string {
+func (x *RenderWorkspacePodRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ControlAdmissionRequest) ProtoMessage() {}
+func (*RenderWorkspacePodRequest) ProtoMessage() {}
 
-func (x *ControlAdmissionRequest) ProtoReflect() protoreflect.Message {
+func (x *RenderWorkspacePodRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_core_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1597,33 +1592,48 @@ func (x *ControlAdmissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ControlAdmissionRequest.ProtoReflect.Descriptor instead.
-func (*ControlAdmissionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RenderWorkspacePodRequest.ProtoReflect.Descriptor instead.
+func (*RenderWorkspacePodRequest) Descriptor() ([]byte, []int) {
 	return file_core_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ControlAdmissionRequest) GetId() string {
+func (x *RenderWorkspacePodRequest) GetRequest() *StartWorkspaceRequest {
 	if x != nil {
-		return x.Id
+		return x.Request
+	}
+	return nil
+}
+
+func (x *RenderWorkspacePodRequest) GetCompareClass() string {
+	if x != nil {
+		return x.CompareClass
 	}
 	return ""
 }
 
-func (x *ControlAdmissionRequest) GetLevel() AdmissionLevel {
+func (x *RenderWorkspacePodRequest) GetCompareConfig() string {
 	if x != nil {
-		return x.Level
+		return x.CompareConfig
 	}
-	return AdmissionLevel_ADMIT_OWNER_ONLY
+	return ""
 }
 
-type ControlAdmissionResponse struct {
+// RenderWorkspacePodResponse is the answer to a render workspace pod request
+type RenderWorkspacePodResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// pod is the YAML of the pod
+	Pod string `protobuf:"bytes,1,opt,name=pod,proto3" json:"pod,omitempty"`
+	// compare_pod is the YAML of the pod rendered with the compare class or configuration
+	ComparePod string `protobuf:"bytes,2,opt,name=compare_pod,json=comparePod,proto3" json:"compare_pod,omitempty"`
+	// diff is a unified diff from pod to compare_pod. It is empty if the pods are the same or there was nothing to compare.
+	Diff string `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
 }
 
-func (x *ControlAdmissionResponse) Reset() {
-	*x = ControlAdmissionResponse{}
+func (x *RenderWorkspacePodResponse) Reset() {
+	*x = RenderWorkspacePodResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1631,13 +1641,13 @@ func (x *ControlAdmissionResponse) Reset() {
 	}
 }
 
-func (x *ControlAdmissionResponse) String() string {
+func (x *RenderWorkspacePodResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ControlAdmissionResponse) ProtoMessage() {}
+func (*RenderWorkspacePodResponse) ProtoMessage() {}
 
-func (x *ControlAdmissionResponse) ProtoReflect() protoreflect.Message {
+func (x *RenderWorkspacePodResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_core_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1649,29 +1659,49 @@ func (x *ControlAdmissionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ControlAdmissionResponse.ProtoReflect.Descriptor instead.
-func (*ControlAdmissionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RenderWorkspacePodResponse.ProtoReflect.Descriptor instead.
+func (*RenderWorkspacePodResponse) Descriptor() ([]byte, []int) {
 	return file_core_proto_rawDescGZIP(), []int{20}
 }
 
-// DeleteVolumeSnapshotRequest deletes volume snapshot from the cluster and cloud provider
-type DeleteVolumeSnapshotRequest struct {
+func (x *RenderWorkspacePodResponse) GetPod() string {
+	if x != nil {
+		return x.Pod
+	}
+	return ""
+}
+
+func (x *RenderWorkspacePodResponse) GetComparePod() string {
+	if x != nil {
+		return x.ComparePod
+	}
+	return ""
+}
+
+func (x *RenderWorkspacePodResponse) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+// ControlPortRequest exposes or un-exposes networking ports of a workspace
+type ControlPortRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ID is the name of volume snapshot, which is equal to instance id of workspace it was taken from
+	// ID is the unique identifier of the workspace whose port to control
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// volume_handle is a unique string that is used to restore volume handle in k8s from cloud provider backend
-	VolumeHandle string `protobuf:"bytes,2,opt,name=volume_handle,json=volumeHandle,proto3" json:"volume_handle,omitempty"`
-	// soft_delete controls whether manager should attempt to restore volume snapshot from handle if it doesn't exist in the cluster yet
-	SoftDelete bool `protobuf:"varint,3,opt,name=soft_delete,json=softDelete,proto3" json:"soft_delete,omitempty"`
-	// ws_type is the type of workspace (prebuild or regular) to which this volume snapshot belongs
-	WsType WorkspaceType `protobuf:"varint,4,opt,name=ws_type,json=wsType,proto3,enum=wsman.WorkspaceType" json:"ws_type,omitempty"`
+	// expose controls whether to make the port publicly available or bar if from being accessible outside of the worksapce.
+	// If true, the port will become publicly available, if false it will become inaccessible from outside the workspace.
+	Expose bool `protobuf:"varint,2,opt,name=expose,proto3" json:"expose,omitempty"`
+	// spec defines the port under control
+	Spec *PortSpec `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"`
 }
 
-func (x *DeleteVolumeSnapshotRequest) Reset() {
-	*x = DeleteVolumeSnapshotRequest{}
+func (x *ControlPortRequest) Reset() {
+	*x = ControlPortRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1679,13 +1709,13 @@ func (x *DeleteVolumeSnapshotRequest) Reset() {
 	}
 }
 
-func (x *DeleteVolumeSnapshotRequest) String() string {
+func (x *ControlPortRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteVolumeSnapshotRequest) ProtoMessage() {}
+func (*ControlPortRequest) ProtoMessage() {}
 
-func (x *DeleteVolumeSnapshotRequest) ProtoReflect() protoreflect.Message {
+func (x *ControlPortRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_core_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1697,50 +1727,41 @@ func (x *DeleteVolumeSnapshotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteVolumeSnapshotRequest.ProtoReflect.Descriptor instead.
-func (*DeleteVolumeSnapshotRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ControlPortRequest.ProtoReflect.Descriptor instead.
+func (*ControlPortRequest) Descriptor() ([]byte, []int) {
 	return file_core_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *DeleteVolumeSnapshotRequest) GetId() string {
+func (x *ControlPortRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *DeleteVolumeSnapshotRequest) GetVolumeHandle() string {
-	if x != nil {
-		return x.VolumeHandle
-	}
-	return ""
-}
-
-func (x *DeleteVolumeSnapshotRequest) GetSoftDelete() bool {
+func (x *ControlPortRequest) GetExpose() bool {
 	if x != nil {
-		return x.SoftDelete
+		return x.Expose
 	}
 	return false
 }
 
-func (x *DeleteVolumeSnapshotRequest) GetWsType() WorkspaceType {
+func (x *ControlPortRequest) GetSpec() *PortSpec {
 	if x != nil {
-		return x.WsType
+		return x.Spec
 	}
-	return WorkspaceType_REGULAR
+	return nil
 }
 
-type DeleteVolumeSnapshotResponse struct {
+// ControlPortResponse is the answer to a workspace port control request
+type ControlPortResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// was_deleted will be true if we were able to delete volume snapshot from the cluster
-	WasDeleted bool `protobuf:"varint,1,opt,name=was_deleted,json=wasDeleted,proto3" json:"was_deleted,omitempty"`
 }
 
-func (x *DeleteVolumeSnapshotResponse) Reset() {
-	*x = DeleteVolumeSnapshotResponse{}
+func (x *ControlPortResponse) Reset() {
+	*x = ControlPortResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1748,13 +1769,13 @@ func (x *DeleteVolumeSnapshotResponse) Reset() {
 	}
 }
 
-func (x *DeleteVolumeSnapshotResponse) String() string {
+func (x *ControlPortResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteVolumeSnapshotResponse) ProtoMessage() {}
+func (*ControlPortResponse) ProtoMessage() {}
 
-func (x *DeleteVolumeSnapshotResponse) ProtoReflect() protoreflect.Message {
+func (x *ControlPortResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_core_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1766,30 +1787,25 @@ func (x *DeleteVolumeSnapshotResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteVolumeSnapshotResponse.ProtoReflect.Descriptor instead.
-func (*DeleteVolumeSnapshotResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ControlPortResponse.ProtoReflect.Descriptor instead.
+func (*ControlPortResponse) Descriptor() ([]byte, []int) {
 	return file_core_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *DeleteVolumeSnapshotResponse) GetWasDeleted() bool {
-	if x != nil {
-		return x.WasDeleted
-	}
-	return false
-}
-
-// BackupWorkspaceRequest backs up a running workspace
-type BackupWorkspaceRequest struct {
+// TakeSnapshotRequest creates a copy of the workspace content. This copy can be used to initialize a new workspace.
+type TakeSnapshotRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ID is the unique identifier of the workspace of which to take a backup
+	// ID is the unique identifier of the workspace of which to take a snapshot
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// return_immediately means we're not waiting until the snapshot is done but return immediately after starting it
+	ReturnImmediately bool `protobuf:"varint,2,opt,name=return_immediately,json=returnImmediately,proto3" json:"return_immediately,omitempty"`
 }
 
-func (x *BackupWorkspaceRequest) Reset() {
-	*x = BackupWorkspaceRequest{}
+func (x *TakeSnapshotRequest) Reset() {
+	*x = TakeSnapshotRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1797,13 +1813,13 @@ func (x *BackupWorkspaceRequest) Reset() {
 	}
 }
 
-func (x *BackupWorkspaceRequest) String() string {
+func (x *TakeSnapshotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BackupWorkspaceRequest) ProtoMessage() {}
+func (*TakeSnapshotRequest) ProtoMessage() {}
 
-func (x *BackupWorkspaceRequest) ProtoReflect() protoreflect.Message {
+func (x *TakeSnapshotRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_core_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1815,30 +1831,37 @@ func (x *BackupWorkspaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BackupWorkspaceRequest.ProtoReflect.Descriptor instead.
-func (*BackupWorkspaceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TakeSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*TakeSnapshotRequest) Descriptor() ([]byte, []int) {
 	return file_core_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *BackupWorkspaceRequest) GetId() string {
+func (x *TakeSnapshotRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-// BackupWorkspaceResponse is the answer to a backup workspace request
-type BackupWorkspaceResponse struct {
+func (x *TakeSnapshotRequest) GetReturnImmediately() bool {
+	if x != nil {
+		return x.ReturnImmediately
+	}
+	return false
+}
+
+// TakeSnapshotResponse is the answer to a take snapshot request
+type TakeSnapshotResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// URL is the location of the backup
+	// URL is the location of the snapshot encoded such that it can be passed back to a snapshot initializer.
 	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
 }
 
-func (x *BackupWorkspaceResponse) Reset() {
-	*x = BackupWorkspaceResponse{}
+func (x *TakeSnapshotResponse) Reset() {
+	*x = TakeSnapshotResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1846,13 +1869,13 @@ func (x *BackupWorkspaceResponse) Reset() {
 	}
 }
 
-func (x *BackupWorkspaceResponse) String() string {
+func (x *TakeSnapshotResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BackupWorkspaceResponse) ProtoMessage() {}
+func (*TakeSnapshotResponse) ProtoMessage() {}
 
-func (x *BackupWorkspaceResponse) ProtoReflect() protoreflect.Message {
+func (x *TakeSnapshotResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_core_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1864,32 +1887,32 @@ func (x *BackupWorkspaceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BackupWorkspaceResponse.ProtoReflect.Descriptor instead.
-func (*BackupWorkspaceResponse) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use TakeSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*TakeSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *BackupWorkspaceResponse) GetUrl() string {
+func (x *TakeSnapshotResponse) GetUrl() string {
 	if x != nil {
 		return x.Url
 	}
 	return ""
 }
 
-// UpdateSSHKeyRequest update ssh public key
-type UpdateSSHKeyRequest struct {
+// ControlAdmissionRequest controls the admission of users to a workspace
+type ControlAdmissionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ID is the unique identifier of the workspace
+	// ID is the unique identifier of the workspace whoose admission to control
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// keys is a set of authorized_keys
-	Keys []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	// level is the new workspace admission level
+	Level AdmissionLevel `protobuf:"varint,2,opt,name=level,proto3,enum=wsman.AdmissionLevel" json:"level,omitempty"`
 }
 
-func (x *UpdateSSHKeyRequest) Reset() {
-	*x = UpdateSSHKeyRequest{}
+func (x *ControlAdmissionRequest) Reset() {
+	*x = ControlAdmissionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1897,13 +1920,13 @@ func (x *UpdateSSHKeyRequest) Reset() {
 	}
 }
 
-func (x *UpdateSSHKeyRequest) String() string {
+func (x *ControlAdmissionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSSHKeyRequest) ProtoMessage() {}
+func (*ControlAdmissionRequest) ProtoMessage() {}
 
-func (x *UpdateSSHKeyRequest) ProtoReflect() protoreflect.Message {
+func (x *ControlAdmissionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_core_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1915,34 +1938,33 @@ func (x *UpdateSSHKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSSHKeyRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSSHKeyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ControlAdmissionRequest.ProtoReflect.Descriptor instead.
+func (*ControlAdmissionRequest) Descriptor() ([]byte, []int) {
 	return file_core_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *UpdateSSHKeyRequest) GetId() string {
+func (x *ControlAdmissionRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateSSHKeyRequest) GetKeys() []string {
+func (x *ControlAdmissionRequest) GetLevel() AdmissionLevel {
 	if x != nil {
-		return x.Keys
+		return x.Level
 	}
-	return nil
+	return AdmissionLevel_ADMIT_OWNER_ONLY
 }
 
-// UpdateSSHKeyResponse is the answer to a upload ssh key request
-type UpdateSSHKeyResponse struct {
+type ControlAdmissionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UpdateSSHKeyResponse) Reset() {
-	*x = UpdateSSHKeyResponse{}
+func (x *ControlAdmissionResponse) Reset() {
+	*x = ControlAdmissionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1950,13 +1972,13 @@ func (x *UpdateSSHKeyResponse) Reset() {
 	}
 }
 
-func (x *UpdateSSHKeyResponse) String() string {
+func (x *ControlAdmissionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSSHKeyResponse) ProtoMessage() {}
+func (*ControlAdmissionResponse) ProtoMessage() {}
 
-func (x *UpdateSSHKeyResponse) ProtoReflect() protoreflect.Message {
+func (x *ControlAdmissionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_core_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1968,49 +1990,29 @@ func (x *UpdateSSHKeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSSHKeyResponse.ProtoReflect.Descriptor instead.
-func (*UpdateSSHKeyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ControlAdmissionResponse.ProtoReflect.Descriptor instead.
+func (*ControlAdmissionResponse) Descriptor() ([]byte, []int) {
 	return file_core_proto_rawDescGZIP(), []int{26}
 }
 
-// WorkspaceStatus describes a workspace status
-type WorkspaceStatus struct {
+// DeleteVolumeSnapshotRequest deletes volume snapshot from the cluster and cloud provider
+type DeleteVolumeSnapshotRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ID is the unique identifier of the workspace
+	// ID is the name of volume snapshot, which is equal to instance id of workspace it was taken from
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// version of the status update. Workspace instances themselves are unversioned,
-	// but their status has different versions.
-	// The value of this field has no semantic meaning (e.g. don't interpret it as
-	// as a timestamp), but it can be used to impose a partial order.
-	// If a.status_version < b.status_version then a was the status before b.
-	StatusVersion uint64 `protobuf:"varint,10,opt,name=status_version,json=statusVersion,proto3" json:"status_version,omitempty"`
-	// Metadata is data associated with this workspace that's required for other parts of Gitpod to function
-	Metadata *WorkspaceMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// Spec is the workspace spec during runtime
-	Spec *WorkspaceSpec `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"`
-	// the phase of a workspace is a simple, high-level summary of where the workspace is in its lifecycle
-	Phase WorkspacePhase `protobuf:"varint,4,opt,name=phase,proto3,enum=wsman.WorkspacePhase" json:"phase,omitempty"`
-	// conditions detail the current state of the workspace
-	Conditions *WorkspaceConditions `protobuf:"bytes,5,opt,name=conditions,proto3" json:"conditions,omitempty"`
-	// message is an optional human-readable message detailing the current phase
-	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
-	// repo details the Git working copy status of the workspace.
-	// Note: this is a best-effort field and more often than not will not be present. Its absence does not
-	// indicate the absence of a working copy.
-	Repo *api.GitStatus `protobuf:"bytes,7,opt,name=repo,proto3" json:"repo,omitempty"`
-	// runtime contains information about the workspace's runtime environment
-	Runtime *WorkspaceRuntimeInfo `protobuf:"bytes,8,opt,name=runtime,proto3" json:"runtime,omitempty"`
-	// auth provides authentication information about the workspace. This info is primarily used by ws-proxy.
-	Auth *WorkspaceAuthentication `protobuf:"bytes,9,opt,name=auth,proto3" json:"auth,omitempty"`
-	// metrics contains metrics about the workspace
-	InitializerMetrics *InitializerMetrics `protobuf:"bytes,11,opt,name=initializer_metrics,json=initializerMetrics,proto3" json:"initializer_metrics,omitempty"`
+	// volume_handle is a unique string that is used to restore volume handle in k8s from cloud provider backend
+	VolumeHandle string `protobuf:"bytes,2,opt,name=volume_handle,json=volumeHandle,proto3" json:"volume_handle,omitempty"`
+	// soft_delete controls whether manager should attempt to restore volume snapshot from handle if it doesn't exist in the cluster yet
+	SoftDelete bool `protobuf:"varint,3,opt,name=soft_delete,json=softDelete,proto3" json:"soft_delete,omitempty"`
+	// ws_type is the type of workspace (prebuild or regular) to which this volume snapshot belongs
+	WsType WorkspaceType `protobuf:"varint,4,opt,name=ws_type,json=wsType,proto3,enum=wsman.WorkspaceType" json:"ws_type,omitempty"`
 }
 
-func (x *WorkspaceStatus) Reset() {
-	*x = WorkspaceStatus{}
+func (x *DeleteVolumeSnapshotRequest) Reset() {
+	*x = DeleteVolumeSnapshotRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2018,13 +2020,13 @@ func (x *WorkspaceStatus) Reset() {
 	}
 }
 
-func (x *WorkspaceStatus) String() string {
+func (x *DeleteVolumeSnapshotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceStatus) ProtoMessage() {}
+func (*DeleteVolumeSnapshotRequest) ProtoMessage() {}
 
-func (x *WorkspaceStatus) ProtoReflect() protoreflect.Message {
+func (x *DeleteVolumeSnapshotRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_core_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2036,102 +2038,50 @@ func (x *WorkspaceStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceStatus.ProtoReflect.Descriptor instead.
-func (*WorkspaceStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteVolumeSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVolumeSnapshotRequest) Descriptor() ([]byte, []int) {
 	return file_core_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *WorkspaceStatus) GetId() string {
+func (x *DeleteVolumeSnapshotRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *WorkspaceStatus) GetStatusVersion() uint64 {
-	if x != nil {
-		return x.StatusVersion
-	}
-	return 0
-}
-
-func (x *WorkspaceStatus) GetMetadata() *WorkspaceMetadata {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
-}
-
-func (x *WorkspaceStatus) GetSpec() *WorkspaceSpec {
-	if x != nil {
-		return x.Spec
-	}
-	return nil
-}
-
-func (x *WorkspaceStatus) GetPhase() WorkspacePhase {
-	if x != nil {
-		return x.Phase
-	}
-	return WorkspacePhase_UNKNOWN
-}
-
-func (x *WorkspaceStatus) GetConditions() *WorkspaceConditions {
-	if x != nil {
-		return x.Conditions
-	}
-	return nil
-}
-
-func (x *WorkspaceStatus) GetMessage() string {
+func (x *DeleteVolumeSnapshotRequest) GetVolumeHandle() string {
 	if x != nil {
-		return x.Message
+		return x.VolumeHandle
 	}
 	return ""
 }
 
-func (x *WorkspaceStatus) GetRepo() *api.GitStatus {
-	if x != nil {
-		return x.Repo
-	}
-	return nil
-}
-
-func (x *WorkspaceStatus) GetRuntime() *WorkspaceRuntimeInfo {
-	if x != nil {
-		return x.Runtime
-	}
-	return nil
-}
-
-func (x *WorkspaceStatus) GetAuth() *WorkspaceAuthentication {
+func (x *DeleteVolumeSnapshotRequest) GetSoftDelete() bool {
 	if x != nil {
-		return x.Auth
+		return x.SoftDelete
 	}
-	return nil
+	return false
 }
 
-func (x *WorkspaceStatus) GetInitializerMetrics() *InitializerMetrics {
+func (x *DeleteVolumeSnapshotRequest) GetWsType() WorkspaceType {
 	if x != nil {
-		return x.InitializerMetrics
+		return x.WsType
 	}
-	return nil
+	return WorkspaceType_REGULAR
 }
 
-// IDEImage configures the IDE images a workspace will use
-type IDEImage struct {
+type DeleteVolumeSnapshotResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// web_ref is a reference to an OCI image used for serving the web-based IDE
-	WebRef string `protobuf:"bytes,1,opt,name=web_ref,json=webRef,proto3" json:"web_ref,omitempty"`
-	// supervisor_ref is a reference to an OCI image used as supervisor
-	SupervisorRef string `protobuf:"bytes,3,opt,name=supervisor_ref,json=supervisorRef,proto3" json:"supervisor_ref,omitempty"`
+	// was_deleted will be true if we were able to delete volume snapshot from the cluster
+	WasDeleted bool `protobuf:"varint,1,opt,name=was_deleted,json=wasDeleted,proto3" json:"was_deleted,omitempty"`
 }
 
-func (x *IDEImage) Reset() {
-	*x = IDEImage{}
+func (x *DeleteVolumeSnapshotResponse) Reset() {
+	*x = DeleteVolumeSnapshotResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2139,13 +2089,13 @@ func (x *IDEImage) Reset() {
 	}
 }
 
-func (x *IDEImage) String() string {
+func (x *DeleteVolumeSnapshotResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IDEImage) ProtoMessage() {}
+func (*DeleteVolumeSnapshotResponse) ProtoMessage() {}
 
-func (x *IDEImage) ProtoReflect() protoreflect.Message {
+func (x *DeleteVolumeSnapshotResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_core_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2157,56 +2107,30 @@ func (x *IDEImage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IDEImage.ProtoReflect.Descriptor instead.
-func (*IDEImage) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteVolumeSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVolumeSnapshotResponse) Descriptor() ([]byte, []int) {
 	return file_core_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *IDEImage) GetWebRef() string {
-	if x != nil {
-		return x.WebRef
-	}
-	return ""
-}
-
-func (x *IDEImage) GetSupervisorRef() string {
+func (x *DeleteVolumeSnapshotResponse) GetWasDeleted() bool {
 	if x != nil {
-		return x.SupervisorRef
+		return x.WasDeleted
 	}
-	return ""
+	return false
 }
 
-// WorkspaceSpec is the specification of a workspace at runtime
-type WorkspaceSpec struct {
+// BackupWorkspaceRequest backs up a running workspace
+type BackupWorkspaceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// workspace_image is the name of the Docker image this workspace runs
-	WorkspaceImage string `protobuf:"bytes,1,opt,name=workspace_image,json=workspaceImage,proto3" json:"workspace_image,omitempty"`
-	// headless marks this workspace a headless one - headless workspaces are not intended for users but for automation
-	Headless bool `protobuf:"varint,3,opt,name=headless,proto3" json:"headless,omitempty"`
-	// URL is the external URL of the workspace
-	Url string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
-	// exposed_ports lists all ports which this workspace has exposed to the outside world
-	ExposedPorts []*PortSpec `protobuf:"bytes,5,rep,name=exposed_ports,json=exposedPorts,proto3" json:"exposed_ports,omitempty"`
-	// workspace type denotes what kind of workspace this is, e.g. if it's user-facing, prebuilding content or probing the service
-	Type WorkspaceType `protobuf:"varint,6,opt,name=type,proto3,enum=wsman.WorkspaceType" json:"type,omitempty"`
-	// The intervals in which a heartbeat must be received for the workspace not to time out
-	Timeout string `protobuf:"bytes,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	// ide_image is the name of the Docker image used as IDE
-	IdeImage *IDEImage `protobuf:"bytes,8,opt,name=ide_image,json=ideImage,proto3" json:"ide_image,omitempty"`
-	// class names the class of this workspace
-	Class string `protobuf:"bytes,9,opt,name=class,proto3" json:"class,omitempty"`
-	// ide_image_layers are contains the images needed for the ide to run,
-	// including ide-desktop, desktop-plugin and so on
-	IdeImageLayers []string `protobuf:"bytes,10,rep,name=ide_image_layers,json=ideImageLayers,proto3" json:"ide_image_layers,omitempty"`
-	// The timeout for closed ide.
-	ClosedTimeout string `protobuf:"bytes,11,opt,name=closed_timeout,json=closedTimeout,proto3" json:"closed_timeout,omitempty"`
+	// ID is the unique identifier of the workspace of which to take a backup
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *WorkspaceSpec) Reset() {
-	*x = WorkspaceSpec{}
+func (x *BackupWorkspaceRequest) Reset() {
+	*x = BackupWorkspaceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2214,13 +2138,13 @@ func (x *WorkspaceSpec) Reset() {
 	}
 }
 
-func (x *WorkspaceSpec) String() string {
+func (x *BackupWorkspaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceSpec) ProtoMessage() {}
+func (*BackupWorkspaceRequest) ProtoMessage() {}
 
-func (x *WorkspaceSpec) ProtoReflect() protoreflect.Message {
+func (x *BackupWorkspaceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_core_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2232,114 +2156,1098 @@ func (x *WorkspaceSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceSpec.ProtoReflect.Descriptor instead.
-func (*WorkspaceSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use BackupWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*BackupWorkspaceRequest) Descriptor() ([]byte, []int) {
 	return file_core_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *WorkspaceSpec) GetWorkspaceImage() string {
+func (x *BackupWorkspaceRequest) GetId() string {
 	if x != nil {
-		return x.WorkspaceImage
+		return x.Id
 	}
 	return ""
 }
 
-func (x *WorkspaceSpec) GetHeadless() bool {
-	if x != nil {
+// BackupWorkspaceResponse is the answer to a backup workspace request
+type BackupWorkspaceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// URL is the location of the backup
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *BackupWorkspaceResponse) Reset() {
+	*x = BackupWorkspaceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupWorkspaceResponse) ProtoMessage() {}
+
+func (x *BackupWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*BackupWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BackupWorkspaceResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// UpdateSSHKeyRequest update ssh public key
+type UpdateSSHKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID is the unique identifier of the workspace
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// keys is a set of authorized_keys
+	Keys []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *UpdateSSHKeyRequest) Reset() {
+	*x = UpdateSSHKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSSHKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSSHKeyRequest) ProtoMessage() {}
+
+func (x *UpdateSSHKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSSHKeyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSSHKeyRequest) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateSSHKeyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSSHKeyRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// UpdateSSHKeyResponse is the answer to a upload ssh key request
+type UpdateSSHKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateSSHKeyResponse) Reset() {
+	*x = UpdateSSHKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSSHKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSSHKeyResponse) ProtoMessage() {}
+
+func (x *UpdateSSHKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSSHKeyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSSHKeyResponse) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{32}
+}
+
+// WorkspaceStatus describes a workspace status
+type WorkspaceStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID is the unique identifier of the workspace
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// version of the status update. Workspace instances themselves are unversioned,
+	// but their status has different versions.
+	// The value of this field has no semantic meaning (e.g. don't interpret it as
+	// as a timestamp), but it can be used to impose a partial order.
+	// If a.status_version < b.status_version then a was the status before b.
+	StatusVersion uint64 `protobuf:"varint,10,opt,name=status_version,json=statusVersion,proto3" json:"status_version,omitempty"`
+	// Metadata is data associated with this workspace that's required for other parts of Gitpod to function
+	Metadata *WorkspaceMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Spec is the workspace spec during runtime
+	Spec *WorkspaceSpec `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"`
+	// the phase of a workspace is a simple, high-level summary of where the workspace is in its lifecycle
+	Phase WorkspacePhase `protobuf:"varint,4,opt,name=phase,proto3,enum=wsman.WorkspacePhase" json:"phase,omitempty"`
+	// conditions detail the current state of the workspace
+	Conditions *WorkspaceConditions `protobuf:"bytes,5,opt,name=conditions,proto3" json:"conditions,omitempty"`
+	// message is an optional human-readable message detailing the current phase
+	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	// repo details the Git working copy status of the workspace.
+	// Note: this is a best-effort field and more often than not will not be present. Its absence does not
+	// indicate the absence of a working copy.
+	Repo *api.GitStatus `protobuf:"bytes,7,opt,name=repo,proto3" json:"repo,omitempty"`
+	// runtime contains information about the workspace's runtime environment
+	Runtime *WorkspaceRuntimeInfo `protobuf:"bytes,8,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	// auth provides authentication information about the workspace. This info is primarily used by ws-proxy.
+	Auth *WorkspaceAuthentication `protobuf:"bytes,9,opt,name=auth,proto3" json:"auth,omitempty"`
+	// metrics contains metrics about the workspace
+	InitializerMetrics *InitializerMetrics `protobuf:"bytes,11,opt,name=initializer_metrics,json=initializerMetrics,proto3" json:"initializer_metrics,omitempty"`
+	// storage reports the use of the workspace's /workspace quota
+	Storage *WorkspaceStorage `protobuf:"bytes,12,opt,name=storage,proto3" json:"storage,omitempty"`
+	// timeline lists when the workspace entered its phases and when its conditions and those of its pod changed, oldest first
+	Timeline []*WorkspaceTimelineEvent `protobuf:"bytes,13,rep,name=timeline,proto3" json:"timeline,omitempty"`
+}
+
+func (x *WorkspaceStatus) Reset() {
+	*x = WorkspaceStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkspaceStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceStatus) ProtoMessage() {}
+
+func (x *WorkspaceStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceStatus.ProtoReflect.Descriptor instead.
+func (*WorkspaceStatus) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WorkspaceStatus) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WorkspaceStatus) GetStatusVersion() uint64 {
+	if x != nil {
+		return x.StatusVersion
+	}
+	return 0
+}
+
+func (x *WorkspaceStatus) GetMetadata() *WorkspaceMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *WorkspaceStatus) GetSpec() *WorkspaceSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *WorkspaceStatus) GetPhase() WorkspacePhase {
+	if x != nil {
+		return x.Phase
+	}
+	return WorkspacePhase_UNKNOWN
+}
+
+func (x *WorkspaceStatus) GetConditions() *WorkspaceConditions {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *WorkspaceStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *WorkspaceStatus) GetRepo() *api.GitStatus {
+	if x != nil {
+		return x.Repo
+	}
+	return nil
+}
+
+func (x *WorkspaceStatus) GetRuntime() *WorkspaceRuntimeInfo {
+	if x != nil {
+		return x.Runtime
+	}
+	return nil
+}
+
+func (x *WorkspaceStatus) GetAuth() *WorkspaceAuthentication {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *WorkspaceStatus) GetInitializerMetrics() *InitializerMetrics {
+	if x != nil {
+		return x.InitializerMetrics
+	}
+	return nil
+}
+
+func (x *WorkspaceStatus) GetStorage() *WorkspaceStorage {
+	if x != nil {
+		return x.Storage
+	}
+	return nil
+}
+
+func (x *WorkspaceStatus) GetTimeline() []*WorkspaceTimelineEvent {
+	if x != nil {
+		return x.Timeline
+	}
+	return nil
+}
+
+// WorkspaceStorage describes how much of its storage quota a workspace uses
+type WorkspaceStorage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// quota_bytes is the quota currently enforced on /workspace
+	QuotaBytes int64 `protobuf:"varint,1,opt,name=quota_bytes,json=quotaBytes,proto3" json:"quota_bytes,omitempty"`
+	// used_bytes is the number of bytes used in /workspace at the time of the last check
+	UsedBytes int64 `protobuf:"varint,2,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	// resize_rejected explains why the last storage quota change could not be applied. Empty if it was applied.
+	ResizeRejected string `protobuf:"bytes,3,opt,name=resize_rejected,json=resizeRejected,proto3" json:"resize_rejected,omitempty"`
+}
+
+func (x *WorkspaceStorage) Reset() {
+	*x = WorkspaceStorage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkspaceStorage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceStorage) ProtoMessage() {}
+
+func (x *WorkspaceStorage) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceStorage.ProtoReflect.Descriptor instead.
+func (*WorkspaceStorage) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *WorkspaceStorage) GetQuotaBytes() int64 {
+	if x != nil {
+		return x.QuotaBytes
+	}
+	return 0
+}
+
+func (x *WorkspaceStorage) GetUsedBytes() int64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+func (x *WorkspaceStorage) GetResizeRejected() string {
+	if x != nil {
+		return x.ResizeRejected
+	}
+	return ""
+}
+
+// WorkspaceTimelineEvent marks a point in the life of a workspace
+type WorkspaceTimelineEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// type is "Phase", "Condition", "Pod" for a condition of the workspace pod, or "ImagePull" for a container pulling its image
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// name is the phase the workspace entered, the condition which changed, or the container which pulls its image
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// status is the status the condition changed to. It is empty for phases.
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// time is when the event happened
+	Time *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *WorkspaceTimelineEvent) Reset() {
+	*x = WorkspaceTimelineEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkspaceTimelineEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceTimelineEvent) ProtoMessage() {}
+
+func (x *WorkspaceTimelineEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceTimelineEvent.ProtoReflect.Descriptor instead.
+func (*WorkspaceTimelineEvent) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *WorkspaceTimelineEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WorkspaceTimelineEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkspaceTimelineEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WorkspaceTimelineEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// IDEImage configures the IDE images a workspace will use
+type IDEImage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// web_ref is a reference to an OCI image used for serving the web-based IDE
+	WebRef string `protobuf:"bytes,1,opt,name=web_ref,json=webRef,proto3" json:"web_ref,omitempty"`
+	// supervisor_ref is a reference to an OCI image used as supervisor
+	SupervisorRef string `protobuf:"bytes,3,opt,name=supervisor_ref,json=supervisorRef,proto3" json:"supervisor_ref,omitempty"`
+}
+
+func (x *IDEImage) Reset() {
+	*x = IDEImage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IDEImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IDEImage) ProtoMessage() {}
+
+func (x *IDEImage) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IDEImage.ProtoReflect.Descriptor instead.
+func (*IDEImage) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *IDEImage) GetWebRef() string {
+	if x != nil {
+		return x.WebRef
+	}
+	return ""
+}
+
+func (x *IDEImage) GetSupervisorRef() string {
+	if x != nil {
+		return x.SupervisorRef
+	}
+	return ""
+}
+
+// WorkspaceSpec is the specification of a workspace at runtime
+type WorkspaceSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// workspace_image is the name of the Docker image this workspace runs
+	WorkspaceImage string `protobuf:"bytes,1,opt,name=workspace_image,json=workspaceImage,proto3" json:"workspace_image,omitempty"`
+	// headless marks this workspace a headless one - headless workspaces are not intended for users but for automation
+	Headless bool `protobuf:"varint,3,opt,name=headless,proto3" json:"headless,omitempty"`
+	// URL is the external URL of the workspace
+	Url string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	// exposed_ports lists all ports which this workspace has exposed to the outside world
+	ExposedPorts []*PortSpec `protobuf:"bytes,5,rep,name=exposed_ports,json=exposedPorts,proto3" json:"exposed_ports,omitempty"`
+	// workspace type denotes what kind of workspace this is, e.g. if it's user-facing, prebuilding content or probing the service
+	Type WorkspaceType `protobuf:"varint,6,opt,name=type,proto3,enum=wsman.WorkspaceType" json:"type,omitempty"`
+	// The intervals in which a heartbeat must be received for the workspace not to time out
+	Timeout string `protobuf:"bytes,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// ide_image is the name of the Docker image used as IDE
+	IdeImage *IDEImage `protobuf:"bytes,8,opt,name=ide_image,json=ideImage,proto3" json:"ide_image,omitempty"`
+	// class names the class of this workspace
+	Class string `protobuf:"bytes,9,opt,name=class,proto3" json:"class,omitempty"`
+	// ide_image_layers are contains the images needed for the ide to run,
+	// including ide-desktop, desktop-plugin and so on
+	IdeImageLayers []string `protobuf:"bytes,10,rep,name=ide_image_layers,json=ideImageLayers,proto3" json:"ide_image_layers,omitempty"`
+	// The timeout for closed ide.
+	ClosedTimeout string `protobuf:"bytes,11,opt,name=closed_timeout,json=closedTimeout,proto3" json:"closed_timeout,omitempty"`
+	// egress_policy names the network egress policy the workspace is subject to. Empty if there is none.
+	EgressPolicy string `protobuf:"bytes,12,opt,name=egress_policy,json=egressPolicy,proto3" json:"egress_policy,omitempty"`
+}
+
+func (x *WorkspaceSpec) Reset() {
+	*x = WorkspaceSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkspaceSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSpec) ProtoMessage() {}
+
+func (x *WorkspaceSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSpec.ProtoReflect.Descriptor instead.
+func (*WorkspaceSpec) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *WorkspaceSpec) GetWorkspaceImage() string {
+	if x != nil {
+		return x.WorkspaceImage
+	}
+	return ""
+}
+
+func (x *WorkspaceSpec) GetHeadless() bool {
+	if x != nil {
 		return x.Headless
 	}
-	return false
+	return false
+}
+
+func (x *WorkspaceSpec) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WorkspaceSpec) GetExposedPorts() []*PortSpec {
+	if x != nil {
+		return x.ExposedPorts
+	}
+	return nil
+}
+
+func (x *WorkspaceSpec) GetType() WorkspaceType {
+	if x != nil {
+		return x.Type
+	}
+	return WorkspaceType_REGULAR
+}
+
+func (x *WorkspaceSpec) GetTimeout() string {
+	if x != nil {
+		return x.Timeout
+	}
+	return ""
+}
+
+func (x *WorkspaceSpec) GetIdeImage() *IDEImage {
+	if x != nil {
+		return x.IdeImage
+	}
+	return nil
+}
+
+func (x *WorkspaceSpec) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *WorkspaceSpec) GetIdeImageLayers() []string {
+	if x != nil {
+		return x.IdeImageLayers
+	}
+	return nil
+}
+
+func (x *WorkspaceSpec) GetClosedTimeout() string {
+	if x != nil {
+		return x.ClosedTimeout
+	}
+	return ""
+}
+
+func (x *WorkspaceSpec) GetEgressPolicy() string {
+	if x != nil {
+		return x.EgressPolicy
+	}
+	return ""
+}
+
+// PortSpec describes a networking port exposed on a workspace
+type PortSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// port is the outward-facing port
+	Port uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	// visibility defines the visibility of the port
+	Visibility PortVisibility `protobuf:"varint,3,opt,name=visibility,proto3,enum=wsman.PortVisibility" json:"visibility,omitempty"`
+	// url is the public-facing URL this port is available at
+	Url string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	// protocol is the workspace port protocol, default is http
+	Protocol PortProtocol `protobuf:"varint,5,opt,name=protocol,proto3,enum=wsman.PortProtocol" json:"protocol,omitempty"`
+}
+
+func (x *PortSpec) Reset() {
+	*x = PortSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortSpec) ProtoMessage() {}
+
+func (x *PortSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortSpec.ProtoReflect.Descriptor instead.
+func (*PortSpec) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PortSpec) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *PortSpec) GetVisibility() PortVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return PortVisibility_PORT_VISIBILITY_PRIVATE
+}
+
+func (x *PortSpec) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *PortSpec) GetProtocol() PortProtocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return PortProtocol_PORT_PROTOCOL_HTTP
+}
+
+// VolumeSnapshotInfo defines volume snapshot information
+type VolumeSnapshotInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// volume_snapshot_name is the name of volume snapshot
+	VolumeSnapshotName string `protobuf:"bytes,1,opt,name=volume_snapshot_name,json=volumeSnapshotName,proto3" json:"volume_snapshot_name,omitempty"`
+	// volume_snapshot_handle is a handle that is used to restore volume snapshot
+	VolumeSnapshotHandle string `protobuf:"bytes,2,opt,name=volume_snapshot_handle,json=volumeSnapshotHandle,proto3" json:"volume_snapshot_handle,omitempty"`
+}
+
+func (x *VolumeSnapshotInfo) Reset() {
+	*x = VolumeSnapshotInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeSnapshotInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeSnapshotInfo) ProtoMessage() {}
+
+func (x *VolumeSnapshotInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeSnapshotInfo.ProtoReflect.Descriptor instead.
+func (*VolumeSnapshotInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *VolumeSnapshotInfo) GetVolumeSnapshotName() string {
+	if x != nil {
+		return x.VolumeSnapshotName
+	}
+	return ""
+}
+
+func (x *VolumeSnapshotInfo) GetVolumeSnapshotHandle() string {
+	if x != nil {
+		return x.VolumeSnapshotHandle
+	}
+	return ""
+}
+
+// WorkspaceCondition gives more detailed information as to the state of the workspace. Which condition actually
+// has a value depends on the phase the workspace is in.
+type WorkspaceConditions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// failed contains the reason the workspace failed to operate. If this field is empty, the workspace has not failed.
+	Failed string `protobuf:"bytes,1,opt,name=failed,proto3" json:"failed,omitempty"`
+	// timeout contains the reason the workspace has timed out. If this field is empty, the workspace has not timed out.
+	Timeout string `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// pulling_images marks if the workspace is currently pulling its images. This condition can only be set during PhaseCreating
+	PullingImages WorkspaceConditionBool `protobuf:"varint,3,opt,name=pulling_images,json=pullingImages,proto3,enum=wsman.WorkspaceConditionBool" json:"pulling_images,omitempty"`
+	// snapshot contains a snapshot URL if a snapshot was produced prior to shutting the workspace down. This condition is only used for headless workspaces.
+	Snapshot string `protobuf:"bytes,5,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// final_backup_complete determines if the last state of the workspace has been backed up to remote storage.
+	// Once this is true, a new workspace with the same ID will be able to use this backup.
+	FinalBackupComplete WorkspaceConditionBool `protobuf:"varint,6,opt,name=final_backup_complete,json=finalBackupComplete,proto3,enum=wsman.WorkspaceConditionBool" json:"final_backup_complete,omitempty"`
+	// deployed indicates if a workspace container is currently deployed. If this condition is false, there is no means for the user to alter the workspace content.
+	Deployed WorkspaceConditionBool `protobuf:"varint,7,opt,name=deployed,proto3,enum=wsman.WorkspaceConditionBool" json:"deployed,omitempty"`
+	// network_not_ready indicates if a workspace container is currently experiencing a network problem.
+	NetworkNotReady WorkspaceConditionBool `protobuf:"varint,8,opt,name=network_not_ready,json=networkNotReady,proto3,enum=wsman.WorkspaceConditionBool" json:"network_not_ready,omitempty"`
+	// first_user_activity is the time when MarkActive was first called on the workspace
+	FirstUserActivity *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=first_user_activity,json=firstUserActivity,proto3" json:"first_user_activity,omitempty"`
+	// headless_task_failed indicates that a headless workspace task failed
+	HeadlessTaskFailed string `protobuf:"bytes,10,opt,name=headless_task_failed,json=headlessTaskFailed,proto3" json:"headless_task_failed,omitempty"`
+	// stopped_by_request is true if the workspace was stopped using a StopWorkspace call
+	StoppedByRequest WorkspaceConditionBool `protobuf:"varint,11,opt,name=stopped_by_request,json=stoppedByRequest,proto3,enum=wsman.WorkspaceConditionBool" json:"stopped_by_request,omitempty"`
+	// volume_snapshot contains info about volume snapshot that was used to save persistent volume
+	VolumeSnapshot *VolumeSnapshotInfo `protobuf:"bytes,12,opt,name=volume_snapshot,json=volumeSnapshot,proto3" json:"volume_snapshot,omitempty"`
+	// aborted is true if StopWorkspace was called with StopWorkspacePolicy set to ABORT
+	Aborted WorkspaceConditionBool `protobuf:"varint,13,opt,name=aborted,proto3,enum=wsman.WorkspaceConditionBool" json:"aborted,omitempty"`
+	// paused is true if the processes of a running workspace are frozen. The workspace resumes once MarkActive is called.
+	Paused WorkspaceConditionBool `protobuf:"varint,14,opt,name=paused,proto3,enum=wsman.WorkspaceConditionBool" json:"paused,omitempty"`
+}
+
+func (x *WorkspaceConditions) Reset() {
+	*x = WorkspaceConditions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkspaceConditions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceConditions) ProtoMessage() {}
+
+func (x *WorkspaceConditions) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceConditions.ProtoReflect.Descriptor instead.
+func (*WorkspaceConditions) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *WorkspaceConditions) GetFailed() string {
+	if x != nil {
+		return x.Failed
+	}
+	return ""
+}
+
+func (x *WorkspaceConditions) GetTimeout() string {
+	if x != nil {
+		return x.Timeout
+	}
+	return ""
+}
+
+func (x *WorkspaceConditions) GetPullingImages() WorkspaceConditionBool {
+	if x != nil {
+		return x.PullingImages
+	}
+	return WorkspaceConditionBool_FALSE
+}
+
+func (x *WorkspaceConditions) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
+func (x *WorkspaceConditions) GetFinalBackupComplete() WorkspaceConditionBool {
+	if x != nil {
+		return x.FinalBackupComplete
+	}
+	return WorkspaceConditionBool_FALSE
+}
+
+func (x *WorkspaceConditions) GetDeployed() WorkspaceConditionBool {
+	if x != nil {
+		return x.Deployed
+	}
+	return WorkspaceConditionBool_FALSE
+}
+
+func (x *WorkspaceConditions) GetNetworkNotReady() WorkspaceConditionBool {
+	if x != nil {
+		return x.NetworkNotReady
+	}
+	return WorkspaceConditionBool_FALSE
+}
+
+func (x *WorkspaceConditions) GetFirstUserActivity() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstUserActivity
+	}
+	return nil
+}
+
+func (x *WorkspaceConditions) GetHeadlessTaskFailed() string {
+	if x != nil {
+		return x.HeadlessTaskFailed
+	}
+	return ""
+}
+
+func (x *WorkspaceConditions) GetStoppedByRequest() WorkspaceConditionBool {
+	if x != nil {
+		return x.StoppedByRequest
+	}
+	return WorkspaceConditionBool_FALSE
+}
+
+func (x *WorkspaceConditions) GetVolumeSnapshot() *VolumeSnapshotInfo {
+	if x != nil {
+		return x.VolumeSnapshot
+	}
+	return nil
 }
 
-func (x *WorkspaceSpec) GetUrl() string {
+func (x *WorkspaceConditions) GetAborted() WorkspaceConditionBool {
 	if x != nil {
-		return x.Url
+		return x.Aborted
 	}
-	return ""
+	return WorkspaceConditionBool_FALSE
 }
 
-func (x *WorkspaceSpec) GetExposedPorts() []*PortSpec {
+func (x *WorkspaceConditions) GetPaused() WorkspaceConditionBool {
 	if x != nil {
-		return x.ExposedPorts
+		return x.Paused
 	}
-	return nil
+	return WorkspaceConditionBool_FALSE
 }
 
-func (x *WorkspaceSpec) GetType() WorkspaceType {
+// WorkspaceMetadata is data associated with a workspace that's required for other parts of the system to function
+type WorkspaceMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// owner is the ID of the Gitpod user to whom we'll bill this workspace and who we consider responsible for its content
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// meta_id is the workspace ID of this currently running workspace instance on the "meta pool" side
+	MetaId string `protobuf:"bytes,2,opt,name=meta_id,json=metaId,proto3" json:"meta_id,omitempty"`
+	// started_at is the time when this workspace was started. Consider this field read-only, i.e. setting in a request will have no effect.
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// Annotations are key/value pairs that gets attached to the workspace.
+	// This is primarily intended for annotating headless workspace loads.
+	Annotations map[string]string `protobuf:"bytes,4,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// team the workspace belongs to, if the workspace is not associated with a team, this property will be empty
+	Team *string `protobuf:"bytes,5,opt,name=team,proto3,oneof" json:"team,omitempty"`
+	// project the workspace belongs to, if the workspace is not associated with a project, this property will be empty
+	Project *string `protobuf:"bytes,6,opt,name=project,proto3,oneof" json:"project,omitempty"`
+	// metrics contains metrics about the workspace
+	Metrics *WorkspaceMetadata_Metrics `protobuf:"bytes,7,opt,name=metrics,proto3" json:"metrics,omitempty"`
+}
+
+func (x *WorkspaceMetadata) Reset() {
+	*x = WorkspaceMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkspaceMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceMetadata) ProtoMessage() {}
+
+func (x *WorkspaceMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceMetadata.ProtoReflect.Descriptor instead.
+func (*WorkspaceMetadata) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *WorkspaceMetadata) GetOwner() string {
 	if x != nil {
-		return x.Type
+		return x.Owner
 	}
-	return WorkspaceType_REGULAR
+	return ""
 }
 
-func (x *WorkspaceSpec) GetTimeout() string {
+func (x *WorkspaceMetadata) GetMetaId() string {
 	if x != nil {
-		return x.Timeout
+		return x.MetaId
 	}
 	return ""
 }
 
-func (x *WorkspaceSpec) GetIdeImage() *IDEImage {
+func (x *WorkspaceMetadata) GetStartedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.IdeImage
+		return x.StartedAt
 	}
 	return nil
 }
 
-func (x *WorkspaceSpec) GetClass() string {
+func (x *WorkspaceMetadata) GetAnnotations() map[string]string {
 	if x != nil {
-		return x.Class
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *WorkspaceMetadata) GetTeam() string {
+	if x != nil && x.Team != nil {
+		return *x.Team
 	}
 	return ""
 }
 
-func (x *WorkspaceSpec) GetIdeImageLayers() []string {
-	if x != nil {
-		return x.IdeImageLayers
+func (x *WorkspaceMetadata) GetProject() string {
+	if x != nil && x.Project != nil {
+		return *x.Project
 	}
-	return nil
+	return ""
 }
 
-func (x *WorkspaceSpec) GetClosedTimeout() string {
+func (x *WorkspaceMetadata) GetMetrics() *WorkspaceMetadata_Metrics {
 	if x != nil {
-		return x.ClosedTimeout
+		return x.Metrics
 	}
-	return ""
+	return nil
 }
 
-// PortSpec describes a networking port exposed on a workspace
-type PortSpec struct {
+// WorkspaceRuntimeInfo details the workspace's runtime, e.g. executing system, node other information
+// about the environment the workspace runs in. This information serves a diagnostic purpose only and
+// should not be directly acted upon.
+type WorkspaceRuntimeInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// port is the outward-facing port
-	Port uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
-	// visibility defines the visibility of the port
-	Visibility PortVisibility `protobuf:"varint,3,opt,name=visibility,proto3,enum=wsman.PortVisibility" json:"visibility,omitempty"`
-	// url is the public-facing URL this port is available at
-	Url string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
-	// protocol is the workspace port protocol, default is http
-	Protocol PortProtocol `protobuf:"varint,5,opt,name=protocol,proto3,enum=wsman.PortProtocol" json:"protocol,omitempty"`
+	// node_name is the name of the node the workspace runs on
+	NodeName string `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	// pod_name is the name of the pod the workspace runs in
+	PodName string `protobuf:"bytes,2,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	// node_ip is the IP of the node the workspace runs on
+	NodeIp string `protobuf:"bytes,3,opt,name=node_ip,json=nodeIp,proto3" json:"node_ip,omitempty"`
 }
 
-func (x *PortSpec) Reset() {
-	*x = PortSpec{}
+func (x *WorkspaceRuntimeInfo) Reset() {
+	*x = WorkspaceRuntimeInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_proto_msgTypes[30]
+		mi := &file_core_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PortSpec) String() string {
+func (x *WorkspaceRuntimeInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PortSpec) ProtoMessage() {}
+func (*WorkspaceRuntimeInfo) ProtoMessage() {}
 
-func (x *PortSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[30]
+func (x *WorkspaceRuntimeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2350,68 +3258,149 @@ func (x *PortSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PortSpec.ProtoReflect.Descriptor instead.
-func (*PortSpec) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use WorkspaceRuntimeInfo.ProtoReflect.Descriptor instead.
+func (*WorkspaceRuntimeInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *PortSpec) GetPort() uint32 {
+func (x *WorkspaceRuntimeInfo) GetNodeName() string {
 	if x != nil {
-		return x.Port
+		return x.NodeName
 	}
-	return 0
+	return ""
 }
 
-func (x *PortSpec) GetVisibility() PortVisibility {
+func (x *WorkspaceRuntimeInfo) GetPodName() string {
 	if x != nil {
-		return x.Visibility
+		return x.PodName
 	}
-	return PortVisibility_PORT_VISIBILITY_PRIVATE
+	return ""
 }
 
-func (x *PortSpec) GetUrl() string {
+func (x *WorkspaceRuntimeInfo) GetNodeIp() string {
 	if x != nil {
-		return x.Url
+		return x.NodeIp
 	}
 	return ""
 }
 
-func (x *PortSpec) GetProtocol() PortProtocol {
+// WorkspaceAuthentication contains authentication information used by ws-proxy to allow/deny access to
+// workspaces and their ports.
+type WorkspaceAuthentication struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Admission describes who can access the workspace and its ports.
+	Admission AdmissionLevel `protobuf:"varint,1,opt,name=admission,proto3,enum=wsman.AdmissionLevel" json:"admission,omitempty"`
+	// Owner token is the token one needs to access the workspace. Its presence is checked by ws-proxy.
+	OwnerToken string `protobuf:"bytes,2,opt,name=owner_token,json=ownerToken,proto3" json:"owner_token,omitempty"`
+}
+
+func (x *WorkspaceAuthentication) Reset() {
+	*x = WorkspaceAuthentication{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkspaceAuthentication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceAuthentication) ProtoMessage() {}
+
+func (x *WorkspaceAuthentication) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceAuthentication.ProtoReflect.Descriptor instead.
+func (*WorkspaceAuthentication) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *WorkspaceAuthentication) GetAdmission() AdmissionLevel {
 	if x != nil {
-		return x.Protocol
+		return x.Admission
 	}
-	return PortProtocol_PORT_PROTOCOL_HTTP
+	return AdmissionLevel_ADMIT_OWNER_ONLY
 }
 
-// VolumeSnapshotInfo defines volume snapshot information
-type VolumeSnapshotInfo struct {
+func (x *WorkspaceAuthentication) GetOwnerToken() string {
+	if x != nil {
+		return x.OwnerToken
+	}
+	return ""
+}
+
+// StartWorkspaceSpec specifies the configuration of a workspace for a workspace start
+type StartWorkspaceSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// volume_snapshot_name is the name of volume snapshot
-	VolumeSnapshotName string `protobuf:"bytes,1,opt,name=volume_snapshot_name,json=volumeSnapshotName,proto3" json:"volume_snapshot_name,omitempty"`
-	// volume_snapshot_handle is a handle that is used to restore volume snapshot
-	VolumeSnapshotHandle string `protobuf:"bytes,2,opt,name=volume_snapshot_handle,json=volumeSnapshotHandle,proto3" json:"volume_snapshot_handle,omitempty"`
+	// workspace_image is the Docker image name of the workspace container
+	WorkspaceImage string `protobuf:"bytes,1,opt,name=workspace_image,json=workspaceImage,proto3" json:"workspace_image,omitempty"`
+	// feature_flags provide a means for starting variants of workspaces (e.g. a privileged one)
+	FeatureFlags []WorkspaceFeatureFlag `protobuf:"varint,3,rep,packed,name=feature_flags,json=featureFlags,proto3,enum=wsman.WorkspaceFeatureFlag" json:"feature_flags,omitempty"`
+	// initializer configures how the workspace is to be initialized
+	Initializer *api.WorkspaceInitializer `protobuf:"bytes,4,opt,name=initializer,proto3" json:"initializer,omitempty"`
+	// ports is the set of ports which ought to be exposed to the internet
+	Ports []*PortSpec `protobuf:"bytes,5,rep,name=ports,proto3" json:"ports,omitempty"`
+	// envvars are user-defined environment variables which ought to be available in the workspace
+	Envvars []*EnvironmentVariable `protobuf:"bytes,6,rep,name=envvars,proto3" json:"envvars,omitempty"`
+	// workspace_location describes where the workspace root of Theia will be
+	WorkspaceLocation string `protobuf:"bytes,8,opt,name=workspace_location,json=workspaceLocation,proto3" json:"workspace_location,omitempty"`
+	// Git configures the Git user in the workspace
+	Git *GitSpec `protobuf:"bytes,9,opt,name=git,proto3" json:"git,omitempty"`
+	// timeout optionally sets a custom workspace timeout
+	Timeout string `protobuf:"bytes,10,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// admission controlls who can access the workspace and its ports.
+	Admission AdmissionLevel `protobuf:"varint,11,opt,name=admission,proto3,enum=wsman.AdmissionLevel" json:"admission,omitempty"`
+	// ide_image is the Docker image name of the IDE image
+	IdeImage *IDEImage `protobuf:"bytes,12,opt,name=ide_image,json=ideImage,proto3" json:"ide_image,omitempty"`
+	// Class denotes the class of the workspace we ought to start
+	Class string `protobuf:"bytes,13,opt,name=class,proto3" json:"class,omitempty"`
+	// ssh_public_keys is user's uploaded ssh public keys
+	SshPublicKeys []string `protobuf:"bytes,15,rep,name=ssh_public_keys,json=sshPublicKeys,proto3" json:"ssh_public_keys,omitempty"`
+	// sys_envvars are system level environment variables which ought to be available in the workspace
+	SysEnvvars []*EnvironmentVariable `protobuf:"bytes,16,rep,name=sys_envvars,json=sysEnvvars,proto3" json:"sys_envvars,omitempty"`
+	// ide_image_layers are contains the images needed for the ide to run,
+	// including ide-desktop, desktop-plugin and so on
+	IdeImageLayers []string `protobuf:"bytes,17,rep,name=ide_image_layers,json=ideImageLayers,proto3" json:"ide_image_layers,omitempty"`
+	// timeout optionally sets a custom closed timeout
+	ClosedTimeout string `protobuf:"bytes,18,opt,name=closed_timeout,json=closedTimeout,proto3" json:"closed_timeout,omitempty"`
+	// maximum lifetime of the workspace
+	MaximumLifetime string `protobuf:"bytes,19,opt,name=maximum_lifetime,json=maximumLifetime,proto3" json:"maximum_lifetime,omitempty"`
 }
 
-func (x *VolumeSnapshotInfo) Reset() {
-	*x = VolumeSnapshotInfo{}
+func (x *StartWorkspaceSpec) Reset() {
+	*x = StartWorkspaceSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_proto_msgTypes[31]
+		mi := &file_core_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VolumeSnapshotInfo) String() string {
+func (x *StartWorkspaceSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VolumeSnapshotInfo) ProtoMessage() {}
+func (*StartWorkspaceSpec) ProtoMessage() {}
 
-func (x *VolumeSnapshotInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[31]
+func (x *StartWorkspaceSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2422,215 +3411,152 @@ func (x *VolumeSnapshotInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VolumeSnapshotInfo.ProtoReflect.Descriptor instead.
-func (*VolumeSnapshotInfo) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use StartWorkspaceSpec.ProtoReflect.Descriptor instead.
+func (*StartWorkspaceSpec) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *VolumeSnapshotInfo) GetVolumeSnapshotName() string {
+func (x *StartWorkspaceSpec) GetWorkspaceImage() string {
 	if x != nil {
-		return x.VolumeSnapshotName
+		return x.WorkspaceImage
 	}
 	return ""
 }
 
-func (x *VolumeSnapshotInfo) GetVolumeSnapshotHandle() string {
+func (x *StartWorkspaceSpec) GetFeatureFlags() []WorkspaceFeatureFlag {
 	if x != nil {
-		return x.VolumeSnapshotHandle
+		return x.FeatureFlags
 	}
-	return ""
-}
-
-// WorkspaceCondition gives more detailed information as to the state of the workspace. Which condition actually
-// has a value depends on the phase the workspace is in.
-type WorkspaceConditions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// failed contains the reason the workspace failed to operate. If this field is empty, the workspace has not failed.
-	Failed string `protobuf:"bytes,1,opt,name=failed,proto3" json:"failed,omitempty"`
-	// timeout contains the reason the workspace has timed out. If this field is empty, the workspace has not timed out.
-	Timeout string `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	// pulling_images marks if the workspace is currently pulling its images. This condition can only be set during PhaseCreating
-	PullingImages WorkspaceConditionBool `protobuf:"varint,3,opt,name=pulling_images,json=pullingImages,proto3,enum=wsman.WorkspaceConditionBool" json:"pulling_images,omitempty"`
-	// snapshot contains a snapshot URL if a snapshot was produced prior to shutting the workspace down. This condition is only used for headless workspaces.
-	Snapshot string `protobuf:"bytes,5,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
-	// final_backup_complete determines if the last state of the workspace has been backed up to remote storage.
-	// Once this is true, a new workspace with the same ID will be able to use this backup.
-	FinalBackupComplete WorkspaceConditionBool `protobuf:"varint,6,opt,name=final_backup_complete,json=finalBackupComplete,proto3,enum=wsman.WorkspaceConditionBool" json:"final_backup_complete,omitempty"`
-	// deployed indicates if a workspace container is currently deployed. If this condition is false, there is no means for the user to alter the workspace content.
-	Deployed WorkspaceConditionBool `protobuf:"varint,7,opt,name=deployed,proto3,enum=wsman.WorkspaceConditionBool" json:"deployed,omitempty"`
-	// network_not_ready indicates if a workspace container is currently experiencing a network problem.
-	NetworkNotReady WorkspaceConditionBool `protobuf:"varint,8,opt,name=network_not_ready,json=networkNotReady,proto3,enum=wsman.WorkspaceConditionBool" json:"network_not_ready,omitempty"`
-	// first_user_activity is the time when MarkActive was first called on the workspace
-	FirstUserActivity *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=first_user_activity,json=firstUserActivity,proto3" json:"first_user_activity,omitempty"`
-	// headless_task_failed indicates that a headless workspace task failed
-	HeadlessTaskFailed string `protobuf:"bytes,10,opt,name=headless_task_failed,json=headlessTaskFailed,proto3" json:"headless_task_failed,omitempty"`
-	// stopped_by_request is true if the workspace was stopped using a StopWorkspace call
-	StoppedByRequest WorkspaceConditionBool `protobuf:"varint,11,opt,name=stopped_by_request,json=stoppedByRequest,proto3,enum=wsman.WorkspaceConditionBool" json:"stopped_by_request,omitempty"`
-	// volume_snapshot contains info about volume snapshot that was used to save persistent volume
-	VolumeSnapshot *VolumeSnapshotInfo `protobuf:"bytes,12,opt,name=volume_snapshot,json=volumeSnapshot,proto3" json:"volume_snapshot,omitempty"`
-	// aborted is true if StopWorkspace was called with StopWorkspacePolicy set to ABORT
-	Aborted WorkspaceConditionBool `protobuf:"varint,13,opt,name=aborted,proto3,enum=wsman.WorkspaceConditionBool" json:"aborted,omitempty"`
+	return nil
 }
 
-func (x *WorkspaceConditions) Reset() {
-	*x = WorkspaceConditions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_core_proto_msgTypes[32]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *StartWorkspaceSpec) GetInitializer() *api.WorkspaceInitializer {
+	if x != nil {
+		return x.Initializer
 	}
+	return nil
 }
 
-func (x *WorkspaceConditions) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*WorkspaceConditions) ProtoMessage() {}
-
-func (x *WorkspaceConditions) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[32]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *StartWorkspaceSpec) GetPorts() []*PortSpec {
+	if x != nil {
+		return x.Ports
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use WorkspaceConditions.ProtoReflect.Descriptor instead.
-func (*WorkspaceConditions) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{32}
+	return nil
 }
 
-func (x *WorkspaceConditions) GetFailed() string {
+func (x *StartWorkspaceSpec) GetEnvvars() []*EnvironmentVariable {
 	if x != nil {
-		return x.Failed
+		return x.Envvars
 	}
-	return ""
+	return nil
 }
 
-func (x *WorkspaceConditions) GetTimeout() string {
+func (x *StartWorkspaceSpec) GetWorkspaceLocation() string {
 	if x != nil {
-		return x.Timeout
+		return x.WorkspaceLocation
 	}
 	return ""
 }
 
-func (x *WorkspaceConditions) GetPullingImages() WorkspaceConditionBool {
+func (x *StartWorkspaceSpec) GetGit() *GitSpec {
 	if x != nil {
-		return x.PullingImages
+		return x.Git
 	}
-	return WorkspaceConditionBool_FALSE
+	return nil
 }
 
-func (x *WorkspaceConditions) GetSnapshot() string {
+func (x *StartWorkspaceSpec) GetTimeout() string {
 	if x != nil {
-		return x.Snapshot
+		return x.Timeout
 	}
 	return ""
 }
 
-func (x *WorkspaceConditions) GetFinalBackupComplete() WorkspaceConditionBool {
+func (x *StartWorkspaceSpec) GetAdmission() AdmissionLevel {
 	if x != nil {
-		return x.FinalBackupComplete
+		return x.Admission
 	}
-	return WorkspaceConditionBool_FALSE
+	return AdmissionLevel_ADMIT_OWNER_ONLY
 }
 
-func (x *WorkspaceConditions) GetDeployed() WorkspaceConditionBool {
+func (x *StartWorkspaceSpec) GetIdeImage() *IDEImage {
 	if x != nil {
-		return x.Deployed
+		return x.IdeImage
 	}
-	return WorkspaceConditionBool_FALSE
+	return nil
 }
 
-func (x *WorkspaceConditions) GetNetworkNotReady() WorkspaceConditionBool {
+func (x *StartWorkspaceSpec) GetClass() string {
 	if x != nil {
-		return x.NetworkNotReady
+		return x.Class
 	}
-	return WorkspaceConditionBool_FALSE
+	return ""
 }
 
-func (x *WorkspaceConditions) GetFirstUserActivity() *timestamppb.Timestamp {
+func (x *StartWorkspaceSpec) GetSshPublicKeys() []string {
 	if x != nil {
-		return x.FirstUserActivity
+		return x.SshPublicKeys
 	}
 	return nil
 }
 
-func (x *WorkspaceConditions) GetHeadlessTaskFailed() string {
+func (x *StartWorkspaceSpec) GetSysEnvvars() []*EnvironmentVariable {
 	if x != nil {
-		return x.HeadlessTaskFailed
+		return x.SysEnvvars
 	}
-	return ""
+	return nil
 }
 
-func (x *WorkspaceConditions) GetStoppedByRequest() WorkspaceConditionBool {
+func (x *StartWorkspaceSpec) GetIdeImageLayers() []string {
 	if x != nil {
-		return x.StoppedByRequest
+		return x.IdeImageLayers
 	}
-	return WorkspaceConditionBool_FALSE
+	return nil
 }
 
-func (x *WorkspaceConditions) GetVolumeSnapshot() *VolumeSnapshotInfo {
+func (x *StartWorkspaceSpec) GetClosedTimeout() string {
 	if x != nil {
-		return x.VolumeSnapshot
+		return x.ClosedTimeout
 	}
-	return nil
+	return ""
 }
 
-func (x *WorkspaceConditions) GetAborted() WorkspaceConditionBool {
+func (x *StartWorkspaceSpec) GetMaximumLifetime() string {
 	if x != nil {
-		return x.Aborted
+		return x.MaximumLifetime
 	}
-	return WorkspaceConditionBool_FALSE
+	return ""
 }
 
-// WorkspaceMetadata is data associated with a workspace that's required for other parts of the system to function
-type WorkspaceMetadata struct {
+// GitSpec configures the Git available within the workspace
+type GitSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// owner is the ID of the Gitpod user to whom we'll bill this workspace and who we consider responsible for its content
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	// meta_id is the workspace ID of this currently running workspace instance on the "meta pool" side
-	MetaId string `protobuf:"bytes,2,opt,name=meta_id,json=metaId,proto3" json:"meta_id,omitempty"`
-	// started_at is the time when this workspace was started. Consider this field read-only, i.e. setting in a request will have no effect.
-	StartedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	// Annotations are key/value pairs that gets attached to the workspace.
-	// This is primarily intended for annotating headless workspace loads.
-	Annotations map[string]string `protobuf:"bytes,4,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// team the workspace belongs to, if the workspace is not associated with a team, this property will be empty
-	Team *string `protobuf:"bytes,5,opt,name=team,proto3,oneof" json:"team,omitempty"`
-	// project the workspace belongs to, if the workspace is not associated with a project, this property will be empty
-	Project *string `protobuf:"bytes,6,opt,name=project,proto3,oneof" json:"project,omitempty"`
-	// metrics contains metrics about the workspace
-	Metrics *WorkspaceMetadata_Metrics `protobuf:"bytes,7,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	// The Git username
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// The Git email address
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
 }
 
-func (x *WorkspaceMetadata) Reset() {
-	*x = WorkspaceMetadata{}
+func (x *GitSpec) Reset() {
+	*x = GitSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_proto_msgTypes[33]
+		mi := &file_core_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WorkspaceMetadata) String() string {
+func (x *GitSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceMetadata) ProtoMessage() {}
+func (*GitSpec) ProtoMessage() {}
 
-func (x *WorkspaceMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[33]
+func (x *GitSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2641,93 +3567,56 @@ func (x *WorkspaceMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceMetadata.ProtoReflect.Descriptor instead.
-func (*WorkspaceMetadata) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *WorkspaceMetadata) GetOwner() string {
-	if x != nil {
-		return x.Owner
-	}
-	return ""
-}
-
-func (x *WorkspaceMetadata) GetMetaId() string {
-	if x != nil {
-		return x.MetaId
-	}
-	return ""
-}
-
-func (x *WorkspaceMetadata) GetStartedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartedAt
-	}
-	return nil
+// Deprecated: Use GitSpec.ProtoReflect.Descriptor instead.
+func (*GitSpec) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *WorkspaceMetadata) GetAnnotations() map[string]string {
+func (x *GitSpec) GetUsername() string {
 	if x != nil {
-		return x.Annotations
-	}
-	return nil
-}
-
-func (x *WorkspaceMetadata) GetTeam() string {
-	if x != nil && x.Team != nil {
-		return *x.Team
-	}
-	return ""
-}
-
-func (x *WorkspaceMetadata) GetProject() string {
-	if x != nil && x.Project != nil {
-		return *x.Project
+		return x.Username
 	}
 	return ""
 }
 
-func (x *WorkspaceMetadata) GetMetrics() *WorkspaceMetadata_Metrics {
+func (x *GitSpec) GetEmail() string {
 	if x != nil {
-		return x.Metrics
+		return x.Email
 	}
-	return nil
+	return ""
 }
 
-// WorkspaceRuntimeInfo details the workspace's runtime, e.g. executing system, node other information
-// about the environment the workspace runs in. This information serves a diagnostic purpose only and
-// should not be directly acted upon.
-type WorkspaceRuntimeInfo struct {
+// EnvironmentVariable describes an env var as key/value pair
+type EnvironmentVariable struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// node_name is the name of the node the workspace runs on
-	NodeName string `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
-	// pod_name is the name of the pod the workspace runs in
-	PodName string `protobuf:"bytes,2,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
-	// node_ip is the IP of the node the workspace runs on
-	NodeIp string `protobuf:"bytes,3,opt,name=node_ip,json=nodeIp,proto3" json:"node_ip,omitempty"`
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Pulls the value from a secret in the cluster.
+	// Use this field with great caution: if the name is wrong or Kubernetes cannot find the secret,
+	// your workspace will not start. Value takes precedence over this field.
+	Secret *EnvironmentVariable_SecretKeyRef `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
 }
 
-func (x *WorkspaceRuntimeInfo) Reset() {
-	*x = WorkspaceRuntimeInfo{}
+func (x *EnvironmentVariable) Reset() {
+	*x = EnvironmentVariable{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_proto_msgTypes[34]
+		mi := &file_core_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WorkspaceRuntimeInfo) String() string {
+func (x *EnvironmentVariable) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceRuntimeInfo) ProtoMessage() {}
+func (*EnvironmentVariable) ProtoMessage() {}
 
-func (x *WorkspaceRuntimeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[34]
+func (x *EnvironmentVariable) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2738,62 +3627,59 @@ func (x *WorkspaceRuntimeInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceRuntimeInfo.ProtoReflect.Descriptor instead.
-func (*WorkspaceRuntimeInfo) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use EnvironmentVariable.ProtoReflect.Descriptor instead.
+func (*EnvironmentVariable) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *WorkspaceRuntimeInfo) GetNodeName() string {
+func (x *EnvironmentVariable) GetName() string {
 	if x != nil {
-		return x.NodeName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *WorkspaceRuntimeInfo) GetPodName() string {
+func (x *EnvironmentVariable) GetValue() string {
 	if x != nil {
-		return x.PodName
+		return x.Value
 	}
 	return ""
 }
 
-func (x *WorkspaceRuntimeInfo) GetNodeIp() string {
+func (x *EnvironmentVariable) GetSecret() *EnvironmentVariable_SecretKeyRef {
 	if x != nil {
-		return x.NodeIp
+		return x.Secret
 	}
-	return ""
+	return nil
 }
 
-// WorkspaceAuthentication contains authentication information used by ws-proxy to allow/deny access to
-// workspaces and their ports.
-type WorkspaceAuthentication struct {
+// ExposedPorts describes the exposed ports of a workspace
+type ExposedPorts struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Admission describes who can access the workspace and its ports.
-	Admission AdmissionLevel `protobuf:"varint,1,opt,name=admission,proto3,enum=wsman.AdmissionLevel" json:"admission,omitempty"`
-	// Owner token is the token one needs to access the workspace. Its presence is checked by ws-proxy.
-	OwnerToken string `protobuf:"bytes,2,opt,name=owner_token,json=ownerToken,proto3" json:"owner_token,omitempty"`
+	// ports is the set of ports which ought to be exposed to the internet
+	Ports []*PortSpec `protobuf:"bytes,1,rep,name=ports,proto3" json:"ports,omitempty"`
 }
 
-func (x *WorkspaceAuthentication) Reset() {
-	*x = WorkspaceAuthentication{}
+func (x *ExposedPorts) Reset() {
+	*x = ExposedPorts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_proto_msgTypes[35]
+		mi := &file_core_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WorkspaceAuthentication) String() string {
+func (x *ExposedPorts) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceAuthentication) ProtoMessage() {}
+func (*ExposedPorts) ProtoMessage() {}
 
-func (x *WorkspaceAuthentication) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[35]
+func (x *ExposedPorts) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2804,83 +3690,45 @@ func (x *WorkspaceAuthentication) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceAuthentication.ProtoReflect.Descriptor instead.
-func (*WorkspaceAuthentication) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *WorkspaceAuthentication) GetAdmission() AdmissionLevel {
-	if x != nil {
-		return x.Admission
-	}
-	return AdmissionLevel_ADMIT_OWNER_ONLY
+// Deprecated: Use ExposedPorts.ProtoReflect.Descriptor instead.
+func (*ExposedPorts) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *WorkspaceAuthentication) GetOwnerToken() string {
+func (x *ExposedPorts) GetPorts() []*PortSpec {
 	if x != nil {
-		return x.OwnerToken
+		return x.Ports
 	}
-	return ""
+	return nil
 }
 
-// StartWorkspaceSpec specifies the configuration of a workspace for a workspace start
-type StartWorkspaceSpec struct {
+// SSHPublicKeys describes the user's uploaded ssh public keys, it will be used only in annotations.
+type SSHPublicKeys struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// workspace_image is the Docker image name of the workspace container
-	WorkspaceImage string `protobuf:"bytes,1,opt,name=workspace_image,json=workspaceImage,proto3" json:"workspace_image,omitempty"`
-	// feature_flags provide a means for starting variants of workspaces (e.g. a privileged one)
-	FeatureFlags []WorkspaceFeatureFlag `protobuf:"varint,3,rep,packed,name=feature_flags,json=featureFlags,proto3,enum=wsman.WorkspaceFeatureFlag" json:"feature_flags,omitempty"`
-	// initializer configures how the workspace is to be initialized
-	Initializer *api.WorkspaceInitializer `protobuf:"bytes,4,opt,name=initializer,proto3" json:"initializer,omitempty"`
-	// ports is the set of ports which ought to be exposed to the internet
-	Ports []*PortSpec `protobuf:"bytes,5,rep,name=ports,proto3" json:"ports,omitempty"`
-	// envvars are user-defined environment variables which ought to be available in the workspace
-	Envvars []*EnvironmentVariable `protobuf:"bytes,6,rep,name=envvars,proto3" json:"envvars,omitempty"`
-	// workspace_location describes where the workspace root of Theia will be
-	WorkspaceLocation string `protobuf:"bytes,8,opt,name=workspace_location,json=workspaceLocation,proto3" json:"workspace_location,omitempty"`
-	// Git configures the Git user in the workspace
-	Git *GitSpec `protobuf:"bytes,9,opt,name=git,proto3" json:"git,omitempty"`
-	// timeout optionally sets a custom workspace timeout
-	Timeout string `protobuf:"bytes,10,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	// admission controlls who can access the workspace and its ports.
-	Admission AdmissionLevel `protobuf:"varint,11,opt,name=admission,proto3,enum=wsman.AdmissionLevel" json:"admission,omitempty"`
-	// ide_image is the Docker image name of the IDE image
-	IdeImage *IDEImage `protobuf:"bytes,12,opt,name=ide_image,json=ideImage,proto3" json:"ide_image,omitempty"`
-	// Class denotes the class of the workspace we ought to start
-	Class string `protobuf:"bytes,13,opt,name=class,proto3" json:"class,omitempty"`
-	// ssh_public_keys is user's uploaded ssh public keys
-	SshPublicKeys []string `protobuf:"bytes,15,rep,name=ssh_public_keys,json=sshPublicKeys,proto3" json:"ssh_public_keys,omitempty"`
-	// sys_envvars are system level environment variables which ought to be available in the workspace
-	SysEnvvars []*EnvironmentVariable `protobuf:"bytes,16,rep,name=sys_envvars,json=sysEnvvars,proto3" json:"sys_envvars,omitempty"`
-	// ide_image_layers are contains the images needed for the ide to run,
-	// including ide-desktop, desktop-plugin and so on
-	IdeImageLayers []string `protobuf:"bytes,17,rep,name=ide_image_layers,json=ideImageLayers,proto3" json:"ide_image_layers,omitempty"`
-	// timeout optionally sets a custom closed timeout
-	ClosedTimeout string `protobuf:"bytes,18,opt,name=closed_timeout,json=closedTimeout,proto3" json:"closed_timeout,omitempty"`
-	// maximum lifetime of the workspace
-	MaximumLifetime string `protobuf:"bytes,19,opt,name=maximum_lifetime,json=maximumLifetime,proto3" json:"maximum_lifetime,omitempty"`
+	// keys is the set of ssh public key
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
-func (x *StartWorkspaceSpec) Reset() {
-	*x = StartWorkspaceSpec{}
+func (x *SSHPublicKeys) Reset() {
+	*x = SSHPublicKeys{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_proto_msgTypes[36]
+		mi := &file_core_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StartWorkspaceSpec) String() string {
+func (x *SSHPublicKeys) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartWorkspaceSpec) ProtoMessage() {}
+func (*SSHPublicKeys) ProtoMessage() {}
 
-func (x *StartWorkspaceSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[36]
+func (x *SSHPublicKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2891,152 +3739,168 @@ func (x *StartWorkspaceSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartWorkspaceSpec.ProtoReflect.Descriptor instead.
-func (*StartWorkspaceSpec) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{36}
-}
-
-func (x *StartWorkspaceSpec) GetWorkspaceImage() string {
-	if x != nil {
-		return x.WorkspaceImage
-	}
-	return ""
+// Deprecated: Use SSHPublicKeys.ProtoReflect.Descriptor instead.
+func (*SSHPublicKeys) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *StartWorkspaceSpec) GetFeatureFlags() []WorkspaceFeatureFlag {
+func (x *SSHPublicKeys) GetKeys() []string {
 	if x != nil {
-		return x.FeatureFlags
+		return x.Keys
 	}
 	return nil
 }
 
-func (x *StartWorkspaceSpec) GetInitializer() *api.WorkspaceInitializer {
-	if x != nil {
-		return x.Initializer
-	}
-	return nil
+// DescribeClusterRequest requests information about the cluster
+type DescribeClusterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *StartWorkspaceSpec) GetPorts() []*PortSpec {
-	if x != nil {
-		return x.Ports
+func (x *DescribeClusterRequest) Reset() {
+	*x = DescribeClusterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *StartWorkspaceSpec) GetEnvvars() []*EnvironmentVariable {
-	if x != nil {
-		return x.Envvars
-	}
-	return nil
+func (x *DescribeClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StartWorkspaceSpec) GetWorkspaceLocation() string {
-	if x != nil {
-		return x.WorkspaceLocation
+func (*DescribeClusterRequest) ProtoMessage() {}
+
+func (x *DescribeClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *StartWorkspaceSpec) GetGit() *GitSpec {
-	if x != nil {
-		return x.Git
-	}
-	return nil
+// Deprecated: Use DescribeClusterRequest.ProtoReflect.Descriptor instead.
+func (*DescribeClusterRequest) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *StartWorkspaceSpec) GetTimeout() string {
-	if x != nil {
-		return x.Timeout
-	}
-	return ""
+// DescribeClusterResponse is the answer to a DescribeClusterRequest
+type DescribeClusterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// workspace classes that are supported by the cluster
+	WorkspaceClasses []*WorkspaceClass `protobuf:"bytes,1,rep,name=workspace_classes,json=workspaceClasses,proto3" json:"workspace_classes,omitempty"`
+	// preferred_workspace_class is the workspace class that is preferred by the cluster (consider this the "default" workspace class)
+	PreferredWorkspaceClass string `protobuf:"bytes,2,opt,name=preferred_workspace_class,json=preferredWorkspaceClass,proto3" json:"preferred_workspace_class,omitempty"`
+	// workspace_quotas are the quotas of organizations in this cluster and how much of them they use
+	WorkspaceQuotas []*WorkspaceQuota `protobuf:"bytes,3,rep,name=workspace_quotas,json=workspaceQuotas,proto3" json:"workspace_quotas,omitempty"`
+	// organization_timeouts are the timeouts of organizations which differ from those of the workspace classes
+	OrganizationTimeouts []*OrganizationTimeouts `protobuf:"bytes,4,rep,name=organization_timeouts,json=organizationTimeouts,proto3" json:"organization_timeouts,omitempty"`
 }
 
-func (x *StartWorkspaceSpec) GetAdmission() AdmissionLevel {
-	if x != nil {
-		return x.Admission
+func (x *DescribeClusterResponse) Reset() {
+	*x = DescribeClusterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return AdmissionLevel_ADMIT_OWNER_ONLY
 }
 
-func (x *StartWorkspaceSpec) GetIdeImage() *IDEImage {
-	if x != nil {
-		return x.IdeImage
-	}
-	return nil
+func (x *DescribeClusterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StartWorkspaceSpec) GetClass() string {
-	if x != nil {
-		return x.Class
+func (*DescribeClusterResponse) ProtoMessage() {}
+
+func (x *DescribeClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *StartWorkspaceSpec) GetSshPublicKeys() []string {
-	if x != nil {
-		return x.SshPublicKeys
-	}
-	return nil
+// Deprecated: Use DescribeClusterResponse.ProtoReflect.Descriptor instead.
+func (*DescribeClusterResponse) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *StartWorkspaceSpec) GetSysEnvvars() []*EnvironmentVariable {
+func (x *DescribeClusterResponse) GetWorkspaceClasses() []*WorkspaceClass {
 	if x != nil {
-		return x.SysEnvvars
+		return x.WorkspaceClasses
 	}
 	return nil
 }
 
-func (x *StartWorkspaceSpec) GetIdeImageLayers() []string {
+func (x *DescribeClusterResponse) GetPreferredWorkspaceClass() string {
 	if x != nil {
-		return x.IdeImageLayers
+		return x.PreferredWorkspaceClass
 	}
-	return nil
+	return ""
 }
 
-func (x *StartWorkspaceSpec) GetClosedTimeout() string {
+func (x *DescribeClusterResponse) GetWorkspaceQuotas() []*WorkspaceQuota {
 	if x != nil {
-		return x.ClosedTimeout
+		return x.WorkspaceQuotas
 	}
-	return ""
+	return nil
 }
 
-func (x *StartWorkspaceSpec) GetMaximumLifetime() string {
+func (x *DescribeClusterResponse) GetOrganizationTimeouts() []*OrganizationTimeouts {
 	if x != nil {
-		return x.MaximumLifetime
+		return x.OrganizationTimeouts
 	}
-	return ""
+	return nil
 }
 
-// GitSpec configures the Git available within the workspace
-type GitSpec struct {
+// WorkspaceClass describes a workspace class that is supported by the cluster
+type WorkspaceClass struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The Git username
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	// The Git email address
-	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// ID is a unique identifier (within the cluster) of this workspace class
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The string we display to users in the UI
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// The description of this workspace class
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The cost of running a workspace of this class per minute expressed in credits
+	CreditsPerMinute float32 `protobuf:"fixed32,4,opt,name=credits_per_minute,json=creditsPerMinute,proto3" json:"credits_per_minute,omitempty"`
+	// timeouts are the timeouts of regular workspaces of this class
+	Timeouts *WorkspaceTimeouts `protobuf:"bytes,5,opt,name=timeouts,proto3" json:"timeouts,omitempty"`
 }
 
-func (x *GitSpec) Reset() {
-	*x = GitSpec{}
+func (x *WorkspaceClass) Reset() {
+	*x = WorkspaceClass{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_proto_msgTypes[37]
+		mi := &file_core_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GitSpec) String() string {
+func (x *WorkspaceClass) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitSpec) ProtoMessage() {}
+func (*WorkspaceClass) ProtoMessage() {}
 
-func (x *GitSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[37]
+func (x *WorkspaceClass) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3047,56 +3911,82 @@ func (x *GitSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitSpec.ProtoReflect.Descriptor instead.
-func (*GitSpec) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use WorkspaceClass.ProtoReflect.Descriptor instead.
+func (*WorkspaceClass) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GitSpec) GetUsername() string {
+func (x *WorkspaceClass) GetId() string {
 	if x != nil {
-		return x.Username
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GitSpec) GetEmail() string {
+func (x *WorkspaceClass) GetDisplayName() string {
 	if x != nil {
-		return x.Email
+		return x.DisplayName
 	}
 	return ""
 }
 
-// EnvironmentVariable describes an env var as key/value pair
-type EnvironmentVariable struct {
+func (x *WorkspaceClass) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WorkspaceClass) GetCreditsPerMinute() float32 {
+	if x != nil {
+		return x.CreditsPerMinute
+	}
+	return 0
+}
+
+func (x *WorkspaceClass) GetTimeouts() *WorkspaceTimeouts {
+	if x != nil {
+		return x.Timeouts
+	}
+	return nil
+}
+
+// WorkspaceTimeouts describes the timeouts regular workspaces are subject to. All values are Go durations
+// (see https://golang.org/pkg/time/#ParseDuration). An empty limit is not enforced.
+type WorkspaceTimeouts struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	// Pulls the value from a secret in the cluster.
-	// Use this field with great caution: if the name is wrong or Kubernetes cannot find the secret,
-	// your workspace will not start. Value takes precedence over this field.
-	Secret *EnvironmentVariable_SecretKeyRef `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	// regular_workspace is the default time a workspace can be without activity before it's stopped
+	RegularWorkspace string `protobuf:"bytes,1,opt,name=regular_workspace,json=regularWorkspace,proto3" json:"regular_workspace,omitempty"`
+	// regular_workspace_limit is the longest inactivity timeout a workspace can be started with or extended to
+	RegularWorkspaceLimit string `protobuf:"bytes,2,opt,name=regular_workspace_limit,json=regularWorkspaceLimit,proto3" json:"regular_workspace_limit,omitempty"`
+	// after_close is the default time a workspace lives after it has been closed
+	AfterClose string `protobuf:"bytes,3,opt,name=after_close,json=afterClose,proto3" json:"after_close,omitempty"`
+	// max_lifetime is the default maximum lifetime of a workspace
+	MaxLifetime string `protobuf:"bytes,4,opt,name=max_lifetime,json=maxLifetime,proto3" json:"max_lifetime,omitempty"`
+	// max_lifetime_limit is the longest maximum lifetime a workspace can be started with
+	MaxLifetimeLimit string `protobuf:"bytes,5,opt,name=max_lifetime_limit,json=maxLifetimeLimit,proto3" json:"max_lifetime_limit,omitempty"`
 }
 
-func (x *EnvironmentVariable) Reset() {
-	*x = EnvironmentVariable{}
+func (x *WorkspaceTimeouts) Reset() {
+	*x = WorkspaceTimeouts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_proto_msgTypes[38]
+		mi := &file_core_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnvironmentVariable) String() string {
+func (x *WorkspaceTimeouts) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnvironmentVariable) ProtoMessage() {}
+func (*WorkspaceTimeouts) ProtoMessage() {}
 
-func (x *EnvironmentVariable) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[38]
+func (x *WorkspaceTimeouts) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3107,59 +3997,75 @@ func (x *EnvironmentVariable) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnvironmentVariable.ProtoReflect.Descriptor instead.
-func (*EnvironmentVariable) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use WorkspaceTimeouts.ProtoReflect.Descriptor instead.
+func (*WorkspaceTimeouts) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *EnvironmentVariable) GetName() string {
+func (x *WorkspaceTimeouts) GetRegularWorkspace() string {
 	if x != nil {
-		return x.Name
+		return x.RegularWorkspace
 	}
 	return ""
 }
 
-func (x *EnvironmentVariable) GetValue() string {
+func (x *WorkspaceTimeouts) GetRegularWorkspaceLimit() string {
 	if x != nil {
-		return x.Value
+		return x.RegularWorkspaceLimit
 	}
 	return ""
 }
 
-func (x *EnvironmentVariable) GetSecret() *EnvironmentVariable_SecretKeyRef {
+func (x *WorkspaceTimeouts) GetAfterClose() string {
 	if x != nil {
-		return x.Secret
+		return x.AfterClose
 	}
-	return nil
+	return ""
 }
 
-// ExposedPorts describes the exposed ports of a workspace
-type ExposedPorts struct {
+func (x *WorkspaceTimeouts) GetMaxLifetime() string {
+	if x != nil {
+		return x.MaxLifetime
+	}
+	return ""
+}
+
+func (x *WorkspaceTimeouts) GetMaxLifetimeLimit() string {
+	if x != nil {
+		return x.MaxLifetimeLimit
+	}
+	return ""
+}
+
+// OrganizationTimeouts are the timeouts of the workspaces of an organization in a workspace class
+type OrganizationTimeouts struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ports is the set of ports which ought to be exposed to the internet
-	Ports []*PortSpec `protobuf:"bytes,1,rep,name=ports,proto3" json:"ports,omitempty"`
+	// team is the organization the timeouts apply to
+	Team           string             `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	WorkspaceClass string             `protobuf:"bytes,2,opt,name=workspace_class,json=workspaceClass,proto3" json:"workspace_class,omitempty"`
+	Timeouts       *WorkspaceTimeouts `protobuf:"bytes,3,opt,name=timeouts,proto3" json:"timeouts,omitempty"`
 }
 
-func (x *ExposedPorts) Reset() {
-	*x = ExposedPorts{}
+func (x *OrganizationTimeouts) Reset() {
+	*x = OrganizationTimeouts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_proto_msgTypes[39]
+		mi := &file_core_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExposedPorts) String() string {
+func (x *OrganizationTimeouts) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExposedPorts) ProtoMessage() {}
+func (*OrganizationTimeouts) ProtoMessage() {}
 
-func (x *ExposedPorts) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[39]
+func (x *OrganizationTimeouts) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3170,45 +4076,72 @@ func (x *ExposedPorts) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExposedPorts.ProtoReflect.Descriptor instead.
-func (*ExposedPorts) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use OrganizationTimeouts.ProtoReflect.Descriptor instead.
+func (*OrganizationTimeouts) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ExposedPorts) GetPorts() []*PortSpec {
+func (x *OrganizationTimeouts) GetTeam() string {
 	if x != nil {
-		return x.Ports
+		return x.Team
+	}
+	return ""
+}
+
+func (x *OrganizationTimeouts) GetWorkspaceClass() string {
+	if x != nil {
+		return x.WorkspaceClass
+	}
+	return ""
+}
+
+func (x *OrganizationTimeouts) GetTimeouts() *WorkspaceTimeouts {
+	if x != nil {
+		return x.Timeouts
 	}
 	return nil
 }
 
-// SSHPublicKeys describes the user's uploaded ssh public keys, it will be used only in annotations.
-type SSHPublicKeys struct {
+// WorkspaceQuota describes the limits the workspaces of an organization are subject to, and how much of them they use.
+// A limit of zero is not enforced.
+type WorkspaceQuota struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// keys is the set of ssh public key
-	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
-}
-
-func (x *SSHPublicKeys) Reset() {
-	*x = SSHPublicKeys{}
+	// team is the organization the quota applies to
+	Team string `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	// running_workspaces_limit is the number of regular workspaces the organization can run at the same time
+	RunningWorkspacesLimit int32 `protobuf:"varint,2,opt,name=running_workspaces_limit,json=runningWorkspacesLimit,proto3" json:"running_workspaces_limit,omitempty"`
+	// running_workspaces is the number of regular workspaces the organization currently runs
+	RunningWorkspaces int32 `protobuf:"varint,3,opt,name=running_workspaces,json=runningWorkspaces,proto3" json:"running_workspaces,omitempty"`
+	// prebuilds_limit is the number of prebuilds the organization can run at the same time
+	PrebuildsLimit int32 `protobuf:"varint,4,opt,name=prebuilds_limit,json=prebuildsLimit,proto3" json:"prebuilds_limit,omitempty"`
+	// prebuilds is the number of prebuilds the organization currently runs
+	Prebuilds int32 `protobuf:"varint,5,opt,name=prebuilds,proto3" json:"prebuilds,omitempty"`
+	// classes limit the number of workspaces of a particular workspace class
+	Classes []*WorkspaceQuotaClass `protobuf:"bytes,6,rep,name=classes,proto3" json:"classes,omitempty"`
+	// resources limit the total amount of resources the workspaces of the organization request
+	Resources []*WorkspaceQuotaResource `protobuf:"bytes,7,rep,name=resources,proto3" json:"resources,omitempty"`
+}
+
+func (x *WorkspaceQuota) Reset() {
+	*x = WorkspaceQuota{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_proto_msgTypes[40]
+		mi := &file_core_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SSHPublicKeys) String() string {
+func (x *WorkspaceQuota) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SSHPublicKeys) ProtoMessage() {}
+func (*WorkspaceQuota) ProtoMessage() {}
 
-func (x *SSHPublicKeys) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[40]
+func (x *WorkspaceQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3219,86 +4152,88 @@ func (x *SSHPublicKeys) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SSHPublicKeys.ProtoReflect.Descriptor instead.
-func (*SSHPublicKeys) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use WorkspaceQuota.ProtoReflect.Descriptor instead.
+func (*WorkspaceQuota) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *SSHPublicKeys) GetKeys() []string {
+func (x *WorkspaceQuota) GetTeam() string {
 	if x != nil {
-		return x.Keys
+		return x.Team
 	}
-	return nil
+	return ""
 }
 
-// DescribeClusterRequest requests information about the cluster
-type DescribeClusterRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *WorkspaceQuota) GetRunningWorkspacesLimit() int32 {
+	if x != nil {
+		return x.RunningWorkspacesLimit
+	}
+	return 0
 }
 
-func (x *DescribeClusterRequest) Reset() {
-	*x = DescribeClusterRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_core_proto_msgTypes[41]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *WorkspaceQuota) GetRunningWorkspaces() int32 {
+	if x != nil {
+		return x.RunningWorkspaces
 	}
+	return 0
 }
 
-func (x *DescribeClusterRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *WorkspaceQuota) GetPrebuildsLimit() int32 {
+	if x != nil {
+		return x.PrebuildsLimit
+	}
+	return 0
 }
 
-func (*DescribeClusterRequest) ProtoMessage() {}
+func (x *WorkspaceQuota) GetPrebuilds() int32 {
+	if x != nil {
+		return x.Prebuilds
+	}
+	return 0
+}
 
-func (x *DescribeClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[41]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *WorkspaceQuota) GetClasses() []*WorkspaceQuotaClass {
+	if x != nil {
+		return x.Classes
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use DescribeClusterRequest.ProtoReflect.Descriptor instead.
-func (*DescribeClusterRequest) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{41}
+func (x *WorkspaceQuota) GetResources() []*WorkspaceQuotaResource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
 }
 
-// DescribeClusterResponse is the answer to a DescribeClusterRequest
-type DescribeClusterResponse struct {
+// WorkspaceQuotaClass is the limit on workspaces of a workspace class
+type WorkspaceQuotaClass struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// workspace classes that are supported by the cluster
-	WorkspaceClasses []*WorkspaceClass `protobuf:"bytes,1,rep,name=workspace_classes,json=workspaceClasses,proto3" json:"workspace_classes,omitempty"`
-	// preferred_workspace_class is the workspace class that is preferred by the cluster (consider this the "default" workspace class)
-	PreferredWorkspaceClass string `protobuf:"bytes,2,opt,name=preferred_workspace_class,json=preferredWorkspaceClass,proto3" json:"preferred_workspace_class,omitempty"`
+	WorkspaceClass string `protobuf:"bytes,1,opt,name=workspace_class,json=workspaceClass,proto3" json:"workspace_class,omitempty"`
+	Limit          int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Used           int32  `protobuf:"varint,3,opt,name=used,proto3" json:"used,omitempty"`
 }
 
-func (x *DescribeClusterResponse) Reset() {
-	*x = DescribeClusterResponse{}
+func (x *WorkspaceQuotaClass) Reset() {
+	*x = WorkspaceQuotaClass{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_proto_msgTypes[42]
+		mi := &file_core_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DescribeClusterResponse) String() string {
+func (x *WorkspaceQuotaClass) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeClusterResponse) ProtoMessage() {}
+func (*WorkspaceQuotaClass) ProtoMessage() {}
 
-func (x *DescribeClusterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[42]
+func (x *WorkspaceQuotaClass) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3309,58 +4244,60 @@ func (x *DescribeClusterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeClusterResponse.ProtoReflect.Descriptor instead.
-func (*DescribeClusterResponse) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use WorkspaceQuotaClass.ProtoReflect.Descriptor instead.
+func (*WorkspaceQuotaClass) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *DescribeClusterResponse) GetWorkspaceClasses() []*WorkspaceClass {
+func (x *WorkspaceQuotaClass) GetWorkspaceClass() string {
 	if x != nil {
-		return x.WorkspaceClasses
+		return x.WorkspaceClass
 	}
-	return nil
+	return ""
 }
 
-func (x *DescribeClusterResponse) GetPreferredWorkspaceClass() string {
+func (x *WorkspaceQuotaClass) GetLimit() int32 {
 	if x != nil {
-		return x.PreferredWorkspaceClass
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-// WorkspaceClass describes a workspace class that is supported by the cluster
-type WorkspaceClass struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// ID is a unique identifier (within the cluster) of this workspace class
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The string we display to users in the UI
-	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	// The description of this workspace class
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// The cost of running a workspace of this class per minute expressed in credits
-	CreditsPerMinute float32 `protobuf:"fixed32,4,opt,name=credits_per_minute,json=creditsPerMinute,proto3" json:"credits_per_minute,omitempty"`
+func (x *WorkspaceQuotaClass) GetUsed() int32 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
 }
 
-func (x *WorkspaceClass) Reset() {
-	*x = WorkspaceClass{}
+// WorkspaceQuotaResource is the limit on a resource, e.g. cpu or memory. Limit and use are Kubernetes quantities.
+type WorkspaceQuotaResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Limit string `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Used  string `protobuf:"bytes,3,opt,name=used,proto3" json:"used,omitempty"`
+}
+
+func (x *WorkspaceQuotaResource) Reset() {
+	*x = WorkspaceQuotaResource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_proto_msgTypes[43]
+		mi := &file_core_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WorkspaceClass) String() string {
+func (x *WorkspaceQuotaResource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceClass) ProtoMessage() {}
+func (*WorkspaceQuotaResource) ProtoMessage() {}
 
-func (x *WorkspaceClass) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[43]
+func (x *WorkspaceQuotaResource) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3371,55 +4308,48 @@ func (x *WorkspaceClass) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceClass.ProtoReflect.Descriptor instead.
-func (*WorkspaceClass) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use WorkspaceQuotaResource.ProtoReflect.Descriptor instead.
+func (*WorkspaceQuotaResource) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *WorkspaceClass) GetId() string {
+func (x *WorkspaceQuotaResource) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-func (x *WorkspaceClass) GetDisplayName() string {
+func (x *WorkspaceQuotaResource) GetLimit() string {
 	if x != nil {
-		return x.DisplayName
+		return x.Limit
 	}
 	return ""
 }
 
-func (x *WorkspaceClass) GetDescription() string {
+func (x *WorkspaceQuotaResource) GetUsed() string {
 	if x != nil {
-		return x.Description
+		return x.Used
 	}
 	return ""
 }
 
-func (x *WorkspaceClass) GetCreditsPerMinute() float32 {
-	if x != nil {
-		return x.CreditsPerMinute
-	}
-	return 0
-}
-
 // Add these new message definitions
 type InitializerMetric struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Duration in nanoseconds (standard protobuf duration)
+	// duration in nanoseconds (standard protobuf duration)
 	Duration *durationpb.Duration `protobuf:"bytes,1,opt,name=duration,proto3" json:"duration,omitempty"`
-	// Size in bytes
+	// size in bytes
 	Size uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
 }
 
 func (x *InitializerMetric) Reset() {
 	*x = InitializerMetric{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_proto_msgTypes[44]
+		mi := &file_core_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3432,7 +4362,7 @@ func (x *InitializerMetric) String() string {
 func (*InitializerMetric) ProtoMessage() {}
 
 func (x *InitializerMetric) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[44]
+	mi := &file_core_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3445,7 +4375,7 @@ func (x *InitializerMetric) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitializerMetric.ProtoReflect.Descriptor instead.
 func (*InitializerMetric) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{44}
+	return file_core_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *InitializerMetric) GetDuration() *durationpb.Duration {
@@ -3467,25 +4397,25 @@ type InitializerMetrics struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Git contains metrics for the git initializer step
+	// git contains metrics for the git initializer step
 	Git *InitializerMetric `protobuf:"bytes,1,opt,name=git,proto3" json:"git,omitempty"`
-	// FileDownload contains metrics for the file download initializer step
+	// file_download contains metrics for the file download initializer step
 	FileDownload *InitializerMetric `protobuf:"bytes,2,opt,name=file_download,json=fileDownload,proto3" json:"file_download,omitempty"`
-	// Snapshot contains metrics for the snapshot initializer step
+	// snapshot contains metrics for the snapshot initializer step
 	// This used for workspaces started from snapshots.
 	Snapshot *InitializerMetric `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
-	// Backup contains metrics for the backup initializer step
+	// backup contains metrics for the backup initializer step
 	Backup *InitializerMetric `protobuf:"bytes,4,opt,name=backup,proto3" json:"backup,omitempty"`
-	// Prebuild contains metrics for the prebuild initializer step
+	// prebuild contains metrics for the prebuild initializer step
 	Prebuild *InitializerMetric `protobuf:"bytes,5,opt,name=prebuild,proto3" json:"prebuild,omitempty"`
-	// Composite contains metrics for the composite initializer step
+	// composite contains metrics for the composite initializer step
 	Composite *InitializerMetric `protobuf:"bytes,6,opt,name=composite,proto3" json:"composite,omitempty"`
 }
 
 func (x *InitializerMetrics) Reset() {
 	*x = InitializerMetrics{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_proto_msgTypes[45]
+		mi := &file_core_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3498,7 +4428,7 @@ func (x *InitializerMetrics) String() string {
 func (*InitializerMetrics) ProtoMessage() {}
 
 func (x *InitializerMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[45]
+	mi := &file_core_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3511,7 +4441,7 @@ func (x *InitializerMetrics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitializerMetrics.ProtoReflect.Descriptor instead.
 func (*InitializerMetrics) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{45}
+	return file_core_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *InitializerMetrics) GetGit() *InitializerMetric {
@@ -3570,7 +4500,7 @@ type WorkspaceMetadata_ImageInfo struct {
 func (x *WorkspaceMetadata_ImageInfo) Reset() {
 	*x = WorkspaceMetadata_ImageInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_proto_msgTypes[48]
+		mi := &file_core_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3583,7 +4513,7 @@ func (x *WorkspaceMetadata_ImageInfo) String() string {
 func (*WorkspaceMetadata_ImageInfo) ProtoMessage() {}
 
 func (x *WorkspaceMetadata_ImageInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[48]
+	mi := &file_core_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3596,7 +4526,7 @@ func (x *WorkspaceMetadata_ImageInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkspaceMetadata_ImageInfo.ProtoReflect.Descriptor instead.
 func (*WorkspaceMetadata_ImageInfo) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{33, 0}
+	return file_core_proto_rawDescGZIP(), []int{41, 0}
 }
 
 func (x *WorkspaceMetadata_ImageInfo) GetTotalSize() int64 {
@@ -3624,7 +4554,7 @@ type WorkspaceMetadata_Metrics struct {
 func (x *WorkspaceMetadata_Metrics) Reset() {
 	*x = WorkspaceMetadata_Metrics{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_proto_msgTypes[49]
+		mi := &file_core_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3637,7 +4567,7 @@ func (x *WorkspaceMetadata_Metrics) String() string {
 func (*WorkspaceMetadata_Metrics) ProtoMessage() {}
 
 func (x *WorkspaceMetadata_Metrics) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[49]
+	mi := &file_core_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3650,7 +4580,7 @@ func (x *WorkspaceMetadata_Metrics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkspaceMetadata_Metrics.ProtoReflect.Descriptor instead.
 func (*WorkspaceMetadata_Metrics) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{33, 1}
+	return file_core_proto_rawDescGZIP(), []int{41, 1}
 }
 
 func (x *WorkspaceMetadata_Metrics) GetImage() *WorkspaceMetadata_ImageInfo {
@@ -3672,7 +4602,7 @@ type EnvironmentVariable_SecretKeyRef struct {
 func (x *EnvironmentVariable_SecretKeyRef) Reset() {
 	*x = EnvironmentVariable_SecretKeyRef{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_proto_msgTypes[51]
+		mi := &file_core_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3685,7 +4615,7 @@ func (x *EnvironmentVariable_SecretKeyRef) String() string {
 func (*EnvironmentVariable_SecretKeyRef) ProtoMessage() {}
 
 func (x *EnvironmentVariable_SecretKeyRef) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[51]
+	mi := &file_core_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3698,7 +4628,7 @@ func (x *EnvironmentVariable_SecretKeyRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnvironmentVariable_SecretKeyRef.ProtoReflect.Descriptor instead.
 func (*EnvironmentVariable_SecretKeyRef) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{38, 0}
+	return file_core_proto_rawDescGZIP(), []int{46, 0}
 }
 
 func (x *EnvironmentVariable_SecretKeyRef) GetSecretName() string {
@@ -3816,469 +4746,617 @@ var file_core_proto_rawDesc = []byte{
 	0x12, 0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
 	0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x54, 0x79,
 	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x54,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x61,
-	0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x04,
-	0x73, 0x70, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x77, 0x73, 0x6d,
-	0x61, 0x6e, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65,
-	0x63, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6f, 0x72, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x13, 0x54, 0x61, 0x6b, 0x65,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x2d, 0x0a, 0x12, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69,
-	0x61, 0x74, 0x65, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x72, 0x65, 0x74,
-	0x75, 0x72, 0x6e, 0x49, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x6c, 0x79, 0x22, 0x28,
-	0x0a, 0x14, 0x54, 0x61, 0x6b, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x56, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x22, 0x1a, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa2, 0x01, 0x0a,
-	0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x6e, 0x61,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e,
+	0x0a, 0x16, 0x53, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x19,
+	0x0a, 0x17, 0x53, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x17, 0x4d, 0x69,
+	0x67, 0x72, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x76, 0x76, 0x61, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x45,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x76, 0x61, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x73,
+	0x79, 0x73, 0x5f, 0x65, 0x6e, 0x76, 0x76, 0x61, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0a, 0x73, 0x79,
+	0x73, 0x45, 0x6e, 0x76, 0x76, 0x61, 0x72, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x4d, 0x69, 0x67, 0x72,
+	0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9f, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x63, 0x0a, 0x1a, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x70, 0x6f, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x65, 0x5f, 0x70, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x65, 0x50, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x22, 0x61, 0x0a, 0x12, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x73, 0x70, 0x65,
+	0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e,
+	0x50, 0x6f, 0x72, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x22, 0x15,
+	0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x13, 0x54, 0x61, 0x6b, 0x65, 0x53, 0x6e, 0x61,
 	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d,
-	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x6c,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x66, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x77, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x77, 0x73, 0x54, 0x79, 0x70,
-	0x65, 0x22, 0x3f, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x77, 0x61, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x22, 0x28, 0x0a, 0x16, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x57, 0x6f, 0x72, 0x6b,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2b, 0x0a, 0x17,
-	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x39, 0x0a, 0x13, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
-	0x6b, 0x65, 0x79, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x53,
-	0x48, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x91, 0x04, 0x0a,
-	0x0f, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x12,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65,
+	0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x49, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x6c, 0x79, 0x22, 0x28, 0x0a, 0x14, 0x54,
+	0x61, 0x6b, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x56, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x73, 0x6d, 0x61,
-	0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a,
-	0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x73,
-	0x6d, 0x61, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x70, 0x65,
-	0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x2b, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57,
-	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70,
-	0x68, 0x61, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e,
+	0x12, 0x2b, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x1a, 0x0a,
+	0x18, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa2, 0x01, 0x0a, 0x1b, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x6f, 0x66, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12,
+	0x2d, 0x0a, 0x07, 0x77, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x14, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x77, 0x73, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3f,
+	0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x77, 0x61, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x77, 0x61, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22,
+	0x28, 0x0a, 0x16, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2b, 0x0a, 0x17, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x39, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79,
+	0x73, 0x22, 0x16, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x53, 0x48, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xff, 0x04, 0x0a, 0x0f, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x04, 0x73, 0x70,
+	0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e,
+	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04,
+	0x73, 0x70, 0x65, 0x63, 0x12, 0x2b, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73,
+	0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x32, 0x0a,
+	0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x73,
+	0x6d, 0x61, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x61, 0x75, 0x74,
+	0x68, 0x12, 0x4a, 0x0a, 0x13, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72,
+	0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x12, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x31, 0x0a,
+	0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x12, 0x39, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0d, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x7b, 0x0a, 0x10, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x27, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x69, 0x7a, 0x65,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x88, 0x01, 0x0a, 0x16, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x22, 0x56, 0x0a, 0x08, 0x49, 0x44, 0x45, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x77, 0x65, 0x62, 0x52, 0x65, 0x66, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x75, 0x70, 0x65,
+	0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x66, 0x4a,
+	0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0xa0, 0x03, 0x0a, 0x0d,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x27, 0x0a,
+	0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x65, 0x61, 0x64, 0x6c, 0x65,
+	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x68, 0x65, 0x61, 0x64, 0x6c, 0x65,
+	0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x34, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x77, 0x73,
+	0x6d, 0x61, 0x6e, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0c, 0x65, 0x78,
+	0x70, 0x6f, 0x73, 0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e,
+	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2c,
+	0x0a, 0x09, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x49, 0x44, 0x45, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x08, 0x69, 0x64, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64,
+	0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x9e,
+	0x01, 0x0a, 0x08, 0x50, 0x6f, 0x72, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x35, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x50, 0x6f, 0x72, 0x74,
+	0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x2f, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x73, 0x6d,
+	0x61, 0x6e, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22,
+	0x7c, 0x0a, 0x12, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x30, 0x0a, 0x14, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0x87, 0x06,
+	0x0a, 0x13, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x44, 0x0a, 0x0e, 0x70, 0x75, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1d, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x0d,
+	0x70, 0x75, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x51, 0x0a, 0x15, 0x66, 0x69, 0x6e,
+	0x61, 0x6c, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e,
 	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x72, 0x65,
-	0x70, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x69, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x73, 0x6d,
-	0x61, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x12, 0x32, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x13, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x42, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x08,
+	0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d,
 	0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04,
-	0x61, 0x75, 0x74, 0x68, 0x12, 0x4a, 0x0a, 0x13, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69,
-	0x7a, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x6c, 0x69, 0x7a, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x12, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
-	0x22, 0x56, 0x0a, 0x08, 0x49, 0x44, 0x45, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07,
-	0x77, 0x65, 0x62, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77,
-	0x65, 0x62, 0x52, 0x65, 0x66, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69,
-	0x73, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73,
-	0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x66, 0x4a, 0x04, 0x08, 0x02,
-	0x10, 0x03, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0xfb, 0x02, 0x0a, 0x0d, 0x57, 0x6f, 0x72,
-	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x27, 0x0a, 0x0f, 0x77, 0x6f,
-	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x65, 0x61, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x68, 0x65, 0x61, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x12,
-	0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
-	0x6c, 0x12, 0x34, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e,
-	0x2e, 0x50, 0x6f, 0x72, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x6f, 0x73,
-	0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57, 0x6f,
-	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x69,
-	0x64, 0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x49, 0x44, 0x45, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
-	0x08, 0x69, 0x64, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61,
-	0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12,
-	0x28, 0x0a, 0x10, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x6f,
-	0x73, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x9e, 0x01, 0x0a, 0x08, 0x50, 0x6f, 0x72, 0x74, 0x53,
-	0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62,
-	0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x73,
-	0x6d, 0x61, 0x6e, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
-	0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
-	0x12, 0x2f, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x7c, 0x0a, 0x12, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x30, 0x0a,
-	0x14, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x76, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x34, 0x0a, 0x16, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x14, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48,
-	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0xd0, 0x05, 0x0a, 0x13, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a,
-	0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
-	0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
-	0x44, 0x0a, 0x0e, 0x70, 0x75, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e,
-	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x0d, 0x70, 0x75, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x12, 0x51, 0x0a, 0x15, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x75,
-	0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1d, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x6f, 0x6c, 0x52,
-	0x13, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x43, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57,
-	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x08, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x12,
-	0x49, 0x0a, 0x11, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x72,
-	0x65, 0x61, 0x64, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x77, 0x73, 0x6d,
-	0x61, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x64,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x4a, 0x0a, 0x13, 0x66, 0x69,
-	0x72, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
-	0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x11, 0x66, 0x69, 0x72, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x14, 0x68, 0x65, 0x61, 0x64, 0x6c, 0x65,
-	0x73, 0x73, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x68, 0x65, 0x61, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x54, 0x61,
-	0x73, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x4b, 0x0a, 0x12, 0x73, 0x74, 0x6f, 0x70,
-	0x70, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57, 0x6f, 0x72,
-	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x6f, 0x6f, 0x6c, 0x52, 0x10, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x42, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x0f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f,
-	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x61, 0x62, 0x6f,
-	0x72, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x77, 0x73, 0x6d,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x08, 0x64,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x12, 0x49, 0x0a, 0x11, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x52, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x52, 0x65, 0x61,
+	0x64, 0x79, 0x12, 0x4a, 0x0a, 0x13, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x30,
+	0x0a, 0x14, 0x68, 0x65, 0x61, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x68, 0x65,
+	0x61, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x12, 0x4b, 0x0a, 0x12, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x77,
+	0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x10, 0x73, 0x74, 0x6f,
+	0x70, 0x70, 0x65, 0x64, 0x42, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a,
+	0x0f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x12, 0x37, 0x0a, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x52, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x70, 0x61,
+	0x75, 0x73, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x77, 0x73, 0x6d,
 	0x61, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x64,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74,
-	0x65, 0x64, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0xb6, 0x04, 0x0a, 0x11, 0x57, 0x6f, 0x72,
-	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14,
-	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x61, 0x49, 0x64, 0x12, 0x39, 0x0a,
-	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x4b, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x64, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0xb6, 0x04, 0x0a, 0x11, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x61, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x4b, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77,
+	0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a,
+	0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x07,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
 	0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1d,
-	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x01, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a,
-	0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52,
+	0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x1a, 0x5c, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x12, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x1a, 0x43, 0x0a, 0x07, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x12, 0x38, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x1a, 0x3e, 0x0a, 0x10, 0x41,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x74, 0x65, 0x61, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x22, 0x67, 0x0a, 0x14, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x70, 0x22, 0x6f, 0x0a, 0x17, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x09, 0x61, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x09,
+	0x61, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xfb, 0x05, 0x0a, 0x12, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x70, 0x65,
+	0x63, 0x12, 0x27, 0x0a, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x66, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x0c,
+	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x46, 0x0a, 0x0b,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x50, 0x6f, 0x72, 0x74,
+	0x53, 0x70, 0x65, 0x63, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x65,
+	0x6e, 0x76, 0x76, 0x61, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77,
+	0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x76, 0x61, 0x72,
+	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x20, 0x0a, 0x03, 0x67, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x47, 0x69, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x03, 0x67,
+	0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x33, 0x0a, 0x09,
+	0x61, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x09, 0x61, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x2c, 0x0a, 0x09, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x49, 0x44, 0x45,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x08, 0x69, 0x64, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x73, 0x68, 0x5f, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x73, 0x73, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x3b, 0x0a,
+	0x0b, 0x73, 0x79, 0x73, 0x5f, 0x65, 0x6e, 0x76, 0x76, 0x61, 0x72, 0x73, 0x18, 0x10, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0a,
+	0x73, 0x79, 0x73, 0x45, 0x6e, 0x76, 0x76, 0x61, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x64,
+	0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x11,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x6d,
+	0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x4c, 0x69,
+	0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x07,
+	0x10, 0x08, 0x4a, 0x04, 0x08, 0x0e, 0x10, 0x0f, 0x22, 0x3b, 0x0a, 0x07, 0x47, 0x69, 0x74, 0x53,
+	0x70, 0x65, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0xc3, 0x01, 0x0a, 0x13, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e,
+	0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x66,
+	0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x41, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x35, 0x0a, 0x0c, 0x45,
+	0x78, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x77, 0x73, 0x6d,
+	0x61, 0x6e, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x05, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x22, 0x23, 0x0a, 0x0d, 0x53, 0x53, 0x48, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xad, 0x02, 0x0a, 0x17, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a,
+	0x11, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e,
+	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52,
+	0x10, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65,
+	0x73, 0x12, 0x3a, 0x0a, 0x19, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x40, 0x0a,
+	0x10, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x0f,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x12,
+	0x50, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x14, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x73, 0x22, 0xc9, 0x01, 0x0a, 0x0e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x50, 0x65,
+	0x72, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x73, 0x6d, 0x61,
+	0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x73, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x22, 0xea, 0x01,
+	0x0a, 0x11, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x36, 0x0a, 0x17, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x15, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78,
+	0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x6d, 0x61, 0x78, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12,
+	0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x4c, 0x69, 0x66,
+	0x65, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x14, 0x4f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x12, 0x27, 0x0a, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x12, 0x34, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x22, 0xc7, 0x02, 0x0a, 0x0e, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x12, 0x38, 0x0a,
+	0x18, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x16, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x11, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x70, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x12, 0x34, 0x0a,
+	0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
-	0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x1a, 0x5c, 0x0a, 0x09, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x12, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x1a, 0x43, 0x0a, 0x07, 0x4d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x73, 0x12, 0x38, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x1a, 0x3e, 0x0a, 0x10,
-	0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x07, 0x0a, 0x05,
-	0x5f, 0x74, 0x65, 0x61, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x22, 0x67, 0x0a, 0x14, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x75,
-	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64,
-	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f,
-	0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x64, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x70, 0x22, 0x6f, 0x0a, 0x17, 0x57, 0x6f,
-	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x09, 0x61, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e,
-	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52,
-	0x09, 0x61, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xfb, 0x05, 0x0a, 0x12,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x70,
-	0x65, 0x63, 0x12, 0x27, 0x0a, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x77, 0x6f, 0x72,
-	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x66,
-	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x52,
-	0x0c, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x46, 0x0a,
-	0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x6c, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x50, 0x6f, 0x72,
-	0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x07,
-	0x65, 0x6e, 0x76, 0x76, 0x61, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x76, 0x61,
-	0x72, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
-	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x20, 0x0a, 0x03, 0x67, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x47, 0x69, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x03,
-	0x67, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x33, 0x0a,
-	0x09, 0x61, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x15, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x09, 0x61, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x09, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x49, 0x44,
-	0x45, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x08, 0x69, 0x64, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x73, 0x68, 0x5f, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0d, 0x73, 0x73, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x3b,
-	0x0a, 0x0b, 0x73, 0x79, 0x73, 0x5f, 0x65, 0x6e, 0x76, 0x76, 0x61, 0x72, 0x73, 0x18, 0x10, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x45, 0x6e, 0x76, 0x69,
-	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52,
-	0x0a, 0x73, 0x79, 0x73, 0x45, 0x6e, 0x76, 0x76, 0x61, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x69,
-	0x64, 0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18,
-	0x11, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c,
-	0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63,
-	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x29, 0x0a, 0x10,
-	0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x4c,
-	0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08,
-	0x07, 0x10, 0x08, 0x4a, 0x04, 0x08, 0x0e, 0x10, 0x0f, 0x22, 0x3b, 0x0a, 0x07, 0x47, 0x69, 0x74,
-	0x53, 0x70, 0x65, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0xc3, 0x01, 0x0a, 0x13, 0x45, 0x6e, 0x76, 0x69, 0x72,
-	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e,
-	0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x72, 0x69,
-	0x61, 0x62, 0x6c, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65,
-	0x66, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x41, 0x0a, 0x0c, 0x53, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x35, 0x0a, 0x0c,
-	0x45, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x05,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x77, 0x73,
-	0x6d, 0x61, 0x6e, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x05, 0x70, 0x6f,
-	0x72, 0x74, 0x73, 0x22, 0x23, 0x0a, 0x0d, 0x53, 0x53, 0x48, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x4b, 0x65, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x99, 0x01, 0x0a, 0x17, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42,
-	0x0a, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73,
-	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x73, 0x6d, 0x61,
-	0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73,
-	0x52, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73,
-	0x65, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f,
-	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
-	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x22, 0x93,
-	0x01, 0x0a, 0x0e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6c, 0x61, 0x73,
-	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
-	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x10, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x50, 0x65, 0x72, 0x4d, 0x69,
-	0x6e, 0x75, 0x74, 0x65, 0x22, 0x5e, 0x0a, 0x11, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69,
-	0x7a, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
-	0x73, 0x69, 0x7a, 0x65, 0x22, 0xd5, 0x02, 0x0a, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x2a, 0x0a, 0x03, 0x67,
-	0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e,
-	0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x52, 0x03, 0x67, 0x69, 0x74, 0x12, 0x3d, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x5f,
-	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a,
-	0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x6f,
-	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e,
-	0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x30, 0x0a, 0x06,
-	0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x22, 0x68, 0x0a, 0x13, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x22, 0x56, 0x0a, 0x16, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x64, 0x22, 0x5e, 0x0a, 0x11, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x22, 0xd5, 0x02, 0x0a, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x2a, 0x0a, 0x03, 0x67, 0x69, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x49,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x52, 0x03, 0x67, 0x69, 0x74, 0x12, 0x3d, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77,
 	0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x34,
-	0x0a, 0x08, 0x70, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x08, 0x70, 0x72, 0x65, 0x62,
-	0x75, 0x69, 0x6c, 0x64, 0x12, 0x36, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e,
-	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x2a, 0x3f, 0x0a, 0x13,
-	0x53, 0x74, 0x6f, 0x70, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x4c, 0x59, 0x10,
-	0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4d, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x54, 0x45, 0x4c, 0x59,
-	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x10, 0x02, 0x2a, 0x38, 0x0a,
-	0x0b, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11,
-	0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55,
-	0x54, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x5f, 0x54, 0x49,
-	0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x01, 0x2a, 0x3a, 0x0a, 0x0e, 0x41, 0x64, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x44, 0x4d,
-	0x49, 0x54, 0x5f, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x00, 0x12,
-	0x12, 0x0a, 0x0e, 0x41, 0x44, 0x4d, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x52, 0x59, 0x4f, 0x4e,
-	0x45, 0x10, 0x01, 0x2a, 0x49, 0x0a, 0x0e, 0x50, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62,
-	0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x56, 0x49,
-	0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45,
-	0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42,
-	0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x10, 0x01, 0x2a, 0x3f,
-	0x0a, 0x0c, 0x50, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x16,
-	0x0a, 0x12, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f,
-	0x48, 0x54, 0x54, 0x50, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x50,
-	0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x48, 0x54, 0x54, 0x50, 0x53, 0x10, 0x01, 0x2a,
-	0x38, 0x0a, 0x16, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x64,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x41, 0x4c,
-	0x53, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x52, 0x55, 0x45, 0x10, 0x01, 0x12, 0x09,
-	0x0a, 0x05, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x02, 0x2a, 0x83, 0x01, 0x0a, 0x0e, 0x57, 0x6f,
-	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x0b, 0x0a, 0x07,
-	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e,
-	0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x52, 0x45, 0x41, 0x54, 0x49,
-	0x4e, 0x47, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x49,
-	0x5a, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e,
-	0x47, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x52, 0x55, 0x50, 0x54,
-	0x45, 0x44, 0x10, 0x07, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x49, 0x4e, 0x47,
-	0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x06, 0x2a,
-	0x98, 0x01, 0x0a, 0x14, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x46, 0x65, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4f, 0x50,
-	0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f,
-	0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54,
-	0x49, 0x4e, 0x47, 0x10, 0x0a, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41,
-	0x43, 0x45, 0x5f, 0x50, 0x53, 0x49, 0x10, 0x0b, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x53, 0x48, 0x5f,
-	0x43, 0x41, 0x10, 0x0c, 0x22, 0x04, 0x08, 0x01, 0x10, 0x01, 0x22, 0x04, 0x08, 0x02, 0x10, 0x02,
-	0x22, 0x04, 0x08, 0x03, 0x10, 0x03, 0x22, 0x04, 0x08, 0x04, 0x10, 0x04, 0x22, 0x04, 0x08, 0x05,
-	0x10, 0x05, 0x22, 0x04, 0x08, 0x06, 0x10, 0x06, 0x22, 0x04, 0x08, 0x07, 0x10, 0x07, 0x22, 0x04,
-	0x08, 0x08, 0x10, 0x08, 0x22, 0x04, 0x08, 0x09, 0x10, 0x09, 0x2a, 0x46, 0x0a, 0x0d, 0x57, 0x6f,
-	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x52,
-	0x45, 0x47, 0x55, 0x4c, 0x41, 0x52, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52, 0x45, 0x42,
-	0x55, 0x49, 0x4c, 0x44, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x42,
-	0x55, 0x49, 0x4c, 0x44, 0x10, 0x04, 0x22, 0x04, 0x08, 0x02, 0x10, 0x02, 0x22, 0x04, 0x08, 0x03,
-	0x10, 0x03, 0x32, 0xe7, 0x08, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x57, 0x6f,
-	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e,
-	0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x47, 0x65,
-	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x57, 0x6f,
-	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x70, 0x57, 0x6f,
-	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e,
-	0x53, 0x74, 0x6f, 0x70, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x53, 0x74, 0x6f,
-	0x70, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x11, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1f, 0x2e, 0x77, 0x73, 0x6d, 0x61,
-	0x6e, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x77, 0x73, 0x6d,
-	0x61, 0x6e, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52,
-	0x0a, 0x0f, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x12, 0x1d, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
-	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1e, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x57,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x49,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x62, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x73, 0x6d,
+	0x61, 0x6e, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x52, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x34, 0x0a, 0x08,
+	0x70, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x08, 0x70, 0x72, 0x65, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x12, 0x36, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x2a, 0x3f, 0x0a, 0x13, 0x53, 0x74,
+	0x6f, 0x70, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x4c, 0x59, 0x10, 0x00, 0x12,
+	0x0f, 0x0a, 0x0b, 0x49, 0x4d, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x54, 0x45, 0x4c, 0x59, 0x10, 0x01,
+	0x12, 0x09, 0x0a, 0x05, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x10, 0x02, 0x2a, 0x38, 0x0a, 0x0b, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x57, 0x4f,
+	0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x5f, 0x54, 0x49, 0x4d, 0x45,
+	0x4f, 0x55, 0x54, 0x10, 0x01, 0x2a, 0x3a, 0x0a, 0x0e, 0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x44, 0x4d, 0x49, 0x54,
+	0x5f, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x00, 0x12, 0x12, 0x0a,
+	0x0e, 0x41, 0x44, 0x4d, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x52, 0x59, 0x4f, 0x4e, 0x45, 0x10,
+	0x01, 0x2a, 0x49, 0x0a, 0x0e, 0x50, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x56, 0x49, 0x53, 0x49,
+	0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x00,
+	0x12, 0x1a, 0x0a, 0x16, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c,
+	0x49, 0x54, 0x59, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x10, 0x01, 0x2a, 0x3f, 0x0a, 0x0c,
+	0x50, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x12,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x48, 0x54,
+	0x54, 0x50, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x50, 0x52, 0x4f,
+	0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x48, 0x54, 0x54, 0x50, 0x53, 0x10, 0x01, 0x2a, 0x38, 0x0a,
+	0x16, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x41, 0x4c, 0x53, 0x45,
+	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x52, 0x55, 0x45, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05,
+	0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x02, 0x2a, 0x83, 0x01, 0x0a, 0x0e, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x52, 0x45, 0x41, 0x54, 0x49, 0x4e, 0x47,
+	0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x49,
+	0x4e, 0x47, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10,
+	0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x52, 0x55, 0x50, 0x54, 0x45, 0x44,
+	0x10, 0x07, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x05,
+	0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x06, 0x2a, 0x98, 0x01,
+	0x0a, 0x14, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x46, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4f, 0x50, 0x10, 0x00,
+	0x12, 0x21, 0x0a, 0x1d, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x43, 0x4f,
+	0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x49, 0x4e,
+	0x47, 0x10, 0x0a, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45,
+	0x5f, 0x50, 0x53, 0x49, 0x10, 0x0b, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x53, 0x48, 0x5f, 0x43, 0x41,
+	0x10, 0x0c, 0x22, 0x04, 0x08, 0x01, 0x10, 0x01, 0x22, 0x04, 0x08, 0x02, 0x10, 0x02, 0x22, 0x04,
+	0x08, 0x03, 0x10, 0x03, 0x22, 0x04, 0x08, 0x04, 0x10, 0x04, 0x22, 0x04, 0x08, 0x05, 0x10, 0x05,
+	0x22, 0x04, 0x08, 0x06, 0x10, 0x06, 0x22, 0x04, 0x08, 0x07, 0x10, 0x07, 0x22, 0x04, 0x08, 0x08,
+	0x10, 0x08, 0x22, 0x04, 0x08, 0x09, 0x10, 0x09, 0x2a, 0x46, 0x0a, 0x0d, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x47,
+	0x55, 0x4c, 0x41, 0x52, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52, 0x45, 0x42, 0x55, 0x49,
+	0x4c, 0x44, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x42, 0x55, 0x49,
+	0x4c, 0x44, 0x10, 0x04, 0x22, 0x04, 0x08, 0x02, 0x10, 0x02, 0x22, 0x04, 0x08, 0x03, 0x10, 0x03,
+	0x32, 0xef, 0x0a, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x47,
+	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x70, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x53, 0x74,
+	0x6f, 0x70, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x57,
 	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12,
-	0x17, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e,
-	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x0a, 0x4d, 0x61, 0x72, 0x6b, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x12, 0x18, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x4d, 0x61, 0x72,
-	0x6b, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
-	0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x53,
-	0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x18, 0x2e, 0x77, 0x73, 0x6d, 0x61,
-	0x6e, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x54,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x46, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12,
-	0x19, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50,
-	0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x77, 0x73, 0x6d,
-	0x61, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0c, 0x54, 0x61, 0x6b, 0x65,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1a, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e,
-	0x2e, 0x54, 0x61, 0x6b, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x54, 0x61, 0x6b,
-	0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x41, 0x64,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x14, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x12, 0x22, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a,
-	0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x2e,
-	0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x53, 0x48, 0x4b,
-	0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x77, 0x73, 0x6d, 0x61,
-	0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0f, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x77, 0x73,
-	0x6d, 0x61, 0x6e, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x73, 0x6d,
-	0x61, 0x6e, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2c, 0x5a, 0x2a,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x69, 0x74, 0x70, 0x6f,
-	0x64, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x69, 0x74, 0x70, 0x6f, 0x64, 0x2f, 0x77, 0x73, 0x2d, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x22, 0x00, 0x12, 0x58, 0x0a, 0x11, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1f, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e,
+	0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0f,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x1d, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x42, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x17, 0x2e,
+	0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x0a, 0x4d, 0x61, 0x72, 0x6b, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x12, 0x18, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x77,
+	0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x53, 0x65, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x18, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e,
+	0x53, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46,
+	0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x2e,
+	0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0c, 0x54, 0x61, 0x6b, 0x65, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1a, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x54,
+	0x61, 0x6b, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x54, 0x61, 0x6b, 0x65, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x55, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x41, 0x64, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x12, 0x22, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0c, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x2e, 0x77, 0x73,
+	0x6d, 0x61, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0f, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x77, 0x73, 0x6d, 0x61,
+	0x6e, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e,
+	0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0f, 0x53, 0x65,
+	0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1d, 0x2e,
+	0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77,
+	0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55,
+	0x0a, 0x10, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x1e, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x12, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x64, 0x12, 0x20, 0x2e, 0x77, 0x73,
+	0x6d, 0x61, 0x6e, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x50, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x77, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x67, 0x69, 0x74, 0x70, 0x6f, 0x64, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x69, 0x74, 0x70, 0x6f,
+	0x64, 0x2f, 0x77, 0x73, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4294,7 +5372,7 @@ func file_core_proto_rawDescGZIP() []byte {
 }
 
 var file_core_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
-var file_core_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
+var file_core_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
 var file_core_proto_goTypes = []interface{}{
 	(StopWorkspacePolicy)(0),                 // 0: wsman.StopWorkspacePolicy
 	(TimeoutType)(0),                         // 1: wsman.TimeoutType
@@ -4320,141 +5398,173 @@ var file_core_proto_goTypes = []interface{}{
 	(*MarkActiveResponse)(nil),               // 21: wsman.MarkActiveResponse
 	(*SetTimeoutRequest)(nil),                // 22: wsman.SetTimeoutRequest
 	(*SetTimeoutResponse)(nil),               // 23: wsman.SetTimeoutResponse
-	(*ControlPortRequest)(nil),               // 24: wsman.ControlPortRequest
-	(*ControlPortResponse)(nil),              // 25: wsman.ControlPortResponse
-	(*TakeSnapshotRequest)(nil),              // 26: wsman.TakeSnapshotRequest
-	(*TakeSnapshotResponse)(nil),             // 27: wsman.TakeSnapshotResponse
-	(*ControlAdmissionRequest)(nil),          // 28: wsman.ControlAdmissionRequest
-	(*ControlAdmissionResponse)(nil),         // 29: wsman.ControlAdmissionResponse
-	(*DeleteVolumeSnapshotRequest)(nil),      // 30: wsman.DeleteVolumeSnapshotRequest
-	(*DeleteVolumeSnapshotResponse)(nil),     // 31: wsman.DeleteVolumeSnapshotResponse
-	(*BackupWorkspaceRequest)(nil),           // 32: wsman.BackupWorkspaceRequest
-	(*BackupWorkspaceResponse)(nil),          // 33: wsman.BackupWorkspaceResponse
-	(*UpdateSSHKeyRequest)(nil),              // 34: wsman.UpdateSSHKeyRequest
-	(*UpdateSSHKeyResponse)(nil),             // 35: wsman.UpdateSSHKeyResponse
-	(*WorkspaceStatus)(nil),                  // 36: wsman.WorkspaceStatus
-	(*IDEImage)(nil),                         // 37: wsman.IDEImage
-	(*WorkspaceSpec)(nil),                    // 38: wsman.WorkspaceSpec
-	(*PortSpec)(nil),                         // 39: wsman.PortSpec
-	(*VolumeSnapshotInfo)(nil),               // 40: wsman.VolumeSnapshotInfo
-	(*WorkspaceConditions)(nil),              // 41: wsman.WorkspaceConditions
-	(*WorkspaceMetadata)(nil),                // 42: wsman.WorkspaceMetadata
-	(*WorkspaceRuntimeInfo)(nil),             // 43: wsman.WorkspaceRuntimeInfo
-	(*WorkspaceAuthentication)(nil),          // 44: wsman.WorkspaceAuthentication
-	(*StartWorkspaceSpec)(nil),               // 45: wsman.StartWorkspaceSpec
-	(*GitSpec)(nil),                          // 46: wsman.GitSpec
-	(*EnvironmentVariable)(nil),              // 47: wsman.EnvironmentVariable
-	(*ExposedPorts)(nil),                     // 48: wsman.ExposedPorts
-	(*SSHPublicKeys)(nil),                    // 49: wsman.SSHPublicKeys
-	(*DescribeClusterRequest)(nil),           // 50: wsman.DescribeClusterRequest
-	(*DescribeClusterResponse)(nil),          // 51: wsman.DescribeClusterResponse
-	(*WorkspaceClass)(nil),                   // 52: wsman.WorkspaceClass
-	(*InitializerMetric)(nil),                // 53: wsman.InitializerMetric
-	(*InitializerMetrics)(nil),               // 54: wsman.InitializerMetrics
-	nil,                                      // 55: wsman.MetadataFilter.AnnotationsEntry
-	nil,                                      // 56: wsman.SubscribeResponse.HeaderEntry
-	(*WorkspaceMetadata_ImageInfo)(nil),      // 57: wsman.WorkspaceMetadata.ImageInfo
-	(*WorkspaceMetadata_Metrics)(nil),        // 58: wsman.WorkspaceMetadata.Metrics
-	nil,                                      // 59: wsman.WorkspaceMetadata.AnnotationsEntry
-	(*EnvironmentVariable_SecretKeyRef)(nil), // 60: wsman.EnvironmentVariable.SecretKeyRef
-	(*api.GitStatus)(nil),                    // 61: contentservice.GitStatus
-	(*timestamppb.Timestamp)(nil),            // 62: google.protobuf.Timestamp
-	(*api.WorkspaceInitializer)(nil),         // 63: contentservice.WorkspaceInitializer
-	(*durationpb.Duration)(nil),              // 64: google.protobuf.Duration
+	(*SetStorageQuotaRequest)(nil),           // 24: wsman.SetStorageQuotaRequest
+	(*SetStorageQuotaResponse)(nil),          // 25: wsman.SetStorageQuotaResponse
+	(*MigrateWorkspaceRequest)(nil),          // 26: wsman.MigrateWorkspaceRequest
+	(*MigrateWorkspaceResponse)(nil),         // 27: wsman.MigrateWorkspaceResponse
+	(*RenderWorkspacePodRequest)(nil),        // 28: wsman.RenderWorkspacePodRequest
+	(*RenderWorkspacePodResponse)(nil),       // 29: wsman.RenderWorkspacePodResponse
+	(*ControlPortRequest)(nil),               // 30: wsman.ControlPortRequest
+	(*ControlPortResponse)(nil),              // 31: wsman.ControlPortResponse
+	(*TakeSnapshotRequest)(nil),              // 32: wsman.TakeSnapshotRequest
+	(*TakeSnapshotResponse)(nil),             // 33: wsman.TakeSnapshotResponse
+	(*ControlAdmissionRequest)(nil),          // 34: wsman.ControlAdmissionRequest
+	(*ControlAdmissionResponse)(nil),         // 35: wsman.ControlAdmissionResponse
+	(*DeleteVolumeSnapshotRequest)(nil),      // 36: wsman.DeleteVolumeSnapshotRequest
+	(*DeleteVolumeSnapshotResponse)(nil),     // 37: wsman.DeleteVolumeSnapshotResponse
+	(*BackupWorkspaceRequest)(nil),           // 38: wsman.BackupWorkspaceRequest
+	(*BackupWorkspaceResponse)(nil),          // 39: wsman.BackupWorkspaceResponse
+	(*UpdateSSHKeyRequest)(nil),              // 40: wsman.UpdateSSHKeyRequest
+	(*UpdateSSHKeyResponse)(nil),             // 41: wsman.UpdateSSHKeyResponse
+	(*WorkspaceStatus)(nil),                  // 42: wsman.WorkspaceStatus
+	(*WorkspaceStorage)(nil),                 // 43: wsman.WorkspaceStorage
+	(*WorkspaceTimelineEvent)(nil),           // 44: wsman.WorkspaceTimelineEvent
+	(*IDEImage)(nil),                         // 45: wsman.IDEImage
+	(*WorkspaceSpec)(nil),                    // 46: wsman.WorkspaceSpec
+	(*PortSpec)(nil),                         // 47: wsman.PortSpec
+	(*VolumeSnapshotInfo)(nil),               // 48: wsman.VolumeSnapshotInfo
+	(*WorkspaceConditions)(nil),              // 49: wsman.WorkspaceConditions
+	(*WorkspaceMetadata)(nil),                // 50: wsman.WorkspaceMetadata
+	(*WorkspaceRuntimeInfo)(nil),             // 51: wsman.WorkspaceRuntimeInfo
+	(*WorkspaceAuthentication)(nil),          // 52: wsman.WorkspaceAuthentication
+	(*StartWorkspaceSpec)(nil),               // 53: wsman.StartWorkspaceSpec
+	(*GitSpec)(nil),                          // 54: wsman.GitSpec
+	(*EnvironmentVariable)(nil),              // 55: wsman.EnvironmentVariable
+	(*ExposedPorts)(nil),                     // 56: wsman.ExposedPorts
+	(*SSHPublicKeys)(nil),                    // 57: wsman.SSHPublicKeys
+	(*DescribeClusterRequest)(nil),           // 58: wsman.DescribeClusterRequest
+	(*DescribeClusterResponse)(nil),          // 59: wsman.DescribeClusterResponse
+	(*WorkspaceClass)(nil),                   // 60: wsman.WorkspaceClass
+	(*WorkspaceTimeouts)(nil),                // 61: wsman.WorkspaceTimeouts
+	(*OrganizationTimeouts)(nil),             // 62: wsman.OrganizationTimeouts
+	(*WorkspaceQuota)(nil),                   // 63: wsman.WorkspaceQuota
+	(*WorkspaceQuotaClass)(nil),              // 64: wsman.WorkspaceQuotaClass
+	(*WorkspaceQuotaResource)(nil),           // 65: wsman.WorkspaceQuotaResource
+	(*InitializerMetric)(nil),                // 66: wsman.InitializerMetric
+	(*InitializerMetrics)(nil),               // 67: wsman.InitializerMetrics
+	nil,                                      // 68: wsman.MetadataFilter.AnnotationsEntry
+	nil,                                      // 69: wsman.SubscribeResponse.HeaderEntry
+	(*WorkspaceMetadata_ImageInfo)(nil),      // 70: wsman.WorkspaceMetadata.ImageInfo
+	(*WorkspaceMetadata_Metrics)(nil),        // 71: wsman.WorkspaceMetadata.Metrics
+	nil,                                      // 72: wsman.WorkspaceMetadata.AnnotationsEntry
+	(*EnvironmentVariable_SecretKeyRef)(nil), // 73: wsman.EnvironmentVariable.SecretKeyRef
+	(*api.GitStatus)(nil),                    // 74: contentservice.GitStatus
+	(*timestamppb.Timestamp)(nil),            // 75: google.protobuf.Timestamp
+	(*api.WorkspaceInitializer)(nil),         // 76: contentservice.WorkspaceInitializer
+	(*durationpb.Duration)(nil),              // 77: google.protobuf.Duration
 }
 var file_core_proto_depIdxs = []int32{
-	55, // 0: wsman.MetadataFilter.annotations:type_name -> wsman.MetadataFilter.AnnotationsEntry
+	68, // 0: wsman.MetadataFilter.annotations:type_name -> wsman.MetadataFilter.AnnotationsEntry
 	9,  // 1: wsman.GetWorkspacesRequest.must_match:type_name -> wsman.MetadataFilter
-	36, // 2: wsman.GetWorkspacesResponse.status:type_name -> wsman.WorkspaceStatus
-	42, // 3: wsman.StartWorkspaceRequest.metadata:type_name -> wsman.WorkspaceMetadata
-	45, // 4: wsman.StartWorkspaceRequest.spec:type_name -> wsman.StartWorkspaceSpec
+	42, // 2: wsman.GetWorkspacesResponse.status:type_name -> wsman.WorkspaceStatus
+	50, // 3: wsman.StartWorkspaceRequest.metadata:type_name -> wsman.WorkspaceMetadata
+	53, // 4: wsman.StartWorkspaceRequest.spec:type_name -> wsman.StartWorkspaceSpec
 	8,  // 5: wsman.StartWorkspaceRequest.type:type_name -> wsman.WorkspaceType
 	0,  // 6: wsman.StopWorkspaceRequest.policy:type_name -> wsman.StopWorkspacePolicy
-	36, // 7: wsman.DescribeWorkspaceResponse.status:type_name -> wsman.WorkspaceStatus
+	42, // 7: wsman.DescribeWorkspaceResponse.status:type_name -> wsman.WorkspaceStatus
 	9,  // 8: wsman.SubscribeRequest.must_match:type_name -> wsman.MetadataFilter
-	36, // 9: wsman.SubscribeResponse.status:type_name -> wsman.WorkspaceStatus
-	56, // 10: wsman.SubscribeResponse.header:type_name -> wsman.SubscribeResponse.HeaderEntry
+	42, // 9: wsman.SubscribeResponse.status:type_name -> wsman.WorkspaceStatus
+	69, // 10: wsman.SubscribeResponse.header:type_name -> wsman.SubscribeResponse.HeaderEntry
 	1,  // 11: wsman.SetTimeoutRequest.type:type_name -> wsman.TimeoutType
-	39, // 12: wsman.ControlPortRequest.spec:type_name -> wsman.PortSpec
-	2,  // 13: wsman.ControlAdmissionRequest.level:type_name -> wsman.AdmissionLevel
-	8,  // 14: wsman.DeleteVolumeSnapshotRequest.ws_type:type_name -> wsman.WorkspaceType
-	42, // 15: wsman.WorkspaceStatus.metadata:type_name -> wsman.WorkspaceMetadata
-	38, // 16: wsman.WorkspaceStatus.spec:type_name -> wsman.WorkspaceSpec
-	6,  // 17: wsman.WorkspaceStatus.phase:type_name -> wsman.WorkspacePhase
-	41, // 18: wsman.WorkspaceStatus.conditions:type_name -> wsman.WorkspaceConditions
-	61, // 19: wsman.WorkspaceStatus.repo:type_name -> contentservice.GitStatus
-	43, // 20: wsman.WorkspaceStatus.runtime:type_name -> wsman.WorkspaceRuntimeInfo
-	44, // 21: wsman.WorkspaceStatus.auth:type_name -> wsman.WorkspaceAuthentication
-	54, // 22: wsman.WorkspaceStatus.initializer_metrics:type_name -> wsman.InitializerMetrics
-	39, // 23: wsman.WorkspaceSpec.exposed_ports:type_name -> wsman.PortSpec
-	8,  // 24: wsman.WorkspaceSpec.type:type_name -> wsman.WorkspaceType
-	37, // 25: wsman.WorkspaceSpec.ide_image:type_name -> wsman.IDEImage
-	3,  // 26: wsman.PortSpec.visibility:type_name -> wsman.PortVisibility
-	4,  // 27: wsman.PortSpec.protocol:type_name -> wsman.PortProtocol
-	5,  // 28: wsman.WorkspaceConditions.pulling_images:type_name -> wsman.WorkspaceConditionBool
-	5,  // 29: wsman.WorkspaceConditions.final_backup_complete:type_name -> wsman.WorkspaceConditionBool
-	5,  // 30: wsman.WorkspaceConditions.deployed:type_name -> wsman.WorkspaceConditionBool
-	5,  // 31: wsman.WorkspaceConditions.network_not_ready:type_name -> wsman.WorkspaceConditionBool
-	62, // 32: wsman.WorkspaceConditions.first_user_activity:type_name -> google.protobuf.Timestamp
-	5,  // 33: wsman.WorkspaceConditions.stopped_by_request:type_name -> wsman.WorkspaceConditionBool
-	40, // 34: wsman.WorkspaceConditions.volume_snapshot:type_name -> wsman.VolumeSnapshotInfo
-	5,  // 35: wsman.WorkspaceConditions.aborted:type_name -> wsman.WorkspaceConditionBool
-	62, // 36: wsman.WorkspaceMetadata.started_at:type_name -> google.protobuf.Timestamp
-	59, // 37: wsman.WorkspaceMetadata.annotations:type_name -> wsman.WorkspaceMetadata.AnnotationsEntry
-	58, // 38: wsman.WorkspaceMetadata.metrics:type_name -> wsman.WorkspaceMetadata.Metrics
-	2,  // 39: wsman.WorkspaceAuthentication.admission:type_name -> wsman.AdmissionLevel
-	7,  // 40: wsman.StartWorkspaceSpec.feature_flags:type_name -> wsman.WorkspaceFeatureFlag
-	63, // 41: wsman.StartWorkspaceSpec.initializer:type_name -> contentservice.WorkspaceInitializer
-	39, // 42: wsman.StartWorkspaceSpec.ports:type_name -> wsman.PortSpec
-	47, // 43: wsman.StartWorkspaceSpec.envvars:type_name -> wsman.EnvironmentVariable
-	46, // 44: wsman.StartWorkspaceSpec.git:type_name -> wsman.GitSpec
-	2,  // 45: wsman.StartWorkspaceSpec.admission:type_name -> wsman.AdmissionLevel
-	37, // 46: wsman.StartWorkspaceSpec.ide_image:type_name -> wsman.IDEImage
-	47, // 47: wsman.StartWorkspaceSpec.sys_envvars:type_name -> wsman.EnvironmentVariable
-	60, // 48: wsman.EnvironmentVariable.secret:type_name -> wsman.EnvironmentVariable.SecretKeyRef
-	39, // 49: wsman.ExposedPorts.ports:type_name -> wsman.PortSpec
-	52, // 50: wsman.DescribeClusterResponse.workspace_classes:type_name -> wsman.WorkspaceClass
-	64, // 51: wsman.InitializerMetric.duration:type_name -> google.protobuf.Duration
-	53, // 52: wsman.InitializerMetrics.git:type_name -> wsman.InitializerMetric
-	53, // 53: wsman.InitializerMetrics.file_download:type_name -> wsman.InitializerMetric
-	53, // 54: wsman.InitializerMetrics.snapshot:type_name -> wsman.InitializerMetric
-	53, // 55: wsman.InitializerMetrics.backup:type_name -> wsman.InitializerMetric
-	53, // 56: wsman.InitializerMetrics.prebuild:type_name -> wsman.InitializerMetric
-	53, // 57: wsman.InitializerMetrics.composite:type_name -> wsman.InitializerMetric
-	57, // 58: wsman.WorkspaceMetadata.Metrics.image:type_name -> wsman.WorkspaceMetadata.ImageInfo
-	10, // 59: wsman.WorkspaceManager.GetWorkspaces:input_type -> wsman.GetWorkspacesRequest
-	12, // 60: wsman.WorkspaceManager.StartWorkspace:input_type -> wsman.StartWorkspaceRequest
-	14, // 61: wsman.WorkspaceManager.StopWorkspace:input_type -> wsman.StopWorkspaceRequest
-	16, // 62: wsman.WorkspaceManager.DescribeWorkspace:input_type -> wsman.DescribeWorkspaceRequest
-	32, // 63: wsman.WorkspaceManager.BackupWorkspace:input_type -> wsman.BackupWorkspaceRequest
-	18, // 64: wsman.WorkspaceManager.Subscribe:input_type -> wsman.SubscribeRequest
-	20, // 65: wsman.WorkspaceManager.MarkActive:input_type -> wsman.MarkActiveRequest
-	22, // 66: wsman.WorkspaceManager.SetTimeout:input_type -> wsman.SetTimeoutRequest
-	24, // 67: wsman.WorkspaceManager.ControlPort:input_type -> wsman.ControlPortRequest
-	26, // 68: wsman.WorkspaceManager.TakeSnapshot:input_type -> wsman.TakeSnapshotRequest
-	28, // 69: wsman.WorkspaceManager.ControlAdmission:input_type -> wsman.ControlAdmissionRequest
-	30, // 70: wsman.WorkspaceManager.DeleteVolumeSnapshot:input_type -> wsman.DeleteVolumeSnapshotRequest
-	34, // 71: wsman.WorkspaceManager.UpdateSSHKey:input_type -> wsman.UpdateSSHKeyRequest
-	50, // 72: wsman.WorkspaceManager.DescribeCluster:input_type -> wsman.DescribeClusterRequest
-	11, // 73: wsman.WorkspaceManager.GetWorkspaces:output_type -> wsman.GetWorkspacesResponse
-	13, // 74: wsman.WorkspaceManager.StartWorkspace:output_type -> wsman.StartWorkspaceResponse
-	15, // 75: wsman.WorkspaceManager.StopWorkspace:output_type -> wsman.StopWorkspaceResponse
-	17, // 76: wsman.WorkspaceManager.DescribeWorkspace:output_type -> wsman.DescribeWorkspaceResponse
-	33, // 77: wsman.WorkspaceManager.BackupWorkspace:output_type -> wsman.BackupWorkspaceResponse
-	19, // 78: wsman.WorkspaceManager.Subscribe:output_type -> wsman.SubscribeResponse
-	21, // 79: wsman.WorkspaceManager.MarkActive:output_type -> wsman.MarkActiveResponse
-	23, // 80: wsman.WorkspaceManager.SetTimeout:output_type -> wsman.SetTimeoutResponse
-	25, // 81: wsman.WorkspaceManager.ControlPort:output_type -> wsman.ControlPortResponse
-	27, // 82: wsman.WorkspaceManager.TakeSnapshot:output_type -> wsman.TakeSnapshotResponse
-	29, // 83: wsman.WorkspaceManager.ControlAdmission:output_type -> wsman.ControlAdmissionResponse
-	31, // 84: wsman.WorkspaceManager.DeleteVolumeSnapshot:output_type -> wsman.DeleteVolumeSnapshotResponse
-	35, // 85: wsman.WorkspaceManager.UpdateSSHKey:output_type -> wsman.UpdateSSHKeyResponse
-	51, // 86: wsman.WorkspaceManager.DescribeCluster:output_type -> wsman.DescribeClusterResponse
-	73, // [73:87] is the sub-list for method output_type
-	59, // [59:73] is the sub-list for method input_type
-	59, // [59:59] is the sub-list for extension type_name
-	59, // [59:59] is the sub-list for extension extendee
-	0,  // [0:59] is the sub-list for field type_name
+	55, // 12: wsman.MigrateWorkspaceRequest.envvars:type_name -> wsman.EnvironmentVariable
+	55, // 13: wsman.MigrateWorkspaceRequest.sys_envvars:type_name -> wsman.EnvironmentVariable
+	12, // 14: wsman.RenderWorkspacePodRequest.request:type_name -> wsman.StartWorkspaceRequest
+	47, // 15: wsman.ControlPortRequest.spec:type_name -> wsman.PortSpec
+	2,  // 16: wsman.ControlAdmissionRequest.level:type_name -> wsman.AdmissionLevel
+	8,  // 17: wsman.DeleteVolumeSnapshotRequest.ws_type:type_name -> wsman.WorkspaceType
+	50, // 18: wsman.WorkspaceStatus.metadata:type_name -> wsman.WorkspaceMetadata
+	46, // 19: wsman.WorkspaceStatus.spec:type_name -> wsman.WorkspaceSpec
+	6,  // 20: wsman.WorkspaceStatus.phase:type_name -> wsman.WorkspacePhase
+	49, // 21: wsman.WorkspaceStatus.conditions:type_name -> wsman.WorkspaceConditions
+	74, // 22: wsman.WorkspaceStatus.repo:type_name -> contentservice.GitStatus
+	51, // 23: wsman.WorkspaceStatus.runtime:type_name -> wsman.WorkspaceRuntimeInfo
+	52, // 24: wsman.WorkspaceStatus.auth:type_name -> wsman.WorkspaceAuthentication
+	67, // 25: wsman.WorkspaceStatus.initializer_metrics:type_name -> wsman.InitializerMetrics
+	43, // 26: wsman.WorkspaceStatus.storage:type_name -> wsman.WorkspaceStorage
+	44, // 27: wsman.WorkspaceStatus.timeline:type_name -> wsman.WorkspaceTimelineEvent
+	75, // 28: wsman.WorkspaceTimelineEvent.time:type_name -> google.protobuf.Timestamp
+	47, // 29: wsman.WorkspaceSpec.exposed_ports:type_name -> wsman.PortSpec
+	8,  // 30: wsman.WorkspaceSpec.type:type_name -> wsman.WorkspaceType
+	45, // 31: wsman.WorkspaceSpec.ide_image:type_name -> wsman.IDEImage
+	3,  // 32: wsman.PortSpec.visibility:type_name -> wsman.PortVisibility
+	4,  // 33: wsman.PortSpec.protocol:type_name -> wsman.PortProtocol
+	5,  // 34: wsman.WorkspaceConditions.pulling_images:type_name -> wsman.WorkspaceConditionBool
+	5,  // 35: wsman.WorkspaceConditions.final_backup_complete:type_name -> wsman.WorkspaceConditionBool
+	5,  // 36: wsman.WorkspaceConditions.deployed:type_name -> wsman.WorkspaceConditionBool
+	5,  // 37: wsman.WorkspaceConditions.network_not_ready:type_name -> wsman.WorkspaceConditionBool
+	75, // 38: wsman.WorkspaceConditions.first_user_activity:type_name -> google.protobuf.Timestamp
+	5,  // 39: wsman.WorkspaceConditions.stopped_by_request:type_name -> wsman.WorkspaceConditionBool
+	48, // 40: wsman.WorkspaceConditions.volume_snapshot:type_name -> wsman.VolumeSnapshotInfo
+	5,  // 41: wsman.WorkspaceConditions.aborted:type_name -> wsman.WorkspaceConditionBool
+	5,  // 42: wsman.WorkspaceConditions.paused:type_name -> wsman.WorkspaceConditionBool
+	75, // 43: wsman.WorkspaceMetadata.started_at:type_name -> google.protobuf.Timestamp
+	72, // 44: wsman.WorkspaceMetadata.annotations:type_name -> wsman.WorkspaceMetadata.AnnotationsEntry
+	71, // 45: wsman.WorkspaceMetadata.metrics:type_name -> wsman.WorkspaceMetadata.Metrics
+	2,  // 46: wsman.WorkspaceAuthentication.admission:type_name -> wsman.AdmissionLevel
+	7,  // 47: wsman.StartWorkspaceSpec.feature_flags:type_name -> wsman.WorkspaceFeatureFlag
+	76, // 48: wsman.StartWorkspaceSpec.initializer:type_name -> contentservice.WorkspaceInitializer
+	47, // 49: wsman.StartWorkspaceSpec.ports:type_name -> wsman.PortSpec
+	55, // 50: wsman.StartWorkspaceSpec.envvars:type_name -> wsman.EnvironmentVariable
+	54, // 51: wsman.StartWorkspaceSpec.git:type_name -> wsman.GitSpec
+	2,  // 52: wsman.StartWorkspaceSpec.admission:type_name -> wsman.AdmissionLevel
+	45, // 53: wsman.StartWorkspaceSpec.ide_image:type_name -> wsman.IDEImage
+	55, // 54: wsman.StartWorkspaceSpec.sys_envvars:type_name -> wsman.EnvironmentVariable
+	73, // 55: wsman.EnvironmentVariable.secret:type_name -> wsman.EnvironmentVariable.SecretKeyRef
+	47, // 56: wsman.ExposedPorts.ports:type_name -> wsman.PortSpec
+	60, // 57: wsman.DescribeClusterResponse.workspace_classes:type_name -> wsman.WorkspaceClass
+	63, // 58: wsman.DescribeClusterResponse.workspace_quotas:type_name -> wsman.WorkspaceQuota
+	62, // 59: wsman.DescribeClusterResponse.organization_timeouts:type_name -> wsman.OrganizationTimeouts
+	61, // 60: wsman.WorkspaceClass.timeouts:type_name -> wsman.WorkspaceTimeouts
+	61, // 61: wsman.OrganizationTimeouts.timeouts:type_name -> wsman.WorkspaceTimeouts
+	64, // 62: wsman.WorkspaceQuota.classes:type_name -> wsman.WorkspaceQuotaClass
+	65, // 63: wsman.WorkspaceQuota.resources:type_name -> wsman.WorkspaceQuotaResource
+	77, // 64: wsman.InitializerMetric.duration:type_name -> google.protobuf.Duration
+	66, // 65: wsman.InitializerMetrics.git:type_name -> wsman.InitializerMetric
+	66, // 66: wsman.InitializerMetrics.file_download:type_name -> wsman.InitializerMetric
+	66, // 67: wsman.InitializerMetrics.snapshot:type_name -> wsman.InitializerMetric
+	66, // 68: wsman.InitializerMetrics.backup:type_name -> wsman.InitializerMetric
+	66, // 69: wsman.InitializerMetrics.prebuild:type_name -> wsman.InitializerMetric
+	66, // 70: wsman.InitializerMetrics.composite:type_name -> wsman.InitializerMetric
+	70, // 71: wsman.WorkspaceMetadata.Metrics.image:type_name -> wsman.WorkspaceMetadata.ImageInfo
+	10, // 72: wsman.WorkspaceManager.GetWorkspaces:input_type -> wsman.GetWorkspacesRequest
+	12, // 73: wsman.WorkspaceManager.StartWorkspace:input_type -> wsman.StartWorkspaceRequest
+	14, // 74: wsman.WorkspaceManager.StopWorkspace:input_type -> wsman.StopWorkspaceRequest
+	16, // 75: wsman.WorkspaceManager.DescribeWorkspace:input_type -> wsman.DescribeWorkspaceRequest
+	38, // 76: wsman.WorkspaceManager.BackupWorkspace:input_type -> wsman.BackupWorkspaceRequest
+	18, // 77: wsman.WorkspaceManager.Subscribe:input_type -> wsman.SubscribeRequest
+	20, // 78: wsman.WorkspaceManager.MarkActive:input_type -> wsman.MarkActiveRequest
+	22, // 79: wsman.WorkspaceManager.SetTimeout:input_type -> wsman.SetTimeoutRequest
+	30, // 80: wsman.WorkspaceManager.ControlPort:input_type -> wsman.ControlPortRequest
+	32, // 81: wsman.WorkspaceManager.TakeSnapshot:input_type -> wsman.TakeSnapshotRequest
+	34, // 82: wsman.WorkspaceManager.ControlAdmission:input_type -> wsman.ControlAdmissionRequest
+	36, // 83: wsman.WorkspaceManager.DeleteVolumeSnapshot:input_type -> wsman.DeleteVolumeSnapshotRequest
+	40, // 84: wsman.WorkspaceManager.UpdateSSHKey:input_type -> wsman.UpdateSSHKeyRequest
+	58, // 85: wsman.WorkspaceManager.DescribeCluster:input_type -> wsman.DescribeClusterRequest
+	24, // 86: wsman.WorkspaceManager.SetStorageQuota:input_type -> wsman.SetStorageQuotaRequest
+	26, // 87: wsman.WorkspaceManager.MigrateWorkspace:input_type -> wsman.MigrateWorkspaceRequest
+	28, // 88: wsman.WorkspaceManager.RenderWorkspacePod:input_type -> wsman.RenderWorkspacePodRequest
+	11, // 89: wsman.WorkspaceManager.GetWorkspaces:output_type -> wsman.GetWorkspacesResponse
+	13, // 90: wsman.WorkspaceManager.StartWorkspace:output_type -> wsman.StartWorkspaceResponse
+	15, // 91: wsman.WorkspaceManager.StopWorkspace:output_type -> wsman.StopWorkspaceResponse
+	17, // 92: wsman.WorkspaceManager.DescribeWorkspace:output_type -> wsman.DescribeWorkspaceResponse
+	39, // 93: wsman.WorkspaceManager.BackupWorkspace:output_type -> wsman.BackupWorkspaceResponse
+	19, // 94: wsman.WorkspaceManager.Subscribe:output_type -> wsman.SubscribeResponse
+	21, // 95: wsman.WorkspaceManager.MarkActive:output_type -> wsman.MarkActiveResponse
+	23, // 96: wsman.WorkspaceManager.SetTimeout:output_type -> wsman.SetTimeoutResponse
+	31, // 97: wsman.WorkspaceManager.ControlPort:output_type -> wsman.ControlPortResponse
+	33, // 98: wsman.WorkspaceManager.TakeSnapshot:output_type -> wsman.TakeSnapshotResponse
+	35, // 99: wsman.WorkspaceManager.ControlAdmission:output_type -> wsman.ControlAdmissionResponse
+	37, // 100: wsman.WorkspaceManager.DeleteVolumeSnapshot:output_type -> wsman.DeleteVolumeSnapshotResponse
+	41, // 101: wsman.WorkspaceManager.UpdateSSHKey:output_type -> wsman.UpdateSSHKeyResponse
+	59, // 102: wsman.WorkspaceManager.DescribeCluster:output_type -> wsman.DescribeClusterResponse
+	25, // 103: wsman.WorkspaceManager.SetStorageQuota:output_type -> wsman.SetStorageQuotaResponse
+	27, // 104: wsman.WorkspaceManager.MigrateWorkspace:output_type -> wsman.MigrateWorkspaceResponse
+	29, // 105: wsman.WorkspaceManager.RenderWorkspacePod:output_type -> wsman.RenderWorkspacePodResponse
+	89, // [89:106] is the sub-list for method output_type
+	72, // [72:89] is the sub-list for method input_type
+	72, // [72:72] is the sub-list for extension type_name
+	72, // [72:72] is the sub-list for extension extendee
+	0,  // [0:72] is the sub-list for field type_name
 }
 
 func init() { file_core_proto_init() }
@@ -4644,7 +5754,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ControlPortRequest); i {
+			switch v := v.(*SetStorageQuotaRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4656,7 +5766,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ControlPortResponse); i {
+			switch v := v.(*SetStorageQuotaResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4668,7 +5778,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TakeSnapshotRequest); i {
+			switch v := v.(*MigrateWorkspaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4680,7 +5790,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TakeSnapshotResponse); i {
+			switch v := v.(*MigrateWorkspaceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4692,7 +5802,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ControlAdmissionRequest); i {
+			switch v := v.(*RenderWorkspacePodRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4704,7 +5814,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ControlAdmissionResponse); i {
+			switch v := v.(*RenderWorkspacePodResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4716,7 +5826,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteVolumeSnapshotRequest); i {
+			switch v := v.(*ControlPortRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4728,7 +5838,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteVolumeSnapshotResponse); i {
+			switch v := v.(*ControlPortResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4740,7 +5850,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BackupWorkspaceRequest); i {
+			switch v := v.(*TakeSnapshotRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4752,7 +5862,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BackupWorkspaceResponse); i {
+			switch v := v.(*TakeSnapshotResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4764,7 +5874,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSSHKeyRequest); i {
+			switch v := v.(*ControlAdmissionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4776,7 +5886,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSSHKeyResponse); i {
+			switch v := v.(*ControlAdmissionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4788,7 +5898,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkspaceStatus); i {
+			switch v := v.(*DeleteVolumeSnapshotRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4800,7 +5910,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IDEImage); i {
+			switch v := v.(*DeleteVolumeSnapshotResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4812,7 +5922,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkspaceSpec); i {
+			switch v := v.(*BackupWorkspaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4824,7 +5934,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PortSpec); i {
+			switch v := v.(*BackupWorkspaceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4836,7 +5946,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VolumeSnapshotInfo); i {
+			switch v := v.(*UpdateSSHKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4848,7 +5958,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkspaceConditions); i {
+			switch v := v.(*UpdateSSHKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4860,7 +5970,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkspaceMetadata); i {
+			switch v := v.(*WorkspaceStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4872,7 +5982,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkspaceRuntimeInfo); i {
+			switch v := v.(*WorkspaceStorage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4884,7 +5994,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkspaceAuthentication); i {
+			switch v := v.(*WorkspaceTimelineEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4896,7 +6006,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartWorkspaceSpec); i {
+			switch v := v.(*IDEImage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4908,7 +6018,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitSpec); i {
+			switch v := v.(*WorkspaceSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4920,7 +6030,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnvironmentVariable); i {
+			switch v := v.(*PortSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4932,7 +6042,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExposedPorts); i {
+			switch v := v.(*VolumeSnapshotInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4944,7 +6054,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SSHPublicKeys); i {
+			switch v := v.(*WorkspaceConditions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4956,7 +6066,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DescribeClusterRequest); i {
+			switch v := v.(*WorkspaceMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4968,7 +6078,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DescribeClusterResponse); i {
+			switch v := v.(*WorkspaceRuntimeInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4980,7 +6090,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkspaceClass); i {
+			switch v := v.(*WorkspaceAuthentication); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4992,7 +6102,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitializerMetric); i {
+			switch v := v.(*StartWorkspaceSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5004,7 +6114,31 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitializerMetrics); i {
+			switch v := v.(*GitSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnvironmentVariable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExposedPorts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5016,7 +6150,7 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkspaceMetadata_ImageInfo); i {
+			switch v := v.(*SSHPublicKeys); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5028,7 +6162,19 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkspaceMetadata_Metrics); i {
+			switch v := v.(*DescribeClusterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeClusterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5040,6 +6186,126 @@ func file_core_proto_init() {
 			}
 		}
 		file_core_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceClass); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceTimeouts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrganizationTimeouts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceQuota); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceQuotaClass); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceQuotaResource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitializerMetric); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitializerMetrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceMetadata_ImageInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceMetadata_Metrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EnvironmentVariable_SecretKeyRef); i {
 			case 0:
 				return &v.state
@@ -5052,14 +6318,14 @@ func file_core_proto_init() {
 			}
 		}
 	}
-	file_core_proto_msgTypes[33].OneofWrappers = []interface{}{}
+	file_core_proto_msgTypes[41].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_core_proto_rawDesc,
 			NumEnums:      9,
-			NumMessages:   52,
+			NumMessages:   65,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -54,6 +54,12 @@ type WorkspaceManagerClient interface {
 	UpdateSSHKey(ctx context.Context, in *UpdateSSHKeyRequest, opts ...grpc.CallOption) (*UpdateSSHKeyResponse, error)
 	// describeCluster provides information about the cluster
 	DescribeCluster(ctx context.Context, in *DescribeClusterRequest, opts ...grpc.CallOption) (*DescribeClusterResponse, error)
+	// setStorageQuota changes the size of the /workspace quota of a running workspace
+	SetStorageQuota(ctx context.Context, in *SetStorageQuotaRequest, opts ...grpc.CallOption) (*SetStorageQuotaResponse, error)
+	// migrateWorkspace moves a running workspace to another node, keeping its ID and URL
+	MigrateWorkspace(ctx context.Context, in *MigrateWorkspaceRequest, opts ...grpc.CallOption) (*MigrateWorkspaceResponse, error)
+	// renderWorkspacePod produces the pod a start workspace request would result in, without creating anything
+	RenderWorkspacePod(ctx context.Context, in *RenderWorkspacePodRequest, opts ...grpc.CallOption) (*RenderWorkspacePodResponse, error)
 }
 
 type workspaceManagerClient struct {
@@ -213,6 +219,33 @@ func (c *workspaceManagerClient) DescribeCluster(ctx context.Context, in *Descri
 	return out, nil
 }
 
+func (c *workspaceManagerClient) SetStorageQuota(ctx context.Context, in *SetStorageQuotaRequest, opts ...grpc.CallOption) (*SetStorageQuotaResponse, error) {
+	out := new(SetStorageQuotaResponse)
+	err := c.cc.Invoke(ctx, "/wsman.WorkspaceManager/SetStorageQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceManagerClient) MigrateWorkspace(ctx context.Context, in *MigrateWorkspaceRequest, opts ...grpc.CallOption) (*MigrateWorkspaceResponse, error) {
+	out := new(MigrateWorkspaceResponse)
+	err := c.cc.Invoke(ctx, "/wsman.WorkspaceManager/MigrateWorkspace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceManagerClient) RenderWorkspacePod(ctx context.Context, in *RenderWorkspacePodRequest, opts ...grpc.CallOption) (*RenderWorkspacePodResponse, error) {
+	out := new(RenderWorkspacePodResponse)
+	err := c.cc.Invoke(ctx, "/wsman.WorkspaceManager/RenderWorkspacePod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkspaceManagerServer is the server API for WorkspaceManager service.
 // All implementations must embed UnimplementedWorkspaceManagerServer
 // for forward compatibility
@@ -245,6 +278,12 @@ type WorkspaceManagerServer interface {
 	UpdateSSHKey(context.Context, *UpdateSSHKeyRequest) (*UpdateSSHKeyResponse, error)
 	// describeCluster provides information about the cluster
 	DescribeCluster(context.Context, *DescribeClusterRequest) (*DescribeClusterResponse, error)
+	// setStorageQuota changes the size of the /workspace quota of a running workspace
+	SetStorageQuota(context.Context, *SetStorageQuotaRequest) (*SetStorageQuotaResponse, error)
+	// migrateWorkspace moves a running workspace to another node, keeping its ID and URL
+	MigrateWorkspace(context.Context, *MigrateWorkspaceRequest) (*MigrateWorkspaceResponse, error)
+	// renderWorkspacePod produces the pod a start workspace request would result in, without creating anything
+	RenderWorkspacePod(context.Context, *RenderWorkspacePodRequest) (*RenderWorkspacePodResponse, error)
 	mustEmbedUnimplementedWorkspaceManagerServer()
 }
 
@@ -294,6 +333,15 @@ func (UnimplementedWorkspaceManagerServer) UpdateSSHKey(context.Context, *Update
 func (UnimplementedWorkspaceManagerServer) DescribeCluster(context.Context, *DescribeClusterRequest) (*DescribeClusterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeCluster not implemented")
 }
+func (UnimplementedWorkspaceManagerServer) SetStorageQuota(context.Context, *SetStorageQuotaRequest) (*SetStorageQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStorageQuota not implemented")
+}
+func (UnimplementedWorkspaceManagerServer) MigrateWorkspace(context.Context, *MigrateWorkspaceRequest) (*MigrateWorkspaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateWorkspace not implemented")
+}
+func (UnimplementedWorkspaceManagerServer) RenderWorkspacePod(context.Context, *RenderWorkspacePodRequest) (*RenderWorkspacePodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderWorkspacePod not implemented")
+}
 func (UnimplementedWorkspaceManagerServer) mustEmbedUnimplementedWorkspaceManagerServer() {}
 
 // UnsafeWorkspaceManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -562,6 +610,60 @@ func _WorkspaceManager_DescribeCluster_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkspaceManager_SetStorageQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStorageQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceManagerServer).SetStorageQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsman.WorkspaceManager/SetStorageQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceManagerServer).SetStorageQuota(ctx, req.(*SetStorageQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceManager_MigrateWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateWorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceManagerServer).MigrateWorkspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsman.WorkspaceManager/MigrateWorkspace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceManagerServer).MigrateWorkspace(ctx, req.(*MigrateWorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceManager_RenderWorkspacePod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderWorkspacePodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceManagerServer).RenderWorkspacePod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsman.WorkspaceManager/RenderWorkspacePod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceManagerServer).RenderWorkspacePod(ctx, req.(*RenderWorkspacePodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorkspaceManager_ServiceDesc is the grpc.ServiceDesc for WorkspaceManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -621,6 +723,18 @@ var WorkspaceManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeCluster",
 			Handler:    _WorkspaceManager_DescribeCluster_Handler,
 		},
+		{
+			MethodName: "SetStorageQuota",
+			Handler:    _WorkspaceManager_SetStorageQuota_Handler,
+		},
+		{
+			MethodName: "MigrateWorkspace",
+			Handler:    _WorkspaceManager_MigrateWorkspace_Handler,
+		},
+		{
+			MethodName: "RenderWorkspacePod",
+			Handler:    _WorkspaceManager_RenderWorkspacePod_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
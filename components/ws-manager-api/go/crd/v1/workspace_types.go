@@ -64,6 +64,15 @@ type WorkspaceSpec struct {
 	StorageQuota int `json:"storageQuota,omitempty"`
 
 	SSHGatewayCAPublicKey string `json:"sshGatewayCAPublicKey,omitempty"`
+
+	// EgressPolicy names the network egress policy the workspace is subject to
+	// +kubebuilder:validation:Optional
+	EgressPolicy string `json:"egressPolicy,omitempty"`
+
+	// Paused requests the workspace's processes to be frozen. ws-daemon freezes the workspace container's cgroup,
+	// which keeps the processes' memory but releases their CPU, and thaws it once this is false again.
+	// +kubebuilder:validation:Optional
+	Paused bool `json:"paused,omitempty"`
 }
 
 type Ownership struct {
@@ -212,6 +221,15 @@ type InitializerStepMetric struct {
 
 	// +kubebuilder:validation:Optional
 	Size uint64 `json:"size"`
+
+	// +kubebuilder:validation:Optional
+	FetchedBytes uint64 `json:"fetchedBytes,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	LFSBytes uint64 `json:"lfsBytes,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	SubmoduleDuration *metav1.Duration `json:"submoduleDuration,omitempty"`
 }
 
 // WorkspaceStatus defines the observed state of Workspace
@@ -253,6 +271,14 @@ type WorkspaceStatus struct {
 
 	// +kubebuilder:validation:Optional
 	InitializerMetrics *InitializerMetrics `json:"initializerMetrics,omitempty"`
+
+	// Migration is set while the workspace is moved to another node
+	// +kubebuilder:validation:Optional
+	Migration *WorkspaceMigrationStatus `json:"migration,omitempty"`
+
+	// Timeline records when the workspace entered its phases and when its conditions and those of its pod changed, oldest first
+	// +kubebuilder:validation:Optional
+	Timeline []WorkspaceTimelineEvent `json:"timeline,omitempty"`
 }
 
 func (s *WorkspaceStatus) SetCondition(cond metav1.Condition) {
@@ -263,6 +289,15 @@ type StorageStatus struct {
 	VolumeName     string `json:"volumeName"`
 	AttachedDevice string `json:"attachedDevice"`
 	MountPath      string `json:"mountPath"`
+
+	// QuotaLimit is the XFS quota in bytes ws-daemon currently enforces on the workspace's /workspace folder.
+	// It lags behind spec.storageQuota while a resize is pending, and stays put if the resize was rejected.
+	// +kubebuilder:validation:Optional
+	QuotaLimit int64 `json:"quotaLimit,omitempty"`
+
+	// QuotaUsed is the number of bytes used in the workspace's /workspace folder at the time of the last check
+	// +kubebuilder:validation:Optional
+	QuotaUsed int64 `json:"quotaUsed,omitempty"`
 }
 
 // +kubebuilder:validation:Enum=Deployed;Failed;Timeout;FirstUserActivity;Closed;HeadlessTaskFailed;StoppedByRequest;Aborted;ContentReady;EverReady;BackupComplete;BackupFailure;Refresh;NodeDisappeared;ThroughputAdjusted
@@ -332,6 +367,23 @@ const (
 
 	// WorkspaceConditionForceKilledTask is true if we send a SIGKILL to the task
 	WorkspaceConditionForceKilledTask WorkspaceCondition = "ForceKilledTask"
+
+	// WorkspaceConditionStorageQuotaRejected is true if ws-daemon refused to apply spec.storageQuota to the running workspace.
+	// The condition message contains the reason.
+	WorkspaceConditionStorageQuotaRejected WorkspaceCondition = "StorageQuotaRejected"
+
+	// WorkspaceConditionPreempted is true if the workspace was stopped to make way for a workspace of higher priority.
+	// While it is true, the workspace is started again once it has stopped.
+	WorkspaceConditionPreempted WorkspaceCondition = "Preempted"
+
+	// WorkspaceConditionMigrating is true while the workspace is moved to another node. Its pod is stopped with a
+	// backup, and a replacement pod restores that backup on another node. The condition turns false once the
+	// replacement runs, or if the migration failed.
+	WorkspaceConditionMigrating WorkspaceCondition = "Migrating"
+
+	// WorkspaceConditionPaused is true while ws-daemon keeps the processes of the workspace frozen, see spec.paused.
+	// The time of its last transition is when the workspace was paused or resumed.
+	WorkspaceConditionPaused WorkspaceCondition = "Paused"
 )
 
 func NewWorkspaceConditionDeployed() metav1.Condition {
@@ -494,7 +546,43 @@ func NewWorkspaceConditionForceKilledTask() metav1.Condition {
 	}
 }
 
-// +kubebuilder:validation:Enum:=Unknown;Pending;Imagebuild;Creating;Initializing;Running;Stopping;Stopped
+func NewWorkspaceConditionStorageQuotaRejected(message string, status metav1.ConditionStatus) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(WorkspaceConditionStorageQuotaRejected),
+		LastTransitionTime: metav1.Now(),
+		Status:             status,
+		Message:            message,
+	}
+}
+
+func NewWorkspaceConditionPreempted(message string, status metav1.ConditionStatus) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(WorkspaceConditionPreempted),
+		LastTransitionTime: metav1.Now(),
+		Status:             status,
+		Message:            message,
+	}
+}
+
+func NewWorkspaceConditionMigrating(message string, status metav1.ConditionStatus) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(WorkspaceConditionMigrating),
+		LastTransitionTime: metav1.Now(),
+		Status:             status,
+		Message:            message,
+	}
+}
+
+func NewWorkspaceConditionPaused(message string, status metav1.ConditionStatus) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(WorkspaceConditionPaused),
+		LastTransitionTime: metav1.Now(),
+		Status:             status,
+		Message:            message,
+	}
+}
+
+// +kubebuilder:validation:Enum:=Unknown;Pending;Imagebuild;Creating;Initializing;Running;Paused;Stopping;Stopped
 type WorkspacePhase string
 
 const (
@@ -504,6 +592,7 @@ const (
 	WorkspacePhaseCreating     WorkspacePhase = "Creating"
 	WorkspacePhaseInitializing WorkspacePhase = "Initializing"
 	WorkspacePhaseRunning      WorkspacePhase = "Running"
+	WorkspacePhasePaused       WorkspacePhase = "Paused"
 	WorkspacePhaseStopping     WorkspacePhase = "Stopping"
 	WorkspacePhaseStopped      WorkspacePhase = "Stopped"
 )
@@ -527,6 +616,44 @@ type GitStatus struct {
 	TotalUnpushedCommits int64 `json:"totalUnpushedCommits,omitempty"`
 }
 
+// WorkspaceMigrationStatus describes the move of a workspace to another node
+type WorkspaceMigrationStatus struct {
+	// SourceNode is the node the workspace is moved away from. The replacement pod is never scheduled there.
+	SourceNode string `json:"sourceNode"`
+
+	// Started is the time the migration was requested
+	Started metav1.Time `json:"started"`
+}
+
+// +kubebuilder:validation:Enum:=Phase;Condition;Pod;ImagePull
+type WorkspaceTimelineEventType string
+
+const (
+	// WorkspaceTimelineEventPhase marks the workspace entering a phase
+	WorkspaceTimelineEventPhase WorkspaceTimelineEventType = "Phase"
+	// WorkspaceTimelineEventCondition marks a change of a workspace condition
+	WorkspaceTimelineEventCondition WorkspaceTimelineEventType = "Condition"
+	// WorkspaceTimelineEventPod marks a change of a condition of the workspace pod, e.g. PodScheduled
+	WorkspaceTimelineEventPod WorkspaceTimelineEventType = "Pod"
+	// WorkspaceTimelineEventImagePull marks a container of the workspace pod starting (True) or finishing (False) to pull its image
+	WorkspaceTimelineEventImagePull WorkspaceTimelineEventType = "ImagePull"
+)
+
+// WorkspaceTimelineEvent marks a point in the life of a workspace
+type WorkspaceTimelineEvent struct {
+	Type WorkspaceTimelineEventType `json:"type"`
+
+	// Name is the phase the workspace entered, the condition which changed, or the container which pulls its image
+	Name string `json:"name"`
+
+	// Status is the status the condition changed to. It is empty for phases.
+	// +kubebuilder:validation:Optional
+	Status metav1.ConditionStatus `json:"status,omitempty"`
+
+	// Time is when the event happened
+	Time metav1.MicroTime `json:"time"`
+}
+
 type WorkspaceRuntimeStatus struct {
 	NodeName string `json:"nodeName,omitempty"`
 	PodName  string `json:"podName,omitempty"`
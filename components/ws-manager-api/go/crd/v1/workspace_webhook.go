@@ -5,6 +5,10 @@
 package v1
 
 import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -21,6 +25,16 @@ func (r *Workspace) SetupWebhookWithManager(mgr ctrl.Manager) error {
 		Complete()
 }
 
+// SetupWebhookWithPolicies registers the webhook such that it also rejects workspaces which would exceed
+// the WorkspaceQuota of their organization, or whose timeouts exceed the limits of their class and organization.
+// Either policy may be nil.
+func (r *Workspace) SetupWebhookWithPolicies(mgr ctrl.Manager, quotas *WorkspaceQuotaEnforcer, timeouts TimeoutLimitsFunc) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithValidator(&workspaceValidator{Quotas: quotas, TimeoutLimits: timeouts}).
+		Complete()
+}
+
 // TODO(user): EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
 
 //+kubebuilder:webhook:path=/mutate-workspace-gitpod-io-v1-workspace,mutating=true,failurePolicy=fail,sideEffects=None,groups=workspace.gitpod.io,resources=workspaces,verbs=create;update,versions=v1,name=mworkspace.kb.io,admissionReviewVersions=v1
@@ -64,3 +78,70 @@ func (r *Workspace) ValidateDelete() (admission.Warnings, error) {
 func (r *Workspace) validateWorkspace() (admission.Warnings, error) {
 	return nil, nil
 }
+
+// workspaceValidator validates workspaces like Workspace does, enforces workspace quotas on creation
+// and timeout limits whenever the timeouts change
+type workspaceValidator struct {
+	Quotas        *WorkspaceQuotaEnforcer
+	TimeoutLimits TimeoutLimitsFunc
+}
+
+var _ webhook.CustomValidator = &workspaceValidator{}
+
+// ValidateCreate implements webhook.CustomValidator
+func (v *workspaceValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	ws, ok := obj.(*Workspace)
+	if !ok {
+		return nil, fmt.Errorf("expected a workspace but got %T", obj)
+	}
+
+	warnings, err := ws.ValidateCreate()
+	if err != nil {
+		return warnings, err
+	}
+	err = v.admitTimeouts(ws)
+	if err != nil {
+		return warnings, err
+	}
+	if v.Quotas == nil {
+		return warnings, nil
+	}
+
+	return warnings, v.Quotas.Admit(ctx, ws)
+}
+
+// ValidateUpdate implements webhook.CustomValidator
+func (v *workspaceValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	ws, ok := newObj.(*Workspace)
+	if !ok {
+		return nil, fmt.Errorf("expected a workspace but got %T", newObj)
+	}
+
+	warnings, err := ws.ValidateUpdate(oldObj)
+	if err != nil {
+		return warnings, err
+	}
+	// limits may have been lowered since the workspace was created, which must not keep us from updating it otherwise
+	if old, ok := oldObj.(*Workspace); ok && equality.Semantic.DeepEqual(old.Spec.Timeout, ws.Spec.Timeout) {
+		return warnings, nil
+	}
+
+	return warnings, v.admitTimeouts(ws)
+}
+
+func (v *workspaceValidator) admitTimeouts(ws *Workspace) error {
+	if v.TimeoutLimits == nil {
+		return nil
+	}
+	return v.TimeoutLimits(ws.Spec.Ownership.Team, ws.Spec.Class).Admit(ws)
+}
+
+// ValidateDelete implements webhook.CustomValidator
+func (v *workspaceValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	ws, ok := obj.(*Workspace)
+	if !ok {
+		return nil, fmt.Errorf("expected a workspace but got %T", obj)
+	}
+
+	return ws.ValidateDelete()
+}
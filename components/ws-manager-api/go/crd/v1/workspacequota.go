@@ -0,0 +1,200 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WorkspaceQuotaUsage is how much of its quota an organization uses
+// +kubebuilder:object:generate=false
+type WorkspaceQuotaUsage struct {
+	RunningWorkspaces int
+	Prebuilds         int
+	Classes           map[string]int
+	Resources         corev1.ResourceList
+}
+
+// ClassResourcesFunc returns the resources a workspace of a workspace class requests
+type ClassResourcesFunc func(class string) (corev1.ResourceList, error)
+
+// QuotaExceededError is returned when starting a workspace would exceed the quota of its organization
+// +kubebuilder:object:generate=false
+type QuotaExceededError struct {
+	Team  string
+	Quota string
+	Limit string
+	Used  string
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("organization %s exceeded its quota of %s %s (%s in use)", e.Team, e.Limit, e.Quota, e.Used)
+}
+
+// NewWorkspaceQuotaUsage computes how much of its quota an organization uses, based on all workspaces of the
+// organization which are not stopped. Image builds do not count towards the quota of an organization.
+// Workspaces whose class no longer exists count towards the number of workspaces, but not towards resources.
+func NewWorkspaceQuotaUsage(team string, workspaces []Workspace, resources ClassResourcesFunc) *WorkspaceQuotaUsage {
+	res := &WorkspaceQuotaUsage{
+		Classes:   make(map[string]int),
+		Resources: make(corev1.ResourceList),
+	}
+	for _, ws := range workspaces {
+		if ws.Spec.Ownership.Team != team || ws.Status.Phase == WorkspacePhaseStopped {
+			continue
+		}
+		// The class of a workspace may have been removed from the configuration since the workspace started.
+		requests, err := resources(ws.Spec.Class)
+		if err != nil {
+			requests = nil
+		}
+		res.add(&ws, requests)
+	}
+	return res
+}
+
+func (u *WorkspaceQuotaUsage) add(ws *Workspace, requests corev1.ResourceList) {
+	switch ws.Spec.Type {
+	case WorkspaceTypeRegular:
+		u.RunningWorkspaces++
+	case WorkspaceTypePrebuild:
+		u.Prebuilds++
+	default:
+		return
+	}
+	u.Classes[ws.Spec.Class]++
+
+	for name, q := range requests {
+		total := u.Resources[name]
+		total.Add(q)
+		u.Resources[name] = total
+	}
+}
+
+// Admit returns a *QuotaExceededError if adding the workspace to the usage would exceed the quota
+func (q *WorkspaceQuota) Admit(usage *WorkspaceQuotaUsage, ws *Workspace, resources ClassResourcesFunc) error {
+	if ws.Spec.Type != WorkspaceTypeRegular && ws.Spec.Type != WorkspaceTypePrebuild {
+		return nil
+	}
+
+	next := &WorkspaceQuotaUsage{
+		RunningWorkspaces: usage.RunningWorkspaces,
+		Prebuilds:         usage.Prebuilds,
+		Classes:           make(map[string]int, len(usage.Classes)),
+		Resources:         usage.Resources.DeepCopy(),
+	}
+	for class, n := range usage.Classes {
+		next.Classes[class] = n
+	}
+	if next.Resources == nil {
+		next.Resources = make(corev1.ResourceList)
+	}
+	requests, err := resources(ws.Spec.Class)
+	if err != nil {
+		return fmt.Errorf("cannot determine resources of workspace %s: %w", ws.Name, err)
+	}
+	next.add(ws, requests)
+
+	exceeded := func(quota string, limit, used int) error {
+		return &QuotaExceededError{Team: q.Spec.Team, Quota: quota, Limit: fmt.Sprint(limit), Used: fmt.Sprint(used)}
+	}
+	if l := q.Spec.RunningWorkspaces; l > 0 && ws.Spec.Type == WorkspaceTypeRegular && next.RunningWorkspaces > l {
+		return exceeded("running workspaces", l, usage.RunningWorkspaces)
+	}
+	if l := q.Spec.Prebuilds; l > 0 && ws.Spec.Type == WorkspaceTypePrebuild && next.Prebuilds > l {
+		return exceeded("prebuilds", l, usage.Prebuilds)
+	}
+	if l, ok := q.Spec.Classes[ws.Spec.Class]; ok && next.Classes[ws.Spec.Class] > l {
+		return exceeded(ws.Spec.Class+" workspaces", l, usage.Classes[ws.Spec.Class])
+	}
+	for name, limit := range q.Spec.Resources {
+		used := next.Resources[name]
+		if used.Cmp(limit) > 0 {
+			current := usage.Resources[name]
+			return &QuotaExceededError{Team: q.Spec.Team, Quota: string(name), Limit: limit.String(), Used: current.String()}
+		}
+	}
+	return nil
+}
+
+// WorkspaceQuotaEnforcer enforces the WorkspaceQuotas of organizations on workspaces which are about to be created
+// +kubebuilder:object:generate=false
+type WorkspaceQuotaEnforcer struct {
+	// Client should read from the API server rather than a cache, so that Admit sees workspaces created just before
+	Client    client.Reader
+	Namespace string
+	Resources ClassResourcesFunc
+}
+
+// Admit returns a *QuotaExceededError if the workspace would exceed a quota of its organization.
+// Workspaces which do not belong to an organization are not subject to any quota. The workspace itself never
+// counts towards the usage, which is why Admit can check a workspace again once it has been created.
+func (e *WorkspaceQuotaEnforcer) Admit(ctx context.Context, ws *Workspace) error {
+	team := ws.Spec.Ownership.Team
+	if team == "" {
+		return nil
+	}
+
+	quotas, usage, err := e.usage(ctx, ws.Name)
+	if err != nil {
+		return err
+	}
+	for _, quota := range quotas {
+		if quota.Spec.Team != team {
+			continue
+		}
+		err = quota.Admit(usage[team], ws, e.Resources)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Usage lists all quotas and computes the usage of every organization which has one
+func (e *WorkspaceQuotaEnforcer) Usage(ctx context.Context) ([]WorkspaceQuota, map[string]*WorkspaceQuotaUsage, error) {
+	return e.usage(ctx, "")
+}
+
+// usage lists all quotas and computes the usage of every organization which has one, ignoring the workspace named exclude
+func (e *WorkspaceQuotaEnforcer) usage(ctx context.Context, exclude string) ([]WorkspaceQuota, map[string]*WorkspaceQuotaUsage, error) {
+	var quotas WorkspaceQuotaList
+	err := e.Client.List(ctx, &quotas, client.InNamespace(e.Namespace))
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot list workspace quotas: %w", err)
+	}
+	if len(quotas.Items) == 0 {
+		return nil, nil, nil
+	}
+
+	var workspaces WorkspaceList
+	err = e.Client.List(ctx, &workspaces, client.InNamespace(e.Namespace))
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot list workspaces: %w", err)
+	}
+	items := workspaces.Items
+	if exclude != "" {
+		items = make([]Workspace, 0, len(workspaces.Items))
+		for _, ws := range workspaces.Items {
+			if ws.Name != exclude {
+				items = append(items, ws)
+			}
+		}
+	}
+
+	usage := make(map[string]*WorkspaceQuotaUsage, len(quotas.Items))
+	for _, quota := range quotas.Items {
+		team := quota.Spec.Team
+		if _, ok := usage[team]; ok {
+			continue
+		}
+		usage[team] = NewWorkspaceQuotaUsage(team, items, e.Resources)
+	}
+	return quotas.Items, usage, nil
+}
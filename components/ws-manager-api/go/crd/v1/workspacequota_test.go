@@ -0,0 +1,176 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestWorkspaceQuotaAdmit(t *testing.T) {
+	resources := func(class string) (corev1.ResourceList, error) {
+		return corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("2"),
+			corev1.ResourceMemory: resource.MustParse("4Gi"),
+		}, nil
+	}
+	workspace := func(tpe WorkspaceType, class string, phase WorkspacePhase) Workspace {
+		return Workspace{
+			Spec: WorkspaceSpec{
+				Type:      tpe,
+				Class:     class,
+				Ownership: Ownership{Team: "acme"},
+			},
+			Status: WorkspaceStatus{Phase: phase},
+		}
+	}
+	existing := []Workspace{
+		workspace(WorkspaceTypeRegular, "default", WorkspacePhaseRunning),
+		workspace(WorkspaceTypeRegular, "large", WorkspacePhaseRunning),
+		workspace(WorkspaceTypePrebuild, "default", WorkspacePhaseInitializing),
+		workspace(WorkspaceTypeImageBuild, "default", WorkspacePhaseRunning),
+		workspace(WorkspaceTypeRegular, "default", WorkspacePhaseStopped),
+	}
+
+	tests := []struct {
+		Name          string
+		Spec          WorkspaceQuotaSpec
+		Workspace     Workspace
+		ExpectedQuota string
+	}{
+		{
+			Name:      "no limits",
+			Workspace: workspace(WorkspaceTypeRegular, "default", ""),
+		},
+		{
+			Name:      "within limits",
+			Spec:      WorkspaceQuotaSpec{RunningWorkspaces: 3, Prebuilds: 2, Classes: map[string]int{"large": 2}},
+			Workspace: workspace(WorkspaceTypeRegular, "large", ""),
+		},
+		{
+			Name:          "running workspaces",
+			Spec:          WorkspaceQuotaSpec{RunningWorkspaces: 2},
+			Workspace:     workspace(WorkspaceTypeRegular, "default", ""),
+			ExpectedQuota: "running workspaces",
+		},
+		{
+			Name:      "prebuilds do not count as running workspaces",
+			Spec:      WorkspaceQuotaSpec{RunningWorkspaces: 2},
+			Workspace: workspace(WorkspaceTypePrebuild, "default", ""),
+		},
+		{
+			Name:          "prebuilds",
+			Spec:          WorkspaceQuotaSpec{Prebuilds: 1},
+			Workspace:     workspace(WorkspaceTypePrebuild, "default", ""),
+			ExpectedQuota: "prebuilds",
+		},
+		{
+			Name:          "class",
+			Spec:          WorkspaceQuotaSpec{Classes: map[string]int{"large": 1}},
+			Workspace:     workspace(WorkspaceTypeRegular, "large", ""),
+			ExpectedQuota: "large workspaces",
+		},
+		{
+			Name:          "resources",
+			Spec:          WorkspaceQuotaSpec{Resources: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("12Gi")}},
+			Workspace:     workspace(WorkspaceTypeRegular, "default", ""),
+			ExpectedQuota: "memory",
+		},
+		{
+			Name:      "image builds are not subject to quota",
+			Spec:      WorkspaceQuotaSpec{RunningWorkspaces: 1, Prebuilds: 1, Resources: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}},
+			Workspace: workspace(WorkspaceTypeImageBuild, "default", ""),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			usage := NewWorkspaceQuotaUsage("acme", existing, resources)
+
+			test.Spec.Team = "acme"
+			quota := &WorkspaceQuota{Spec: test.Spec}
+			err := quota.Admit(usage, &test.Workspace, resources)
+
+			var act string
+			var qerr *QuotaExceededError
+			if errors.As(err, &qerr) {
+				act = qerr.Quota
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			if act != test.ExpectedQuota {
+				t.Errorf("unexpected exceeded quota: expected \"%s\", got \"%s\"", test.ExpectedQuota, act)
+			}
+		})
+	}
+}
+
+func TestWorkspaceQuotaUsageRemovedClass(t *testing.T) {
+	resources := func(class string) (corev1.ResourceList, error) {
+		if class != "default" {
+			return nil, fmt.Errorf("unknown workspace class: %s", class)
+		}
+		return corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")}, nil
+	}
+	workspaces := []Workspace{
+		{Spec: WorkspaceSpec{Type: WorkspaceTypeRegular, Class: "default", Ownership: Ownership{Team: "acme"}}},
+		{Spec: WorkspaceSpec{Type: WorkspaceTypeRegular, Class: "removed", Ownership: Ownership{Team: "acme"}}},
+	}
+
+	usage := NewWorkspaceQuotaUsage("acme", workspaces, resources)
+	if usage.RunningWorkspaces != 2 || usage.Classes["removed"] != 1 {
+		t.Errorf("workspaces of a removed class must still count: %+v", usage)
+	}
+	if cpu := usage.Resources[corev1.ResourceCPU]; cpu.Cmp(resource.MustParse("2")) != 0 {
+		t.Errorf("workspaces of a removed class must not count towards resources: %s", cpu.String())
+	}
+}
+
+func TestWorkspaceQuotaEnforcerAdmitCreated(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	workspace := func(name string) *Workspace {
+		return &Workspace{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       WorkspaceSpec{Type: WorkspaceTypeRegular, Class: "default", Ownership: Ownership{Team: "acme"}},
+			Status:     WorkspaceStatus{Phase: WorkspacePhaseRunning},
+		}
+	}
+	enforcer := func(objs ...client.Object) *WorkspaceQuotaEnforcer {
+		quota := &WorkspaceQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: "acme", Namespace: "default"},
+			Spec:       WorkspaceQuotaSpec{Team: "acme", RunningWorkspaces: 2},
+		}
+		return &WorkspaceQuotaEnforcer{
+			Client:    fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(objs, quota)...).Build(),
+			Namespace: "default",
+			Resources: func(class string) (corev1.ResourceList, error) { return nil, nil },
+		}
+	}
+
+	// the workspace was created already and must not count against itself
+	err := enforcer(workspace("running"), workspace("new")).Admit(context.Background(), workspace("new"))
+	if err != nil {
+		t.Errorf("expected created workspace to be admitted, got %v", err)
+	}
+
+	// a concurrently created workspace took the last spot
+	var qerr *QuotaExceededError
+	err = enforcer(workspace("running"), workspace("concurrent"), workspace("new")).Admit(context.Background(), workspace("new"))
+	if !errors.As(err, &qerr) {
+		t.Errorf("expected quota to be exceeded, got %v", err)
+	}
+}
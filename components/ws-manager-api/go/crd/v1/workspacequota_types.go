@@ -0,0 +1,66 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WorkspaceQuotaSpec defines the limits the workspaces of an organization are subject to.
+// Limits which are not set are not enforced.
+type WorkspaceQuotaSpec struct {
+	// Team is the organization the quota applies to. It matches the team of the workspace ownership.
+	// +kubebuilder:validation:Required
+	Team string `json:"team"`
+
+	// RunningWorkspaces is the number of regular workspaces the organization can run at the same time
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	RunningWorkspaces int `json:"runningWorkspaces,omitempty"`
+
+	// Classes is the number of workspaces of a workspace class the organization can run at the same time
+	// +kubebuilder:validation:Optional
+	Classes map[string]int `json:"classes,omitempty"`
+
+	// Resources is the total amount of resources the workspaces of the organization can request
+	// +kubebuilder:validation:Optional
+	Resources corev1.ResourceList `json:"resources,omitempty"`
+
+	// Prebuilds is the number of prebuilds the organization can run at the same time
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	Prebuilds int `json:"prebuilds,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:shortName=wsquota
+// Custom print columns on the Custom Resource Definition. These are the columns
+// showing up when doing e.g. `kubectl get workspacequotas`.
+// Columns with priority > 0 will only show up with `-o wide`.
+//+kubebuilder:printcolumn:name="Team",type="string",JSONPath=".spec.team"
+//+kubebuilder:printcolumn:name="Running",type="integer",JSONPath=".spec.runningWorkspaces"
+//+kubebuilder:printcolumn:name="Prebuilds",type="integer",JSONPath=".spec.prebuilds"
+
+// WorkspaceQuota is the Schema for the workspacequota API
+type WorkspaceQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec WorkspaceQuotaSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// WorkspaceQuotaList contains a list of WorkspaceQuotas
+type WorkspaceQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WorkspaceQuota `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&WorkspaceQuota{}, &WorkspaceQuotaList{})
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package v1
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+var scheduleDays = map[WorkspaceScheduleDay]time.Weekday{
+	"Sunday":    time.Sunday,
+	"Monday":    time.Monday,
+	"Tuesday":   time.Tuesday,
+	"Wednesday": time.Wednesday,
+	"Thursday":  time.Thursday,
+	"Friday":    time.Friday,
+	"Saturday":  time.Saturday,
+}
+
+// Location returns the time zone the windows of the schedule are expressed in
+func (s *WorkspaceScheduleSpec) Location() (*time.Location, error) {
+	if s.Timezone == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %s: %w", s.Timezone, err)
+	}
+	return loc, nil
+}
+
+// Window returns the window which contains t. Windows which overlap or touch count as one. If t lies outside
+// of all windows, ok is false.
+func (s *WorkspaceScheduleSpec) Window(t time.Time) (start, end time.Time, ok bool, err error) {
+	windows, err := s.windows(t)
+	if err != nil {
+		return time.Time{}, time.Time{}, false, err
+	}
+	for _, w := range windows {
+		if !t.Before(w.Start) && t.Before(w.End) {
+			return w.Start, w.End, true, nil
+		}
+	}
+	return time.Time{}, time.Time{}, false, nil
+}
+
+// NextWindow returns the first window which starts after t. ok is false if the schedule has no windows.
+func (s *WorkspaceScheduleSpec) NextWindow(t time.Time) (start, end time.Time, ok bool, err error) {
+	windows, err := s.windows(t)
+	if err != nil {
+		return time.Time{}, time.Time{}, false, err
+	}
+	for _, w := range windows {
+		if w.Start.After(t) {
+			return w.Start, w.End, true, nil
+		}
+	}
+	return time.Time{}, time.Time{}, false, nil
+}
+
+type scheduleInterval struct {
+	Start, End time.Time
+}
+
+// windows returns the windows which start between the day before and a week after t, sorted and merged.
+// The start and end of every window are computed from the calendar date in the schedule's time zone, which
+// is what keeps them at the same local time across daylight saving time transitions.
+func (s *WorkspaceScheduleSpec) windows(t time.Time) ([]scheduleInterval, error) {
+	loc, err := s.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	local := t.In(loc)
+	var res []scheduleInterval
+	for i, w := range s.Windows {
+		sh, sm, err := parseTimeOfDay(w.Start)
+		if err != nil {
+			return nil, fmt.Errorf("window %d: invalid start: %w", i, err)
+		}
+		eh, em, err := parseTimeOfDay(w.End)
+		if err != nil {
+			return nil, fmt.Errorf("window %d: invalid end: %w", i, err)
+		}
+		days := make(map[time.Weekday]struct{}, len(w.Days))
+		for _, d := range w.Days {
+			wd, ok := scheduleDays[d]
+			if !ok {
+				return nil, fmt.Errorf("window %d: invalid day %s", i, d)
+			}
+			days[wd] = struct{}{}
+		}
+
+		for offset := -1; offset <= 7; offset++ {
+			y, m, d := local.Date()
+			d += offset
+			start := time.Date(y, m, d, sh, sm, 0, 0, loc)
+			if _, ok := days[start.Weekday()]; len(days) > 0 && !ok {
+				continue
+			}
+			if eh < sh || (eh == sh && em <= sm) {
+				d++
+			}
+			res = append(res, scheduleInterval{Start: start, End: time.Date(y, m, d, eh, em, 0, 0, loc)})
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].Start.Before(res[j].Start) })
+	var merged []scheduleInterval
+	for _, w := range res {
+		if n := len(merged); n > 0 && !w.Start.After(merged[n-1].End) {
+			if w.End.After(merged[n-1].End) {
+				merged[n-1].End = w.End
+			}
+			continue
+		}
+		merged = append(merged, w)
+	}
+	return merged, nil
+}
+
+func parseTimeOfDay(s string) (hour, minute int, err error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, 0, err
+	}
+	return t.Hour(), t.Minute(), nil
+}
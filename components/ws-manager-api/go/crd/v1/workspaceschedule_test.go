@@ -0,0 +1,144 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package v1
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWorkspaceScheduleWindow(t *testing.T) {
+	weekdays := []WorkspaceScheduleDay{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"}
+	utc := func(s string) time.Time {
+		res, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+
+	type Expectation struct {
+		InWindow  bool
+		End       time.Time
+		NextStart time.Time
+		Error     bool
+	}
+	tests := []struct {
+		Name        string
+		Spec        WorkspaceScheduleSpec
+		Time        time.Time
+		Expectation Expectation
+	}{
+		{
+			Name: "within window before DST change",
+			Spec: WorkspaceScheduleSpec{
+				Timezone: "Europe/Berlin",
+				Windows:  []WorkspaceScheduleWindow{{Days: weekdays, Start: "09:00", End: "17:00"}},
+			},
+			// Friday 10:00 CET
+			Time: utc("2026-03-27T09:00:00Z"),
+			Expectation: Expectation{
+				InWindow:  true,
+				End:       utc("2026-03-27T16:00:00Z"),
+				NextStart: utc("2026-03-30T07:00:00Z"),
+			},
+		},
+		{
+			Name: "weekend across DST change",
+			Spec: WorkspaceScheduleSpec{
+				Timezone: "Europe/Berlin",
+				Windows:  []WorkspaceScheduleWindow{{Days: weekdays, Start: "09:00", End: "17:00"}},
+			},
+			// Saturday noon CET, the clocks go forward on Sunday
+			Time: utc("2026-03-28T11:00:00Z"),
+			Expectation: Expectation{
+				// Monday 09:00 CEST
+				NextStart: utc("2026-03-30T07:00:00Z"),
+			},
+		},
+		{
+			Name: "window across midnight",
+			Spec: WorkspaceScheduleSpec{
+				Timezone: "America/New_York",
+				Windows:  []WorkspaceScheduleWindow{{Start: "22:00", End: "06:00"}},
+			},
+			// 01:00 EDT
+			Time: utc("2026-07-01T05:00:00Z"),
+			Expectation: Expectation{
+				InWindow:  true,
+				End:       utc("2026-07-01T10:00:00Z"),
+				NextStart: utc("2026-07-02T02:00:00Z"),
+			},
+		},
+		{
+			Name: "adjacent windows are merged",
+			Spec: WorkspaceScheduleSpec{
+				Windows: []WorkspaceScheduleWindow{
+					{Start: "08:00", End: "12:00"},
+					{Start: "12:00", End: "18:00"},
+				},
+			},
+			Time: utc("2026-07-01T11:00:00Z"),
+			Expectation: Expectation{
+				InWindow:  true,
+				End:       utc("2026-07-01T18:00:00Z"),
+				NextStart: utc("2026-07-02T08:00:00Z"),
+			},
+		},
+		{
+			Name: "end of window is outside",
+			Spec: WorkspaceScheduleSpec{
+				Windows: []WorkspaceScheduleWindow{{Start: "08:00", End: "18:00"}},
+			},
+			Time: utc("2026-07-01T18:00:00Z"),
+			Expectation: Expectation{
+				NextStart: utc("2026-07-02T08:00:00Z"),
+			},
+		},
+		{
+			Name: "invalid timezone",
+			Spec: WorkspaceScheduleSpec{
+				Timezone: "Mars/Olympus_Mons",
+				Windows:  []WorkspaceScheduleWindow{{Start: "08:00", End: "18:00"}},
+			},
+			Time:        utc("2026-07-01T12:00:00Z"),
+			Expectation: Expectation{Error: true},
+		},
+		{
+			Name: "invalid day",
+			Spec: WorkspaceScheduleSpec{
+				Windows: []WorkspaceScheduleWindow{{Days: []WorkspaceScheduleDay{"Caturday"}, Start: "08:00", End: "18:00"}},
+			},
+			Time:        utc("2026-07-01T12:00:00Z"),
+			Expectation: Expectation{Error: true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			_, end, inWindow, err := test.Spec.Window(test.Time)
+			if test.Expectation.Error {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if inWindow != test.Expectation.InWindow || !end.Equal(test.Expectation.End) {
+				t.Errorf("unexpected window: expected in window %v until %v, got %v until %v", test.Expectation.InWindow, test.Expectation.End, inWindow, end)
+			}
+
+			next, _, ok, err := test.Spec.NextWindow(test.Time)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !ok || !next.Equal(test.Expectation.NextStart) {
+				t.Errorf("unexpected next window: expected %v, got %v", test.Expectation.NextStart, next)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WorkspaceScheduleSpec defines when the workspace a schedule applies to may run.
+// A schedule applies to the workspace whose ownership workspace ID matches the name of the schedule.
+type WorkspaceScheduleSpec struct {
+	// Timezone is the IANA time zone the windows are expressed in, e.g. Europe/Berlin. Defaults to UTC.
+	// +kubebuilder:validation:Optional
+	Timezone string `json:"timezone,omitempty"`
+
+	// Windows are the times during which the workspace may run. Outside of them the workspace is stopped
+	// regardless of activity.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Windows []WorkspaceScheduleWindow `json:"windows"`
+
+	// StopWarnings are how long before the end of a window the user is warned that the workspace will be stopped
+	// +kubebuilder:validation:Optional
+	StopWarnings []metav1.Duration `json:"stopWarnings,omitempty"`
+
+	// Prewarm makes ws-manager ask for the workspace to be started before the beginning of every window
+	// +kubebuilder:validation:Optional
+	Prewarm *WorkspaceSchedulePrewarm `json:"prewarm,omitempty"`
+}
+
+// WorkspaceScheduleWindow is a recurring period of time, expressed in the time zone of the schedule
+type WorkspaceScheduleWindow struct {
+	// Days are the days of the week the window starts on. If empty, the window starts on every day.
+	// +kubebuilder:validation:Optional
+	Days []WorkspaceScheduleDay `json:"days,omitempty"`
+
+	// Start is the time of day the window starts at, as HH:MM
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Start string `json:"start"`
+
+	// End is the time of day the window ends at, as HH:MM. If End is not after Start, the window ends on the next day.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	End string `json:"end"`
+}
+
+// +kubebuilder:validation:Enum=Monday;Tuesday;Wednesday;Thursday;Friday;Saturday;Sunday
+type WorkspaceScheduleDay string
+
+// WorkspaceSchedulePrewarm configures how a workspace is started ahead of its windows
+type WorkspaceSchedulePrewarm struct {
+	// Lead is how long before the start of a window the workspace is started
+	// +kubebuilder:validation:Optional
+	Lead metav1.Duration `json:"lead,omitempty"`
+}
+
+// WorkspaceScheduleStatus defines the observed state of a WorkspaceSchedule
+type WorkspaceScheduleStatus struct {
+	// LastPrewarm is the start of the window the workspace was last started for
+	// +kubebuilder:validation:Optional
+	LastPrewarm *metav1.Time `json:"lastPrewarm,omitempty"`
+
+	// LastPrewarmRequest is when ws-manager last asked for the workspace to be started ahead of a window
+	// +kubebuilder:validation:Optional
+	LastPrewarmRequest *metav1.Time `json:"lastPrewarmRequest,omitempty"`
+
+	// LastStopWarning is when the user was last warned that the workspace will be stopped
+	// +kubebuilder:validation:Optional
+	LastStopWarning *metav1.Time `json:"lastStopWarning,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:shortName=wssched
+// Custom print columns on the Custom Resource Definition. These are the columns
+// showing up when doing e.g. `kubectl get workspaceschedules`.
+// Columns with priority > 0 will only show up with `-o wide`.
+//+kubebuilder:printcolumn:name="Timezone",type="string",JSONPath=".spec.timezone"
+//+kubebuilder:printcolumn:name="Last Prewarm",type="date",JSONPath=".status.lastPrewarm"
+
+// WorkspaceSchedule is the Schema for the workspaceschedule API
+type WorkspaceSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WorkspaceScheduleSpec   `json:"spec,omitempty"`
+	Status WorkspaceScheduleStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// WorkspaceScheduleList contains a list of WorkspaceSchedules
+type WorkspaceScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WorkspaceSchedule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&WorkspaceSchedule{}, &WorkspaceScheduleList{})
+}
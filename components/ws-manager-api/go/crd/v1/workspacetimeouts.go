@@ -0,0 +1,53 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TimeoutLimits are the longest timeouts a regular workspace can ask for. A limit of zero is not enforced.
+// +kubebuilder:object:generate=false
+type TimeoutLimits struct {
+	Time            time.Duration
+	MaximumLifetime time.Duration
+}
+
+// TimeoutLimitsFunc returns the timeout limits of workspaces of an organization and workspace class
+type TimeoutLimitsFunc func(organization, class string) TimeoutLimits
+
+// TimeoutLimitExceededError is returned when a workspace asks for a timeout beyond the limit of its class or organization
+// +kubebuilder:object:generate=false
+type TimeoutLimitExceededError struct {
+	Timeout string
+	Value   time.Duration
+	Limit   time.Duration
+}
+
+func (e *TimeoutLimitExceededError) Error() string {
+	return fmt.Sprintf("%s of %s exceeds the limit of %s", e.Timeout, e.Value, e.Limit)
+}
+
+// Admit returns a *TimeoutLimitExceededError if the timeouts of the workspace exceed the limits.
+// Only regular workspaces are subject to these limits.
+func (l TimeoutLimits) Admit(ws *Workspace) error {
+	if ws.Spec.Type != WorkspaceTypeRegular {
+		return nil
+	}
+
+	check := func(name string, value *metav1.Duration, limit time.Duration) error {
+		if value == nil || limit == 0 || value.Duration <= limit {
+			return nil
+		}
+		return &TimeoutLimitExceededError{Timeout: name, Value: value.Duration, Limit: limit}
+	}
+	if err := check("timeout", ws.Spec.Timeout.Time, l.Time); err != nil {
+		return err
+	}
+	return check("maximum lifetime", ws.Spec.Timeout.MaximumLifetime, l.MaximumLifetime)
+}
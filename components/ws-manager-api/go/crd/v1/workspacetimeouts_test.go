@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTimeoutLimitsAdmit(t *testing.T) {
+	limits := TimeoutLimits{Time: time.Hour, MaximumLifetime: 8 * time.Hour}
+	workspace := func(tpe WorkspaceType, timeout, lifetime time.Duration) *Workspace {
+		ws := &Workspace{Spec: WorkspaceSpec{Type: tpe}}
+		if timeout != 0 {
+			ws.Spec.Timeout.Time = &metav1.Duration{Duration: timeout}
+		}
+		if lifetime != 0 {
+			ws.Spec.Timeout.MaximumLifetime = &metav1.Duration{Duration: lifetime}
+		}
+		return ws
+	}
+
+	tests := []struct {
+		Name            string
+		Limits          TimeoutLimits
+		Workspace       *Workspace
+		ExpectedTimeout string
+	}{
+		{Name: "no timeouts", Limits: limits, Workspace: workspace(WorkspaceTypeRegular, 0, 0)},
+		{Name: "within limits", Limits: limits, Workspace: workspace(WorkspaceTypeRegular, time.Hour, 8*time.Hour)},
+		{Name: "no limits", Workspace: workspace(WorkspaceTypeRegular, 24*time.Hour, 48*time.Hour)},
+		{Name: "timeout", Limits: limits, Workspace: workspace(WorkspaceTypeRegular, 2*time.Hour, 0), ExpectedTimeout: "timeout"},
+		{Name: "maximum lifetime", Limits: limits, Workspace: workspace(WorkspaceTypeRegular, 0, 9*time.Hour), ExpectedTimeout: "maximum lifetime"},
+		{Name: "prebuild", Limits: limits, Workspace: workspace(WorkspaceTypePrebuild, 2*time.Hour, 0)},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			err := test.Limits.Admit(test.Workspace)
+			var exceeded *TimeoutLimitExceededError
+			if test.ExpectedTimeout == "" {
+				if err != nil {
+					t.Errorf("expected workspace to be admitted, got %v", err)
+				}
+				return
+			}
+			if !errors.As(err, &exceeded) {
+				t.Fatalf("expected a TimeoutLimitExceededError, got %v", err)
+			}
+			if exceeded.Timeout != test.ExpectedTimeout {
+				t.Errorf("expected %s to exceed its limit, got %s", test.ExpectedTimeout, exceeded.Timeout)
+			}
+		})
+	}
+}
+
+func TestValidatorTimeoutLimits(t *testing.T) {
+	v := &workspaceValidator{TimeoutLimits: func(organization, class string) TimeoutLimits {
+		if organization == "acme" {
+			return TimeoutLimits{Time: time.Hour}
+		}
+		return TimeoutLimits{}
+	}}
+	ws := &Workspace{Spec: WorkspaceSpec{
+		Type:      WorkspaceTypeRegular,
+		Ownership: Ownership{Team: "acme"},
+		Timeout:   TimeoutSpec{Time: &metav1.Duration{Duration: 2 * time.Hour}},
+	}}
+
+	_, err := v.ValidateCreate(context.Background(), ws)
+	if err == nil {
+		t.Error("expected creation beyond the limit to be rejected")
+	}
+
+	// the limit was lowered after the workspace was created
+	updated := ws.DeepCopy()
+	updated.Spec.Ports = []PortSpec{{Port: 8080}}
+	_, err = v.ValidateUpdate(context.Background(), ws, updated)
+	if err != nil {
+		t.Errorf("expected update which keeps the timeouts to be admitted, got %v", err)
+	}
+
+	updated = ws.DeepCopy()
+	updated.Spec.Timeout.Time = &metav1.Duration{Duration: 3 * time.Hour}
+	_, err = v.ValidateUpdate(context.Background(), ws, updated)
+	if err == nil {
+		t.Error("expected extension beyond the limit to be rejected")
+	}
+}
@@ -369,6 +369,109 @@ func (in *WorkspaceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceMigrationStatus) DeepCopyInto(out *WorkspaceMigrationStatus) {
+	*out = *in
+	in.Started.DeepCopyInto(&out.Started)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceMigrationStatus.
+func (in *WorkspaceMigrationStatus) DeepCopy() *WorkspaceMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceQuota) DeepCopyInto(out *WorkspaceQuota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceQuota.
+func (in *WorkspaceQuota) DeepCopy() *WorkspaceQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkspaceQuota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceQuotaList) DeepCopyInto(out *WorkspaceQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WorkspaceQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceQuotaList.
+func (in *WorkspaceQuotaList) DeepCopy() *WorkspaceQuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceQuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkspaceQuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceQuotaSpec) DeepCopyInto(out *WorkspaceQuotaSpec) {
+	*out = *in
+	if in.Classes != nil {
+		in, out := &in.Classes, &out.Classes
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceQuotaSpec.
+func (in *WorkspaceQuotaSpec) DeepCopy() *WorkspaceQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkspaceRuntimeStatus) DeepCopyInto(out *WorkspaceRuntimeStatus) {
 	*out = *in
@@ -384,6 +487,160 @@ func (in *WorkspaceRuntimeStatus) DeepCopy() *WorkspaceRuntimeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceSchedule) DeepCopyInto(out *WorkspaceSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceSchedule.
+func (in *WorkspaceSchedule) DeepCopy() *WorkspaceSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkspaceSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceScheduleList) DeepCopyInto(out *WorkspaceScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WorkspaceSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceScheduleList.
+func (in *WorkspaceScheduleList) DeepCopy() *WorkspaceScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkspaceScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceSchedulePrewarm) DeepCopyInto(out *WorkspaceSchedulePrewarm) {
+	*out = *in
+	out.Lead = in.Lead
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceSchedulePrewarm.
+func (in *WorkspaceSchedulePrewarm) DeepCopy() *WorkspaceSchedulePrewarm {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceSchedulePrewarm)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceScheduleSpec) DeepCopyInto(out *WorkspaceScheduleSpec) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]WorkspaceScheduleWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StopWarnings != nil {
+		in, out := &in.StopWarnings, &out.StopWarnings
+		*out = make([]metav1.Duration, len(*in))
+		copy(*out, *in)
+	}
+	if in.Prewarm != nil {
+		in, out := &in.Prewarm, &out.Prewarm
+		*out = new(WorkspaceSchedulePrewarm)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceScheduleSpec.
+func (in *WorkspaceScheduleSpec) DeepCopy() *WorkspaceScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceScheduleStatus) DeepCopyInto(out *WorkspaceScheduleStatus) {
+	*out = *in
+	if in.LastPrewarm != nil {
+		in, out := &in.LastPrewarm, &out.LastPrewarm
+		*out = (*in).DeepCopy()
+	}
+	if in.LastPrewarmRequest != nil {
+		in, out := &in.LastPrewarmRequest, &out.LastPrewarmRequest
+		*out = (*in).DeepCopy()
+	}
+	if in.LastStopWarning != nil {
+		in, out := &in.LastStopWarning, &out.LastStopWarning
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceScheduleStatus.
+func (in *WorkspaceScheduleStatus) DeepCopy() *WorkspaceScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceScheduleWindow) DeepCopyInto(out *WorkspaceScheduleWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]WorkspaceScheduleDay, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceScheduleWindow.
+func (in *WorkspaceScheduleWindow) DeepCopy() *WorkspaceScheduleWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceScheduleWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkspaceSpec) DeepCopyInto(out *WorkspaceSpec) {
 	*out = *in
@@ -475,6 +732,18 @@ func (in *WorkspaceStatus) DeepCopyInto(out *WorkspaceStatus) {
 		*out = new(WorkspaceImageInfo)
 		**out = **in
 	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(WorkspaceMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeline != nil {
+		in, out := &in.Timeline, &out.Timeline
+		*out = make([]WorkspaceTimelineEvent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceStatus.
@@ -486,3 +755,19 @@ func (in *WorkspaceStatus) DeepCopy() *WorkspaceStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceTimelineEvent) DeepCopyInto(out *WorkspaceTimelineEvent) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceTimelineEvent.
+func (in *WorkspaceTimelineEvent) DeepCopy() *WorkspaceTimelineEvent {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceTimelineEvent)
+	in.DeepCopyInto(out)
+	return out
+}
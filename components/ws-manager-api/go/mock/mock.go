@@ -280,6 +280,51 @@ func (mr *MockWorkspaceManagerServerMockRecorder) MarkActive(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkActive", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).MarkActive), arg0, arg1)
 }
 
+// MigrateWorkspace mocks base method.
+func (m *MockWorkspaceManagerServer) MigrateWorkspace(arg0 context.Context, arg1 *api.MigrateWorkspaceRequest) (*api.MigrateWorkspaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateWorkspace", arg0, arg1)
+	ret0, _ := ret[0].(*api.MigrateWorkspaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateWorkspace indicates an expected call of MigrateWorkspace.
+func (mr *MockWorkspaceManagerServerMockRecorder) MigrateWorkspace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateWorkspace", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).MigrateWorkspace), arg0, arg1)
+}
+
+// RenderWorkspacePod mocks base method.
+func (m *MockWorkspaceManagerServer) RenderWorkspacePod(arg0 context.Context, arg1 *api.RenderWorkspacePodRequest) (*api.RenderWorkspacePodResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderWorkspacePod", arg0, arg1)
+	ret0, _ := ret[0].(*api.RenderWorkspacePodResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderWorkspacePod indicates an expected call of RenderWorkspacePod.
+func (mr *MockWorkspaceManagerServerMockRecorder) RenderWorkspacePod(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderWorkspacePod", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).RenderWorkspacePod), arg0, arg1)
+}
+
+// SetStorageQuota mocks base method.
+func (m *MockWorkspaceManagerServer) SetStorageQuota(arg0 context.Context, arg1 *api.SetStorageQuotaRequest) (*api.SetStorageQuotaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStorageQuota", arg0, arg1)
+	ret0, _ := ret[0].(*api.SetStorageQuotaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetStorageQuota indicates an expected call of SetStorageQuota.
+func (mr *MockWorkspaceManagerServerMockRecorder) SetStorageQuota(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStorageQuota", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).SetStorageQuota), arg0, arg1)
+}
+
 // SetTimeout mocks base method.
 func (m *MockWorkspaceManagerServer) SetTimeout(arg0 context.Context, arg1 *api.SetTimeoutRequest) (*api.SetTimeoutResponse, error) {
 	m.ctrl.T.Helper()
@@ -687,6 +732,66 @@ func (mr *MockWorkspaceManagerClientMockRecorder) MarkActive(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkActive", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).MarkActive), varargs...)
 }
 
+// MigrateWorkspace mocks base method.
+func (m *MockWorkspaceManagerClient) MigrateWorkspace(arg0 context.Context, arg1 *api.MigrateWorkspaceRequest, arg2 ...grpc.CallOption) (*api.MigrateWorkspaceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MigrateWorkspace", varargs...)
+	ret0, _ := ret[0].(*api.MigrateWorkspaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateWorkspace indicates an expected call of MigrateWorkspace.
+func (mr *MockWorkspaceManagerClientMockRecorder) MigrateWorkspace(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateWorkspace", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).MigrateWorkspace), varargs...)
+}
+
+// RenderWorkspacePod mocks base method.
+func (m *MockWorkspaceManagerClient) RenderWorkspacePod(arg0 context.Context, arg1 *api.RenderWorkspacePodRequest, arg2 ...grpc.CallOption) (*api.RenderWorkspacePodResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RenderWorkspacePod", varargs...)
+	ret0, _ := ret[0].(*api.RenderWorkspacePodResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderWorkspacePod indicates an expected call of RenderWorkspacePod.
+func (mr *MockWorkspaceManagerClientMockRecorder) RenderWorkspacePod(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderWorkspacePod", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).RenderWorkspacePod), varargs...)
+}
+
+// SetStorageQuota mocks base method.
+func (m *MockWorkspaceManagerClient) SetStorageQuota(arg0 context.Context, arg1 *api.SetStorageQuotaRequest, arg2 ...grpc.CallOption) (*api.SetStorageQuotaResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetStorageQuota", varargs...)
+	ret0, _ := ret[0].(*api.SetStorageQuotaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetStorageQuota indicates an expected call of SetStorageQuota.
+func (mr *MockWorkspaceManagerClientMockRecorder) SetStorageQuota(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStorageQuota", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).SetStorageQuota), varargs...)
+}
+
 // SetTimeout mocks base method.
 func (m *MockWorkspaceManagerClient) SetTimeout(arg0 context.Context, arg1 *api.SetTimeoutRequest, arg2 ...grpc.CallOption) (*api.SetTimeoutResponse, error) {
 	m.ctrl.T.Helper()
@@ -31,6 +31,9 @@ interface IWorkspaceManagerService extends grpc.ServiceDefinition<grpc.UntypedSe
     deleteVolumeSnapshot: IWorkspaceManagerService_IDeleteVolumeSnapshot;
     updateSSHKey: IWorkspaceManagerService_IUpdateSSHKey;
     describeCluster: IWorkspaceManagerService_IDescribeCluster;
+    setStorageQuota: IWorkspaceManagerService_ISetStorageQuota;
+    migrateWorkspace: IWorkspaceManagerService_IMigrateWorkspace;
+    renderWorkspacePod: IWorkspaceManagerService_IRenderWorkspacePod;
 }
 
 interface IWorkspaceManagerService_IGetWorkspaces extends grpc.MethodDefinition<core_pb.GetWorkspacesRequest, core_pb.GetWorkspacesResponse> {
@@ -159,6 +162,33 @@ interface IWorkspaceManagerService_IDescribeCluster extends grpc.MethodDefinitio
     responseSerialize: grpc.serialize<core_pb.DescribeClusterResponse>;
     responseDeserialize: grpc.deserialize<core_pb.DescribeClusterResponse>;
 }
+interface IWorkspaceManagerService_ISetStorageQuota extends grpc.MethodDefinition<core_pb.SetStorageQuotaRequest, core_pb.SetStorageQuotaResponse> {
+    path: "/wsman.WorkspaceManager/SetStorageQuota";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<core_pb.SetStorageQuotaRequest>;
+    requestDeserialize: grpc.deserialize<core_pb.SetStorageQuotaRequest>;
+    responseSerialize: grpc.serialize<core_pb.SetStorageQuotaResponse>;
+    responseDeserialize: grpc.deserialize<core_pb.SetStorageQuotaResponse>;
+}
+interface IWorkspaceManagerService_IMigrateWorkspace extends grpc.MethodDefinition<core_pb.MigrateWorkspaceRequest, core_pb.MigrateWorkspaceResponse> {
+    path: "/wsman.WorkspaceManager/MigrateWorkspace";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<core_pb.MigrateWorkspaceRequest>;
+    requestDeserialize: grpc.deserialize<core_pb.MigrateWorkspaceRequest>;
+    responseSerialize: grpc.serialize<core_pb.MigrateWorkspaceResponse>;
+    responseDeserialize: grpc.deserialize<core_pb.MigrateWorkspaceResponse>;
+}
+interface IWorkspaceManagerService_IRenderWorkspacePod extends grpc.MethodDefinition<core_pb.RenderWorkspacePodRequest, core_pb.RenderWorkspacePodResponse> {
+    path: "/wsman.WorkspaceManager/RenderWorkspacePod";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<core_pb.RenderWorkspacePodRequest>;
+    requestDeserialize: grpc.deserialize<core_pb.RenderWorkspacePodRequest>;
+    responseSerialize: grpc.serialize<core_pb.RenderWorkspacePodResponse>;
+    responseDeserialize: grpc.deserialize<core_pb.RenderWorkspacePodResponse>;
+}
 
 export const WorkspaceManagerService: IWorkspaceManagerService;
 
@@ -177,6 +207,9 @@ export interface IWorkspaceManagerServer extends grpc.UntypedServiceImplementati
     deleteVolumeSnapshot: grpc.handleUnaryCall<core_pb.DeleteVolumeSnapshotRequest, core_pb.DeleteVolumeSnapshotResponse>;
     updateSSHKey: grpc.handleUnaryCall<core_pb.UpdateSSHKeyRequest, core_pb.UpdateSSHKeyResponse>;
     describeCluster: grpc.handleUnaryCall<core_pb.DescribeClusterRequest, core_pb.DescribeClusterResponse>;
+    setStorageQuota: grpc.handleUnaryCall<core_pb.SetStorageQuotaRequest, core_pb.SetStorageQuotaResponse>;
+    migrateWorkspace: grpc.handleUnaryCall<core_pb.MigrateWorkspaceRequest, core_pb.MigrateWorkspaceResponse>;
+    renderWorkspacePod: grpc.handleUnaryCall<core_pb.RenderWorkspacePodRequest, core_pb.RenderWorkspacePodResponse>;
 }
 
 export interface IWorkspaceManagerClient {
@@ -221,6 +254,15 @@ export interface IWorkspaceManagerClient {
     describeCluster(request: core_pb.DescribeClusterRequest, callback: (error: grpc.ServiceError | null, response: core_pb.DescribeClusterResponse) => void): grpc.ClientUnaryCall;
     describeCluster(request: core_pb.DescribeClusterRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.DescribeClusterResponse) => void): grpc.ClientUnaryCall;
     describeCluster(request: core_pb.DescribeClusterRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.DescribeClusterResponse) => void): grpc.ClientUnaryCall;
+    setStorageQuota(request: core_pb.SetStorageQuotaRequest, callback: (error: grpc.ServiceError | null, response: core_pb.SetStorageQuotaResponse) => void): grpc.ClientUnaryCall;
+    setStorageQuota(request: core_pb.SetStorageQuotaRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.SetStorageQuotaResponse) => void): grpc.ClientUnaryCall;
+    setStorageQuota(request: core_pb.SetStorageQuotaRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.SetStorageQuotaResponse) => void): grpc.ClientUnaryCall;
+    migrateWorkspace(request: core_pb.MigrateWorkspaceRequest, callback: (error: grpc.ServiceError | null, response: core_pb.MigrateWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    migrateWorkspace(request: core_pb.MigrateWorkspaceRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.MigrateWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    migrateWorkspace(request: core_pb.MigrateWorkspaceRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.MigrateWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    renderWorkspacePod(request: core_pb.RenderWorkspacePodRequest, callback: (error: grpc.ServiceError | null, response: core_pb.RenderWorkspacePodResponse) => void): grpc.ClientUnaryCall;
+    renderWorkspacePod(request: core_pb.RenderWorkspacePodRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.RenderWorkspacePodResponse) => void): grpc.ClientUnaryCall;
+    renderWorkspacePod(request: core_pb.RenderWorkspacePodRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.RenderWorkspacePodResponse) => void): grpc.ClientUnaryCall;
 }
 
 export class WorkspaceManagerClient extends grpc.Client implements IWorkspaceManagerClient {
@@ -266,4 +308,13 @@ export class WorkspaceManagerClient extends grpc.Client implements IWorkspaceMan
     public describeCluster(request: core_pb.DescribeClusterRequest, callback: (error: grpc.ServiceError | null, response: core_pb.DescribeClusterResponse) => void): grpc.ClientUnaryCall;
     public describeCluster(request: core_pb.DescribeClusterRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.DescribeClusterResponse) => void): grpc.ClientUnaryCall;
     public describeCluster(request: core_pb.DescribeClusterRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.DescribeClusterResponse) => void): grpc.ClientUnaryCall;
+    public setStorageQuota(request: core_pb.SetStorageQuotaRequest, callback: (error: grpc.ServiceError | null, response: core_pb.SetStorageQuotaResponse) => void): grpc.ClientUnaryCall;
+    public setStorageQuota(request: core_pb.SetStorageQuotaRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.SetStorageQuotaResponse) => void): grpc.ClientUnaryCall;
+    public setStorageQuota(request: core_pb.SetStorageQuotaRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.SetStorageQuotaResponse) => void): grpc.ClientUnaryCall;
+    public migrateWorkspace(request: core_pb.MigrateWorkspaceRequest, callback: (error: grpc.ServiceError | null, response: core_pb.MigrateWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    public migrateWorkspace(request: core_pb.MigrateWorkspaceRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.MigrateWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    public migrateWorkspace(request: core_pb.MigrateWorkspaceRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.MigrateWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    public renderWorkspacePod(request: core_pb.RenderWorkspacePodRequest, callback: (error: grpc.ServiceError | null, response: core_pb.RenderWorkspacePodResponse) => void): grpc.ClientUnaryCall;
+    public renderWorkspacePod(request: core_pb.RenderWorkspacePodRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.RenderWorkspacePodResponse) => void): grpc.ClientUnaryCall;
+    public renderWorkspacePod(request: core_pb.RenderWorkspacePodRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.RenderWorkspacePodResponse) => void): grpc.ClientUnaryCall;
 }
@@ -189,6 +189,72 @@ function deserialize_wsman_MarkActiveResponse(buffer_arg) {
   return core_pb.MarkActiveResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_wsman_MigrateWorkspaceRequest(arg) {
+  if (!(arg instanceof core_pb.MigrateWorkspaceRequest)) {
+    throw new Error('Expected argument of type wsman.MigrateWorkspaceRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_MigrateWorkspaceRequest(buffer_arg) {
+  return core_pb.MigrateWorkspaceRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_MigrateWorkspaceResponse(arg) {
+  if (!(arg instanceof core_pb.MigrateWorkspaceResponse)) {
+    throw new Error('Expected argument of type wsman.MigrateWorkspaceResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_MigrateWorkspaceResponse(buffer_arg) {
+  return core_pb.MigrateWorkspaceResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_RenderWorkspacePodRequest(arg) {
+  if (!(arg instanceof core_pb.RenderWorkspacePodRequest)) {
+    throw new Error('Expected argument of type wsman.RenderWorkspacePodRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_RenderWorkspacePodRequest(buffer_arg) {
+  return core_pb.RenderWorkspacePodRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_RenderWorkspacePodResponse(arg) {
+  if (!(arg instanceof core_pb.RenderWorkspacePodResponse)) {
+    throw new Error('Expected argument of type wsman.RenderWorkspacePodResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_RenderWorkspacePodResponse(buffer_arg) {
+  return core_pb.RenderWorkspacePodResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_SetStorageQuotaRequest(arg) {
+  if (!(arg instanceof core_pb.SetStorageQuotaRequest)) {
+    throw new Error('Expected argument of type wsman.SetStorageQuotaRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_SetStorageQuotaRequest(buffer_arg) {
+  return core_pb.SetStorageQuotaRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_SetStorageQuotaResponse(arg) {
+  if (!(arg instanceof core_pb.SetStorageQuotaResponse)) {
+    throw new Error('Expected argument of type wsman.SetStorageQuotaResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_SetStorageQuotaResponse(buffer_arg) {
+  return core_pb.SetStorageQuotaResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_wsman_SetTimeoutRequest(arg) {
   if (!(arg instanceof core_pb.SetTimeoutRequest)) {
     throw new Error('Expected argument of type wsman.SetTimeoutRequest');
@@ -491,6 +557,42 @@ describeCluster: {
     responseSerialize: serialize_wsman_DescribeClusterResponse,
     responseDeserialize: deserialize_wsman_DescribeClusterResponse,
   },
+  // setStorageQuota changes the size of the /workspace quota of a running workspace
+setStorageQuota: {
+    path: '/wsman.WorkspaceManager/SetStorageQuota',
+    requestStream: false,
+    responseStream: false,
+    requestType: core_pb.SetStorageQuotaRequest,
+    responseType: core_pb.SetStorageQuotaResponse,
+    requestSerialize: serialize_wsman_SetStorageQuotaRequest,
+    requestDeserialize: deserialize_wsman_SetStorageQuotaRequest,
+    responseSerialize: serialize_wsman_SetStorageQuotaResponse,
+    responseDeserialize: deserialize_wsman_SetStorageQuotaResponse,
+  },
+  // migrateWorkspace moves a running workspace to another node, keeping its ID and URL
+migrateWorkspace: {
+    path: '/wsman.WorkspaceManager/MigrateWorkspace',
+    requestStream: false,
+    responseStream: false,
+    requestType: core_pb.MigrateWorkspaceRequest,
+    responseType: core_pb.MigrateWorkspaceResponse,
+    requestSerialize: serialize_wsman_MigrateWorkspaceRequest,
+    requestDeserialize: deserialize_wsman_MigrateWorkspaceRequest,
+    responseSerialize: serialize_wsman_MigrateWorkspaceResponse,
+    responseDeserialize: deserialize_wsman_MigrateWorkspaceResponse,
+  },
+  // renderWorkspacePod produces the pod a start workspace request would result in, without creating anything
+renderWorkspacePod: {
+    path: '/wsman.WorkspaceManager/RenderWorkspacePod',
+    requestStream: false,
+    responseStream: false,
+    requestType: core_pb.RenderWorkspacePodRequest,
+    responseType: core_pb.RenderWorkspacePodResponse,
+    requestSerialize: serialize_wsman_RenderWorkspacePodRequest,
+    requestDeserialize: deserialize_wsman_RenderWorkspacePodRequest,
+    responseSerialize: serialize_wsman_RenderWorkspacePodResponse,
+    responseDeserialize: deserialize_wsman_RenderWorkspacePodResponse,
+  },
 };
 
 exports.WorkspaceManagerClient = grpc.makeGenericClientConstructor(WorkspaceManagerService);
@@ -372,6 +372,148 @@ export namespace SetTimeoutResponse {
     }
 }
 
+export class SetStorageQuotaRequest extends jspb.Message {
+    getId(): string;
+    setId(value: string): SetStorageQuotaRequest;
+    getQuota(): string;
+    setQuota(value: string): SetStorageQuotaRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): SetStorageQuotaRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: SetStorageQuotaRequest): SetStorageQuotaRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: SetStorageQuotaRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): SetStorageQuotaRequest;
+    static deserializeBinaryFromReader(message: SetStorageQuotaRequest, reader: jspb.BinaryReader): SetStorageQuotaRequest;
+}
+
+export namespace SetStorageQuotaRequest {
+    export type AsObject = {
+        id: string,
+        quota: string,
+    }
+}
+
+export class SetStorageQuotaResponse extends jspb.Message {
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): SetStorageQuotaResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: SetStorageQuotaResponse): SetStorageQuotaResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: SetStorageQuotaResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): SetStorageQuotaResponse;
+    static deserializeBinaryFromReader(message: SetStorageQuotaResponse, reader: jspb.BinaryReader): SetStorageQuotaResponse;
+}
+
+export namespace SetStorageQuotaResponse {
+    export type AsObject = {
+    }
+}
+
+export class MigrateWorkspaceRequest extends jspb.Message {
+    getId(): string;
+    setId(value: string): MigrateWorkspaceRequest;
+    clearEnvvarsList(): void;
+    getEnvvarsList(): Array<EnvironmentVariable>;
+    setEnvvarsList(value: Array<EnvironmentVariable>): MigrateWorkspaceRequest;
+    addEnvvars(value?: EnvironmentVariable, index?: number): EnvironmentVariable;
+    clearSysEnvvarsList(): void;
+    getSysEnvvarsList(): Array<EnvironmentVariable>;
+    setSysEnvvarsList(value: Array<EnvironmentVariable>): MigrateWorkspaceRequest;
+    addSysEnvvars(value?: EnvironmentVariable, index?: number): EnvironmentVariable;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): MigrateWorkspaceRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: MigrateWorkspaceRequest): MigrateWorkspaceRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: MigrateWorkspaceRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): MigrateWorkspaceRequest;
+    static deserializeBinaryFromReader(message: MigrateWorkspaceRequest, reader: jspb.BinaryReader): MigrateWorkspaceRequest;
+}
+
+export namespace MigrateWorkspaceRequest {
+    export type AsObject = {
+        id: string,
+        envvarsList: Array<EnvironmentVariable.AsObject>,
+        sysEnvvarsList: Array<EnvironmentVariable.AsObject>,
+    }
+}
+
+export class MigrateWorkspaceResponse extends jspb.Message {
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): MigrateWorkspaceResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: MigrateWorkspaceResponse): MigrateWorkspaceResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: MigrateWorkspaceResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): MigrateWorkspaceResponse;
+    static deserializeBinaryFromReader(message: MigrateWorkspaceResponse, reader: jspb.BinaryReader): MigrateWorkspaceResponse;
+}
+
+export namespace MigrateWorkspaceResponse {
+    export type AsObject = {
+    }
+}
+
+export class RenderWorkspacePodRequest extends jspb.Message {
+
+    hasRequest(): boolean;
+    clearRequest(): void;
+    getRequest(): StartWorkspaceRequest | undefined;
+    setRequest(value?: StartWorkspaceRequest): RenderWorkspacePodRequest;
+    getCompareClass(): string;
+    setCompareClass(value: string): RenderWorkspacePodRequest;
+    getCompareConfig(): string;
+    setCompareConfig(value: string): RenderWorkspacePodRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RenderWorkspacePodRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: RenderWorkspacePodRequest): RenderWorkspacePodRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RenderWorkspacePodRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RenderWorkspacePodRequest;
+    static deserializeBinaryFromReader(message: RenderWorkspacePodRequest, reader: jspb.BinaryReader): RenderWorkspacePodRequest;
+}
+
+export namespace RenderWorkspacePodRequest {
+    export type AsObject = {
+        request?: StartWorkspaceRequest.AsObject,
+        compareClass: string,
+        compareConfig: string,
+    }
+}
+
+export class RenderWorkspacePodResponse extends jspb.Message {
+    getPod(): string;
+    setPod(value: string): RenderWorkspacePodResponse;
+    getComparePod(): string;
+    setComparePod(value: string): RenderWorkspacePodResponse;
+    getDiff(): string;
+    setDiff(value: string): RenderWorkspacePodResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RenderWorkspacePodResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: RenderWorkspacePodResponse): RenderWorkspacePodResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RenderWorkspacePodResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RenderWorkspacePodResponse;
+    static deserializeBinaryFromReader(message: RenderWorkspacePodResponse, reader: jspb.BinaryReader): RenderWorkspacePodResponse;
+}
+
+export namespace RenderWorkspacePodResponse {
+    export type AsObject = {
+        pod: string,
+        comparePod: string,
+        diff: string,
+    }
+}
+
 export class ControlPortRequest extends jspb.Message {
     getId(): string;
     setId(value: string): ControlPortRequest;
@@ -677,6 +819,15 @@ export class WorkspaceStatus extends jspb.Message {
     getInitializerMetrics(): InitializerMetrics | undefined;
     setInitializerMetrics(value?: InitializerMetrics): WorkspaceStatus;
 
+    hasStorage(): boolean;
+    clearStorage(): void;
+    getStorage(): WorkspaceStorage | undefined;
+    setStorage(value?: WorkspaceStorage): WorkspaceStatus;
+    clearTimelineList(): void;
+    getTimelineList(): Array<WorkspaceTimelineEvent>;
+    setTimelineList(value: Array<WorkspaceTimelineEvent>): WorkspaceStatus;
+    addTimeline(value?: WorkspaceTimelineEvent, index?: number): WorkspaceTimelineEvent;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): WorkspaceStatus.AsObject;
     static toObject(includeInstance: boolean, msg: WorkspaceStatus): WorkspaceStatus.AsObject;
@@ -700,6 +851,66 @@ export namespace WorkspaceStatus {
         runtime?: WorkspaceRuntimeInfo.AsObject,
         auth?: WorkspaceAuthentication.AsObject,
         initializerMetrics?: InitializerMetrics.AsObject,
+        storage?: WorkspaceStorage.AsObject,
+        timelineList: Array<WorkspaceTimelineEvent.AsObject>,
+    }
+}
+
+export class WorkspaceStorage extends jspb.Message {
+    getQuotaBytes(): number;
+    setQuotaBytes(value: number): WorkspaceStorage;
+    getUsedBytes(): number;
+    setUsedBytes(value: number): WorkspaceStorage;
+    getResizeRejected(): string;
+    setResizeRejected(value: string): WorkspaceStorage;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): WorkspaceStorage.AsObject;
+    static toObject(includeInstance: boolean, msg: WorkspaceStorage): WorkspaceStorage.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: WorkspaceStorage, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): WorkspaceStorage;
+    static deserializeBinaryFromReader(message: WorkspaceStorage, reader: jspb.BinaryReader): WorkspaceStorage;
+}
+
+export namespace WorkspaceStorage {
+    export type AsObject = {
+        quotaBytes: number,
+        usedBytes: number,
+        resizeRejected: string,
+    }
+}
+
+export class WorkspaceTimelineEvent extends jspb.Message {
+    getType(): string;
+    setType(value: string): WorkspaceTimelineEvent;
+    getName(): string;
+    setName(value: string): WorkspaceTimelineEvent;
+    getStatus(): string;
+    setStatus(value: string): WorkspaceTimelineEvent;
+
+    hasTime(): boolean;
+    clearTime(): void;
+    getTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setTime(value?: google_protobuf_timestamp_pb.Timestamp): WorkspaceTimelineEvent;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): WorkspaceTimelineEvent.AsObject;
+    static toObject(includeInstance: boolean, msg: WorkspaceTimelineEvent): WorkspaceTimelineEvent.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: WorkspaceTimelineEvent, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): WorkspaceTimelineEvent;
+    static deserializeBinaryFromReader(message: WorkspaceTimelineEvent, reader: jspb.BinaryReader): WorkspaceTimelineEvent;
+}
+
+export namespace WorkspaceTimelineEvent {
+    export type AsObject = {
+        type: string,
+        name: string,
+        status: string,
+        time?: google_protobuf_timestamp_pb.Timestamp.AsObject,
     }
 }
 
@@ -754,6 +965,8 @@ export class WorkspaceSpec extends jspb.Message {
     addIdeImageLayers(value: string, index?: number): string;
     getClosedTimeout(): string;
     setClosedTimeout(value: string): WorkspaceSpec;
+    getEgressPolicy(): string;
+    setEgressPolicy(value: string): WorkspaceSpec;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): WorkspaceSpec.AsObject;
@@ -777,6 +990,7 @@ export namespace WorkspaceSpec {
         pb_class: string,
         ideImageLayersList: Array<string>,
         closedTimeout: string,
+        egressPolicy: string,
     }
 }
 
@@ -863,6 +1077,8 @@ export class WorkspaceConditions extends jspb.Message {
     setVolumeSnapshot(value?: VolumeSnapshotInfo): WorkspaceConditions;
     getAborted(): WorkspaceConditionBool;
     setAborted(value: WorkspaceConditionBool): WorkspaceConditions;
+    getPaused(): WorkspaceConditionBool;
+    setPaused(value: WorkspaceConditionBool): WorkspaceConditions;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): WorkspaceConditions.AsObject;
@@ -888,6 +1104,7 @@ export namespace WorkspaceConditions {
         stoppedByRequest: WorkspaceConditionBool,
         volumeSnapshot?: VolumeSnapshotInfo.AsObject,
         aborted: WorkspaceConditionBool,
+        paused: WorkspaceConditionBool,
     }
 }
 
@@ -1271,6 +1488,14 @@ export class DescribeClusterResponse extends jspb.Message {
     addWorkspaceClasses(value?: WorkspaceClass, index?: number): WorkspaceClass;
     getPreferredWorkspaceClass(): string;
     setPreferredWorkspaceClass(value: string): DescribeClusterResponse;
+    clearWorkspaceQuotasList(): void;
+    getWorkspaceQuotasList(): Array<WorkspaceQuota>;
+    setWorkspaceQuotasList(value: Array<WorkspaceQuota>): DescribeClusterResponse;
+    addWorkspaceQuotas(value?: WorkspaceQuota, index?: number): WorkspaceQuota;
+    clearOrganizationTimeoutsList(): void;
+    getOrganizationTimeoutsList(): Array<OrganizationTimeouts>;
+    setOrganizationTimeoutsList(value: Array<OrganizationTimeouts>): DescribeClusterResponse;
+    addOrganizationTimeouts(value?: OrganizationTimeouts, index?: number): OrganizationTimeouts;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): DescribeClusterResponse.AsObject;
@@ -1286,6 +1511,8 @@ export namespace DescribeClusterResponse {
     export type AsObject = {
         workspaceClassesList: Array<WorkspaceClass.AsObject>,
         preferredWorkspaceClass: string,
+        workspaceQuotasList: Array<WorkspaceQuota.AsObject>,
+        organizationTimeoutsList: Array<OrganizationTimeouts.AsObject>,
     }
 }
 
@@ -1299,6 +1526,11 @@ export class WorkspaceClass extends jspb.Message {
     getCreditsPerMinute(): number;
     setCreditsPerMinute(value: number): WorkspaceClass;
 
+    hasTimeouts(): boolean;
+    clearTimeouts(): void;
+    getTimeouts(): WorkspaceTimeouts | undefined;
+    setTimeouts(value?: WorkspaceTimeouts): WorkspaceClass;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): WorkspaceClass.AsObject;
     static toObject(includeInstance: boolean, msg: WorkspaceClass): WorkspaceClass.AsObject;
@@ -1315,6 +1547,162 @@ export namespace WorkspaceClass {
         displayName: string,
         description: string,
         creditsPerMinute: number,
+        timeouts?: WorkspaceTimeouts.AsObject,
+    }
+}
+
+export class WorkspaceTimeouts extends jspb.Message {
+    getRegularWorkspace(): string;
+    setRegularWorkspace(value: string): WorkspaceTimeouts;
+    getRegularWorkspaceLimit(): string;
+    setRegularWorkspaceLimit(value: string): WorkspaceTimeouts;
+    getAfterClose(): string;
+    setAfterClose(value: string): WorkspaceTimeouts;
+    getMaxLifetime(): string;
+    setMaxLifetime(value: string): WorkspaceTimeouts;
+    getMaxLifetimeLimit(): string;
+    setMaxLifetimeLimit(value: string): WorkspaceTimeouts;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): WorkspaceTimeouts.AsObject;
+    static toObject(includeInstance: boolean, msg: WorkspaceTimeouts): WorkspaceTimeouts.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: WorkspaceTimeouts, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): WorkspaceTimeouts;
+    static deserializeBinaryFromReader(message: WorkspaceTimeouts, reader: jspb.BinaryReader): WorkspaceTimeouts;
+}
+
+export namespace WorkspaceTimeouts {
+    export type AsObject = {
+        regularWorkspace: string,
+        regularWorkspaceLimit: string,
+        afterClose: string,
+        maxLifetime: string,
+        maxLifetimeLimit: string,
+    }
+}
+
+export class OrganizationTimeouts extends jspb.Message {
+    getTeam(): string;
+    setTeam(value: string): OrganizationTimeouts;
+    getWorkspaceClass(): string;
+    setWorkspaceClass(value: string): OrganizationTimeouts;
+
+    hasTimeouts(): boolean;
+    clearTimeouts(): void;
+    getTimeouts(): WorkspaceTimeouts | undefined;
+    setTimeouts(value?: WorkspaceTimeouts): OrganizationTimeouts;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): OrganizationTimeouts.AsObject;
+    static toObject(includeInstance: boolean, msg: OrganizationTimeouts): OrganizationTimeouts.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: OrganizationTimeouts, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): OrganizationTimeouts;
+    static deserializeBinaryFromReader(message: OrganizationTimeouts, reader: jspb.BinaryReader): OrganizationTimeouts;
+}
+
+export namespace OrganizationTimeouts {
+    export type AsObject = {
+        team: string,
+        workspaceClass: string,
+        timeouts?: WorkspaceTimeouts.AsObject,
+    }
+}
+
+export class WorkspaceQuota extends jspb.Message {
+    getTeam(): string;
+    setTeam(value: string): WorkspaceQuota;
+    getRunningWorkspacesLimit(): number;
+    setRunningWorkspacesLimit(value: number): WorkspaceQuota;
+    getRunningWorkspaces(): number;
+    setRunningWorkspaces(value: number): WorkspaceQuota;
+    getPrebuildsLimit(): number;
+    setPrebuildsLimit(value: number): WorkspaceQuota;
+    getPrebuilds(): number;
+    setPrebuilds(value: number): WorkspaceQuota;
+    clearClassesList(): void;
+    getClassesList(): Array<WorkspaceQuotaClass>;
+    setClassesList(value: Array<WorkspaceQuotaClass>): WorkspaceQuota;
+    addClasses(value?: WorkspaceQuotaClass, index?: number): WorkspaceQuotaClass;
+    clearResourcesList(): void;
+    getResourcesList(): Array<WorkspaceQuotaResource>;
+    setResourcesList(value: Array<WorkspaceQuotaResource>): WorkspaceQuota;
+    addResources(value?: WorkspaceQuotaResource, index?: number): WorkspaceQuotaResource;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): WorkspaceQuota.AsObject;
+    static toObject(includeInstance: boolean, msg: WorkspaceQuota): WorkspaceQuota.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: WorkspaceQuota, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): WorkspaceQuota;
+    static deserializeBinaryFromReader(message: WorkspaceQuota, reader: jspb.BinaryReader): WorkspaceQuota;
+}
+
+export namespace WorkspaceQuota {
+    export type AsObject = {
+        team: string,
+        runningWorkspacesLimit: number,
+        runningWorkspaces: number,
+        prebuildsLimit: number,
+        prebuilds: number,
+        classesList: Array<WorkspaceQuotaClass.AsObject>,
+        resourcesList: Array<WorkspaceQuotaResource.AsObject>,
+    }
+}
+
+export class WorkspaceQuotaClass extends jspb.Message {
+    getWorkspaceClass(): string;
+    setWorkspaceClass(value: string): WorkspaceQuotaClass;
+    getLimit(): number;
+    setLimit(value: number): WorkspaceQuotaClass;
+    getUsed(): number;
+    setUsed(value: number): WorkspaceQuotaClass;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): WorkspaceQuotaClass.AsObject;
+    static toObject(includeInstance: boolean, msg: WorkspaceQuotaClass): WorkspaceQuotaClass.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: WorkspaceQuotaClass, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): WorkspaceQuotaClass;
+    static deserializeBinaryFromReader(message: WorkspaceQuotaClass, reader: jspb.BinaryReader): WorkspaceQuotaClass;
+}
+
+export namespace WorkspaceQuotaClass {
+    export type AsObject = {
+        workspaceClass: string,
+        limit: number,
+        used: number,
+    }
+}
+
+export class WorkspaceQuotaResource extends jspb.Message {
+    getName(): string;
+    setName(value: string): WorkspaceQuotaResource;
+    getLimit(): string;
+    setLimit(value: string): WorkspaceQuotaResource;
+    getUsed(): string;
+    setUsed(value: string): WorkspaceQuotaResource;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): WorkspaceQuotaResource.AsObject;
+    static toObject(includeInstance: boolean, msg: WorkspaceQuotaResource): WorkspaceQuotaResource.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: WorkspaceQuotaResource, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): WorkspaceQuotaResource;
+    static deserializeBinaryFromReader(message: WorkspaceQuotaResource, reader: jspb.BinaryReader): WorkspaceQuotaResource;
+}
+
+export namespace WorkspaceQuotaResource {
+    export type AsObject = {
+        name: string,
+        limit: string,
+        used: string,
     }
 }
 
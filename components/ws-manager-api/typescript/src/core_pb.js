@@ -52,10 +52,17 @@ goog.exportSymbol('proto.wsman.InitializerMetrics', null, global);
 goog.exportSymbol('proto.wsman.MarkActiveRequest', null, global);
 goog.exportSymbol('proto.wsman.MarkActiveResponse', null, global);
 goog.exportSymbol('proto.wsman.MetadataFilter', null, global);
+goog.exportSymbol('proto.wsman.MigrateWorkspaceRequest', null, global);
+goog.exportSymbol('proto.wsman.MigrateWorkspaceResponse', null, global);
+goog.exportSymbol('proto.wsman.OrganizationTimeouts', null, global);
 goog.exportSymbol('proto.wsman.PortProtocol', null, global);
 goog.exportSymbol('proto.wsman.PortSpec', null, global);
 goog.exportSymbol('proto.wsman.PortVisibility', null, global);
+goog.exportSymbol('proto.wsman.RenderWorkspacePodRequest', null, global);
+goog.exportSymbol('proto.wsman.RenderWorkspacePodResponse', null, global);
 goog.exportSymbol('proto.wsman.SSHPublicKeys', null, global);
+goog.exportSymbol('proto.wsman.SetStorageQuotaRequest', null, global);
+goog.exportSymbol('proto.wsman.SetStorageQuotaResponse', null, global);
 goog.exportSymbol('proto.wsman.SetTimeoutRequest', null, global);
 goog.exportSymbol('proto.wsman.SetTimeoutResponse', null, global);
 goog.exportSymbol('proto.wsman.StartWorkspaceRequest', null, global);
@@ -81,9 +88,15 @@ goog.exportSymbol('proto.wsman.WorkspaceMetadata', null, global);
 goog.exportSymbol('proto.wsman.WorkspaceMetadata.ImageInfo', null, global);
 goog.exportSymbol('proto.wsman.WorkspaceMetadata.Metrics', null, global);
 goog.exportSymbol('proto.wsman.WorkspacePhase', null, global);
+goog.exportSymbol('proto.wsman.WorkspaceQuota', null, global);
+goog.exportSymbol('proto.wsman.WorkspaceQuotaClass', null, global);
+goog.exportSymbol('proto.wsman.WorkspaceQuotaResource', null, global);
 goog.exportSymbol('proto.wsman.WorkspaceRuntimeInfo', null, global);
 goog.exportSymbol('proto.wsman.WorkspaceSpec', null, global);
 goog.exportSymbol('proto.wsman.WorkspaceStatus', null, global);
+goog.exportSymbol('proto.wsman.WorkspaceStorage', null, global);
+goog.exportSymbol('proto.wsman.WorkspaceTimelineEvent', null, global);
+goog.exportSymbol('proto.wsman.WorkspaceTimeouts', null, global);
 goog.exportSymbol('proto.wsman.WorkspaceType', null, global);
 /**
  * Generated by JsPbCodeGenerator.
@@ -400,6 +413,132 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.wsman.SetTimeoutResponse.displayName = 'proto.wsman.SetTimeoutResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.SetStorageQuotaRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.SetStorageQuotaRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.SetStorageQuotaRequest.displayName = 'proto.wsman.SetStorageQuotaRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.SetStorageQuotaResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.SetStorageQuotaResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.SetStorageQuotaResponse.displayName = 'proto.wsman.SetStorageQuotaResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.MigrateWorkspaceRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsman.MigrateWorkspaceRequest.repeatedFields_, null);
+};
+goog.inherits(proto.wsman.MigrateWorkspaceRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.MigrateWorkspaceRequest.displayName = 'proto.wsman.MigrateWorkspaceRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.MigrateWorkspaceResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.MigrateWorkspaceResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.MigrateWorkspaceResponse.displayName = 'proto.wsman.MigrateWorkspaceResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.RenderWorkspacePodRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.RenderWorkspacePodRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.RenderWorkspacePodRequest.displayName = 'proto.wsman.RenderWorkspacePodRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.RenderWorkspacePodResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.RenderWorkspacePodResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.RenderWorkspacePodResponse.displayName = 'proto.wsman.RenderWorkspacePodResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -663,7 +802,7 @@ if (goog.DEBUG && !COMPILED) {
  * @constructor
  */
 proto.wsman.WorkspaceStatus = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsman.WorkspaceStatus.repeatedFields_, null);
 };
 goog.inherits(proto.wsman.WorkspaceStatus, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -673,6 +812,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.wsman.WorkspaceStatus.displayName = 'proto.wsman.WorkspaceStatus';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.WorkspaceStorage = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.WorkspaceStorage, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.WorkspaceStorage.displayName = 'proto.wsman.WorkspaceStorage';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.WorkspaceTimelineEvent = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.WorkspaceTimelineEvent, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.WorkspaceTimelineEvent.displayName = 'proto.wsman.WorkspaceTimelineEvent';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -1072,6 +1253,111 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.wsman.WorkspaceClass.displayName = 'proto.wsman.WorkspaceClass';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.WorkspaceTimeouts = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.WorkspaceTimeouts, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.WorkspaceTimeouts.displayName = 'proto.wsman.WorkspaceTimeouts';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.OrganizationTimeouts = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.OrganizationTimeouts, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.OrganizationTimeouts.displayName = 'proto.wsman.OrganizationTimeouts';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.WorkspaceQuota = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsman.WorkspaceQuota.repeatedFields_, null);
+};
+goog.inherits(proto.wsman.WorkspaceQuota, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.WorkspaceQuota.displayName = 'proto.wsman.WorkspaceQuota';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.WorkspaceQuotaClass = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.WorkspaceQuotaClass, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.WorkspaceQuotaClass.displayName = 'proto.wsman.WorkspaceQuotaClass';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.WorkspaceQuotaResource = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.WorkspaceQuotaResource, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.WorkspaceQuotaResource.displayName = 'proto.wsman.WorkspaceQuotaResource';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -3561,7 +3847,6 @@ proto.wsman.SetTimeoutResponse.serializeBinaryToWriter = function(message, write
 
 
 
-
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -3575,8 +3860,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.ControlPortRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.ControlPortRequest.toObject(opt_includeInstance, this);
+proto.wsman.SetStorageQuotaRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.SetStorageQuotaRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3585,15 +3870,14 @@ proto.wsman.ControlPortRequest.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.ControlPortRequest} msg The msg instance to transform.
+ * @param {!proto.wsman.SetStorageQuotaRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.ControlPortRequest.toObject = function(includeInstance, msg) {
+proto.wsman.SetStorageQuotaRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    expose: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
-    spec: (f = msg.getSpec()) && proto.wsman.PortSpec.toObject(includeInstance, f)
+    quota: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -3607,23 +3891,23 @@ proto.wsman.ControlPortRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.ControlPortRequest}
+ * @return {!proto.wsman.SetStorageQuotaRequest}
  */
-proto.wsman.ControlPortRequest.deserializeBinary = function(bytes) {
+proto.wsman.SetStorageQuotaRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.ControlPortRequest;
-  return proto.wsman.ControlPortRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.SetStorageQuotaRequest;
+  return proto.wsman.SetStorageQuotaRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.ControlPortRequest} msg The message object to deserialize into.
+ * @param {!proto.wsman.SetStorageQuotaRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.ControlPortRequest}
+ * @return {!proto.wsman.SetStorageQuotaRequest}
  */
-proto.wsman.ControlPortRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.SetStorageQuotaRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3635,13 +3919,8 @@ proto.wsman.ControlPortRequest.deserializeBinaryFromReader = function(msg, reade
       msg.setId(value);
       break;
     case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setExpose(value);
-      break;
-    case 3:
-      var value = new proto.wsman.PortSpec;
-      reader.readMessage(value,proto.wsman.PortSpec.deserializeBinaryFromReader);
-      msg.setSpec(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setQuota(value);
       break;
     default:
       reader.skipField();
@@ -3656,123 +3935,77 @@ proto.wsman.ControlPortRequest.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.ControlPortRequest.prototype.serializeBinary = function() {
+proto.wsman.SetStorageQuotaRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.ControlPortRequest.serializeBinaryToWriter(this, writer);
+  proto.wsman.SetStorageQuotaRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
 
-/**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.ControlPortRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.wsman.ControlPortRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getExpose();
-  if (f) {
-    writer.writeBool(
-      2,
-      f
-    );
-  }
-  f = message.getSpec();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      proto.wsman.PortSpec.serializeBinaryToWriter
-    );
-  }
-};
-
-
 /**
  * optional string id = 1;
  * @return {string}
  */
-proto.wsman.ControlPortRequest.prototype.getId = function() {
+proto.wsman.SetStorageQuotaRequest.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.ControlPortRequest} returns this
+ * @return {!proto.wsman.SetStorageQuotaRequest} returns this
  */
-proto.wsman.ControlPortRequest.prototype.setId = function(value) {
+proto.wsman.SetStorageQuotaRequest.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional bool expose = 2;
- * @return {boolean}
- */
-proto.wsman.ControlPortRequest.prototype.getExpose = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.wsman.ControlPortRequest} returns this
- */
-proto.wsman.ControlPortRequest.prototype.setExpose = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
-};
-
-
-/**
- * optional PortSpec spec = 3;
- * @return {?proto.wsman.PortSpec}
+ * optional string quota = 2;
+ * @return {string}
  */
-proto.wsman.ControlPortRequest.prototype.getSpec = function() {
-  return /** @type{?proto.wsman.PortSpec} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.PortSpec, 3));
-};
-
-
-/**
- * @param {?proto.wsman.PortSpec|undefined} value
- * @return {!proto.wsman.ControlPortRequest} returns this
-*/
-proto.wsman.ControlPortRequest.prototype.setSpec = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+proto.wsman.SetStorageQuotaRequest.prototype.getQuota = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.ControlPortRequest} returns this
+ * @param {string} value
+ * @return {!proto.wsman.SetStorageQuotaRequest} returns this
  */
-proto.wsman.ControlPortRequest.prototype.clearSpec = function() {
-  return this.setSpec(undefined);
+proto.wsman.SetStorageQuotaRequest.prototype.setQuota = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.SetStorageQuotaRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.ControlPortRequest.prototype.hasSpec = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.wsman.SetStorageQuotaRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getQuota();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 
 
-
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -3786,8 +4019,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.ControlPortResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.ControlPortResponse.toObject(opt_includeInstance, this);
+proto.wsman.SetStorageQuotaResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.SetStorageQuotaResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3796,11 +4029,11 @@ proto.wsman.ControlPortResponse.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.ControlPortResponse} msg The msg instance to transform.
+ * @param {!proto.wsman.SetStorageQuotaResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.ControlPortResponse.toObject = function(includeInstance, msg) {
+proto.wsman.SetStorageQuotaResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
 
   };
@@ -3816,23 +4049,23 @@ proto.wsman.ControlPortResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.ControlPortResponse}
+ * @return {!proto.wsman.SetStorageQuotaResponse}
  */
-proto.wsman.ControlPortResponse.deserializeBinary = function(bytes) {
+proto.wsman.SetStorageQuotaResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.ControlPortResponse;
-  return proto.wsman.ControlPortResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.SetStorageQuotaResponse;
+  return proto.wsman.SetStorageQuotaResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.ControlPortResponse} msg The message object to deserialize into.
+ * @param {!proto.wsman.SetStorageQuotaResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.ControlPortResponse}
+ * @return {!proto.wsman.SetStorageQuotaResponse}
  */
-proto.wsman.ControlPortResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.SetStorageQuotaResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3852,9 +4085,9 @@ proto.wsman.ControlPortResponse.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.ControlPortResponse.prototype.serializeBinary = function() {
+proto.wsman.SetStorageQuotaResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.ControlPortResponse.serializeBinaryToWriter(this, writer);
+  proto.wsman.SetStorageQuotaResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3862,16 +4095,23 @@ proto.wsman.ControlPortResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.ControlPortResponse} message
+ * @param {!proto.wsman.SetStorageQuotaResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.ControlPortResponse.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.SetStorageQuotaResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.wsman.MigrateWorkspaceRequest.repeatedFields_ = [2,3];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -3887,8 +4127,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.TakeSnapshotRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.TakeSnapshotRequest.toObject(opt_includeInstance, this);
+proto.wsman.MigrateWorkspaceRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.MigrateWorkspaceRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3897,14 +4137,17 @@ proto.wsman.TakeSnapshotRequest.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.TakeSnapshotRequest} msg The msg instance to transform.
+ * @param {!proto.wsman.MigrateWorkspaceRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.TakeSnapshotRequest.toObject = function(includeInstance, msg) {
+proto.wsman.MigrateWorkspaceRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    returnImmediately: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
+    envvarsList: jspb.Message.toObjectList(msg.getEnvvarsList(),
+    proto.wsman.EnvironmentVariable.toObject, includeInstance),
+    sysEnvvarsList: jspb.Message.toObjectList(msg.getSysEnvvarsList(),
+    proto.wsman.EnvironmentVariable.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3918,23 +4161,23 @@ proto.wsman.TakeSnapshotRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.TakeSnapshotRequest}
+ * @return {!proto.wsman.MigrateWorkspaceRequest}
  */
-proto.wsman.TakeSnapshotRequest.deserializeBinary = function(bytes) {
+proto.wsman.MigrateWorkspaceRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.TakeSnapshotRequest;
-  return proto.wsman.TakeSnapshotRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.MigrateWorkspaceRequest;
+  return proto.wsman.MigrateWorkspaceRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.TakeSnapshotRequest} msg The message object to deserialize into.
+ * @param {!proto.wsman.MigrateWorkspaceRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.TakeSnapshotRequest}
+ * @return {!proto.wsman.MigrateWorkspaceRequest}
  */
-proto.wsman.TakeSnapshotRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.MigrateWorkspaceRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3946,8 +4189,14 @@ proto.wsman.TakeSnapshotRequest.deserializeBinaryFromReader = function(msg, read
       msg.setId(value);
       break;
     case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setReturnImmediately(value);
+      var value = new proto.wsman.EnvironmentVariable;
+      reader.readMessage(value,proto.wsman.EnvironmentVariable.deserializeBinaryFromReader);
+      msg.addEnvvars(value);
+      break;
+    case 3:
+      var value = new proto.wsman.EnvironmentVariable;
+      reader.readMessage(value,proto.wsman.EnvironmentVariable.deserializeBinaryFromReader);
+      msg.addSysEnvvars(value);
       break;
     default:
       reader.skipField();
@@ -3962,206 +4211,142 @@ proto.wsman.TakeSnapshotRequest.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.TakeSnapshotRequest.prototype.serializeBinary = function() {
+proto.wsman.MigrateWorkspaceRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.TakeSnapshotRequest.serializeBinaryToWriter(this, writer);
+  proto.wsman.MigrateWorkspaceRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
 
-/**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.TakeSnapshotRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.wsman.TakeSnapshotRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getReturnImmediately();
-  if (f) {
-    writer.writeBool(
-      2,
-      f
-    );
-  }
-};
-
-
 /**
  * optional string id = 1;
  * @return {string}
  */
-proto.wsman.TakeSnapshotRequest.prototype.getId = function() {
+proto.wsman.MigrateWorkspaceRequest.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.TakeSnapshotRequest} returns this
+ * @return {!proto.wsman.MigrateWorkspaceRequest} returns this
  */
-proto.wsman.TakeSnapshotRequest.prototype.setId = function(value) {
+proto.wsman.MigrateWorkspaceRequest.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional bool return_immediately = 2;
- * @return {boolean}
+ * repeated EnvironmentVariable envvars = 2;
+ * @return {!Array<!proto.wsman.EnvironmentVariable>}
  */
-proto.wsman.TakeSnapshotRequest.prototype.getReturnImmediately = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+proto.wsman.MigrateWorkspaceRequest.prototype.getEnvvarsList = function() {
+  return /** @type{!Array<!proto.wsman.EnvironmentVariable>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.EnvironmentVariable, 2));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.wsman.TakeSnapshotRequest} returns this
- */
-proto.wsman.TakeSnapshotRequest.prototype.setReturnImmediately = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
+ * @param {!Array<!proto.wsman.EnvironmentVariable>} value
+ * @return {!proto.wsman.MigrateWorkspaceRequest} returns this
+*/
+proto.wsman.MigrateWorkspaceRequest.prototype.setEnvvarsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
 };
 
 
+/**
+ * @param {!proto.wsman.EnvironmentVariable=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.EnvironmentVariable}
+ */
+proto.wsman.MigrateWorkspaceRequest.prototype.addEnvvars = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.wsman.EnvironmentVariable, opt_index);
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.MigrateWorkspaceRequest} returns this
  */
-proto.wsman.TakeSnapshotResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.TakeSnapshotResponse.toObject(opt_includeInstance, this);
+proto.wsman.MigrateWorkspaceRequest.prototype.clearEnvvarsList = function() {
+  return this.setEnvvarsList([]);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.wsman.TakeSnapshotResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * repeated EnvironmentVariable sys_envvars = 3;
+ * @return {!Array<!proto.wsman.EnvironmentVariable>}
  */
-proto.wsman.TakeSnapshotResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    url: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
+proto.wsman.MigrateWorkspaceRequest.prototype.getSysEnvvarsList = function() {
+  return /** @type{!Array<!proto.wsman.EnvironmentVariable>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.EnvironmentVariable, 3));
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * @param {!Array<!proto.wsman.EnvironmentVariable>} value
+ * @return {!proto.wsman.MigrateWorkspaceRequest} returns this
+*/
+proto.wsman.MigrateWorkspaceRequest.prototype.setSysEnvvarsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.TakeSnapshotResponse}
+ * @param {!proto.wsman.EnvironmentVariable=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.EnvironmentVariable}
  */
-proto.wsman.TakeSnapshotResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.TakeSnapshotResponse;
-  return proto.wsman.TakeSnapshotResponse.deserializeBinaryFromReader(msg, reader);
+proto.wsman.MigrateWorkspaceRequest.prototype.addSysEnvvars = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.wsman.EnvironmentVariable, opt_index);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.wsman.TakeSnapshotResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.TakeSnapshotResponse}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.MigrateWorkspaceRequest} returns this
  */
-proto.wsman.TakeSnapshotResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUrl(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
-};
-
-
-/**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
- */
-proto.wsman.TakeSnapshotResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.wsman.TakeSnapshotResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.wsman.MigrateWorkspaceRequest.prototype.clearSysEnvvarsList = function() {
+  return this.setSysEnvvarsList([]);
 };
 
 
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.TakeSnapshotResponse} message
+ * @param {!proto.wsman.MigrateWorkspaceRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.TakeSnapshotResponse.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.MigrateWorkspaceRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUrl();
+  f = message.getId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getEnvvarsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.wsman.EnvironmentVariable.serializeBinaryToWriter
+    );
+  }
+  f = message.getSysEnvvarsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.wsman.EnvironmentVariable.serializeBinaryToWriter
+    );
+  }
 };
 
 
-/**
- * optional string url = 1;
- * @return {string}
- */
-proto.wsman.TakeSnapshotResponse.prototype.getUrl = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.wsman.TakeSnapshotResponse} returns this
- */
-proto.wsman.TakeSnapshotResponse.prototype.setUrl = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -4177,8 +4362,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.ControlAdmissionRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.ControlAdmissionRequest.toObject(opt_includeInstance, this);
+proto.wsman.MigrateWorkspaceResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.MigrateWorkspaceResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -4187,14 +4372,13 @@ proto.wsman.ControlAdmissionRequest.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.ControlAdmissionRequest} msg The msg instance to transform.
+ * @param {!proto.wsman.MigrateWorkspaceResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.ControlAdmissionRequest.toObject = function(includeInstance, msg) {
+proto.wsman.MigrateWorkspaceResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    level: jspb.Message.getFieldWithDefault(msg, 2, 0)
+
   };
 
   if (includeInstance) {
@@ -4208,37 +4392,29 @@ proto.wsman.ControlAdmissionRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.ControlAdmissionRequest}
+ * @return {!proto.wsman.MigrateWorkspaceResponse}
  */
-proto.wsman.ControlAdmissionRequest.deserializeBinary = function(bytes) {
+proto.wsman.MigrateWorkspaceResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.ControlAdmissionRequest;
-  return proto.wsman.ControlAdmissionRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.MigrateWorkspaceResponse;
+  return proto.wsman.MigrateWorkspaceResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.ControlAdmissionRequest} msg The message object to deserialize into.
+ * @param {!proto.wsman.MigrateWorkspaceResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.ControlAdmissionRequest}
+ * @return {!proto.wsman.MigrateWorkspaceResponse}
  */
-proto.wsman.ControlAdmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.MigrateWorkspaceResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {!proto.wsman.AdmissionLevel} */ (reader.readEnum());
-      msg.setLevel(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -4252,9 +4428,9 @@ proto.wsman.ControlAdmissionRequest.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.ControlAdmissionRequest.prototype.serializeBinary = function() {
+proto.wsman.MigrateWorkspaceResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.ControlAdmissionRequest.serializeBinaryToWriter(this, writer);
+  proto.wsman.MigrateWorkspaceResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4262,68 +4438,17 @@ proto.wsman.ControlAdmissionRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.ControlAdmissionRequest} message
+ * @param {!proto.wsman.MigrateWorkspaceResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.ControlAdmissionRequest.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.MigrateWorkspaceResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getLevel();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      2,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string id = 1;
- * @return {string}
- */
-proto.wsman.ControlAdmissionRequest.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.wsman.ControlAdmissionRequest} returns this
- */
-proto.wsman.ControlAdmissionRequest.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional AdmissionLevel level = 2;
- * @return {!proto.wsman.AdmissionLevel}
- */
-proto.wsman.ControlAdmissionRequest.prototype.getLevel = function() {
-  return /** @type {!proto.wsman.AdmissionLevel} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {!proto.wsman.AdmissionLevel} value
- * @return {!proto.wsman.ControlAdmissionRequest} returns this
- */
-proto.wsman.ControlAdmissionRequest.prototype.setLevel = function(value) {
-  return jspb.Message.setProto3EnumField(this, 2, value);
 };
 
 
 
 
-
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -4337,8 +4462,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.ControlAdmissionResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.ControlAdmissionResponse.toObject(opt_includeInstance, this);
+proto.wsman.RenderWorkspacePodRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.RenderWorkspacePodRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4347,13 +4472,15 @@ proto.wsman.ControlAdmissionResponse.prototype.toObject = function(opt_includeIn
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.ControlAdmissionResponse} msg The msg instance to transform.
+ * @param {!proto.wsman.RenderWorkspacePodRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.ControlAdmissionResponse.toObject = function(includeInstance, msg) {
+proto.wsman.RenderWorkspacePodRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    request: (f = msg.getRequest()) && proto.wsman.StartWorkspaceRequest.toObject(includeInstance, f),
+    compareClass: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    compareConfig: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -4367,29 +4494,42 @@ proto.wsman.ControlAdmissionResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.ControlAdmissionResponse}
+ * @return {!proto.wsman.RenderWorkspacePodRequest}
  */
-proto.wsman.ControlAdmissionResponse.deserializeBinary = function(bytes) {
+proto.wsman.RenderWorkspacePodRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.ControlAdmissionResponse;
-  return proto.wsman.ControlAdmissionResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.RenderWorkspacePodRequest;
+  return proto.wsman.RenderWorkspacePodRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.ControlAdmissionResponse} msg The message object to deserialize into.
+ * @param {!proto.wsman.RenderWorkspacePodRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.ControlAdmissionResponse}
+ * @return {!proto.wsman.RenderWorkspacePodRequest}
  */
-proto.wsman.ControlAdmissionResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.RenderWorkspacePodRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = new proto.wsman.StartWorkspaceRequest;
+      reader.readMessage(value,proto.wsman.StartWorkspaceRequest.deserializeBinaryFromReader);
+      msg.setRequest(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCompareClass(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCompareConfig(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -4403,28 +4543,122 @@ proto.wsman.ControlAdmissionResponse.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.ControlAdmissionResponse.prototype.serializeBinary = function() {
+proto.wsman.RenderWorkspacePodRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.ControlAdmissionResponse.serializeBinaryToWriter(this, writer);
+  proto.wsman.RenderWorkspacePodRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
 
+/**
+ * optional StartWorkspaceRequest request = 1;
+ * @return {?proto.wsman.StartWorkspaceRequest}
+ */
+proto.wsman.RenderWorkspacePodRequest.prototype.getRequest = function() {
+  return /** @type{?proto.wsman.StartWorkspaceRequest} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.StartWorkspaceRequest, 1));
+};
+
+
+/**
+ * @param {?proto.wsman.StartWorkspaceRequest|undefined} value
+ * @return {!proto.wsman.RenderWorkspacePodRequest} returns this
+*/
+proto.wsman.RenderWorkspacePodRequest.prototype.setRequest = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.RenderWorkspacePodRequest} returns this
+ */
+proto.wsman.RenderWorkspacePodRequest.prototype.clearRequest = function() {
+  return this.setRequest(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.RenderWorkspacePodRequest.prototype.hasRequest = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string compare_class = 2;
+ * @return {string}
+ */
+proto.wsman.RenderWorkspacePodRequest.prototype.getCompareClass = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.RenderWorkspacePodRequest} returns this
+ */
+proto.wsman.RenderWorkspacePodRequest.prototype.setCompareClass = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string compare_config = 3;
+ * @return {string}
+ */
+proto.wsman.RenderWorkspacePodRequest.prototype.getCompareConfig = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.RenderWorkspacePodRequest} returns this
+ */
+proto.wsman.RenderWorkspacePodRequest.prototype.setCompareConfig = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.ControlAdmissionResponse} message
+ * @param {!proto.wsman.RenderWorkspacePodRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.ControlAdmissionResponse.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.RenderWorkspacePodRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getRequest();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.wsman.StartWorkspaceRequest.serializeBinaryToWriter
+    );
+  }
+  f = message.getCompareClass();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getCompareConfig();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
 
 
-
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -4438,8 +4672,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.DeleteVolumeSnapshotRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.DeleteVolumeSnapshotRequest.toObject(opt_includeInstance, this);
+proto.wsman.RenderWorkspacePodResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.RenderWorkspacePodResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -4448,16 +4682,15 @@ proto.wsman.DeleteVolumeSnapshotRequest.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.DeleteVolumeSnapshotRequest} msg The msg instance to transform.
+ * @param {!proto.wsman.RenderWorkspacePodResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.DeleteVolumeSnapshotRequest.toObject = function(includeInstance, msg) {
+proto.wsman.RenderWorkspacePodResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    volumeHandle: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    softDelete: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-    wsType: jspb.Message.getFieldWithDefault(msg, 4, 0)
+    pod: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    comparePod: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    diff: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -4471,23 +4704,23 @@ proto.wsman.DeleteVolumeSnapshotRequest.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.DeleteVolumeSnapshotRequest}
+ * @return {!proto.wsman.RenderWorkspacePodResponse}
  */
-proto.wsman.DeleteVolumeSnapshotRequest.deserializeBinary = function(bytes) {
+proto.wsman.RenderWorkspacePodResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.DeleteVolumeSnapshotRequest;
-  return proto.wsman.DeleteVolumeSnapshotRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.RenderWorkspacePodResponse;
+  return proto.wsman.RenderWorkspacePodResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.DeleteVolumeSnapshotRequest} msg The message object to deserialize into.
+ * @param {!proto.wsman.RenderWorkspacePodResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.DeleteVolumeSnapshotRequest}
+ * @return {!proto.wsman.RenderWorkspacePodResponse}
  */
-proto.wsman.DeleteVolumeSnapshotRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.RenderWorkspacePodResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4496,19 +4729,15 @@ proto.wsman.DeleteVolumeSnapshotRequest.deserializeBinaryFromReader = function(m
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.setPod(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setVolumeHandle(value);
+      msg.setComparePod(value);
       break;
     case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSoftDelete(value);
-      break;
-    case 4:
-      var value = /** @type {!proto.wsman.WorkspaceType} */ (reader.readEnum());
-      msg.setWsType(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDiff(value);
       break;
     default:
       reader.skipField();
@@ -4523,122 +4752,97 @@ proto.wsman.DeleteVolumeSnapshotRequest.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.DeleteVolumeSnapshotRequest.prototype.serializeBinary = function() {
+proto.wsman.RenderWorkspacePodResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.DeleteVolumeSnapshotRequest.serializeBinaryToWriter(this, writer);
+  proto.wsman.RenderWorkspacePodResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.DeleteVolumeSnapshotRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.wsman.DeleteVolumeSnapshotRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getVolumeHandle();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getSoftDelete();
-  if (f) {
-    writer.writeBool(
-      3,
-      f
-    );
-  }
-  f = message.getWsType();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      4,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string id = 1;
+ * optional string pod = 1;
  * @return {string}
  */
-proto.wsman.DeleteVolumeSnapshotRequest.prototype.getId = function() {
+proto.wsman.RenderWorkspacePodResponse.prototype.getPod = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.DeleteVolumeSnapshotRequest} returns this
+ * @return {!proto.wsman.RenderWorkspacePodResponse} returns this
  */
-proto.wsman.DeleteVolumeSnapshotRequest.prototype.setId = function(value) {
+proto.wsman.RenderWorkspacePodResponse.prototype.setPod = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string volume_handle = 2;
+ * optional string compare_pod = 2;
  * @return {string}
  */
-proto.wsman.DeleteVolumeSnapshotRequest.prototype.getVolumeHandle = function() {
+proto.wsman.RenderWorkspacePodResponse.prototype.getComparePod = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.DeleteVolumeSnapshotRequest} returns this
+ * @return {!proto.wsman.RenderWorkspacePodResponse} returns this
  */
-proto.wsman.DeleteVolumeSnapshotRequest.prototype.setVolumeHandle = function(value) {
+proto.wsman.RenderWorkspacePodResponse.prototype.setComparePod = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional bool soft_delete = 3;
- * @return {boolean}
- */
-proto.wsman.DeleteVolumeSnapshotRequest.prototype.getSoftDelete = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.wsman.DeleteVolumeSnapshotRequest} returns this
+ * optional string diff = 3;
+ * @return {string}
  */
-proto.wsman.DeleteVolumeSnapshotRequest.prototype.setSoftDelete = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
+proto.wsman.RenderWorkspacePodResponse.prototype.getDiff = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * optional WorkspaceType ws_type = 4;
- * @return {!proto.wsman.WorkspaceType}
+ * @param {string} value
+ * @return {!proto.wsman.RenderWorkspacePodResponse} returns this
  */
-proto.wsman.DeleteVolumeSnapshotRequest.prototype.getWsType = function() {
-  return /** @type {!proto.wsman.WorkspaceType} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.wsman.RenderWorkspacePodResponse.prototype.setDiff = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * @param {!proto.wsman.WorkspaceType} value
- * @return {!proto.wsman.DeleteVolumeSnapshotRequest} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.RenderWorkspacePodResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.DeleteVolumeSnapshotRequest.prototype.setWsType = function(value) {
-  return jspb.Message.setProto3EnumField(this, 4, value);
+proto.wsman.RenderWorkspacePodResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPod();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getComparePod();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getDiff();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -4658,8 +4862,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.DeleteVolumeSnapshotResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.DeleteVolumeSnapshotResponse.toObject(opt_includeInstance, this);
+proto.wsman.ControlPortRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.ControlPortRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4668,13 +4872,15 @@ proto.wsman.DeleteVolumeSnapshotResponse.prototype.toObject = function(opt_inclu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.DeleteVolumeSnapshotResponse} msg The msg instance to transform.
+ * @param {!proto.wsman.ControlPortRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.DeleteVolumeSnapshotResponse.toObject = function(includeInstance, msg) {
+proto.wsman.ControlPortRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    wasDeleted: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    expose: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    spec: (f = msg.getSpec()) && proto.wsman.PortSpec.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -4688,23 +4894,23 @@ proto.wsman.DeleteVolumeSnapshotResponse.toObject = function(includeInstance, ms
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.DeleteVolumeSnapshotResponse}
+ * @return {!proto.wsman.ControlPortRequest}
  */
-proto.wsman.DeleteVolumeSnapshotResponse.deserializeBinary = function(bytes) {
+proto.wsman.ControlPortRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.DeleteVolumeSnapshotResponse;
-  return proto.wsman.DeleteVolumeSnapshotResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.ControlPortRequest;
+  return proto.wsman.ControlPortRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.DeleteVolumeSnapshotResponse} msg The message object to deserialize into.
+ * @param {!proto.wsman.ControlPortRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.DeleteVolumeSnapshotResponse}
+ * @return {!proto.wsman.ControlPortRequest}
  */
-proto.wsman.DeleteVolumeSnapshotResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.ControlPortRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4712,8 +4918,17 @@ proto.wsman.DeleteVolumeSnapshotResponse.deserializeBinaryFromReader = function(
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
       var value = /** @type {boolean} */ (reader.readBool());
-      msg.setWasDeleted(value);
+      msg.setExpose(value);
+      break;
+    case 3:
+      var value = new proto.wsman.PortSpec;
+      reader.readMessage(value,proto.wsman.PortSpec.deserializeBinaryFromReader);
+      msg.setSpec(value);
       break;
     default:
       reader.skipField();
@@ -4728,9 +4943,9 @@ proto.wsman.DeleteVolumeSnapshotResponse.deserializeBinaryFromReader = function(
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.DeleteVolumeSnapshotResponse.prototype.serializeBinary = function() {
+proto.wsman.ControlPortRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.DeleteVolumeSnapshotResponse.serializeBinaryToWriter(this, writer);
+  proto.wsman.ControlPortRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4738,37 +4953,107 @@ proto.wsman.DeleteVolumeSnapshotResponse.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.DeleteVolumeSnapshotResponse} message
+ * @param {!proto.wsman.ControlPortRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.DeleteVolumeSnapshotResponse.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.ControlPortRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getWasDeleted();
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getExpose();
   if (f) {
     writer.writeBool(
-      1,
+      2,
       f
     );
   }
+  f = message.getSpec();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.wsman.PortSpec.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional bool was_deleted = 1;
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.ControlPortRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.ControlPortRequest} returns this
+ */
+proto.wsman.ControlPortRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bool expose = 2;
  * @return {boolean}
  */
-proto.wsman.DeleteVolumeSnapshotResponse.prototype.getWasDeleted = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.wsman.ControlPortRequest.prototype.getExpose = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
 };
 
 
 /**
  * @param {boolean} value
- * @return {!proto.wsman.DeleteVolumeSnapshotResponse} returns this
+ * @return {!proto.wsman.ControlPortRequest} returns this
  */
-proto.wsman.DeleteVolumeSnapshotResponse.prototype.setWasDeleted = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.wsman.ControlPortRequest.prototype.setExpose = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional PortSpec spec = 3;
+ * @return {?proto.wsman.PortSpec}
+ */
+proto.wsman.ControlPortRequest.prototype.getSpec = function() {
+  return /** @type{?proto.wsman.PortSpec} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.PortSpec, 3));
+};
+
+
+/**
+ * @param {?proto.wsman.PortSpec|undefined} value
+ * @return {!proto.wsman.ControlPortRequest} returns this
+*/
+proto.wsman.ControlPortRequest.prototype.setSpec = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.ControlPortRequest} returns this
+ */
+proto.wsman.ControlPortRequest.prototype.clearSpec = function() {
+  return this.setSpec(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.ControlPortRequest.prototype.hasSpec = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
@@ -4788,8 +5073,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.BackupWorkspaceRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.BackupWorkspaceRequest.toObject(opt_includeInstance, this);
+proto.wsman.ControlPortResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.ControlPortResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -4798,13 +5083,13 @@ proto.wsman.BackupWorkspaceRequest.prototype.toObject = function(opt_includeInst
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.BackupWorkspaceRequest} msg The msg instance to transform.
+ * @param {!proto.wsman.ControlPortResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.BackupWorkspaceRequest.toObject = function(includeInstance, msg) {
+proto.wsman.ControlPortResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+
   };
 
   if (includeInstance) {
@@ -4818,33 +5103,29 @@ proto.wsman.BackupWorkspaceRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.BackupWorkspaceRequest}
+ * @return {!proto.wsman.ControlPortResponse}
  */
-proto.wsman.BackupWorkspaceRequest.deserializeBinary = function(bytes) {
+proto.wsman.ControlPortResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.BackupWorkspaceRequest;
-  return proto.wsman.BackupWorkspaceRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.ControlPortResponse;
+  return proto.wsman.ControlPortResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.BackupWorkspaceRequest} msg The message object to deserialize into.
+ * @param {!proto.wsman.ControlPortResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.BackupWorkspaceRequest}
+ * @return {!proto.wsman.ControlPortResponse}
  */
-proto.wsman.BackupWorkspaceRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.ControlPortResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -4858,9 +5139,9 @@ proto.wsman.BackupWorkspaceRequest.deserializeBinaryFromReader = function(msg, r
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.BackupWorkspaceRequest.prototype.serializeBinary = function() {
+proto.wsman.ControlPortResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.BackupWorkspaceRequest.serializeBinaryToWriter(this, writer);
+  proto.wsman.ControlPortResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4868,37 +5149,12 @@ proto.wsman.BackupWorkspaceRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.BackupWorkspaceRequest} message
+ * @param {!proto.wsman.ControlPortResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.BackupWorkspaceRequest.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.ControlPortResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string id = 1;
- * @return {string}
- */
-proto.wsman.BackupWorkspaceRequest.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.wsman.BackupWorkspaceRequest} returns this
- */
-proto.wsman.BackupWorkspaceRequest.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -4918,8 +5174,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.BackupWorkspaceResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.BackupWorkspaceResponse.toObject(opt_includeInstance, this);
+proto.wsman.TakeSnapshotRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.TakeSnapshotRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4928,13 +5184,14 @@ proto.wsman.BackupWorkspaceResponse.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.BackupWorkspaceResponse} msg The msg instance to transform.
+ * @param {!proto.wsman.TakeSnapshotRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.BackupWorkspaceResponse.toObject = function(includeInstance, msg) {
+proto.wsman.TakeSnapshotRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    url: jspb.Message.getFieldWithDefault(msg, 1, "")
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    returnImmediately: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
   };
 
   if (includeInstance) {
@@ -4948,23 +5205,23 @@ proto.wsman.BackupWorkspaceResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.BackupWorkspaceResponse}
+ * @return {!proto.wsman.TakeSnapshotRequest}
  */
-proto.wsman.BackupWorkspaceResponse.deserializeBinary = function(bytes) {
+proto.wsman.TakeSnapshotRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.BackupWorkspaceResponse;
-  return proto.wsman.BackupWorkspaceResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.TakeSnapshotRequest;
+  return proto.wsman.TakeSnapshotRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.BackupWorkspaceResponse} msg The message object to deserialize into.
+ * @param {!proto.wsman.TakeSnapshotRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.BackupWorkspaceResponse}
+ * @return {!proto.wsman.TakeSnapshotRequest}
  */
-proto.wsman.BackupWorkspaceResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.TakeSnapshotRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4973,7 +5230,11 @@ proto.wsman.BackupWorkspaceResponse.deserializeBinaryFromReader = function(msg,
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUrl(value);
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReturnImmediately(value);
       break;
     default:
       reader.skipField();
@@ -4988,9 +5249,9 @@ proto.wsman.BackupWorkspaceResponse.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.BackupWorkspaceResponse.prototype.serializeBinary = function() {
+proto.wsman.TakeSnapshotRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.BackupWorkspaceResponse.serializeBinaryToWriter(this, writer);
+  proto.wsman.TakeSnapshotRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4998,47 +5259,65 @@ proto.wsman.BackupWorkspaceResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.BackupWorkspaceResponse} message
+ * @param {!proto.wsman.TakeSnapshotRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.BackupWorkspaceResponse.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.TakeSnapshotRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUrl();
+  f = message.getId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getReturnImmediately();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string url = 1;
+ * optional string id = 1;
  * @return {string}
  */
-proto.wsman.BackupWorkspaceResponse.prototype.getUrl = function() {
+proto.wsman.TakeSnapshotRequest.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.BackupWorkspaceResponse} returns this
+ * @return {!proto.wsman.TakeSnapshotRequest} returns this
  */
-proto.wsman.BackupWorkspaceResponse.prototype.setUrl = function(value) {
+proto.wsman.TakeSnapshotRequest.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional bool return_immediately = 2;
+ * @return {boolean}
+ */
+proto.wsman.TakeSnapshotRequest.prototype.getReturnImmediately = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {boolean} value
+ * @return {!proto.wsman.TakeSnapshotRequest} returns this
  */
-proto.wsman.UpdateSSHKeyRequest.repeatedFields_ = [2];
+proto.wsman.TakeSnapshotRequest.prototype.setReturnImmediately = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
 
 
 
@@ -5055,8 +5334,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.UpdateSSHKeyRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.UpdateSSHKeyRequest.toObject(opt_includeInstance, this);
+proto.wsman.TakeSnapshotResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.TakeSnapshotResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -5065,14 +5344,13 @@ proto.wsman.UpdateSSHKeyRequest.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.UpdateSSHKeyRequest} msg The msg instance to transform.
+ * @param {!proto.wsman.TakeSnapshotResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.UpdateSSHKeyRequest.toObject = function(includeInstance, msg) {
+proto.wsman.TakeSnapshotResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    keysList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+    url: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -5086,23 +5364,23 @@ proto.wsman.UpdateSSHKeyRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.UpdateSSHKeyRequest}
+ * @return {!proto.wsman.TakeSnapshotResponse}
  */
-proto.wsman.UpdateSSHKeyRequest.deserializeBinary = function(bytes) {
+proto.wsman.TakeSnapshotResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.UpdateSSHKeyRequest;
-  return proto.wsman.UpdateSSHKeyRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.TakeSnapshotResponse;
+  return proto.wsman.TakeSnapshotResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.UpdateSSHKeyRequest} msg The message object to deserialize into.
+ * @param {!proto.wsman.TakeSnapshotResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.UpdateSSHKeyRequest}
+ * @return {!proto.wsman.TakeSnapshotResponse}
  */
-proto.wsman.UpdateSSHKeyRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.TakeSnapshotResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5111,11 +5389,7 @@ proto.wsman.UpdateSSHKeyRequest.deserializeBinaryFromReader = function(msg, read
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addKeys(value);
+      msg.setUrl(value);
       break;
     default:
       reader.skipField();
@@ -5130,9 +5404,9 @@ proto.wsman.UpdateSSHKeyRequest.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.UpdateSSHKeyRequest.prototype.serializeBinary = function() {
+proto.wsman.TakeSnapshotResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.UpdateSSHKeyRequest.serializeBinaryToWriter(this, writer);
+  proto.wsman.TakeSnapshotResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5140,84 +5414,40 @@ proto.wsman.UpdateSSHKeyRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.UpdateSSHKeyRequest} message
+ * @param {!proto.wsman.TakeSnapshotResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.UpdateSSHKeyRequest.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.TakeSnapshotResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getUrl();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getKeysList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      2,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string id = 1;
+ * optional string url = 1;
  * @return {string}
  */
-proto.wsman.UpdateSSHKeyRequest.prototype.getId = function() {
+proto.wsman.TakeSnapshotResponse.prototype.getUrl = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.UpdateSSHKeyRequest} returns this
+ * @return {!proto.wsman.TakeSnapshotResponse} returns this
  */
-proto.wsman.UpdateSSHKeyRequest.prototype.setId = function(value) {
+proto.wsman.TakeSnapshotResponse.prototype.setUrl = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * repeated string keys = 2;
- * @return {!Array<string>}
- */
-proto.wsman.UpdateSSHKeyRequest.prototype.getKeysList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
-};
-
-
-/**
- * @param {!Array<string>} value
- * @return {!proto.wsman.UpdateSSHKeyRequest} returns this
- */
-proto.wsman.UpdateSSHKeyRequest.prototype.setKeysList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
-};
-
-
-/**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.wsman.UpdateSSHKeyRequest} returns this
- */
-proto.wsman.UpdateSSHKeyRequest.prototype.addKeys = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.wsman.UpdateSSHKeyRequest} returns this
- */
-proto.wsman.UpdateSSHKeyRequest.prototype.clearKeysList = function() {
-  return this.setKeysList([]);
-};
-
-
 
 
 
@@ -5234,8 +5464,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.UpdateSSHKeyResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.UpdateSSHKeyResponse.toObject(opt_includeInstance, this);
+proto.wsman.ControlAdmissionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.ControlAdmissionRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -5244,13 +5474,14 @@ proto.wsman.UpdateSSHKeyResponse.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.UpdateSSHKeyResponse} msg The msg instance to transform.
+ * @param {!proto.wsman.ControlAdmissionRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.UpdateSSHKeyResponse.toObject = function(includeInstance, msg) {
+proto.wsman.ControlAdmissionRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    level: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -5264,29 +5495,37 @@ proto.wsman.UpdateSSHKeyResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.UpdateSSHKeyResponse}
+ * @return {!proto.wsman.ControlAdmissionRequest}
  */
-proto.wsman.UpdateSSHKeyResponse.deserializeBinary = function(bytes) {
+proto.wsman.ControlAdmissionRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.UpdateSSHKeyResponse;
-  return proto.wsman.UpdateSSHKeyResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.ControlAdmissionRequest;
+  return proto.wsman.ControlAdmissionRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.UpdateSSHKeyResponse} msg The message object to deserialize into.
+ * @param {!proto.wsman.ControlAdmissionRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.UpdateSSHKeyResponse}
+ * @return {!proto.wsman.ControlAdmissionRequest}
  */
-proto.wsman.UpdateSSHKeyResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.ControlAdmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.wsman.AdmissionLevel} */ (reader.readEnum());
+      msg.setLevel(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -5300,9 +5539,9 @@ proto.wsman.UpdateSSHKeyResponse.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.UpdateSSHKeyResponse.prototype.serializeBinary = function() {
+proto.wsman.ControlAdmissionRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.UpdateSSHKeyResponse.serializeBinaryToWriter(this, writer);
+  proto.wsman.ControlAdmissionRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5310,20 +5549,70 @@ proto.wsman.UpdateSSHKeyResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.UpdateSSHKeyResponse} message
+ * @param {!proto.wsman.ControlAdmissionRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.UpdateSSHKeyResponse.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.ControlAdmissionRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-};
-
-
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
-/**
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getLevel();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.ControlAdmissionRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.ControlAdmissionRequest} returns this
+ */
+proto.wsman.ControlAdmissionRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional AdmissionLevel level = 2;
+ * @return {!proto.wsman.AdmissionLevel}
+ */
+proto.wsman.ControlAdmissionRequest.prototype.getLevel = function() {
+  return /** @type {!proto.wsman.AdmissionLevel} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.wsman.AdmissionLevel} value
+ * @return {!proto.wsman.ControlAdmissionRequest} returns this
+ */
+proto.wsman.ControlAdmissionRequest.prototype.setLevel = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
  * Creates an object representation of this proto.
  * Field names that are reserved in JavaScript and will be renamed to pb_name.
  * Optional fields that are not set will be set to undefined.
@@ -5335,8 +5624,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.WorkspaceStatus.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.WorkspaceStatus.toObject(opt_includeInstance, this);
+proto.wsman.ControlAdmissionResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.ControlAdmissionResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -5345,23 +5634,117 @@ proto.wsman.WorkspaceStatus.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.WorkspaceStatus} msg The msg instance to transform.
+ * @param {!proto.wsman.ControlAdmissionResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceStatus.toObject = function(includeInstance, msg) {
+proto.wsman.ControlAdmissionResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.ControlAdmissionResponse}
+ */
+proto.wsman.ControlAdmissionResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.ControlAdmissionResponse;
+  return proto.wsman.ControlAdmissionResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.ControlAdmissionResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.ControlAdmissionResponse}
+ */
+proto.wsman.ControlAdmissionResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.ControlAdmissionResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.ControlAdmissionResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.ControlAdmissionResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.ControlAdmissionResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.DeleteVolumeSnapshotRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.DeleteVolumeSnapshotRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.DeleteVolumeSnapshotRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.DeleteVolumeSnapshotRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    statusVersion: jspb.Message.getFieldWithDefault(msg, 10, 0),
-    metadata: (f = msg.getMetadata()) && proto.wsman.WorkspaceMetadata.toObject(includeInstance, f),
-    spec: (f = msg.getSpec()) && proto.wsman.WorkspaceSpec.toObject(includeInstance, f),
-    phase: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    conditions: (f = msg.getConditions()) && proto.wsman.WorkspaceConditions.toObject(includeInstance, f),
-    message: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    repo: (f = msg.getRepo()) && content$service$api_initializer_pb.GitStatus.toObject(includeInstance, f),
-    runtime: (f = msg.getRuntime()) && proto.wsman.WorkspaceRuntimeInfo.toObject(includeInstance, f),
-    auth: (f = msg.getAuth()) && proto.wsman.WorkspaceAuthentication.toObject(includeInstance, f),
-    initializerMetrics: (f = msg.getInitializerMetrics()) && proto.wsman.InitializerMetrics.toObject(includeInstance, f)
+    volumeHandle: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    softDelete: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    wsType: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -5375,23 +5758,23 @@ proto.wsman.WorkspaceStatus.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.WorkspaceStatus}
+ * @return {!proto.wsman.DeleteVolumeSnapshotRequest}
  */
-proto.wsman.WorkspaceStatus.deserializeBinary = function(bytes) {
+proto.wsman.DeleteVolumeSnapshotRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.WorkspaceStatus;
-  return proto.wsman.WorkspaceStatus.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.DeleteVolumeSnapshotRequest;
+  return proto.wsman.DeleteVolumeSnapshotRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.WorkspaceStatus} msg The message object to deserialize into.
+ * @param {!proto.wsman.DeleteVolumeSnapshotRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.WorkspaceStatus}
+ * @return {!proto.wsman.DeleteVolumeSnapshotRequest}
  */
-proto.wsman.WorkspaceStatus.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.DeleteVolumeSnapshotRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5402,52 +5785,17 @@ proto.wsman.WorkspaceStatus.deserializeBinaryFromReader = function(msg, reader)
       var value = /** @type {string} */ (reader.readString());
       msg.setId(value);
       break;
-    case 10:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setStatusVersion(value);
-      break;
     case 2:
-      var value = new proto.wsman.WorkspaceMetadata;
-      reader.readMessage(value,proto.wsman.WorkspaceMetadata.deserializeBinaryFromReader);
-      msg.setMetadata(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setVolumeHandle(value);
       break;
     case 3:
-      var value = new proto.wsman.WorkspaceSpec;
-      reader.readMessage(value,proto.wsman.WorkspaceSpec.deserializeBinaryFromReader);
-      msg.setSpec(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSoftDelete(value);
       break;
     case 4:
-      var value = /** @type {!proto.wsman.WorkspacePhase} */ (reader.readEnum());
-      msg.setPhase(value);
-      break;
-    case 5:
-      var value = new proto.wsman.WorkspaceConditions;
-      reader.readMessage(value,proto.wsman.WorkspaceConditions.deserializeBinaryFromReader);
-      msg.setConditions(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMessage(value);
-      break;
-    case 7:
-      var value = new content$service$api_initializer_pb.GitStatus;
-      reader.readMessage(value,content$service$api_initializer_pb.GitStatus.deserializeBinaryFromReader);
-      msg.setRepo(value);
-      break;
-    case 8:
-      var value = new proto.wsman.WorkspaceRuntimeInfo;
-      reader.readMessage(value,proto.wsman.WorkspaceRuntimeInfo.deserializeBinaryFromReader);
-      msg.setRuntime(value);
-      break;
-    case 9:
-      var value = new proto.wsman.WorkspaceAuthentication;
-      reader.readMessage(value,proto.wsman.WorkspaceAuthentication.deserializeBinaryFromReader);
-      msg.setAuth(value);
-      break;
-    case 11:
-      var value = new proto.wsman.InitializerMetrics;
-      reader.readMessage(value,proto.wsman.InitializerMetrics.deserializeBinaryFromReader);
-      msg.setInitializerMetrics(value);
+      var value = /** @type {!proto.wsman.WorkspaceType} */ (reader.readEnum());
+      msg.setWsType(value);
       break;
     default:
       reader.skipField();
@@ -5462,9 +5810,9 @@ proto.wsman.WorkspaceStatus.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.WorkspaceStatus.prototype.serializeBinary = function() {
+proto.wsman.DeleteVolumeSnapshotRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.WorkspaceStatus.serializeBinaryToWriter(this, writer);
+  proto.wsman.DeleteVolumeSnapshotRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5472,11 +5820,11 @@ proto.wsman.WorkspaceStatus.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.WorkspaceStatus} message
+ * @param {!proto.wsman.DeleteVolumeSnapshotRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceStatus.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.DeleteVolumeSnapshotRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -5485,83 +5833,27 @@ proto.wsman.WorkspaceStatus.serializeBinaryToWriter = function(message, writer)
       f
     );
   }
-  f = message.getStatusVersion();
-  if (f !== 0) {
-    writer.writeUint64(
-      10,
+  f = message.getVolumeHandle();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
       f
     );
   }
-  f = message.getMetadata();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      proto.wsman.WorkspaceMetadata.serializeBinaryToWriter
-    );
-  }
-  f = message.getSpec();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getSoftDelete();
+  if (f) {
+    writer.writeBool(
       3,
-      f,
-      proto.wsman.WorkspaceSpec.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getPhase();
+  f = message.getWsType();
   if (f !== 0.0) {
     writer.writeEnum(
       4,
       f
     );
   }
-  f = message.getConditions();
-  if (f != null) {
-    writer.writeMessage(
-      5,
-      f,
-      proto.wsman.WorkspaceConditions.serializeBinaryToWriter
-    );
-  }
-  f = message.getMessage();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getRepo();
-  if (f != null) {
-    writer.writeMessage(
-      7,
-      f,
-      content$service$api_initializer_pb.GitStatus.serializeBinaryToWriter
-    );
-  }
-  f = message.getRuntime();
-  if (f != null) {
-    writer.writeMessage(
-      8,
-      f,
-      proto.wsman.WorkspaceRuntimeInfo.serializeBinaryToWriter
-    );
-  }
-  f = message.getAuth();
-  if (f != null) {
-    writer.writeMessage(
-      9,
-      f,
-      proto.wsman.WorkspaceAuthentication.serializeBinaryToWriter
-    );
-  }
-  f = message.getInitializerMetrics();
-  if (f != null) {
-    writer.writeMessage(
-      11,
-      f,
-      proto.wsman.InitializerMetrics.serializeBinaryToWriter
-    );
-  }
 };
 
 
@@ -5569,330 +5861,331 @@ proto.wsman.WorkspaceStatus.serializeBinaryToWriter = function(message, writer)
  * optional string id = 1;
  * @return {string}
  */
-proto.wsman.WorkspaceStatus.prototype.getId = function() {
+proto.wsman.DeleteVolumeSnapshotRequest.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.WorkspaceStatus} returns this
+ * @return {!proto.wsman.DeleteVolumeSnapshotRequest} returns this
  */
-proto.wsman.WorkspaceStatus.prototype.setId = function(value) {
+proto.wsman.DeleteVolumeSnapshotRequest.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional uint64 status_version = 10;
- * @return {number}
+ * optional string volume_handle = 2;
+ * @return {string}
  */
-proto.wsman.WorkspaceStatus.prototype.getStatusVersion = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+proto.wsman.DeleteVolumeSnapshotRequest.prototype.getVolumeHandle = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.wsman.WorkspaceStatus} returns this
+ * @param {string} value
+ * @return {!proto.wsman.DeleteVolumeSnapshotRequest} returns this
  */
-proto.wsman.WorkspaceStatus.prototype.setStatusVersion = function(value) {
-  return jspb.Message.setProto3IntField(this, 10, value);
+proto.wsman.DeleteVolumeSnapshotRequest.prototype.setVolumeHandle = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional WorkspaceMetadata metadata = 2;
- * @return {?proto.wsman.WorkspaceMetadata}
+ * optional bool soft_delete = 3;
+ * @return {boolean}
  */
-proto.wsman.WorkspaceStatus.prototype.getMetadata = function() {
-  return /** @type{?proto.wsman.WorkspaceMetadata} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceMetadata, 2));
+proto.wsman.DeleteVolumeSnapshotRequest.prototype.getSoftDelete = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
 };
 
 
 /**
- * @param {?proto.wsman.WorkspaceMetadata|undefined} value
- * @return {!proto.wsman.WorkspaceStatus} returns this
-*/
-proto.wsman.WorkspaceStatus.prototype.setMetadata = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+ * @param {boolean} value
+ * @return {!proto.wsman.DeleteVolumeSnapshotRequest} returns this
+ */
+proto.wsman.DeleteVolumeSnapshotRequest.prototype.setSoftDelete = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.WorkspaceStatus} returns this
+ * optional WorkspaceType ws_type = 4;
+ * @return {!proto.wsman.WorkspaceType}
  */
-proto.wsman.WorkspaceStatus.prototype.clearMetadata = function() {
-  return this.setMetadata(undefined);
+proto.wsman.DeleteVolumeSnapshotRequest.prototype.getWsType = function() {
+  return /** @type {!proto.wsman.WorkspaceType} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {!proto.wsman.WorkspaceType} value
+ * @return {!proto.wsman.DeleteVolumeSnapshotRequest} returns this
  */
-proto.wsman.WorkspaceStatus.prototype.hasMetadata = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.wsman.DeleteVolumeSnapshotRequest.prototype.setWsType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
 };
 
 
-/**
- * optional WorkspaceSpec spec = 3;
- * @return {?proto.wsman.WorkspaceSpec}
- */
-proto.wsman.WorkspaceStatus.prototype.getSpec = function() {
-  return /** @type{?proto.wsman.WorkspaceSpec} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceSpec, 3));
-};
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {?proto.wsman.WorkspaceSpec|undefined} value
- * @return {!proto.wsman.WorkspaceStatus} returns this
-*/
-proto.wsman.WorkspaceStatus.prototype.setSpec = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.DeleteVolumeSnapshotResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.DeleteVolumeSnapshotResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.WorkspaceStatus} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.DeleteVolumeSnapshotResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceStatus.prototype.clearSpec = function() {
-  return this.setSpec(undefined);
+proto.wsman.DeleteVolumeSnapshotResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    wasDeleted: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.DeleteVolumeSnapshotResponse}
  */
-proto.wsman.WorkspaceStatus.prototype.hasSpec = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.wsman.DeleteVolumeSnapshotResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.DeleteVolumeSnapshotResponse;
+  return proto.wsman.DeleteVolumeSnapshotResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * optional WorkspacePhase phase = 4;
- * @return {!proto.wsman.WorkspacePhase}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.DeleteVolumeSnapshotResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.DeleteVolumeSnapshotResponse}
  */
-proto.wsman.WorkspaceStatus.prototype.getPhase = function() {
-  return /** @type {!proto.wsman.WorkspacePhase} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.wsman.DeleteVolumeSnapshotResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setWasDeleted(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {!proto.wsman.WorkspacePhase} value
- * @return {!proto.wsman.WorkspaceStatus} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.wsman.WorkspaceStatus.prototype.setPhase = function(value) {
-  return jspb.Message.setProto3EnumField(this, 4, value);
+proto.wsman.DeleteVolumeSnapshotResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.DeleteVolumeSnapshotResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * optional WorkspaceConditions conditions = 5;
- * @return {?proto.wsman.WorkspaceConditions}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.DeleteVolumeSnapshotResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceStatus.prototype.getConditions = function() {
-  return /** @type{?proto.wsman.WorkspaceConditions} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceConditions, 5));
+proto.wsman.DeleteVolumeSnapshotResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getWasDeleted();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * @param {?proto.wsman.WorkspaceConditions|undefined} value
- * @return {!proto.wsman.WorkspaceStatus} returns this
-*/
-proto.wsman.WorkspaceStatus.prototype.setConditions = function(value) {
-  return jspb.Message.setWrapperField(this, 5, value);
+ * optional bool was_deleted = 1;
+ * @return {boolean}
+ */
+proto.wsman.DeleteVolumeSnapshotResponse.prototype.getWasDeleted = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.WorkspaceStatus} returns this
+ * @param {boolean} value
+ * @return {!proto.wsman.DeleteVolumeSnapshotResponse} returns this
  */
-proto.wsman.WorkspaceStatus.prototype.clearConditions = function() {
-  return this.setConditions(undefined);
+proto.wsman.DeleteVolumeSnapshotResponse.prototype.setWasDeleted = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
 };
 
 
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.wsman.WorkspaceStatus.prototype.hasConditions = function() {
-  return jspb.Message.getField(this, 5) != null;
-};
-
-
-/**
- * optional string message = 6;
- * @return {string}
- */
-proto.wsman.WorkspaceStatus.prototype.getMessage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.wsman.WorkspaceStatus} returns this
- */
-proto.wsman.WorkspaceStatus.prototype.setMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
-};
-
-
-/**
- * optional contentservice.GitStatus repo = 7;
- * @return {?proto.contentservice.GitStatus}
- */
-proto.wsman.WorkspaceStatus.prototype.getRepo = function() {
-  return /** @type{?proto.contentservice.GitStatus} */ (
-    jspb.Message.getWrapperField(this, content$service$api_initializer_pb.GitStatus, 7));
-};
-
-
-/**
- * @param {?proto.contentservice.GitStatus|undefined} value
- * @return {!proto.wsman.WorkspaceStatus} returns this
-*/
-proto.wsman.WorkspaceStatus.prototype.setRepo = function(value) {
-  return jspb.Message.setWrapperField(this, 7, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.WorkspaceStatus} returns this
- */
-proto.wsman.WorkspaceStatus.prototype.clearRepo = function() {
-  return this.setRepo(undefined);
-};
-
 
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.wsman.WorkspaceStatus.prototype.hasRepo = function() {
-  return jspb.Message.getField(this, 7) != null;
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional WorkspaceRuntimeInfo runtime = 8;
- * @return {?proto.wsman.WorkspaceRuntimeInfo}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.wsman.WorkspaceStatus.prototype.getRuntime = function() {
-  return /** @type{?proto.wsman.WorkspaceRuntimeInfo} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceRuntimeInfo, 8));
-};
-
-
-/**
- * @param {?proto.wsman.WorkspaceRuntimeInfo|undefined} value
- * @return {!proto.wsman.WorkspaceStatus} returns this
-*/
-proto.wsman.WorkspaceStatus.prototype.setRuntime = function(value) {
-  return jspb.Message.setWrapperField(this, 8, value);
+proto.wsman.BackupWorkspaceRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.BackupWorkspaceRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.WorkspaceStatus} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.BackupWorkspaceRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceStatus.prototype.clearRuntime = function() {
-  return this.setRuntime(undefined);
-};
-
+proto.wsman.BackupWorkspaceRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
 
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.wsman.WorkspaceStatus.prototype.hasRuntime = function() {
-  return jspb.Message.getField(this, 8) != null;
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional WorkspaceAuthentication auth = 9;
- * @return {?proto.wsman.WorkspaceAuthentication}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.BackupWorkspaceRequest}
  */
-proto.wsman.WorkspaceStatus.prototype.getAuth = function() {
-  return /** @type{?proto.wsman.WorkspaceAuthentication} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceAuthentication, 9));
-};
-
-
-/**
- * @param {?proto.wsman.WorkspaceAuthentication|undefined} value
- * @return {!proto.wsman.WorkspaceStatus} returns this
-*/
-proto.wsman.WorkspaceStatus.prototype.setAuth = function(value) {
-  return jspb.Message.setWrapperField(this, 9, value);
+proto.wsman.BackupWorkspaceRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.BackupWorkspaceRequest;
+  return proto.wsman.BackupWorkspaceRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.WorkspaceStatus} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.BackupWorkspaceRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.BackupWorkspaceRequest}
  */
-proto.wsman.WorkspaceStatus.prototype.clearAuth = function() {
-  return this.setAuth(undefined);
+proto.wsman.BackupWorkspaceRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.wsman.WorkspaceStatus.prototype.hasAuth = function() {
-  return jspb.Message.getField(this, 9) != null;
+proto.wsman.BackupWorkspaceRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.BackupWorkspaceRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * optional InitializerMetrics initializer_metrics = 11;
- * @return {?proto.wsman.InitializerMetrics}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.BackupWorkspaceRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceStatus.prototype.getInitializerMetrics = function() {
-  return /** @type{?proto.wsman.InitializerMetrics} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.InitializerMetrics, 11));
-};
-
-
-/**
- * @param {?proto.wsman.InitializerMetrics|undefined} value
- * @return {!proto.wsman.WorkspaceStatus} returns this
-*/
-proto.wsman.WorkspaceStatus.prototype.setInitializerMetrics = function(value) {
-  return jspb.Message.setWrapperField(this, 11, value);
+proto.wsman.BackupWorkspaceRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.WorkspaceStatus} returns this
+ * optional string id = 1;
+ * @return {string}
  */
-proto.wsman.WorkspaceStatus.prototype.clearInitializerMetrics = function() {
-  return this.setInitializerMetrics(undefined);
+proto.wsman.BackupWorkspaceRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.wsman.BackupWorkspaceRequest} returns this
  */
-proto.wsman.WorkspaceStatus.prototype.hasInitializerMetrics = function() {
-  return jspb.Message.getField(this, 11) != null;
+proto.wsman.BackupWorkspaceRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -5912,8 +6205,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.IDEImage.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.IDEImage.toObject(opt_includeInstance, this);
+proto.wsman.BackupWorkspaceResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.BackupWorkspaceResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -5922,14 +6215,13 @@ proto.wsman.IDEImage.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.IDEImage} msg The msg instance to transform.
+ * @param {!proto.wsman.BackupWorkspaceResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.IDEImage.toObject = function(includeInstance, msg) {
+proto.wsman.BackupWorkspaceResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    webRef: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    supervisorRef: jspb.Message.getFieldWithDefault(msg, 3, "")
+    url: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -5943,23 +6235,23 @@ proto.wsman.IDEImage.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.IDEImage}
+ * @return {!proto.wsman.BackupWorkspaceResponse}
  */
-proto.wsman.IDEImage.deserializeBinary = function(bytes) {
+proto.wsman.BackupWorkspaceResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.IDEImage;
-  return proto.wsman.IDEImage.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.BackupWorkspaceResponse;
+  return proto.wsman.BackupWorkspaceResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.IDEImage} msg The message object to deserialize into.
+ * @param {!proto.wsman.BackupWorkspaceResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.IDEImage}
+ * @return {!proto.wsman.BackupWorkspaceResponse}
  */
-proto.wsman.IDEImage.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.BackupWorkspaceResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5968,11 +6260,7 @@ proto.wsman.IDEImage.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setWebRef(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSupervisorRef(value);
+      msg.setUrl(value);
       break;
     default:
       reader.skipField();
@@ -5987,9 +6275,9 @@ proto.wsman.IDEImage.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.IDEImage.prototype.serializeBinary = function() {
+proto.wsman.BackupWorkspaceResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.IDEImage.serializeBinaryToWriter(this, writer);
+  proto.wsman.BackupWorkspaceResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5997,72 +6285,47 @@ proto.wsman.IDEImage.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.IDEImage} message
+ * @param {!proto.wsman.BackupWorkspaceResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.IDEImage.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.BackupWorkspaceResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getWebRef();
+  f = message.getUrl();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getSupervisorRef();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string web_ref = 1;
+ * optional string url = 1;
  * @return {string}
  */
-proto.wsman.IDEImage.prototype.getWebRef = function() {
+proto.wsman.BackupWorkspaceResponse.prototype.getUrl = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.IDEImage} returns this
+ * @return {!proto.wsman.BackupWorkspaceResponse} returns this
  */
-proto.wsman.IDEImage.prototype.setWebRef = function(value) {
+proto.wsman.BackupWorkspaceResponse.prototype.setUrl = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * optional string supervisor_ref = 3;
- * @return {string}
- */
-proto.wsman.IDEImage.prototype.getSupervisorRef = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.wsman.IDEImage} returns this
- */
-proto.wsman.IDEImage.prototype.setSupervisorRef = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
-};
-
-
 
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.wsman.WorkspaceSpec.repeatedFields_ = [5,10];
+proto.wsman.UpdateSSHKeyRequest.repeatedFields_ = [2];
 
 
 
@@ -6079,8 +6342,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.WorkspaceSpec.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.WorkspaceSpec.toObject(opt_includeInstance, this);
+proto.wsman.UpdateSSHKeyRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.UpdateSSHKeyRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -6089,23 +6352,14 @@ proto.wsman.WorkspaceSpec.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.WorkspaceSpec} msg The msg instance to transform.
+ * @param {!proto.wsman.UpdateSSHKeyRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceSpec.toObject = function(includeInstance, msg) {
+proto.wsman.UpdateSSHKeyRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    workspaceImage: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    headless: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-    url: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    exposedPortsList: jspb.Message.toObjectList(msg.getExposedPortsList(),
-    proto.wsman.PortSpec.toObject, includeInstance),
-    type: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    timeout: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    ideImage: (f = msg.getIdeImage()) && proto.wsman.IDEImage.toObject(includeInstance, f),
-    pb_class: jspb.Message.getFieldWithDefault(msg, 9, ""),
-    ideImageLayersList: (f = jspb.Message.getRepeatedField(msg, 10)) == null ? undefined : f,
-    closedTimeout: jspb.Message.getFieldWithDefault(msg, 11, "")
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    keysList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -6119,23 +6373,23 @@ proto.wsman.WorkspaceSpec.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.WorkspaceSpec}
+ * @return {!proto.wsman.UpdateSSHKeyRequest}
  */
-proto.wsman.WorkspaceSpec.deserializeBinary = function(bytes) {
+proto.wsman.UpdateSSHKeyRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.WorkspaceSpec;
-  return proto.wsman.WorkspaceSpec.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.UpdateSSHKeyRequest;
+  return proto.wsman.UpdateSSHKeyRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.WorkspaceSpec} msg The message object to deserialize into.
+ * @param {!proto.wsman.UpdateSSHKeyRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.WorkspaceSpec}
+ * @return {!proto.wsman.UpdateSSHKeyRequest}
  */
-proto.wsman.WorkspaceSpec.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.UpdateSSHKeyRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6144,45 +6398,11 @@ proto.wsman.WorkspaceSpec.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setWorkspaceImage(value);
-      break;
-    case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setHeadless(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUrl(value);
-      break;
-    case 5:
-      var value = new proto.wsman.PortSpec;
-      reader.readMessage(value,proto.wsman.PortSpec.deserializeBinaryFromReader);
-      msg.addExposedPorts(value);
-      break;
-    case 6:
-      var value = /** @type {!proto.wsman.WorkspaceType} */ (reader.readEnum());
-      msg.setType(value);
-      break;
-    case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setTimeout(value);
-      break;
-    case 8:
-      var value = new proto.wsman.IDEImage;
-      reader.readMessage(value,proto.wsman.IDEImage.deserializeBinaryFromReader);
-      msg.setIdeImage(value);
-      break;
-    case 9:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setClass(value);
-      break;
-    case 10:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addIdeImageLayers(value);
+      msg.setId(value);
       break;
-    case 11:
+    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setClosedTimeout(value);
+      msg.addKeys(value);
       break;
     default:
       reader.skipField();
@@ -6197,9 +6417,9 @@ proto.wsman.WorkspaceSpec.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.WorkspaceSpec.prototype.serializeBinary = function() {
+proto.wsman.UpdateSSHKeyRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.WorkspaceSpec.serializeBinaryToWriter(this, writer);
+  proto.wsman.UpdateSSHKeyRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6207,81 +6427,23 @@ proto.wsman.WorkspaceSpec.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.WorkspaceSpec} message
+ * @param {!proto.wsman.UpdateSSHKeyRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceSpec.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.UpdateSSHKeyRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getWorkspaceImage();
+  f = message.getId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getHeadless();
-  if (f) {
-    writer.writeBool(
-      3,
-      f
-    );
-  }
-  f = message.getUrl();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getExposedPortsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      5,
-      f,
-      proto.wsman.PortSpec.serializeBinaryToWriter
-    );
-  }
-  f = message.getType();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      6,
-      f
-    );
-  }
-  f = message.getTimeout();
-  if (f.length > 0) {
-    writer.writeString(
-      7,
-      f
-    );
-  }
-  f = message.getIdeImage();
-  if (f != null) {
-    writer.writeMessage(
-      8,
-      f,
-      proto.wsman.IDEImage.serializeBinaryToWriter
-    );
-  }
-  f = message.getClass();
-  if (f.length > 0) {
-    writer.writeString(
-      9,
-      f
-    );
-  }
-  f = message.getIdeImageLayersList();
+  f = message.getKeysList();
   if (f.length > 0) {
     writer.writeRepeatedString(
-      10,
-      f
-    );
-  }
-  f = message.getClosedTimeout();
-  if (f.length > 0) {
-    writer.writeString(
-      11,
+      2,
       f
     );
   }
@@ -6289,243 +6451,168 @@ proto.wsman.WorkspaceSpec.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional string workspace_image = 1;
+ * optional string id = 1;
  * @return {string}
  */
-proto.wsman.WorkspaceSpec.prototype.getWorkspaceImage = function() {
+proto.wsman.UpdateSSHKeyRequest.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.WorkspaceSpec} returns this
+ * @return {!proto.wsman.UpdateSSHKeyRequest} returns this
  */
-proto.wsman.WorkspaceSpec.prototype.setWorkspaceImage = function(value) {
+proto.wsman.UpdateSSHKeyRequest.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional bool headless = 3;
- * @return {boolean}
+ * repeated string keys = 2;
+ * @return {!Array<string>}
  */
-proto.wsman.WorkspaceSpec.prototype.getHeadless = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.wsman.UpdateSSHKeyRequest.prototype.getKeysList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.wsman.WorkspaceSpec} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.wsman.UpdateSSHKeyRequest} returns this
  */
-proto.wsman.WorkspaceSpec.prototype.setHeadless = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
+proto.wsman.UpdateSSHKeyRequest.prototype.setKeysList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
- * optional string url = 4;
- * @return {string}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.UpdateSSHKeyRequest} returns this
  */
-proto.wsman.WorkspaceSpec.prototype.getUrl = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.wsman.UpdateSSHKeyRequest.prototype.addKeys = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.WorkspaceSpec} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.UpdateSSHKeyRequest} returns this
  */
-proto.wsman.WorkspaceSpec.prototype.setUrl = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.wsman.UpdateSSHKeyRequest.prototype.clearKeysList = function() {
+  return this.setKeysList([]);
 };
 
 
-/**
- * repeated PortSpec exposed_ports = 5;
- * @return {!Array<!proto.wsman.PortSpec>}
- */
-proto.wsman.WorkspaceSpec.prototype.getExposedPortsList = function() {
-  return /** @type{!Array<!proto.wsman.PortSpec>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.wsman.PortSpec, 5));
-};
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {!Array<!proto.wsman.PortSpec>} value
- * @return {!proto.wsman.WorkspaceSpec} returns this
-*/
-proto.wsman.WorkspaceSpec.prototype.setExposedPortsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 5, value);
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.UpdateSSHKeyResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.UpdateSSHKeyResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {!proto.wsman.PortSpec=} opt_value
- * @param {number=} opt_index
- * @return {!proto.wsman.PortSpec}
- */
-proto.wsman.WorkspaceSpec.prototype.addExposedPorts = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.wsman.PortSpec, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.wsman.WorkspaceSpec} returns this
- */
-proto.wsman.WorkspaceSpec.prototype.clearExposedPortsList = function() {
-  return this.setExposedPortsList([]);
-};
-
-
-/**
- * optional WorkspaceType type = 6;
- * @return {!proto.wsman.WorkspaceType}
- */
-proto.wsman.WorkspaceSpec.prototype.getType = function() {
-  return /** @type {!proto.wsman.WorkspaceType} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
-};
-
-
-/**
- * @param {!proto.wsman.WorkspaceType} value
- * @return {!proto.wsman.WorkspaceSpec} returns this
- */
-proto.wsman.WorkspaceSpec.prototype.setType = function(value) {
-  return jspb.Message.setProto3EnumField(this, 6, value);
-};
-
-
-/**
- * optional string timeout = 7;
- * @return {string}
- */
-proto.wsman.WorkspaceSpec.prototype.getTimeout = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.wsman.WorkspaceSpec} returns this
- */
-proto.wsman.WorkspaceSpec.prototype.setTimeout = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
-};
-
-
-/**
- * optional IDEImage ide_image = 8;
- * @return {?proto.wsman.IDEImage}
- */
-proto.wsman.WorkspaceSpec.prototype.getIdeImage = function() {
-  return /** @type{?proto.wsman.IDEImage} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.IDEImage, 8));
-};
-
-
-/**
- * @param {?proto.wsman.IDEImage|undefined} value
- * @return {!proto.wsman.WorkspaceSpec} returns this
-*/
-proto.wsman.WorkspaceSpec.prototype.setIdeImage = function(value) {
-  return jspb.Message.setWrapperField(this, 8, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.WorkspaceSpec} returns this
- */
-proto.wsman.WorkspaceSpec.prototype.clearIdeImage = function() {
-  return this.setIdeImage(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.wsman.WorkspaceSpec.prototype.hasIdeImage = function() {
-  return jspb.Message.getField(this, 8) != null;
-};
-
-
-/**
- * optional string class = 9;
- * @return {string}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.UpdateSSHKeyResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceSpec.prototype.getClass = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
-};
+proto.wsman.UpdateSSHKeyResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
 
+  };
 
-/**
- * @param {string} value
- * @return {!proto.wsman.WorkspaceSpec} returns this
- */
-proto.wsman.WorkspaceSpec.prototype.setClass = function(value) {
-  return jspb.Message.setProto3StringField(this, 9, value);
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * repeated string ide_image_layers = 10;
- * @return {!Array<string>}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.UpdateSSHKeyResponse}
  */
-proto.wsman.WorkspaceSpec.prototype.getIdeImageLayersList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 10));
+proto.wsman.UpdateSSHKeyResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.UpdateSSHKeyResponse;
+  return proto.wsman.UpdateSSHKeyResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.wsman.WorkspaceSpec} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.UpdateSSHKeyResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.UpdateSSHKeyResponse}
  */
-proto.wsman.WorkspaceSpec.prototype.setIdeImageLayersList = function(value) {
-  return jspb.Message.setField(this, 10, value || []);
+proto.wsman.UpdateSSHKeyResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.wsman.WorkspaceSpec} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.wsman.WorkspaceSpec.prototype.addIdeImageLayers = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 10, value, opt_index);
+proto.wsman.UpdateSSHKeyResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.UpdateSSHKeyResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.wsman.WorkspaceSpec} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.UpdateSSHKeyResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceSpec.prototype.clearIdeImageLayersList = function() {
-  return this.setIdeImageLayersList([]);
+proto.wsman.UpdateSSHKeyResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
 };
 
 
-/**
- * optional string closed_timeout = 11;
- * @return {string}
- */
-proto.wsman.WorkspaceSpec.prototype.getClosedTimeout = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
-};
-
 
 /**
- * @param {string} value
- * @return {!proto.wsman.WorkspaceSpec} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.wsman.WorkspaceSpec.prototype.setClosedTimeout = function(value) {
-  return jspb.Message.setProto3StringField(this, 11, value);
-};
-
-
+proto.wsman.WorkspaceStatus.repeatedFields_ = [13];
 
 
 
@@ -6542,8 +6629,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.PortSpec.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.PortSpec.toObject(opt_includeInstance, this);
+proto.wsman.WorkspaceStatus.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceStatus.toObject(opt_includeInstance, this);
 };
 
 
@@ -6552,16 +6639,26 @@ proto.wsman.PortSpec.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.PortSpec} msg The msg instance to transform.
+ * @param {!proto.wsman.WorkspaceStatus} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.PortSpec.toObject = function(includeInstance, msg) {
+proto.wsman.WorkspaceStatus.toObject = function(includeInstance, msg) {
   var f, obj = {
-    port: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    visibility: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    url: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    protocol: jspb.Message.getFieldWithDefault(msg, 5, 0)
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    statusVersion: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    metadata: (f = msg.getMetadata()) && proto.wsman.WorkspaceMetadata.toObject(includeInstance, f),
+    spec: (f = msg.getSpec()) && proto.wsman.WorkspaceSpec.toObject(includeInstance, f),
+    phase: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    conditions: (f = msg.getConditions()) && proto.wsman.WorkspaceConditions.toObject(includeInstance, f),
+    message: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    repo: (f = msg.getRepo()) && content$service$api_initializer_pb.GitStatus.toObject(includeInstance, f),
+    runtime: (f = msg.getRuntime()) && proto.wsman.WorkspaceRuntimeInfo.toObject(includeInstance, f),
+    auth: (f = msg.getAuth()) && proto.wsman.WorkspaceAuthentication.toObject(includeInstance, f),
+    initializerMetrics: (f = msg.getInitializerMetrics()) && proto.wsman.InitializerMetrics.toObject(includeInstance, f),
+    storage: (f = msg.getStorage()) && proto.wsman.WorkspaceStorage.toObject(includeInstance, f),
+    timelineList: jspb.Message.toObjectList(msg.getTimelineList(),
+    proto.wsman.WorkspaceTimelineEvent.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -6575,23 +6672,23 @@ proto.wsman.PortSpec.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.PortSpec}
+ * @return {!proto.wsman.WorkspaceStatus}
  */
-proto.wsman.PortSpec.deserializeBinary = function(bytes) {
+proto.wsman.WorkspaceStatus.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.PortSpec;
-  return proto.wsman.PortSpec.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.WorkspaceStatus;
+  return proto.wsman.WorkspaceStatus.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.PortSpec} msg The message object to deserialize into.
+ * @param {!proto.wsman.WorkspaceStatus} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.PortSpec}
+ * @return {!proto.wsman.WorkspaceStatus}
  */
-proto.wsman.PortSpec.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.WorkspaceStatus.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6599,25 +6696,70 @@ proto.wsman.PortSpec.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setPort(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setStatusVersion(value);
+      break;
+    case 2:
+      var value = new proto.wsman.WorkspaceMetadata;
+      reader.readMessage(value,proto.wsman.WorkspaceMetadata.deserializeBinaryFromReader);
+      msg.setMetadata(value);
       break;
     case 3:
-      var value = /** @type {!proto.wsman.PortVisibility} */ (reader.readEnum());
-      msg.setVisibility(value);
+      var value = new proto.wsman.WorkspaceSpec;
+      reader.readMessage(value,proto.wsman.WorkspaceSpec.deserializeBinaryFromReader);
+      msg.setSpec(value);
       break;
     case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUrl(value);
+      var value = /** @type {!proto.wsman.WorkspacePhase} */ (reader.readEnum());
+      msg.setPhase(value);
       break;
     case 5:
-      var value = /** @type {!proto.wsman.PortProtocol} */ (reader.readEnum());
-      msg.setProtocol(value);
+      var value = new proto.wsman.WorkspaceConditions;
+      reader.readMessage(value,proto.wsman.WorkspaceConditions.deserializeBinaryFromReader);
+      msg.setConditions(value);
       break;
-    default:
-      reader.skipField();
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMessage(value);
       break;
-    }
+    case 7:
+      var value = new content$service$api_initializer_pb.GitStatus;
+      reader.readMessage(value,content$service$api_initializer_pb.GitStatus.deserializeBinaryFromReader);
+      msg.setRepo(value);
+      break;
+    case 8:
+      var value = new proto.wsman.WorkspaceRuntimeInfo;
+      reader.readMessage(value,proto.wsman.WorkspaceRuntimeInfo.deserializeBinaryFromReader);
+      msg.setRuntime(value);
+      break;
+    case 9:
+      var value = new proto.wsman.WorkspaceAuthentication;
+      reader.readMessage(value,proto.wsman.WorkspaceAuthentication.deserializeBinaryFromReader);
+      msg.setAuth(value);
+      break;
+    case 11:
+      var value = new proto.wsman.InitializerMetrics;
+      reader.readMessage(value,proto.wsman.InitializerMetrics.deserializeBinaryFromReader);
+      msg.setInitializerMetrics(value);
+      break;
+    case 12:
+      var value = new proto.wsman.WorkspaceStorage;
+      reader.readMessage(value,proto.wsman.WorkspaceStorage.deserializeBinaryFromReader);
+      msg.setStorage(value);
+      break;
+    case 13:
+      var value = new proto.wsman.WorkspaceTimelineEvent;
+      reader.readMessage(value,proto.wsman.WorkspaceTimelineEvent.deserializeBinaryFromReader);
+      msg.addTimeline(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
   }
   return msg;
 };
@@ -6627,9 +6769,9 @@ proto.wsman.PortSpec.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.PortSpec.prototype.serializeBinary = function() {
+proto.wsman.WorkspaceStatus.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.PortSpec.serializeBinaryToWriter(this, writer);
+  proto.wsman.WorkspaceStatus.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6637,777 +6779,709 @@ proto.wsman.PortSpec.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.PortSpec} message
+ * @param {!proto.wsman.WorkspaceStatus} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.PortSpec.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.WorkspaceStatus.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPort();
-  if (f !== 0) {
-    writer.writeUint32(
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getVisibility();
+  f = message.getStatusVersion();
+  if (f !== 0) {
+    writer.writeUint64(
+      10,
+      f
+    );
+  }
+  f = message.getMetadata();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.wsman.WorkspaceMetadata.serializeBinaryToWriter
+    );
+  }
+  f = message.getSpec();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.wsman.WorkspaceSpec.serializeBinaryToWriter
+    );
+  }
+  f = message.getPhase();
   if (f !== 0.0) {
     writer.writeEnum(
-      3,
+      4,
       f
     );
   }
-  f = message.getUrl();
+  f = message.getConditions();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.wsman.WorkspaceConditions.serializeBinaryToWriter
+    );
+  }
+  f = message.getMessage();
   if (f.length > 0) {
     writer.writeString(
-      4,
+      6,
       f
     );
   }
-  f = message.getProtocol();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      5,
-      f
+  f = message.getRepo();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      content$service$api_initializer_pb.GitStatus.serializeBinaryToWriter
+    );
+  }
+  f = message.getRuntime();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      proto.wsman.WorkspaceRuntimeInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getAuth();
+  if (f != null) {
+    writer.writeMessage(
+      9,
+      f,
+      proto.wsman.WorkspaceAuthentication.serializeBinaryToWriter
+    );
+  }
+  f = message.getInitializerMetrics();
+  if (f != null) {
+    writer.writeMessage(
+      11,
+      f,
+      proto.wsman.InitializerMetrics.serializeBinaryToWriter
+    );
+  }
+  f = message.getStorage();
+  if (f != null) {
+    writer.writeMessage(
+      12,
+      f,
+      proto.wsman.WorkspaceStorage.serializeBinaryToWriter
+    );
+  }
+  f = message.getTimelineList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      13,
+      f,
+      proto.wsman.WorkspaceTimelineEvent.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional uint32 port = 1;
- * @return {number}
+ * optional string id = 1;
+ * @return {string}
  */
-proto.wsman.PortSpec.prototype.getPort = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.wsman.WorkspaceStatus.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.wsman.PortSpec} returns this
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceStatus} returns this
  */
-proto.wsman.PortSpec.prototype.setPort = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.wsman.WorkspaceStatus.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional PortVisibility visibility = 3;
- * @return {!proto.wsman.PortVisibility}
+ * optional uint64 status_version = 10;
+ * @return {number}
  */
-proto.wsman.PortSpec.prototype.getVisibility = function() {
-  return /** @type {!proto.wsman.PortVisibility} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.wsman.WorkspaceStatus.prototype.getStatusVersion = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
 };
 
 
 /**
- * @param {!proto.wsman.PortVisibility} value
- * @return {!proto.wsman.PortSpec} returns this
+ * @param {number} value
+ * @return {!proto.wsman.WorkspaceStatus} returns this
  */
-proto.wsman.PortSpec.prototype.setVisibility = function(value) {
-  return jspb.Message.setProto3EnumField(this, 3, value);
+proto.wsman.WorkspaceStatus.prototype.setStatusVersion = function(value) {
+  return jspb.Message.setProto3IntField(this, 10, value);
 };
 
 
 /**
- * optional string url = 4;
- * @return {string}
+ * optional WorkspaceMetadata metadata = 2;
+ * @return {?proto.wsman.WorkspaceMetadata}
  */
-proto.wsman.PortSpec.prototype.getUrl = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.wsman.WorkspaceStatus.prototype.getMetadata = function() {
+  return /** @type{?proto.wsman.WorkspaceMetadata} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceMetadata, 2));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.PortSpec} returns this
- */
-proto.wsman.PortSpec.prototype.setUrl = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+ * @param {?proto.wsman.WorkspaceMetadata|undefined} value
+ * @return {!proto.wsman.WorkspaceStatus} returns this
+*/
+proto.wsman.WorkspaceStatus.prototype.setMetadata = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
- * optional PortProtocol protocol = 5;
- * @return {!proto.wsman.PortProtocol}
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceStatus} returns this
  */
-proto.wsman.PortSpec.prototype.getProtocol = function() {
-  return /** @type {!proto.wsman.PortProtocol} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.wsman.WorkspaceStatus.prototype.clearMetadata = function() {
+  return this.setMetadata(undefined);
 };
 
 
 /**
- * @param {!proto.wsman.PortProtocol} value
- * @return {!proto.wsman.PortSpec} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.wsman.PortSpec.prototype.setProtocol = function(value) {
-  return jspb.Message.setProto3EnumField(this, 5, value);
+proto.wsman.WorkspaceStatus.prototype.hasMetadata = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
+/**
+ * optional WorkspaceSpec spec = 3;
+ * @return {?proto.wsman.WorkspaceSpec}
+ */
+proto.wsman.WorkspaceStatus.prototype.getSpec = function() {
+  return /** @type{?proto.wsman.WorkspaceSpec} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceSpec, 3));
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
- */
-proto.wsman.VolumeSnapshotInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.VolumeSnapshotInfo.toObject(opt_includeInstance, this);
+ * @param {?proto.wsman.WorkspaceSpec|undefined} value
+ * @return {!proto.wsman.WorkspaceStatus} returns this
+*/
+proto.wsman.WorkspaceStatus.prototype.setSpec = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.wsman.VolumeSnapshotInfo} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceStatus} returns this
  */
-proto.wsman.VolumeSnapshotInfo.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    volumeSnapshotName: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    volumeSnapshotHandle: jspb.Message.getFieldWithDefault(msg, 2, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.wsman.WorkspaceStatus.prototype.clearSpec = function() {
+  return this.setSpec(undefined);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.VolumeSnapshotInfo}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.wsman.VolumeSnapshotInfo.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.VolumeSnapshotInfo;
-  return proto.wsman.VolumeSnapshotInfo.deserializeBinaryFromReader(msg, reader);
+proto.wsman.WorkspaceStatus.prototype.hasSpec = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.wsman.VolumeSnapshotInfo} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.VolumeSnapshotInfo}
+ * optional WorkspacePhase phase = 4;
+ * @return {!proto.wsman.WorkspacePhase}
  */
-proto.wsman.VolumeSnapshotInfo.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setVolumeSnapshotName(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setVolumeSnapshotHandle(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.wsman.WorkspaceStatus.prototype.getPhase = function() {
+  return /** @type {!proto.wsman.WorkspacePhase} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {!proto.wsman.WorkspacePhase} value
+ * @return {!proto.wsman.WorkspaceStatus} returns this
  */
-proto.wsman.VolumeSnapshotInfo.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.wsman.VolumeSnapshotInfo.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.wsman.WorkspaceStatus.prototype.setPhase = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.VolumeSnapshotInfo} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional WorkspaceConditions conditions = 5;
+ * @return {?proto.wsman.WorkspaceConditions}
  */
-proto.wsman.VolumeSnapshotInfo.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getVolumeSnapshotName();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getVolumeSnapshotHandle();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
+proto.wsman.WorkspaceStatus.prototype.getConditions = function() {
+  return /** @type{?proto.wsman.WorkspaceConditions} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceConditions, 5));
 };
 
 
 /**
- * optional string volume_snapshot_name = 1;
- * @return {string}
+ * @param {?proto.wsman.WorkspaceConditions|undefined} value
+ * @return {!proto.wsman.WorkspaceStatus} returns this
+*/
+proto.wsman.WorkspaceStatus.prototype.setConditions = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceStatus} returns this
  */
-proto.wsman.VolumeSnapshotInfo.prototype.getVolumeSnapshotName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.wsman.WorkspaceStatus.prototype.clearConditions = function() {
+  return this.setConditions(undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.VolumeSnapshotInfo} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.wsman.VolumeSnapshotInfo.prototype.setVolumeSnapshotName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.wsman.WorkspaceStatus.prototype.hasConditions = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
 /**
- * optional string volume_snapshot_handle = 2;
+ * optional string message = 6;
  * @return {string}
  */
-proto.wsman.VolumeSnapshotInfo.prototype.getVolumeSnapshotHandle = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.wsman.WorkspaceStatus.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.VolumeSnapshotInfo} returns this
+ * @return {!proto.wsman.WorkspaceStatus} returns this
  */
-proto.wsman.VolumeSnapshotInfo.prototype.setVolumeSnapshotHandle = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.wsman.WorkspaceStatus.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional contentservice.GitStatus repo = 7;
+ * @return {?proto.contentservice.GitStatus}
  */
-proto.wsman.WorkspaceConditions.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.WorkspaceConditions.toObject(opt_includeInstance, this);
+proto.wsman.WorkspaceStatus.prototype.getRepo = function() {
+  return /** @type{?proto.contentservice.GitStatus} */ (
+    jspb.Message.getWrapperField(this, content$service$api_initializer_pb.GitStatus, 7));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.wsman.WorkspaceConditions} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.wsman.WorkspaceConditions.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    failed: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    timeout: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    pullingImages: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    snapshot: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    finalBackupComplete: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    deployed: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    networkNotReady: jspb.Message.getFieldWithDefault(msg, 8, 0),
-    firstUserActivity: (f = msg.getFirstUserActivity()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
-    headlessTaskFailed: jspb.Message.getFieldWithDefault(msg, 10, ""),
-    stoppedByRequest: jspb.Message.getFieldWithDefault(msg, 11, 0),
-    volumeSnapshot: (f = msg.getVolumeSnapshot()) && proto.wsman.VolumeSnapshotInfo.toObject(includeInstance, f),
-    aborted: jspb.Message.getFieldWithDefault(msg, 13, 0)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+ * @param {?proto.contentservice.GitStatus|undefined} value
+ * @return {!proto.wsman.WorkspaceStatus} returns this
+*/
+proto.wsman.WorkspaceStatus.prototype.setRepo = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.WorkspaceConditions}
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceStatus} returns this
  */
-proto.wsman.WorkspaceConditions.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.WorkspaceConditions;
-  return proto.wsman.WorkspaceConditions.deserializeBinaryFromReader(msg, reader);
+proto.wsman.WorkspaceStatus.prototype.clearRepo = function() {
+  return this.setRepo(undefined);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.wsman.WorkspaceConditions} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.WorkspaceConditions}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.wsman.WorkspaceConditions.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setFailed(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setTimeout(value);
-      break;
-    case 3:
-      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
-      msg.setPullingImages(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSnapshot(value);
-      break;
-    case 6:
-      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
-      msg.setFinalBackupComplete(value);
-      break;
-    case 7:
-      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
-      msg.setDeployed(value);
-      break;
-    case 8:
-      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
-      msg.setNetworkNotReady(value);
-      break;
-    case 9:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setFirstUserActivity(value);
-      break;
-    case 10:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setHeadlessTaskFailed(value);
-      break;
-    case 11:
-      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
-      msg.setStoppedByRequest(value);
-      break;
-    case 12:
-      var value = new proto.wsman.VolumeSnapshotInfo;
-      reader.readMessage(value,proto.wsman.VolumeSnapshotInfo.deserializeBinaryFromReader);
-      msg.setVolumeSnapshot(value);
-      break;
-    case 13:
-      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
-      msg.setAborted(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.wsman.WorkspaceStatus.prototype.hasRepo = function() {
+  return jspb.Message.getField(this, 7) != null;
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional WorkspaceRuntimeInfo runtime = 8;
+ * @return {?proto.wsman.WorkspaceRuntimeInfo}
  */
-proto.wsman.WorkspaceConditions.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.wsman.WorkspaceConditions.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.wsman.WorkspaceStatus.prototype.getRuntime = function() {
+  return /** @type{?proto.wsman.WorkspaceRuntimeInfo} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceRuntimeInfo, 8));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.WorkspaceConditions} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.wsman.WorkspaceConditions.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getFailed();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getTimeout();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getPullingImages();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      3,
-      f
-    );
-  }
-  f = message.getSnapshot();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-  f = message.getFinalBackupComplete();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      6,
-      f
-    );
-  }
-  f = message.getDeployed();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      7,
-      f
-    );
-  }
-  f = message.getNetworkNotReady();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      8,
-      f
-    );
-  }
-  f = message.getFirstUserActivity();
-  if (f != null) {
-    writer.writeMessage(
-      9,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
-    );
-  }
-  f = message.getHeadlessTaskFailed();
-  if (f.length > 0) {
-    writer.writeString(
-      10,
-      f
-    );
-  }
-  f = message.getStoppedByRequest();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      11,
-      f
-    );
-  }
-  f = message.getVolumeSnapshot();
-  if (f != null) {
-    writer.writeMessage(
-      12,
-      f,
-      proto.wsman.VolumeSnapshotInfo.serializeBinaryToWriter
-    );
-  }
-  f = message.getAborted();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      13,
-      f
-    );
-  }
+ * @param {?proto.wsman.WorkspaceRuntimeInfo|undefined} value
+ * @return {!proto.wsman.WorkspaceStatus} returns this
+*/
+proto.wsman.WorkspaceStatus.prototype.setRuntime = function(value) {
+  return jspb.Message.setWrapperField(this, 8, value);
 };
 
 
 /**
- * optional string failed = 1;
- * @return {string}
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceStatus} returns this
  */
-proto.wsman.WorkspaceConditions.prototype.getFailed = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.wsman.WorkspaceStatus.prototype.clearRuntime = function() {
+  return this.setRuntime(undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.WorkspaceConditions} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.wsman.WorkspaceConditions.prototype.setFailed = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.wsman.WorkspaceStatus.prototype.hasRuntime = function() {
+  return jspb.Message.getField(this, 8) != null;
 };
 
 
 /**
- * optional string timeout = 2;
- * @return {string}
+ * optional WorkspaceAuthentication auth = 9;
+ * @return {?proto.wsman.WorkspaceAuthentication}
  */
-proto.wsman.WorkspaceConditions.prototype.getTimeout = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.wsman.WorkspaceStatus.prototype.getAuth = function() {
+  return /** @type{?proto.wsman.WorkspaceAuthentication} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceAuthentication, 9));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.WorkspaceConditions} returns this
- */
-proto.wsman.WorkspaceConditions.prototype.setTimeout = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+ * @param {?proto.wsman.WorkspaceAuthentication|undefined} value
+ * @return {!proto.wsman.WorkspaceStatus} returns this
+*/
+proto.wsman.WorkspaceStatus.prototype.setAuth = function(value) {
+  return jspb.Message.setWrapperField(this, 9, value);
 };
 
 
 /**
- * optional WorkspaceConditionBool pulling_images = 3;
- * @return {!proto.wsman.WorkspaceConditionBool}
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceStatus} returns this
  */
-proto.wsman.WorkspaceConditions.prototype.getPullingImages = function() {
-  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.wsman.WorkspaceStatus.prototype.clearAuth = function() {
+  return this.setAuth(undefined);
 };
 
 
 /**
- * @param {!proto.wsman.WorkspaceConditionBool} value
- * @return {!proto.wsman.WorkspaceConditions} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.wsman.WorkspaceConditions.prototype.setPullingImages = function(value) {
-  return jspb.Message.setProto3EnumField(this, 3, value);
+proto.wsman.WorkspaceStatus.prototype.hasAuth = function() {
+  return jspb.Message.getField(this, 9) != null;
 };
 
 
 /**
- * optional string snapshot = 5;
- * @return {string}
+ * optional InitializerMetrics initializer_metrics = 11;
+ * @return {?proto.wsman.InitializerMetrics}
  */
-proto.wsman.WorkspaceConditions.prototype.getSnapshot = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.wsman.WorkspaceStatus.prototype.getInitializerMetrics = function() {
+  return /** @type{?proto.wsman.InitializerMetrics} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.InitializerMetrics, 11));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.WorkspaceConditions} returns this
- */
-proto.wsman.WorkspaceConditions.prototype.setSnapshot = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+ * @param {?proto.wsman.InitializerMetrics|undefined} value
+ * @return {!proto.wsman.WorkspaceStatus} returns this
+*/
+proto.wsman.WorkspaceStatus.prototype.setInitializerMetrics = function(value) {
+  return jspb.Message.setWrapperField(this, 11, value);
 };
 
 
 /**
- * optional WorkspaceConditionBool final_backup_complete = 6;
- * @return {!proto.wsman.WorkspaceConditionBool}
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceStatus} returns this
  */
-proto.wsman.WorkspaceConditions.prototype.getFinalBackupComplete = function() {
-  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.wsman.WorkspaceStatus.prototype.clearInitializerMetrics = function() {
+  return this.setInitializerMetrics(undefined);
 };
 
 
 /**
- * @param {!proto.wsman.WorkspaceConditionBool} value
- * @return {!proto.wsman.WorkspaceConditions} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.wsman.WorkspaceConditions.prototype.setFinalBackupComplete = function(value) {
-  return jspb.Message.setProto3EnumField(this, 6, value);
+proto.wsman.WorkspaceStatus.prototype.hasInitializerMetrics = function() {
+  return jspb.Message.getField(this, 11) != null;
 };
 
 
 /**
- * optional WorkspaceConditionBool deployed = 7;
- * @return {!proto.wsman.WorkspaceConditionBool}
+ * optional WorkspaceStorage storage = 12;
+ * @return {?proto.wsman.WorkspaceStorage}
  */
-proto.wsman.WorkspaceConditions.prototype.getDeployed = function() {
-  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.wsman.WorkspaceStatus.prototype.getStorage = function() {
+  return /** @type{?proto.wsman.WorkspaceStorage} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceStorage, 12));
 };
 
 
 /**
- * @param {!proto.wsman.WorkspaceConditionBool} value
- * @return {!proto.wsman.WorkspaceConditions} returns this
- */
-proto.wsman.WorkspaceConditions.prototype.setDeployed = function(value) {
-  return jspb.Message.setProto3EnumField(this, 7, value);
+ * @param {?proto.wsman.WorkspaceStorage|undefined} value
+ * @return {!proto.wsman.WorkspaceStatus} returns this
+*/
+proto.wsman.WorkspaceStatus.prototype.setStorage = function(value) {
+  return jspb.Message.setWrapperField(this, 12, value);
 };
 
 
 /**
- * optional WorkspaceConditionBool network_not_ready = 8;
- * @return {!proto.wsman.WorkspaceConditionBool}
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceStatus} returns this
  */
-proto.wsman.WorkspaceConditions.prototype.getNetworkNotReady = function() {
-  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+proto.wsman.WorkspaceStatus.prototype.clearStorage = function() {
+  return this.setStorage(undefined);
 };
 
 
 /**
- * @param {!proto.wsman.WorkspaceConditionBool} value
- * @return {!proto.wsman.WorkspaceConditions} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.wsman.WorkspaceConditions.prototype.setNetworkNotReady = function(value) {
-  return jspb.Message.setProto3EnumField(this, 8, value);
+proto.wsman.WorkspaceStatus.prototype.hasStorage = function() {
+  return jspb.Message.getField(this, 12) != null;
 };
 
 
 /**
- * optional google.protobuf.Timestamp first_user_activity = 9;
- * @return {?proto.google.protobuf.Timestamp}
+ * repeated WorkspaceTimelineEvent timeline = 13;
+ * @return {!Array<!proto.wsman.WorkspaceTimelineEvent>}
  */
-proto.wsman.WorkspaceConditions.prototype.getFirstUserActivity = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 9));
+proto.wsman.WorkspaceStatus.prototype.getTimelineList = function() {
+  return /** @type{!Array<!proto.wsman.WorkspaceTimelineEvent>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.WorkspaceTimelineEvent, 13));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.wsman.WorkspaceConditions} returns this
+ * @param {!Array<!proto.wsman.WorkspaceTimelineEvent>} value
+ * @return {!proto.wsman.WorkspaceStatus} returns this
 */
-proto.wsman.WorkspaceConditions.prototype.setFirstUserActivity = function(value) {
-  return jspb.Message.setWrapperField(this, 9, value);
+proto.wsman.WorkspaceStatus.prototype.setTimelineList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 13, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.WorkspaceConditions} returns this
+ * @param {!proto.wsman.WorkspaceTimelineEvent=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.WorkspaceTimelineEvent}
  */
-proto.wsman.WorkspaceConditions.prototype.clearFirstUserActivity = function() {
-  return this.setFirstUserActivity(undefined);
+proto.wsman.WorkspaceStatus.prototype.addTimeline = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 13, opt_value, proto.wsman.WorkspaceTimelineEvent, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.WorkspaceStatus} returns this
  */
-proto.wsman.WorkspaceConditions.prototype.hasFirstUserActivity = function() {
-  return jspb.Message.getField(this, 9) != null;
+proto.wsman.WorkspaceStatus.prototype.clearTimelineList = function() {
+  return this.setTimelineList([]);
 };
 
 
-/**
- * optional string headless_task_failed = 10;
- * @return {string}
- */
-proto.wsman.WorkspaceConditions.prototype.getHeadlessTaskFailed = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {string} value
- * @return {!proto.wsman.WorkspaceConditions} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.wsman.WorkspaceConditions.prototype.setHeadlessTaskFailed = function(value) {
-  return jspb.Message.setProto3StringField(this, 10, value);
+proto.wsman.WorkspaceStorage.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceStorage.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional WorkspaceConditionBool stopped_by_request = 11;
- * @return {!proto.wsman.WorkspaceConditionBool}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.WorkspaceStorage} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceConditions.prototype.getStoppedByRequest = function() {
-  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+proto.wsman.WorkspaceStorage.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    quotaBytes: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    usedBytes: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    resizeRejected: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {!proto.wsman.WorkspaceConditionBool} value
- * @return {!proto.wsman.WorkspaceConditions} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.WorkspaceStorage}
  */
-proto.wsman.WorkspaceConditions.prototype.setStoppedByRequest = function(value) {
-  return jspb.Message.setProto3EnumField(this, 11, value);
+proto.wsman.WorkspaceStorage.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.WorkspaceStorage;
+  return proto.wsman.WorkspaceStorage.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * optional VolumeSnapshotInfo volume_snapshot = 12;
- * @return {?proto.wsman.VolumeSnapshotInfo}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.WorkspaceStorage} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.WorkspaceStorage}
  */
-proto.wsman.WorkspaceConditions.prototype.getVolumeSnapshot = function() {
-  return /** @type{?proto.wsman.VolumeSnapshotInfo} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.VolumeSnapshotInfo, 12));
+proto.wsman.WorkspaceStorage.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setQuotaBytes(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setUsedBytes(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setResizeRejected(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {?proto.wsman.VolumeSnapshotInfo|undefined} value
- * @return {!proto.wsman.WorkspaceConditions} returns this
-*/
-proto.wsman.WorkspaceConditions.prototype.setVolumeSnapshot = function(value) {
-  return jspb.Message.setWrapperField(this, 12, value);
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.WorkspaceStorage.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.WorkspaceStorage.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.WorkspaceConditions} returns this
+ * optional int64 quota_bytes = 1;
+ * @return {number}
  */
-proto.wsman.WorkspaceConditions.prototype.clearVolumeSnapshot = function() {
-  return this.setVolumeSnapshot(undefined);
+proto.wsman.WorkspaceStorage.prototype.getQuotaBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.wsman.WorkspaceStorage} returns this
  */
-proto.wsman.WorkspaceConditions.prototype.hasVolumeSnapshot = function() {
-  return jspb.Message.getField(this, 12) != null;
+proto.wsman.WorkspaceStorage.prototype.setQuotaBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional WorkspaceConditionBool aborted = 13;
- * @return {!proto.wsman.WorkspaceConditionBool}
+ * optional int64 used_bytes = 2;
+ * @return {number}
  */
-proto.wsman.WorkspaceConditions.prototype.getAborted = function() {
-  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 13, 0));
+proto.wsman.WorkspaceStorage.prototype.getUsedBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {!proto.wsman.WorkspaceConditionBool} value
- * @return {!proto.wsman.WorkspaceConditions} returns this
+ * @param {number} value
+ * @return {!proto.wsman.WorkspaceStorage} returns this
  */
-proto.wsman.WorkspaceConditions.prototype.setAborted = function(value) {
-  return jspb.Message.setProto3EnumField(this, 13, value);
+proto.wsman.WorkspaceStorage.prototype.setUsedBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
+/**
+ * optional string resize_rejected = 3;
+ * @return {string}
+ */
+proto.wsman.WorkspaceStorage.prototype.getResizeRejected = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceStorage} returns this
+ */
+proto.wsman.WorkspaceStorage.prototype.setResizeRejected = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.WorkspaceStorage} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.WorkspaceStorage.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getQuotaBytes();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getUsedBytes();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+  f = message.getResizeRejected();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
 
 
 
@@ -7424,8 +7498,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.WorkspaceMetadata.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.WorkspaceMetadata.toObject(opt_includeInstance, this);
+proto.wsman.WorkspaceTimelineEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceTimelineEvent.toObject(opt_includeInstance, this);
 };
 
 
@@ -7434,19 +7508,16 @@ proto.wsman.WorkspaceMetadata.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.WorkspaceMetadata} msg The msg instance to transform.
+ * @param {!proto.wsman.WorkspaceTimelineEvent} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceMetadata.toObject = function(includeInstance, msg) {
+proto.wsman.WorkspaceTimelineEvent.toObject = function(includeInstance, msg) {
   var f, obj = {
-    owner: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    metaId: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    startedAt: (f = msg.getStartedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
-    annotationsMap: (f = msg.getAnnotationsMap()) ? f.toObject(includeInstance, undefined) : [],
-    team: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    project: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    metrics: (f = msg.getMetrics()) && proto.wsman.WorkspaceMetadata.Metrics.toObject(includeInstance, f)
+    type: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    status: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    time: (f = msg.getTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -7460,23 +7531,23 @@ proto.wsman.WorkspaceMetadata.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.WorkspaceMetadata}
+ * @return {!proto.wsman.WorkspaceTimelineEvent}
  */
-proto.wsman.WorkspaceMetadata.deserializeBinary = function(bytes) {
+proto.wsman.WorkspaceTimelineEvent.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.WorkspaceMetadata;
-  return proto.wsman.WorkspaceMetadata.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.WorkspaceTimelineEvent;
+  return proto.wsman.WorkspaceTimelineEvent.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.WorkspaceMetadata} msg The message object to deserialize into.
+ * @param {!proto.wsman.WorkspaceTimelineEvent} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.WorkspaceMetadata}
+ * @return {!proto.wsman.WorkspaceTimelineEvent}
  */
-proto.wsman.WorkspaceMetadata.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.WorkspaceTimelineEvent.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7485,35 +7556,20 @@ proto.wsman.WorkspaceMetadata.deserializeBinaryFromReader = function(msg, reader
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setOwner(value);
+      msg.setType(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setMetaId(value);
+      msg.setName(value);
       break;
     case 3:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setStartedAt(value);
-      break;
-    case 4:
-      var value = msg.getAnnotationsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setTeam(value);
-      break;
-    case 6:
       var value = /** @type {string} */ (reader.readString());
-      msg.setProject(value);
+      msg.setStatus(value);
       break;
-    case 7:
-      var value = new proto.wsman.WorkspaceMetadata.Metrics;
-      reader.readMessage(value,proto.wsman.WorkspaceMetadata.Metrics.deserializeBinaryFromReader);
-      msg.setMetrics(value);
+    case 4:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTime(value);
       break;
     default:
       reader.skipField();
@@ -7528,70 +7584,142 @@ proto.wsman.WorkspaceMetadata.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.WorkspaceMetadata.prototype.serializeBinary = function() {
+proto.wsman.WorkspaceTimelineEvent.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.WorkspaceMetadata.serializeBinaryToWriter(this, writer);
+  proto.wsman.WorkspaceTimelineEvent.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
 
+/**
+ * optional string type = 1;
+ * @return {string}
+ */
+proto.wsman.WorkspaceTimelineEvent.prototype.getType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceTimelineEvent} returns this
+ */
+proto.wsman.WorkspaceTimelineEvent.prototype.setType = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.wsman.WorkspaceTimelineEvent.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceTimelineEvent} returns this
+ */
+proto.wsman.WorkspaceTimelineEvent.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string status = 3;
+ * @return {string}
+ */
+proto.wsman.WorkspaceTimelineEvent.prototype.getStatus = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceTimelineEvent} returns this
+ */
+proto.wsman.WorkspaceTimelineEvent.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp time = 4;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.wsman.WorkspaceTimelineEvent.prototype.getTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.wsman.WorkspaceTimelineEvent} returns this
+*/
+proto.wsman.WorkspaceTimelineEvent.prototype.setTime = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceTimelineEvent} returns this
+ */
+proto.wsman.WorkspaceTimelineEvent.prototype.clearTime = function() {
+  return this.setTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.WorkspaceTimelineEvent.prototype.hasTime = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.WorkspaceMetadata} message
+ * @param {!proto.wsman.WorkspaceTimelineEvent} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceMetadata.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.WorkspaceTimelineEvent.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getOwner();
+  f = message.getType();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getMetaId();
+  f = message.getName();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getStartedAt();
+  f = message.getStatus();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getTime();
   if (f != null) {
     writer.writeMessage(
-      3,
+      4,
       f,
       google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
-  f = message.getAnnotationsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(4, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 5));
-  if (f != null) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 6));
-  if (f != null) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getMetrics();
-  if (f != null) {
-    writer.writeMessage(
-      7,
-      f,
-      proto.wsman.WorkspaceMetadata.Metrics.serializeBinaryToWriter
-    );
-  }
 };
 
 
@@ -7611,8 +7739,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.WorkspaceMetadata.ImageInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.WorkspaceMetadata.ImageInfo.toObject(opt_includeInstance, this);
+proto.wsman.IDEImage.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.IDEImage.toObject(opt_includeInstance, this);
 };
 
 
@@ -7621,14 +7749,14 @@ proto.wsman.WorkspaceMetadata.ImageInfo.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.WorkspaceMetadata.ImageInfo} msg The msg instance to transform.
+ * @param {!proto.wsman.IDEImage} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceMetadata.ImageInfo.toObject = function(includeInstance, msg) {
+proto.wsman.IDEImage.toObject = function(includeInstance, msg) {
   var f, obj = {
-    totalSize: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    workspaceImageSize: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    webRef: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    supervisorRef: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -7642,23 +7770,23 @@ proto.wsman.WorkspaceMetadata.ImageInfo.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.WorkspaceMetadata.ImageInfo}
+ * @return {!proto.wsman.IDEImage}
  */
-proto.wsman.WorkspaceMetadata.ImageInfo.deserializeBinary = function(bytes) {
+proto.wsman.IDEImage.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.WorkspaceMetadata.ImageInfo;
-  return proto.wsman.WorkspaceMetadata.ImageInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.IDEImage;
+  return proto.wsman.IDEImage.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.WorkspaceMetadata.ImageInfo} msg The message object to deserialize into.
+ * @param {!proto.wsman.IDEImage} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.WorkspaceMetadata.ImageInfo}
+ * @return {!proto.wsman.IDEImage}
  */
-proto.wsman.WorkspaceMetadata.ImageInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.IDEImage.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7666,12 +7794,12 @@ proto.wsman.WorkspaceMetadata.ImageInfo.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setTotalSize(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setWebRef(value);
       break;
-    case 2:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setWorkspaceImageSize(value);
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSupervisorRef(value);
       break;
     default:
       reader.skipField();
@@ -7686,9 +7814,9 @@ proto.wsman.WorkspaceMetadata.ImageInfo.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.WorkspaceMetadata.ImageInfo.prototype.serializeBinary = function() {
+proto.wsman.IDEImage.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.WorkspaceMetadata.ImageInfo.serializeBinaryToWriter(this, writer);
+  proto.wsman.IDEImage.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7696,23 +7824,23 @@ proto.wsman.WorkspaceMetadata.ImageInfo.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.WorkspaceMetadata.ImageInfo} message
+ * @param {!proto.wsman.IDEImage} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceMetadata.ImageInfo.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.IDEImage.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTotalSize();
-  if (f !== 0) {
-    writer.writeInt64(
+  f = message.getWebRef();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getWorkspaceImageSize();
-  if (f !== 0) {
-    writer.writeInt64(
-      2,
+  f = message.getSupervisorRef();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
       f
     );
   }
@@ -7720,42 +7848,49 @@ proto.wsman.WorkspaceMetadata.ImageInfo.serializeBinaryToWriter = function(messa
 
 
 /**
- * optional int64 total_size = 1;
- * @return {number}
+ * optional string web_ref = 1;
+ * @return {string}
  */
-proto.wsman.WorkspaceMetadata.ImageInfo.prototype.getTotalSize = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.wsman.IDEImage.prototype.getWebRef = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.wsman.WorkspaceMetadata.ImageInfo} returns this
+ * @param {string} value
+ * @return {!proto.wsman.IDEImage} returns this
  */
-proto.wsman.WorkspaceMetadata.ImageInfo.prototype.setTotalSize = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.wsman.IDEImage.prototype.setWebRef = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional int64 workspace_image_size = 2;
- * @return {number}
+ * optional string supervisor_ref = 3;
+ * @return {string}
  */
-proto.wsman.WorkspaceMetadata.ImageInfo.prototype.getWorkspaceImageSize = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.wsman.IDEImage.prototype.getSupervisorRef = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.wsman.WorkspaceMetadata.ImageInfo} returns this
+ * @param {string} value
+ * @return {!proto.wsman.IDEImage} returns this
  */
-proto.wsman.WorkspaceMetadata.ImageInfo.prototype.setWorkspaceImageSize = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.wsman.IDEImage.prototype.setSupervisorRef = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.wsman.WorkspaceSpec.repeatedFields_ = [5,10];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -7771,8 +7906,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.WorkspaceMetadata.Metrics.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.WorkspaceMetadata.Metrics.toObject(opt_includeInstance, this);
+proto.wsman.WorkspaceSpec.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceSpec.toObject(opt_includeInstance, this);
 };
 
 
@@ -7781,13 +7916,24 @@ proto.wsman.WorkspaceMetadata.Metrics.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.WorkspaceMetadata.Metrics} msg The msg instance to transform.
+ * @param {!proto.wsman.WorkspaceSpec} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceMetadata.Metrics.toObject = function(includeInstance, msg) {
+proto.wsman.WorkspaceSpec.toObject = function(includeInstance, msg) {
   var f, obj = {
-    image: (f = msg.getImage()) && proto.wsman.WorkspaceMetadata.ImageInfo.toObject(includeInstance, f)
+    workspaceImage: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    headless: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    url: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    exposedPortsList: jspb.Message.toObjectList(msg.getExposedPortsList(),
+    proto.wsman.PortSpec.toObject, includeInstance),
+    type: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    timeout: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    ideImage: (f = msg.getIdeImage()) && proto.wsman.IDEImage.toObject(includeInstance, f),
+    pb_class: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    ideImageLayersList: (f = jspb.Message.getRepeatedField(msg, 10)) == null ? undefined : f,
+    closedTimeout: jspb.Message.getFieldWithDefault(msg, 11, ""),
+    egressPolicy: jspb.Message.getFieldWithDefault(msg, 12, "")
   };
 
   if (includeInstance) {
@@ -7801,23 +7947,23 @@ proto.wsman.WorkspaceMetadata.Metrics.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.WorkspaceMetadata.Metrics}
+ * @return {!proto.wsman.WorkspaceSpec}
  */
-proto.wsman.WorkspaceMetadata.Metrics.deserializeBinary = function(bytes) {
+proto.wsman.WorkspaceSpec.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.WorkspaceMetadata.Metrics;
-  return proto.wsman.WorkspaceMetadata.Metrics.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.WorkspaceSpec;
+  return proto.wsman.WorkspaceSpec.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.WorkspaceMetadata.Metrics} msg The message object to deserialize into.
+ * @param {!proto.wsman.WorkspaceSpec} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.WorkspaceMetadata.Metrics}
+ * @return {!proto.wsman.WorkspaceSpec}
  */
-proto.wsman.WorkspaceMetadata.Metrics.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.WorkspaceSpec.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7825,9 +7971,50 @@ proto.wsman.WorkspaceMetadata.Metrics.deserializeBinaryFromReader = function(msg
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.wsman.WorkspaceMetadata.ImageInfo;
-      reader.readMessage(value,proto.wsman.WorkspaceMetadata.ImageInfo.deserializeBinaryFromReader);
-      msg.setImage(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setWorkspaceImage(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setHeadless(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrl(value);
+      break;
+    case 5:
+      var value = new proto.wsman.PortSpec;
+      reader.readMessage(value,proto.wsman.PortSpec.deserializeBinaryFromReader);
+      msg.addExposedPorts(value);
+      break;
+    case 6:
+      var value = /** @type {!proto.wsman.WorkspaceType} */ (reader.readEnum());
+      msg.setType(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTimeout(value);
+      break;
+    case 8:
+      var value = new proto.wsman.IDEImage;
+      reader.readMessage(value,proto.wsman.IDEImage.deserializeBinaryFromReader);
+      msg.setIdeImage(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClass(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addIdeImageLayers(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClosedTimeout(value);
+      break;
+    case 12:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEgressPolicy(value);
       break;
     default:
       reader.skipField();
@@ -7842,9 +8029,9 @@ proto.wsman.WorkspaceMetadata.Metrics.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.WorkspaceMetadata.Metrics.prototype.serializeBinary = function() {
+proto.wsman.WorkspaceSpec.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.WorkspaceMetadata.Metrics.serializeBinaryToWriter(this, writer);
+  proto.wsman.WorkspaceSpec.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7852,179 +8039,247 @@ proto.wsman.WorkspaceMetadata.Metrics.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.WorkspaceMetadata.Metrics} message
+ * @param {!proto.wsman.WorkspaceSpec} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceMetadata.Metrics.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.WorkspaceSpec.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getImage();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getWorkspaceImage();
+  if (f.length > 0) {
+    writer.writeString(
       1,
+      f
+    );
+  }
+  f = message.getHeadless();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getExposedPortsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      5,
       f,
-      proto.wsman.WorkspaceMetadata.ImageInfo.serializeBinaryToWriter
+      proto.wsman.PortSpec.serializeBinaryToWriter
+    );
+  }
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      6,
+      f
+    );
+  }
+  f = message.getTimeout();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getIdeImage();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      proto.wsman.IDEImage.serializeBinaryToWriter
+    );
+  }
+  f = message.getClass();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getIdeImageLayersList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      10,
+      f
+    );
+  }
+  f = message.getClosedTimeout();
+  if (f.length > 0) {
+    writer.writeString(
+      11,
+      f
+    );
+  }
+  f = message.getEgressPolicy();
+  if (f.length > 0) {
+    writer.writeString(
+      12,
+      f
     );
   }
 };
 
 
 /**
- * optional ImageInfo image = 1;
- * @return {?proto.wsman.WorkspaceMetadata.ImageInfo}
- */
-proto.wsman.WorkspaceMetadata.Metrics.prototype.getImage = function() {
-  return /** @type{?proto.wsman.WorkspaceMetadata.ImageInfo} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceMetadata.ImageInfo, 1));
-};
-
-
-/**
- * @param {?proto.wsman.WorkspaceMetadata.ImageInfo|undefined} value
- * @return {!proto.wsman.WorkspaceMetadata.Metrics} returns this
-*/
-proto.wsman.WorkspaceMetadata.Metrics.prototype.setImage = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.WorkspaceMetadata.Metrics} returns this
+ * optional string workspace_image = 1;
+ * @return {string}
  */
-proto.wsman.WorkspaceMetadata.Metrics.prototype.clearImage = function() {
-  return this.setImage(undefined);
+proto.wsman.WorkspaceSpec.prototype.getWorkspaceImage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceSpec} returns this
  */
-proto.wsman.WorkspaceMetadata.Metrics.prototype.hasImage = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.wsman.WorkspaceSpec.prototype.setWorkspaceImage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string owner = 1;
- * @return {string}
+ * optional bool headless = 3;
+ * @return {boolean}
  */
-proto.wsman.WorkspaceMetadata.prototype.getOwner = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.wsman.WorkspaceSpec.prototype.getHeadless = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.WorkspaceMetadata} returns this
+ * @param {boolean} value
+ * @return {!proto.wsman.WorkspaceSpec} returns this
  */
-proto.wsman.WorkspaceMetadata.prototype.setOwner = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.wsman.WorkspaceSpec.prototype.setHeadless = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
 
 /**
- * optional string meta_id = 2;
+ * optional string url = 4;
  * @return {string}
  */
-proto.wsman.WorkspaceMetadata.prototype.getMetaId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.wsman.WorkspaceSpec.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.WorkspaceMetadata} returns this
+ * @return {!proto.wsman.WorkspaceSpec} returns this
  */
-proto.wsman.WorkspaceMetadata.prototype.setMetaId = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.wsman.WorkspaceSpec.prototype.setUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * optional google.protobuf.Timestamp started_at = 3;
- * @return {?proto.google.protobuf.Timestamp}
+ * repeated PortSpec exposed_ports = 5;
+ * @return {!Array<!proto.wsman.PortSpec>}
  */
-proto.wsman.WorkspaceMetadata.prototype.getStartedAt = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+proto.wsman.WorkspaceSpec.prototype.getExposedPortsList = function() {
+  return /** @type{!Array<!proto.wsman.PortSpec>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.PortSpec, 5));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.wsman.WorkspaceMetadata} returns this
+ * @param {!Array<!proto.wsman.PortSpec>} value
+ * @return {!proto.wsman.WorkspaceSpec} returns this
 */
-proto.wsman.WorkspaceMetadata.prototype.setStartedAt = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+proto.wsman.WorkspaceSpec.prototype.setExposedPortsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 5, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.WorkspaceMetadata} returns this
+ * @param {!proto.wsman.PortSpec=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.PortSpec}
  */
-proto.wsman.WorkspaceMetadata.prototype.clearStartedAt = function() {
-  return this.setStartedAt(undefined);
+proto.wsman.WorkspaceSpec.prototype.addExposedPorts = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.wsman.PortSpec, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.WorkspaceSpec} returns this
  */
-proto.wsman.WorkspaceMetadata.prototype.hasStartedAt = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.wsman.WorkspaceSpec.prototype.clearExposedPortsList = function() {
+  return this.setExposedPortsList([]);
 };
 
 
 /**
- * map<string, string> annotations = 4;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * optional WorkspaceType type = 6;
+ * @return {!proto.wsman.WorkspaceType}
  */
-proto.wsman.WorkspaceMetadata.prototype.getAnnotationsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 4, opt_noLazyCreate,
-      null));
+proto.wsman.WorkspaceSpec.prototype.getType = function() {
+  return /** @type {!proto.wsman.WorkspaceType} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.wsman.WorkspaceMetadata} returns this
+ * @param {!proto.wsman.WorkspaceType} value
+ * @return {!proto.wsman.WorkspaceSpec} returns this
  */
-proto.wsman.WorkspaceMetadata.prototype.clearAnnotationsMap = function() {
-  this.getAnnotationsMap().clear();
-  return this;};
+proto.wsman.WorkspaceSpec.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 6, value);
+};
 
 
 /**
- * optional string team = 5;
+ * optional string timeout = 7;
  * @return {string}
  */
-proto.wsman.WorkspaceMetadata.prototype.getTeam = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.wsman.WorkspaceSpec.prototype.getTimeout = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.WorkspaceMetadata} returns this
+ * @return {!proto.wsman.WorkspaceSpec} returns this
  */
-proto.wsman.WorkspaceMetadata.prototype.setTeam = function(value) {
-  return jspb.Message.setField(this, 5, value);
+proto.wsman.WorkspaceSpec.prototype.setTimeout = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.wsman.WorkspaceMetadata} returns this
+ * optional IDEImage ide_image = 8;
+ * @return {?proto.wsman.IDEImage}
  */
-proto.wsman.WorkspaceMetadata.prototype.clearTeam = function() {
-  return jspb.Message.setField(this, 5, undefined);
+proto.wsman.WorkspaceSpec.prototype.getIdeImage = function() {
+  return /** @type{?proto.wsman.IDEImage} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.IDEImage, 8));
+};
+
+
+/**
+ * @param {?proto.wsman.IDEImage|undefined} value
+ * @return {!proto.wsman.WorkspaceSpec} returns this
+*/
+proto.wsman.WorkspaceSpec.prototype.setIdeImage = function(value) {
+  return jspb.Message.setWrapperField(this, 8, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceSpec} returns this
+ */
+proto.wsman.WorkspaceSpec.prototype.clearIdeImage = function() {
+  return this.setIdeImage(undefined);
 };
 
 
@@ -8032,81 +8287,99 @@ proto.wsman.WorkspaceMetadata.prototype.clearTeam = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.wsman.WorkspaceMetadata.prototype.hasTeam = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.wsman.WorkspaceSpec.prototype.hasIdeImage = function() {
+  return jspb.Message.getField(this, 8) != null;
 };
 
 
 /**
- * optional string project = 6;
+ * optional string class = 9;
  * @return {string}
  */
-proto.wsman.WorkspaceMetadata.prototype.getProject = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.wsman.WorkspaceSpec.prototype.getClass = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.WorkspaceMetadata} returns this
+ * @return {!proto.wsman.WorkspaceSpec} returns this
  */
-proto.wsman.WorkspaceMetadata.prototype.setProject = function(value) {
-  return jspb.Message.setField(this, 6, value);
+proto.wsman.WorkspaceSpec.prototype.setClass = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.wsman.WorkspaceMetadata} returns this
+ * repeated string ide_image_layers = 10;
+ * @return {!Array<string>}
  */
-proto.wsman.WorkspaceMetadata.prototype.clearProject = function() {
-  return jspb.Message.setField(this, 6, undefined);
+proto.wsman.WorkspaceSpec.prototype.getIdeImageLayersList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 10));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {!Array<string>} value
+ * @return {!proto.wsman.WorkspaceSpec} returns this
  */
-proto.wsman.WorkspaceMetadata.prototype.hasProject = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.wsman.WorkspaceSpec.prototype.setIdeImageLayersList = function(value) {
+  return jspb.Message.setField(this, 10, value || []);
 };
 
 
 /**
- * optional Metrics metrics = 7;
- * @return {?proto.wsman.WorkspaceMetadata.Metrics}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.WorkspaceSpec} returns this
  */
-proto.wsman.WorkspaceMetadata.prototype.getMetrics = function() {
-  return /** @type{?proto.wsman.WorkspaceMetadata.Metrics} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceMetadata.Metrics, 7));
+proto.wsman.WorkspaceSpec.prototype.addIdeImageLayers = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 10, value, opt_index);
 };
 
 
 /**
- * @param {?proto.wsman.WorkspaceMetadata.Metrics|undefined} value
- * @return {!proto.wsman.WorkspaceMetadata} returns this
-*/
-proto.wsman.WorkspaceMetadata.prototype.setMetrics = function(value) {
-  return jspb.Message.setWrapperField(this, 7, value);
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.WorkspaceSpec} returns this
+ */
+proto.wsman.WorkspaceSpec.prototype.clearIdeImageLayersList = function() {
+  return this.setIdeImageLayersList([]);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.WorkspaceMetadata} returns this
+ * optional string closed_timeout = 11;
+ * @return {string}
  */
-proto.wsman.WorkspaceMetadata.prototype.clearMetrics = function() {
-  return this.setMetrics(undefined);
+proto.wsman.WorkspaceSpec.prototype.getClosedTimeout = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceSpec} returns this
  */
-proto.wsman.WorkspaceMetadata.prototype.hasMetrics = function() {
-  return jspb.Message.getField(this, 7) != null;
+proto.wsman.WorkspaceSpec.prototype.setClosedTimeout = function(value) {
+  return jspb.Message.setProto3StringField(this, 11, value);
+};
+
+
+/**
+ * optional string egress_policy = 12;
+ * @return {string}
+ */
+proto.wsman.WorkspaceSpec.prototype.getEgressPolicy = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceSpec} returns this
+ */
+proto.wsman.WorkspaceSpec.prototype.setEgressPolicy = function(value) {
+  return jspb.Message.setProto3StringField(this, 12, value);
 };
 
 
@@ -8126,8 +8399,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.WorkspaceRuntimeInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.WorkspaceRuntimeInfo.toObject(opt_includeInstance, this);
+proto.wsman.PortSpec.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.PortSpec.toObject(opt_includeInstance, this);
 };
 
 
@@ -8136,15 +8409,16 @@ proto.wsman.WorkspaceRuntimeInfo.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.WorkspaceRuntimeInfo} msg The msg instance to transform.
+ * @param {!proto.wsman.PortSpec} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceRuntimeInfo.toObject = function(includeInstance, msg) {
+proto.wsman.PortSpec.toObject = function(includeInstance, msg) {
   var f, obj = {
-    nodeName: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    podName: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    nodeIp: jspb.Message.getFieldWithDefault(msg, 3, "")
+    port: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    visibility: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    url: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    protocol: jspb.Message.getFieldWithDefault(msg, 5, 0)
   };
 
   if (includeInstance) {
@@ -8158,23 +8432,23 @@ proto.wsman.WorkspaceRuntimeInfo.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.WorkspaceRuntimeInfo}
+ * @return {!proto.wsman.PortSpec}
  */
-proto.wsman.WorkspaceRuntimeInfo.deserializeBinary = function(bytes) {
+proto.wsman.PortSpec.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.WorkspaceRuntimeInfo;
-  return proto.wsman.WorkspaceRuntimeInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.PortSpec;
+  return proto.wsman.PortSpec.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.WorkspaceRuntimeInfo} msg The message object to deserialize into.
+ * @param {!proto.wsman.PortSpec} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.WorkspaceRuntimeInfo}
+ * @return {!proto.wsman.PortSpec}
  */
-proto.wsman.WorkspaceRuntimeInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.PortSpec.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8182,16 +8456,20 @@ proto.wsman.WorkspaceRuntimeInfo.deserializeBinaryFromReader = function(msg, rea
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setNodeName(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPodName(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPort(value);
       break;
     case 3:
+      var value = /** @type {!proto.wsman.PortVisibility} */ (reader.readEnum());
+      msg.setVisibility(value);
+      break;
+    case 4:
       var value = /** @type {string} */ (reader.readString());
-      msg.setNodeIp(value);
+      msg.setUrl(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.wsman.PortProtocol} */ (reader.readEnum());
+      msg.setProtocol(value);
       break;
     default:
       reader.skipField();
@@ -8206,9 +8484,9 @@ proto.wsman.WorkspaceRuntimeInfo.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.WorkspaceRuntimeInfo.prototype.serializeBinary = function() {
+proto.wsman.PortSpec.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.WorkspaceRuntimeInfo.serializeBinaryToWriter(this, writer);
+  proto.wsman.PortSpec.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8216,30 +8494,37 @@ proto.wsman.WorkspaceRuntimeInfo.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.WorkspaceRuntimeInfo} message
+ * @param {!proto.wsman.PortSpec} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceRuntimeInfo.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.PortSpec.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getNodeName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getPort();
+  if (f !== 0) {
+    writer.writeUint32(
       1,
       f
     );
   }
-  f = message.getPodName();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
+  f = message.getVisibility();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
       f
     );
   }
-  f = message.getNodeIp();
+  f = message.getUrl();
   if (f.length > 0) {
     writer.writeString(
-      3,
+      4,
+      f
+    );
+  }
+  f = message.getProtocol();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
       f
     );
   }
@@ -8247,56 +8532,74 @@ proto.wsman.WorkspaceRuntimeInfo.serializeBinaryToWriter = function(message, wri
 
 
 /**
- * optional string node_name = 1;
- * @return {string}
+ * optional uint32 port = 1;
+ * @return {number}
  */
-proto.wsman.WorkspaceRuntimeInfo.prototype.getNodeName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.wsman.PortSpec.prototype.getPort = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.WorkspaceRuntimeInfo} returns this
+ * @param {number} value
+ * @return {!proto.wsman.PortSpec} returns this
  */
-proto.wsman.WorkspaceRuntimeInfo.prototype.setNodeName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.wsman.PortSpec.prototype.setPort = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional string pod_name = 2;
- * @return {string}
+ * optional PortVisibility visibility = 3;
+ * @return {!proto.wsman.PortVisibility}
  */
-proto.wsman.WorkspaceRuntimeInfo.prototype.getPodName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.wsman.PortSpec.prototype.getVisibility = function() {
+  return /** @type {!proto.wsman.PortVisibility} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.WorkspaceRuntimeInfo} returns this
+ * @param {!proto.wsman.PortVisibility} value
+ * @return {!proto.wsman.PortSpec} returns this
  */
-proto.wsman.WorkspaceRuntimeInfo.prototype.setPodName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.wsman.PortSpec.prototype.setVisibility = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
 };
 
 
 /**
- * optional string node_ip = 3;
+ * optional string url = 4;
  * @return {string}
  */
-proto.wsman.WorkspaceRuntimeInfo.prototype.getNodeIp = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.wsman.PortSpec.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.WorkspaceRuntimeInfo} returns this
+ * @return {!proto.wsman.PortSpec} returns this
  */
-proto.wsman.WorkspaceRuntimeInfo.prototype.setNodeIp = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.wsman.PortSpec.prototype.setUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional PortProtocol protocol = 5;
+ * @return {!proto.wsman.PortProtocol}
+ */
+proto.wsman.PortSpec.prototype.getProtocol = function() {
+  return /** @type {!proto.wsman.PortProtocol} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {!proto.wsman.PortProtocol} value
+ * @return {!proto.wsman.PortSpec} returns this
+ */
+proto.wsman.PortSpec.prototype.setProtocol = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
 };
 
 
@@ -8316,8 +8619,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.WorkspaceAuthentication.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.WorkspaceAuthentication.toObject(opt_includeInstance, this);
+proto.wsman.VolumeSnapshotInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.VolumeSnapshotInfo.toObject(opt_includeInstance, this);
 };
 
 
@@ -8326,14 +8629,14 @@ proto.wsman.WorkspaceAuthentication.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.WorkspaceAuthentication} msg The msg instance to transform.
+ * @param {!proto.wsman.VolumeSnapshotInfo} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceAuthentication.toObject = function(includeInstance, msg) {
+proto.wsman.VolumeSnapshotInfo.toObject = function(includeInstance, msg) {
   var f, obj = {
-    admission: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    ownerToken: jspb.Message.getFieldWithDefault(msg, 2, "")
+    volumeSnapshotName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    volumeSnapshotHandle: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -8347,23 +8650,23 @@ proto.wsman.WorkspaceAuthentication.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.WorkspaceAuthentication}
+ * @return {!proto.wsman.VolumeSnapshotInfo}
  */
-proto.wsman.WorkspaceAuthentication.deserializeBinary = function(bytes) {
+proto.wsman.VolumeSnapshotInfo.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.WorkspaceAuthentication;
-  return proto.wsman.WorkspaceAuthentication.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.VolumeSnapshotInfo;
+  return proto.wsman.VolumeSnapshotInfo.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.WorkspaceAuthentication} msg The message object to deserialize into.
+ * @param {!proto.wsman.VolumeSnapshotInfo} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.WorkspaceAuthentication}
+ * @return {!proto.wsman.VolumeSnapshotInfo}
  */
-proto.wsman.WorkspaceAuthentication.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.VolumeSnapshotInfo.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8371,12 +8674,12 @@ proto.wsman.WorkspaceAuthentication.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.wsman.AdmissionLevel} */ (reader.readEnum());
-      msg.setAdmission(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setVolumeSnapshotName(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setOwnerToken(value);
+      msg.setVolumeSnapshotHandle(value);
       break;
     default:
       reader.skipField();
@@ -8391,9 +8694,9 @@ proto.wsman.WorkspaceAuthentication.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.WorkspaceAuthentication.prototype.serializeBinary = function() {
+proto.wsman.VolumeSnapshotInfo.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.WorkspaceAuthentication.serializeBinaryToWriter(this, writer);
+  proto.wsman.VolumeSnapshotInfo.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8401,20 +8704,20 @@ proto.wsman.WorkspaceAuthentication.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.WorkspaceAuthentication} message
+ * @param {!proto.wsman.VolumeSnapshotInfo} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceAuthentication.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.VolumeSnapshotInfo.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAdmission();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getVolumeSnapshotName();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getOwnerToken();
+  f = message.getVolumeSnapshotHandle();
   if (f.length > 0) {
     writer.writeString(
       2,
@@ -8425,49 +8728,42 @@ proto.wsman.WorkspaceAuthentication.serializeBinaryToWriter = function(message,
 
 
 /**
- * optional AdmissionLevel admission = 1;
- * @return {!proto.wsman.AdmissionLevel}
+ * optional string volume_snapshot_name = 1;
+ * @return {string}
  */
-proto.wsman.WorkspaceAuthentication.prototype.getAdmission = function() {
-  return /** @type {!proto.wsman.AdmissionLevel} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.wsman.VolumeSnapshotInfo.prototype.getVolumeSnapshotName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!proto.wsman.AdmissionLevel} value
- * @return {!proto.wsman.WorkspaceAuthentication} returns this
+ * @param {string} value
+ * @return {!proto.wsman.VolumeSnapshotInfo} returns this
  */
-proto.wsman.WorkspaceAuthentication.prototype.setAdmission = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
+proto.wsman.VolumeSnapshotInfo.prototype.setVolumeSnapshotName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string owner_token = 2;
+ * optional string volume_snapshot_handle = 2;
  * @return {string}
  */
-proto.wsman.WorkspaceAuthentication.prototype.getOwnerToken = function() {
+proto.wsman.VolumeSnapshotInfo.prototype.getVolumeSnapshotHandle = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.WorkspaceAuthentication} returns this
+ * @return {!proto.wsman.VolumeSnapshotInfo} returns this
  */
-proto.wsman.WorkspaceAuthentication.prototype.setOwnerToken = function(value) {
+proto.wsman.VolumeSnapshotInfo.prototype.setVolumeSnapshotHandle = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.wsman.StartWorkspaceSpec.repeatedFields_ = [3,5,6,15,16,17];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -8483,8 +8779,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.StartWorkspaceSpec.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.StartWorkspaceSpec.toObject(opt_includeInstance, this);
+proto.wsman.WorkspaceConditions.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceConditions.toObject(opt_includeInstance, this);
 };
 
 
@@ -8493,31 +8789,25 @@ proto.wsman.StartWorkspaceSpec.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.StartWorkspaceSpec} msg The msg instance to transform.
+ * @param {!proto.wsman.WorkspaceConditions} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.StartWorkspaceSpec.toObject = function(includeInstance, msg) {
+proto.wsman.WorkspaceConditions.toObject = function(includeInstance, msg) {
   var f, obj = {
-    workspaceImage: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    featureFlagsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
-    initializer: (f = msg.getInitializer()) && content$service$api_initializer_pb.WorkspaceInitializer.toObject(includeInstance, f),
-    portsList: jspb.Message.toObjectList(msg.getPortsList(),
-    proto.wsman.PortSpec.toObject, includeInstance),
-    envvarsList: jspb.Message.toObjectList(msg.getEnvvarsList(),
-    proto.wsman.EnvironmentVariable.toObject, includeInstance),
-    workspaceLocation: jspb.Message.getFieldWithDefault(msg, 8, ""),
-    git: (f = msg.getGit()) && proto.wsman.GitSpec.toObject(includeInstance, f),
-    timeout: jspb.Message.getFieldWithDefault(msg, 10, ""),
-    admission: jspb.Message.getFieldWithDefault(msg, 11, 0),
-    ideImage: (f = msg.getIdeImage()) && proto.wsman.IDEImage.toObject(includeInstance, f),
-    pb_class: jspb.Message.getFieldWithDefault(msg, 13, ""),
-    sshPublicKeysList: (f = jspb.Message.getRepeatedField(msg, 15)) == null ? undefined : f,
-    sysEnvvarsList: jspb.Message.toObjectList(msg.getSysEnvvarsList(),
-    proto.wsman.EnvironmentVariable.toObject, includeInstance),
-    ideImageLayersList: (f = jspb.Message.getRepeatedField(msg, 17)) == null ? undefined : f,
-    closedTimeout: jspb.Message.getFieldWithDefault(msg, 18, ""),
-    maximumLifetime: jspb.Message.getFieldWithDefault(msg, 19, "")
+    failed: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    timeout: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    pullingImages: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    snapshot: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    finalBackupComplete: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    deployed: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    networkNotReady: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    firstUserActivity: (f = msg.getFirstUserActivity()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    headlessTaskFailed: jspb.Message.getFieldWithDefault(msg, 10, ""),
+    stoppedByRequest: jspb.Message.getFieldWithDefault(msg, 11, 0),
+    volumeSnapshot: (f = msg.getVolumeSnapshot()) && proto.wsman.VolumeSnapshotInfo.toObject(includeInstance, f),
+    aborted: jspb.Message.getFieldWithDefault(msg, 13, 0),
+    paused: jspb.Message.getFieldWithDefault(msg, 14, 0)
   };
 
   if (includeInstance) {
@@ -8531,23 +8821,23 @@ proto.wsman.StartWorkspaceSpec.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.StartWorkspaceSpec}
+ * @return {!proto.wsman.WorkspaceConditions}
  */
-proto.wsman.StartWorkspaceSpec.deserializeBinary = function(bytes) {
+proto.wsman.WorkspaceConditions.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.StartWorkspaceSpec;
-  return proto.wsman.StartWorkspaceSpec.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.WorkspaceConditions;
+  return proto.wsman.WorkspaceConditions.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.StartWorkspaceSpec} msg The message object to deserialize into.
+ * @param {!proto.wsman.WorkspaceConditions} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.StartWorkspaceSpec}
+ * @return {!proto.wsman.WorkspaceConditions}
  */
-proto.wsman.StartWorkspaceSpec.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.WorkspaceConditions.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8556,75 +8846,57 @@ proto.wsman.StartWorkspaceSpec.deserializeBinaryFromReader = function(msg, reade
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setWorkspaceImage(value);
+      msg.setFailed(value);
       break;
-    case 3:
-      var values = /** @type {!Array<!proto.wsman.WorkspaceFeatureFlag>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addFeatureFlags(values[i]);
-      }
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTimeout(value);
       break;
-    case 4:
-      var value = new content$service$api_initializer_pb.WorkspaceInitializer;
-      reader.readMessage(value,content$service$api_initializer_pb.WorkspaceInitializer.deserializeBinaryFromReader);
-      msg.setInitializer(value);
+    case 3:
+      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
+      msg.setPullingImages(value);
       break;
     case 5:
-      var value = new proto.wsman.PortSpec;
-      reader.readMessage(value,proto.wsman.PortSpec.deserializeBinaryFromReader);
-      msg.addPorts(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSnapshot(value);
       break;
     case 6:
-      var value = new proto.wsman.EnvironmentVariable;
-      reader.readMessage(value,proto.wsman.EnvironmentVariable.deserializeBinaryFromReader);
-      msg.addEnvvars(value);
+      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
+      msg.setFinalBackupComplete(value);
+      break;
+    case 7:
+      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
+      msg.setDeployed(value);
       break;
     case 8:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setWorkspaceLocation(value);
+      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
+      msg.setNetworkNotReady(value);
       break;
     case 9:
-      var value = new proto.wsman.GitSpec;
-      reader.readMessage(value,proto.wsman.GitSpec.deserializeBinaryFromReader);
-      msg.setGit(value);
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setFirstUserActivity(value);
       break;
     case 10:
       var value = /** @type {string} */ (reader.readString());
-      msg.setTimeout(value);
+      msg.setHeadlessTaskFailed(value);
       break;
     case 11:
-      var value = /** @type {!proto.wsman.AdmissionLevel} */ (reader.readEnum());
-      msg.setAdmission(value);
+      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
+      msg.setStoppedByRequest(value);
       break;
     case 12:
-      var value = new proto.wsman.IDEImage;
-      reader.readMessage(value,proto.wsman.IDEImage.deserializeBinaryFromReader);
-      msg.setIdeImage(value);
+      var value = new proto.wsman.VolumeSnapshotInfo;
+      reader.readMessage(value,proto.wsman.VolumeSnapshotInfo.deserializeBinaryFromReader);
+      msg.setVolumeSnapshot(value);
       break;
     case 13:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setClass(value);
-      break;
-    case 15:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addSshPublicKeys(value);
-      break;
-    case 16:
-      var value = new proto.wsman.EnvironmentVariable;
-      reader.readMessage(value,proto.wsman.EnvironmentVariable.deserializeBinaryFromReader);
-      msg.addSysEnvvars(value);
-      break;
-    case 17:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addIdeImageLayers(value);
-      break;
-    case 18:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setClosedTimeout(value);
+      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
+      msg.setAborted(value);
       break;
-    case 19:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMaximumLifetime(value);
+    case 14:
+      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
+      msg.setPaused(value);
       break;
     default:
       reader.skipField();
@@ -8639,9 +8911,9 @@ proto.wsman.StartWorkspaceSpec.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.StartWorkspaceSpec.prototype.serializeBinary = function() {
+proto.wsman.WorkspaceConditions.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.StartWorkspaceSpec.serializeBinaryToWriter(this, writer);
+  proto.wsman.WorkspaceConditions.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8649,127 +8921,102 @@ proto.wsman.StartWorkspaceSpec.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.StartWorkspaceSpec} message
+ * @param {!proto.wsman.WorkspaceConditions} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.StartWorkspaceSpec.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.WorkspaceConditions.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getWorkspaceImage();
+  f = message.getFailed();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getFeatureFlagsList();
+  f = message.getTimeout();
   if (f.length > 0) {
-    writer.writePackedEnum(
-      3,
+    writer.writeString(
+      2,
       f
     );
   }
-  f = message.getInitializer();
-  if (f != null) {
-    writer.writeMessage(
-      4,
-      f,
-      content$service$api_initializer_pb.WorkspaceInitializer.serializeBinaryToWriter
+  f = message.getPullingImages();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
     );
   }
-  f = message.getPortsList();
+  f = message.getSnapshot();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       5,
-      f,
-      proto.wsman.PortSpec.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getEnvvarsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getFinalBackupComplete();
+  if (f !== 0.0) {
+    writer.writeEnum(
       6,
-      f,
-      proto.wsman.EnvironmentVariable.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getWorkspaceLocation();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getDeployed();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      7,
+      f
+    );
+  }
+  f = message.getNetworkNotReady();
+  if (f !== 0.0) {
+    writer.writeEnum(
       8,
       f
     );
   }
-  f = message.getGit();
+  f = message.getFirstUserActivity();
   if (f != null) {
     writer.writeMessage(
       9,
       f,
-      proto.wsman.GitSpec.serializeBinaryToWriter
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
-  f = message.getTimeout();
+  f = message.getHeadlessTaskFailed();
   if (f.length > 0) {
     writer.writeString(
       10,
       f
     );
   }
-  f = message.getAdmission();
+  f = message.getStoppedByRequest();
   if (f !== 0.0) {
     writer.writeEnum(
       11,
       f
     );
   }
-  f = message.getIdeImage();
+  f = message.getVolumeSnapshot();
   if (f != null) {
     writer.writeMessage(
       12,
       f,
-      proto.wsman.IDEImage.serializeBinaryToWriter
+      proto.wsman.VolumeSnapshotInfo.serializeBinaryToWriter
     );
   }
-  f = message.getClass();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getAborted();
+  if (f !== 0.0) {
+    writer.writeEnum(
       13,
       f
     );
   }
-  f = message.getSshPublicKeysList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      15,
-      f
-    );
-  }
-  f = message.getSysEnvvarsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      16,
-      f,
-      proto.wsman.EnvironmentVariable.serializeBinaryToWriter
-    );
-  }
-  f = message.getIdeImageLayersList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      17,
-      f
-    );
-  }
-  f = message.getClosedTimeout();
-  if (f.length > 0) {
-    writer.writeString(
-      18,
-      f
-    );
-  }
-  f = message.getMaximumLifetime();
-  if (f.length > 0) {
-    writer.writeString(
-      19,
+  f = message.getPaused();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      14,
       f
     );
   }
@@ -8777,464 +9024,3348 @@ proto.wsman.StartWorkspaceSpec.serializeBinaryToWriter = function(message, write
 
 
 /**
- * optional string workspace_image = 1;
+ * optional string failed = 1;
  * @return {string}
  */
-proto.wsman.StartWorkspaceSpec.prototype.getWorkspaceImage = function() {
+proto.wsman.WorkspaceConditions.prototype.getFailed = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ * @return {!proto.wsman.WorkspaceConditions} returns this
  */
-proto.wsman.StartWorkspaceSpec.prototype.setWorkspaceImage = function(value) {
+proto.wsman.WorkspaceConditions.prototype.setFailed = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * repeated WorkspaceFeatureFlag feature_flags = 3;
- * @return {!Array<!proto.wsman.WorkspaceFeatureFlag>}
+ * optional string timeout = 2;
+ * @return {string}
  */
-proto.wsman.StartWorkspaceSpec.prototype.getFeatureFlagsList = function() {
-  return /** @type {!Array<!proto.wsman.WorkspaceFeatureFlag>} */ (jspb.Message.getRepeatedField(this, 3));
+proto.wsman.WorkspaceConditions.prototype.getTimeout = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {!Array<!proto.wsman.WorkspaceFeatureFlag>} value
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
  */
-proto.wsman.StartWorkspaceSpec.prototype.setFeatureFlagsList = function(value) {
-  return jspb.Message.setField(this, 3, value || []);
+proto.wsman.WorkspaceConditions.prototype.setTimeout = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional WorkspaceConditionBool pulling_images = 3;
+ * @return {!proto.wsman.WorkspaceConditionBool}
+ */
+proto.wsman.WorkspaceConditions.prototype.getPullingImages = function() {
+  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {!proto.wsman.WorkspaceConditionBool} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
+ */
+proto.wsman.WorkspaceConditions.prototype.setPullingImages = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
+/**
+ * optional string snapshot = 5;
+ * @return {string}
+ */
+proto.wsman.WorkspaceConditions.prototype.getSnapshot = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
+ */
+proto.wsman.WorkspaceConditions.prototype.setSnapshot = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional WorkspaceConditionBool final_backup_complete = 6;
+ * @return {!proto.wsman.WorkspaceConditionBool}
+ */
+proto.wsman.WorkspaceConditions.prototype.getFinalBackupComplete = function() {
+  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {!proto.wsman.WorkspaceConditionBool} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
+ */
+proto.wsman.WorkspaceConditions.prototype.setFinalBackupComplete = function(value) {
+  return jspb.Message.setProto3EnumField(this, 6, value);
+};
+
+
+/**
+ * optional WorkspaceConditionBool deployed = 7;
+ * @return {!proto.wsman.WorkspaceConditionBool}
+ */
+proto.wsman.WorkspaceConditions.prototype.getDeployed = function() {
+  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {!proto.wsman.WorkspaceConditionBool} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
+ */
+proto.wsman.WorkspaceConditions.prototype.setDeployed = function(value) {
+  return jspb.Message.setProto3EnumField(this, 7, value);
+};
+
+
+/**
+ * optional WorkspaceConditionBool network_not_ready = 8;
+ * @return {!proto.wsman.WorkspaceConditionBool}
+ */
+proto.wsman.WorkspaceConditions.prototype.getNetworkNotReady = function() {
+  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {!proto.wsman.WorkspaceConditionBool} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
+ */
+proto.wsman.WorkspaceConditions.prototype.setNetworkNotReady = function(value) {
+  return jspb.Message.setProto3EnumField(this, 8, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp first_user_activity = 9;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.wsman.WorkspaceConditions.prototype.getFirstUserActivity = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 9));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
+*/
+proto.wsman.WorkspaceConditions.prototype.setFirstUserActivity = function(value) {
+  return jspb.Message.setWrapperField(this, 9, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceConditions} returns this
+ */
+proto.wsman.WorkspaceConditions.prototype.clearFirstUserActivity = function() {
+  return this.setFirstUserActivity(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.WorkspaceConditions.prototype.hasFirstUserActivity = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
+/**
+ * optional string headless_task_failed = 10;
+ * @return {string}
+ */
+proto.wsman.WorkspaceConditions.prototype.getHeadlessTaskFailed = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
+ */
+proto.wsman.WorkspaceConditions.prototype.setHeadlessTaskFailed = function(value) {
+  return jspb.Message.setProto3StringField(this, 10, value);
+};
+
+
+/**
+ * optional WorkspaceConditionBool stopped_by_request = 11;
+ * @return {!proto.wsman.WorkspaceConditionBool}
+ */
+proto.wsman.WorkspaceConditions.prototype.getStoppedByRequest = function() {
+  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+};
+
+
+/**
+ * @param {!proto.wsman.WorkspaceConditionBool} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
+ */
+proto.wsman.WorkspaceConditions.prototype.setStoppedByRequest = function(value) {
+  return jspb.Message.setProto3EnumField(this, 11, value);
+};
+
+
+/**
+ * optional VolumeSnapshotInfo volume_snapshot = 12;
+ * @return {?proto.wsman.VolumeSnapshotInfo}
+ */
+proto.wsman.WorkspaceConditions.prototype.getVolumeSnapshot = function() {
+  return /** @type{?proto.wsman.VolumeSnapshotInfo} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.VolumeSnapshotInfo, 12));
+};
+
+
+/**
+ * @param {?proto.wsman.VolumeSnapshotInfo|undefined} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
+*/
+proto.wsman.WorkspaceConditions.prototype.setVolumeSnapshot = function(value) {
+  return jspb.Message.setWrapperField(this, 12, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceConditions} returns this
+ */
+proto.wsman.WorkspaceConditions.prototype.clearVolumeSnapshot = function() {
+  return this.setVolumeSnapshot(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.WorkspaceConditions.prototype.hasVolumeSnapshot = function() {
+  return jspb.Message.getField(this, 12) != null;
+};
+
+
+/**
+ * optional WorkspaceConditionBool aborted = 13;
+ * @return {!proto.wsman.WorkspaceConditionBool}
+ */
+proto.wsman.WorkspaceConditions.prototype.getAborted = function() {
+  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 13, 0));
+};
+
+
+/**
+ * @param {!proto.wsman.WorkspaceConditionBool} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
+ */
+proto.wsman.WorkspaceConditions.prototype.setAborted = function(value) {
+  return jspb.Message.setProto3EnumField(this, 13, value);
+};
+
+
+/**
+ * optional WorkspaceConditionBool paused = 14;
+ * @return {!proto.wsman.WorkspaceConditionBool}
+ */
+proto.wsman.WorkspaceConditions.prototype.getPaused = function() {
+  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 14, 0));
+};
+
+
+/**
+ * @param {!proto.wsman.WorkspaceConditionBool} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
+ */
+proto.wsman.WorkspaceConditions.prototype.setPaused = function(value) {
+  return jspb.Message.setProto3EnumField(this, 14, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.WorkspaceMetadata.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceMetadata.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.WorkspaceMetadata} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.WorkspaceMetadata.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    owner: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    metaId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    startedAt: (f = msg.getStartedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    annotationsMap: (f = msg.getAnnotationsMap()) ? f.toObject(includeInstance, undefined) : [],
+    team: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    project: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    metrics: (f = msg.getMetrics()) && proto.wsman.WorkspaceMetadata.Metrics.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.WorkspaceMetadata}
+ */
+proto.wsman.WorkspaceMetadata.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.WorkspaceMetadata;
+  return proto.wsman.WorkspaceMetadata.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.WorkspaceMetadata} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.WorkspaceMetadata}
+ */
+proto.wsman.WorkspaceMetadata.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOwner(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMetaId(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setStartedAt(value);
+      break;
+    case 4:
+      var value = msg.getAnnotationsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTeam(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProject(value);
+      break;
+    case 7:
+      var value = new proto.wsman.WorkspaceMetadata.Metrics;
+      reader.readMessage(value,proto.wsman.WorkspaceMetadata.Metrics.deserializeBinaryFromReader);
+      msg.setMetrics(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.WorkspaceMetadata.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.WorkspaceMetadata.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.WorkspaceMetadata} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.WorkspaceMetadata.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getOwner();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getMetaId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getStartedAt();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getAnnotationsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(4, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getMetrics();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.wsman.WorkspaceMetadata.Metrics.serializeBinaryToWriter
+    );
+  }
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.WorkspaceMetadata.ImageInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceMetadata.ImageInfo.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.WorkspaceMetadata.ImageInfo} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.WorkspaceMetadata.ImageInfo.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    totalSize: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    workspaceImageSize: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.WorkspaceMetadata.ImageInfo}
+ */
+proto.wsman.WorkspaceMetadata.ImageInfo.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.WorkspaceMetadata.ImageInfo;
+  return proto.wsman.WorkspaceMetadata.ImageInfo.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.WorkspaceMetadata.ImageInfo} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.WorkspaceMetadata.ImageInfo}
+ */
+proto.wsman.WorkspaceMetadata.ImageInfo.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTotalSize(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setWorkspaceImageSize(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.WorkspaceMetadata.ImageInfo.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.WorkspaceMetadata.ImageInfo.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.WorkspaceMetadata.ImageInfo} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.WorkspaceMetadata.ImageInfo.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTotalSize();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getWorkspaceImageSize();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 total_size = 1;
+ * @return {number}
+ */
+proto.wsman.WorkspaceMetadata.ImageInfo.prototype.getTotalSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsman.WorkspaceMetadata.ImageInfo} returns this
+ */
+proto.wsman.WorkspaceMetadata.ImageInfo.prototype.setTotalSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional int64 workspace_image_size = 2;
+ * @return {number}
+ */
+proto.wsman.WorkspaceMetadata.ImageInfo.prototype.getWorkspaceImageSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsman.WorkspaceMetadata.ImageInfo} returns this
+ */
+proto.wsman.WorkspaceMetadata.ImageInfo.prototype.setWorkspaceImageSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.WorkspaceMetadata.Metrics.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceMetadata.Metrics.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.WorkspaceMetadata.Metrics} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.WorkspaceMetadata.Metrics.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    image: (f = msg.getImage()) && proto.wsman.WorkspaceMetadata.ImageInfo.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.WorkspaceMetadata.Metrics}
+ */
+proto.wsman.WorkspaceMetadata.Metrics.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.WorkspaceMetadata.Metrics;
+  return proto.wsman.WorkspaceMetadata.Metrics.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.WorkspaceMetadata.Metrics} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.WorkspaceMetadata.Metrics}
+ */
+proto.wsman.WorkspaceMetadata.Metrics.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.wsman.WorkspaceMetadata.ImageInfo;
+      reader.readMessage(value,proto.wsman.WorkspaceMetadata.ImageInfo.deserializeBinaryFromReader);
+      msg.setImage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.WorkspaceMetadata.Metrics.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.WorkspaceMetadata.Metrics.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.WorkspaceMetadata.Metrics} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.WorkspaceMetadata.Metrics.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getImage();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.wsman.WorkspaceMetadata.ImageInfo.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional ImageInfo image = 1;
+ * @return {?proto.wsman.WorkspaceMetadata.ImageInfo}
+ */
+proto.wsman.WorkspaceMetadata.Metrics.prototype.getImage = function() {
+  return /** @type{?proto.wsman.WorkspaceMetadata.ImageInfo} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceMetadata.ImageInfo, 1));
+};
+
+
+/**
+ * @param {?proto.wsman.WorkspaceMetadata.ImageInfo|undefined} value
+ * @return {!proto.wsman.WorkspaceMetadata.Metrics} returns this
+*/
+proto.wsman.WorkspaceMetadata.Metrics.prototype.setImage = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceMetadata.Metrics} returns this
+ */
+proto.wsman.WorkspaceMetadata.Metrics.prototype.clearImage = function() {
+  return this.setImage(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.WorkspaceMetadata.Metrics.prototype.hasImage = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string owner = 1;
+ * @return {string}
+ */
+proto.wsman.WorkspaceMetadata.prototype.getOwner = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceMetadata} returns this
+ */
+proto.wsman.WorkspaceMetadata.prototype.setOwner = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string meta_id = 2;
+ * @return {string}
+ */
+proto.wsman.WorkspaceMetadata.prototype.getMetaId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceMetadata} returns this
+ */
+proto.wsman.WorkspaceMetadata.prototype.setMetaId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp started_at = 3;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.wsman.WorkspaceMetadata.prototype.getStartedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.wsman.WorkspaceMetadata} returns this
+*/
+proto.wsman.WorkspaceMetadata.prototype.setStartedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceMetadata} returns this
+ */
+proto.wsman.WorkspaceMetadata.prototype.clearStartedAt = function() {
+  return this.setStartedAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.WorkspaceMetadata.prototype.hasStartedAt = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * map<string, string> annotations = 4;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.wsman.WorkspaceMetadata.prototype.getAnnotationsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 4, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.wsman.WorkspaceMetadata} returns this
+ */
+proto.wsman.WorkspaceMetadata.prototype.clearAnnotationsMap = function() {
+  this.getAnnotationsMap().clear();
+  return this;};
+
+
+/**
+ * optional string team = 5;
+ * @return {string}
+ */
+proto.wsman.WorkspaceMetadata.prototype.getTeam = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceMetadata} returns this
+ */
+proto.wsman.WorkspaceMetadata.prototype.setTeam = function(value) {
+  return jspb.Message.setField(this, 5, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.wsman.WorkspaceMetadata} returns this
+ */
+proto.wsman.WorkspaceMetadata.prototype.clearTeam = function() {
+  return jspb.Message.setField(this, 5, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.WorkspaceMetadata.prototype.hasTeam = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional string project = 6;
+ * @return {string}
+ */
+proto.wsman.WorkspaceMetadata.prototype.getProject = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceMetadata} returns this
+ */
+proto.wsman.WorkspaceMetadata.prototype.setProject = function(value) {
+  return jspb.Message.setField(this, 6, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.wsman.WorkspaceMetadata} returns this
+ */
+proto.wsman.WorkspaceMetadata.prototype.clearProject = function() {
+  return jspb.Message.setField(this, 6, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.WorkspaceMetadata.prototype.hasProject = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional Metrics metrics = 7;
+ * @return {?proto.wsman.WorkspaceMetadata.Metrics}
+ */
+proto.wsman.WorkspaceMetadata.prototype.getMetrics = function() {
+  return /** @type{?proto.wsman.WorkspaceMetadata.Metrics} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceMetadata.Metrics, 7));
+};
+
+
+/**
+ * @param {?proto.wsman.WorkspaceMetadata.Metrics|undefined} value
+ * @return {!proto.wsman.WorkspaceMetadata} returns this
+*/
+proto.wsman.WorkspaceMetadata.prototype.setMetrics = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceMetadata} returns this
+ */
+proto.wsman.WorkspaceMetadata.prototype.clearMetrics = function() {
+  return this.setMetrics(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.WorkspaceMetadata.prototype.hasMetrics = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.WorkspaceRuntimeInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceRuntimeInfo.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.WorkspaceRuntimeInfo} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.WorkspaceRuntimeInfo.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    nodeName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    podName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    nodeIp: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.WorkspaceRuntimeInfo}
+ */
+proto.wsman.WorkspaceRuntimeInfo.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.WorkspaceRuntimeInfo;
+  return proto.wsman.WorkspaceRuntimeInfo.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.WorkspaceRuntimeInfo} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.WorkspaceRuntimeInfo}
+ */
+proto.wsman.WorkspaceRuntimeInfo.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNodeName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPodName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNodeIp(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.WorkspaceRuntimeInfo.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.WorkspaceRuntimeInfo.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.WorkspaceRuntimeInfo} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.WorkspaceRuntimeInfo.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNodeName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPodName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getNodeIp();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string node_name = 1;
+ * @return {string}
+ */
+proto.wsman.WorkspaceRuntimeInfo.prototype.getNodeName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceRuntimeInfo} returns this
+ */
+proto.wsman.WorkspaceRuntimeInfo.prototype.setNodeName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string pod_name = 2;
+ * @return {string}
+ */
+proto.wsman.WorkspaceRuntimeInfo.prototype.getPodName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceRuntimeInfo} returns this
+ */
+proto.wsman.WorkspaceRuntimeInfo.prototype.setPodName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string node_ip = 3;
+ * @return {string}
+ */
+proto.wsman.WorkspaceRuntimeInfo.prototype.getNodeIp = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceRuntimeInfo} returns this
+ */
+proto.wsman.WorkspaceRuntimeInfo.prototype.setNodeIp = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.WorkspaceAuthentication.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceAuthentication.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.WorkspaceAuthentication} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.WorkspaceAuthentication.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    admission: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    ownerToken: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.WorkspaceAuthentication}
+ */
+proto.wsman.WorkspaceAuthentication.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.WorkspaceAuthentication;
+  return proto.wsman.WorkspaceAuthentication.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.WorkspaceAuthentication} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.WorkspaceAuthentication}
+ */
+proto.wsman.WorkspaceAuthentication.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.wsman.AdmissionLevel} */ (reader.readEnum());
+      msg.setAdmission(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOwnerToken(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.WorkspaceAuthentication.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.WorkspaceAuthentication.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.WorkspaceAuthentication} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.WorkspaceAuthentication.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAdmission();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getOwnerToken();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional AdmissionLevel admission = 1;
+ * @return {!proto.wsman.AdmissionLevel}
+ */
+proto.wsman.WorkspaceAuthentication.prototype.getAdmission = function() {
+  return /** @type {!proto.wsman.AdmissionLevel} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.wsman.AdmissionLevel} value
+ * @return {!proto.wsman.WorkspaceAuthentication} returns this
+ */
+proto.wsman.WorkspaceAuthentication.prototype.setAdmission = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional string owner_token = 2;
+ * @return {string}
+ */
+proto.wsman.WorkspaceAuthentication.prototype.getOwnerToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceAuthentication} returns this
+ */
+proto.wsman.WorkspaceAuthentication.prototype.setOwnerToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.wsman.StartWorkspaceSpec.repeatedFields_ = [3,5,6,15,16,17];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.StartWorkspaceSpec.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.StartWorkspaceSpec} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.StartWorkspaceSpec.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    workspaceImage: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    featureFlagsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    initializer: (f = msg.getInitializer()) && content$service$api_initializer_pb.WorkspaceInitializer.toObject(includeInstance, f),
+    portsList: jspb.Message.toObjectList(msg.getPortsList(),
+    proto.wsman.PortSpec.toObject, includeInstance),
+    envvarsList: jspb.Message.toObjectList(msg.getEnvvarsList(),
+    proto.wsman.EnvironmentVariable.toObject, includeInstance),
+    workspaceLocation: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    git: (f = msg.getGit()) && proto.wsman.GitSpec.toObject(includeInstance, f),
+    timeout: jspb.Message.getFieldWithDefault(msg, 10, ""),
+    admission: jspb.Message.getFieldWithDefault(msg, 11, 0),
+    ideImage: (f = msg.getIdeImage()) && proto.wsman.IDEImage.toObject(includeInstance, f),
+    pb_class: jspb.Message.getFieldWithDefault(msg, 13, ""),
+    sshPublicKeysList: (f = jspb.Message.getRepeatedField(msg, 15)) == null ? undefined : f,
+    sysEnvvarsList: jspb.Message.toObjectList(msg.getSysEnvvarsList(),
+    proto.wsman.EnvironmentVariable.toObject, includeInstance),
+    ideImageLayersList: (f = jspb.Message.getRepeatedField(msg, 17)) == null ? undefined : f,
+    closedTimeout: jspb.Message.getFieldWithDefault(msg, 18, ""),
+    maximumLifetime: jspb.Message.getFieldWithDefault(msg, 19, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.StartWorkspaceSpec}
+ */
+proto.wsman.StartWorkspaceSpec.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.StartWorkspaceSpec;
+  return proto.wsman.StartWorkspaceSpec.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.StartWorkspaceSpec} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.StartWorkspaceSpec}
+ */
+proto.wsman.StartWorkspaceSpec.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setWorkspaceImage(value);
+      break;
+    case 3:
+      var values = /** @type {!Array<!proto.wsman.WorkspaceFeatureFlag>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addFeatureFlags(values[i]);
+      }
+      break;
+    case 4:
+      var value = new content$service$api_initializer_pb.WorkspaceInitializer;
+      reader.readMessage(value,content$service$api_initializer_pb.WorkspaceInitializer.deserializeBinaryFromReader);
+      msg.setInitializer(value);
+      break;
+    case 5:
+      var value = new proto.wsman.PortSpec;
+      reader.readMessage(value,proto.wsman.PortSpec.deserializeBinaryFromReader);
+      msg.addPorts(value);
+      break;
+    case 6:
+      var value = new proto.wsman.EnvironmentVariable;
+      reader.readMessage(value,proto.wsman.EnvironmentVariable.deserializeBinaryFromReader);
+      msg.addEnvvars(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setWorkspaceLocation(value);
+      break;
+    case 9:
+      var value = new proto.wsman.GitSpec;
+      reader.readMessage(value,proto.wsman.GitSpec.deserializeBinaryFromReader);
+      msg.setGit(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTimeout(value);
+      break;
+    case 11:
+      var value = /** @type {!proto.wsman.AdmissionLevel} */ (reader.readEnum());
+      msg.setAdmission(value);
+      break;
+    case 12:
+      var value = new proto.wsman.IDEImage;
+      reader.readMessage(value,proto.wsman.IDEImage.deserializeBinaryFromReader);
+      msg.setIdeImage(value);
+      break;
+    case 13:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClass(value);
+      break;
+    case 15:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addSshPublicKeys(value);
+      break;
+    case 16:
+      var value = new proto.wsman.EnvironmentVariable;
+      reader.readMessage(value,proto.wsman.EnvironmentVariable.deserializeBinaryFromReader);
+      msg.addSysEnvvars(value);
+      break;
+    case 17:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addIdeImageLayers(value);
+      break;
+    case 18:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClosedTimeout(value);
+      break;
+    case 19:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMaximumLifetime(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.StartWorkspaceSpec.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.StartWorkspaceSpec} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.StartWorkspaceSpec.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getWorkspaceImage();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getFeatureFlagsList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      3,
+      f
+    );
+  }
+  f = message.getInitializer();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      content$service$api_initializer_pb.WorkspaceInitializer.serializeBinaryToWriter
+    );
+  }
+  f = message.getPortsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      5,
+      f,
+      proto.wsman.PortSpec.serializeBinaryToWriter
+    );
+  }
+  f = message.getEnvvarsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      6,
+      f,
+      proto.wsman.EnvironmentVariable.serializeBinaryToWriter
+    );
+  }
+  f = message.getWorkspaceLocation();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+  f = message.getGit();
+  if (f != null) {
+    writer.writeMessage(
+      9,
+      f,
+      proto.wsman.GitSpec.serializeBinaryToWriter
+    );
+  }
+  f = message.getTimeout();
+  if (f.length > 0) {
+    writer.writeString(
+      10,
+      f
+    );
+  }
+  f = message.getAdmission();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      11,
+      f
+    );
+  }
+  f = message.getIdeImage();
+  if (f != null) {
+    writer.writeMessage(
+      12,
+      f,
+      proto.wsman.IDEImage.serializeBinaryToWriter
+    );
+  }
+  f = message.getClass();
+  if (f.length > 0) {
+    writer.writeString(
+      13,
+      f
+    );
+  }
+  f = message.getSshPublicKeysList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      15,
+      f
+    );
+  }
+  f = message.getSysEnvvarsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      16,
+      f,
+      proto.wsman.EnvironmentVariable.serializeBinaryToWriter
+    );
+  }
+  f = message.getIdeImageLayersList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      17,
+      f
+    );
+  }
+  f = message.getClosedTimeout();
+  if (f.length > 0) {
+    writer.writeString(
+      18,
+      f
+    );
+  }
+  f = message.getMaximumLifetime();
+  if (f.length > 0) {
+    writer.writeString(
+      19,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string workspace_image = 1;
+ * @return {string}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.getWorkspaceImage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.setWorkspaceImage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated WorkspaceFeatureFlag feature_flags = 3;
+ * @return {!Array<!proto.wsman.WorkspaceFeatureFlag>}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.getFeatureFlagsList = function() {
+  return /** @type {!Array<!proto.wsman.WorkspaceFeatureFlag>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.wsman.WorkspaceFeatureFlag>} value
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.setFeatureFlagsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {!proto.wsman.WorkspaceFeatureFlag} value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.addFeatureFlags = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.clearFeatureFlagsList = function() {
+  return this.setFeatureFlagsList([]);
+};
+
+
+/**
+ * optional contentservice.WorkspaceInitializer initializer = 4;
+ * @return {?proto.contentservice.WorkspaceInitializer}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.getInitializer = function() {
+  return /** @type{?proto.contentservice.WorkspaceInitializer} */ (
+    jspb.Message.getWrapperField(this, content$service$api_initializer_pb.WorkspaceInitializer, 4));
+};
+
+
+/**
+ * @param {?proto.contentservice.WorkspaceInitializer|undefined} value
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+*/
+proto.wsman.StartWorkspaceSpec.prototype.setInitializer = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.clearInitializer = function() {
+  return this.setInitializer(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.hasInitializer = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * repeated PortSpec ports = 5;
+ * @return {!Array<!proto.wsman.PortSpec>}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.getPortsList = function() {
+  return /** @type{!Array<!proto.wsman.PortSpec>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.PortSpec, 5));
+};
+
+
+/**
+ * @param {!Array<!proto.wsman.PortSpec>} value
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+*/
+proto.wsman.StartWorkspaceSpec.prototype.setPortsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 5, value);
+};
+
+
+/**
+ * @param {!proto.wsman.PortSpec=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.PortSpec}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.addPorts = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.wsman.PortSpec, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.clearPortsList = function() {
+  return this.setPortsList([]);
+};
+
+
+/**
+ * repeated EnvironmentVariable envvars = 6;
+ * @return {!Array<!proto.wsman.EnvironmentVariable>}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.getEnvvarsList = function() {
+  return /** @type{!Array<!proto.wsman.EnvironmentVariable>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.EnvironmentVariable, 6));
+};
+
+
+/**
+ * @param {!Array<!proto.wsman.EnvironmentVariable>} value
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+*/
+proto.wsman.StartWorkspaceSpec.prototype.setEnvvarsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 6, value);
+};
+
+
+/**
+ * @param {!proto.wsman.EnvironmentVariable=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.EnvironmentVariable}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.addEnvvars = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.wsman.EnvironmentVariable, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.clearEnvvarsList = function() {
+  return this.setEnvvarsList([]);
+};
+
+
+/**
+ * optional string workspace_location = 8;
+ * @return {string}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.getWorkspaceLocation = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.setWorkspaceLocation = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
+};
+
+
+/**
+ * optional GitSpec git = 9;
+ * @return {?proto.wsman.GitSpec}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.getGit = function() {
+  return /** @type{?proto.wsman.GitSpec} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.GitSpec, 9));
+};
+
+
+/**
+ * @param {?proto.wsman.GitSpec|undefined} value
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+*/
+proto.wsman.StartWorkspaceSpec.prototype.setGit = function(value) {
+  return jspb.Message.setWrapperField(this, 9, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.clearGit = function() {
+  return this.setGit(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.hasGit = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
+/**
+ * optional string timeout = 10;
+ * @return {string}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.getTimeout = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.setTimeout = function(value) {
+  return jspb.Message.setProto3StringField(this, 10, value);
+};
+
+
+/**
+ * optional AdmissionLevel admission = 11;
+ * @return {!proto.wsman.AdmissionLevel}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.getAdmission = function() {
+  return /** @type {!proto.wsman.AdmissionLevel} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+};
+
+
+/**
+ * @param {!proto.wsman.AdmissionLevel} value
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.setAdmission = function(value) {
+  return jspb.Message.setProto3EnumField(this, 11, value);
+};
+
+
+/**
+ * optional IDEImage ide_image = 12;
+ * @return {?proto.wsman.IDEImage}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.getIdeImage = function() {
+  return /** @type{?proto.wsman.IDEImage} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.IDEImage, 12));
+};
+
+
+/**
+ * @param {?proto.wsman.IDEImage|undefined} value
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+*/
+proto.wsman.StartWorkspaceSpec.prototype.setIdeImage = function(value) {
+  return jspb.Message.setWrapperField(this, 12, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.clearIdeImage = function() {
+  return this.setIdeImage(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.hasIdeImage = function() {
+  return jspb.Message.getField(this, 12) != null;
+};
+
+
+/**
+ * optional string class = 13;
+ * @return {string}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.getClass = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 13, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.setClass = function(value) {
+  return jspb.Message.setProto3StringField(this, 13, value);
+};
+
+
+/**
+ * repeated string ssh_public_keys = 15;
+ * @return {!Array<string>}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.getSshPublicKeysList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 15));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.setSshPublicKeysList = function(value) {
+  return jspb.Message.setField(this, 15, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.addSshPublicKeys = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 15, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.clearSshPublicKeysList = function() {
+  return this.setSshPublicKeysList([]);
+};
+
+
+/**
+ * repeated EnvironmentVariable sys_envvars = 16;
+ * @return {!Array<!proto.wsman.EnvironmentVariable>}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.getSysEnvvarsList = function() {
+  return /** @type{!Array<!proto.wsman.EnvironmentVariable>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.EnvironmentVariable, 16));
+};
+
+
+/**
+ * @param {!Array<!proto.wsman.EnvironmentVariable>} value
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+*/
+proto.wsman.StartWorkspaceSpec.prototype.setSysEnvvarsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 16, value);
+};
+
+
+/**
+ * @param {!proto.wsman.EnvironmentVariable=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.EnvironmentVariable}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.addSysEnvvars = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 16, opt_value, proto.wsman.EnvironmentVariable, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.clearSysEnvvarsList = function() {
+  return this.setSysEnvvarsList([]);
+};
+
+
+/**
+ * repeated string ide_image_layers = 17;
+ * @return {!Array<string>}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.getIdeImageLayersList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 17));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.setIdeImageLayersList = function(value) {
+  return jspb.Message.setField(this, 17, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.addIdeImageLayers = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 17, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.clearIdeImageLayersList = function() {
+  return this.setIdeImageLayersList([]);
+};
+
+
+/**
+ * optional string closed_timeout = 18;
+ * @return {string}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.getClosedTimeout = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 18, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.setClosedTimeout = function(value) {
+  return jspb.Message.setProto3StringField(this, 18, value);
+};
+
+
+/**
+ * optional string maximum_lifetime = 19;
+ * @return {string}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.getMaximumLifetime = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 19, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.setMaximumLifetime = function(value) {
+  return jspb.Message.setProto3StringField(this, 19, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.GitSpec.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.GitSpec.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.GitSpec} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.GitSpec.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    username: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    email: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.GitSpec}
+ */
+proto.wsman.GitSpec.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.GitSpec;
+  return proto.wsman.GitSpec.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.GitSpec} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.GitSpec}
+ */
+proto.wsman.GitSpec.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUsername(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEmail(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.GitSpec.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.GitSpec.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.GitSpec} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.GitSpec.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getEmail();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string username = 1;
+ * @return {string}
+ */
+proto.wsman.GitSpec.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.GitSpec} returns this
+ */
+proto.wsman.GitSpec.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string email = 2;
+ * @return {string}
+ */
+proto.wsman.GitSpec.prototype.getEmail = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.GitSpec} returns this
+ */
+proto.wsman.GitSpec.prototype.setEmail = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.EnvironmentVariable.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.EnvironmentVariable.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.EnvironmentVariable} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.EnvironmentVariable.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    value: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    secret: (f = msg.getSecret()) && proto.wsman.EnvironmentVariable.SecretKeyRef.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.EnvironmentVariable}
+ */
+proto.wsman.EnvironmentVariable.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.EnvironmentVariable;
+  return proto.wsman.EnvironmentVariable.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.EnvironmentVariable} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.EnvironmentVariable}
+ */
+proto.wsman.EnvironmentVariable.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setValue(value);
+      break;
+    case 3:
+      var value = new proto.wsman.EnvironmentVariable.SecretKeyRef;
+      reader.readMessage(value,proto.wsman.EnvironmentVariable.SecretKeyRef.deserializeBinaryFromReader);
+      msg.setSecret(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.EnvironmentVariable.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.EnvironmentVariable.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.EnvironmentVariable} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.EnvironmentVariable.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getValue();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getSecret();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.wsman.EnvironmentVariable.SecretKeyRef.serializeBinaryToWriter
+    );
+  }
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.EnvironmentVariable.SecretKeyRef.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.EnvironmentVariable.SecretKeyRef.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.EnvironmentVariable.SecretKeyRef} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.EnvironmentVariable.SecretKeyRef.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    secretName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    key: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.EnvironmentVariable.SecretKeyRef}
+ */
+proto.wsman.EnvironmentVariable.SecretKeyRef.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.EnvironmentVariable.SecretKeyRef;
+  return proto.wsman.EnvironmentVariable.SecretKeyRef.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.EnvironmentVariable.SecretKeyRef} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.EnvironmentVariable.SecretKeyRef}
+ */
+proto.wsman.EnvironmentVariable.SecretKeyRef.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSecretName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setKey(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.EnvironmentVariable.SecretKeyRef.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.EnvironmentVariable.SecretKeyRef.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.EnvironmentVariable.SecretKeyRef} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.EnvironmentVariable.SecretKeyRef.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSecretName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getKey();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string secret_name = 1;
+ * @return {string}
+ */
+proto.wsman.EnvironmentVariable.SecretKeyRef.prototype.getSecretName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.EnvironmentVariable.SecretKeyRef} returns this
+ */
+proto.wsman.EnvironmentVariable.SecretKeyRef.prototype.setSecretName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string key = 2;
+ * @return {string}
+ */
+proto.wsman.EnvironmentVariable.SecretKeyRef.prototype.getKey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.EnvironmentVariable.SecretKeyRef} returns this
+ */
+proto.wsman.EnvironmentVariable.SecretKeyRef.prototype.setKey = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.wsman.EnvironmentVariable.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.EnvironmentVariable} returns this
+ */
+proto.wsman.EnvironmentVariable.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string value = 2;
+ * @return {string}
+ */
+proto.wsman.EnvironmentVariable.prototype.getValue = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.EnvironmentVariable} returns this
+ */
+proto.wsman.EnvironmentVariable.prototype.setValue = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional SecretKeyRef secret = 3;
+ * @return {?proto.wsman.EnvironmentVariable.SecretKeyRef}
+ */
+proto.wsman.EnvironmentVariable.prototype.getSecret = function() {
+  return /** @type{?proto.wsman.EnvironmentVariable.SecretKeyRef} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.EnvironmentVariable.SecretKeyRef, 3));
+};
+
+
+/**
+ * @param {?proto.wsman.EnvironmentVariable.SecretKeyRef|undefined} value
+ * @return {!proto.wsman.EnvironmentVariable} returns this
+*/
+proto.wsman.EnvironmentVariable.prototype.setSecret = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.EnvironmentVariable} returns this
+ */
+proto.wsman.EnvironmentVariable.prototype.clearSecret = function() {
+  return this.setSecret(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.EnvironmentVariable.prototype.hasSecret = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.wsman.ExposedPorts.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.ExposedPorts.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.ExposedPorts.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.ExposedPorts} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.ExposedPorts.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    portsList: jspb.Message.toObjectList(msg.getPortsList(),
+    proto.wsman.PortSpec.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.ExposedPorts}
+ */
+proto.wsman.ExposedPorts.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.ExposedPorts;
+  return proto.wsman.ExposedPorts.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.ExposedPorts} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.ExposedPorts}
+ */
+proto.wsman.ExposedPorts.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.wsman.PortSpec;
+      reader.readMessage(value,proto.wsman.PortSpec.deserializeBinaryFromReader);
+      msg.addPorts(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {!proto.wsman.WorkspaceFeatureFlag} value
- * @param {number=} opt_index
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.wsman.StartWorkspaceSpec.prototype.addFeatureFlags = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+proto.wsman.ExposedPorts.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.ExposedPorts.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.ExposedPorts} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.StartWorkspaceSpec.prototype.clearFeatureFlagsList = function() {
-  return this.setFeatureFlagsList([]);
+proto.wsman.ExposedPorts.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPortsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.wsman.PortSpec.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional contentservice.WorkspaceInitializer initializer = 4;
- * @return {?proto.contentservice.WorkspaceInitializer}
+ * repeated PortSpec ports = 1;
+ * @return {!Array<!proto.wsman.PortSpec>}
  */
-proto.wsman.StartWorkspaceSpec.prototype.getInitializer = function() {
-  return /** @type{?proto.contentservice.WorkspaceInitializer} */ (
-    jspb.Message.getWrapperField(this, content$service$api_initializer_pb.WorkspaceInitializer, 4));
+proto.wsman.ExposedPorts.prototype.getPortsList = function() {
+  return /** @type{!Array<!proto.wsman.PortSpec>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.PortSpec, 1));
 };
 
 
 /**
- * @param {?proto.contentservice.WorkspaceInitializer|undefined} value
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ * @param {!Array<!proto.wsman.PortSpec>} value
+ * @return {!proto.wsman.ExposedPorts} returns this
 */
-proto.wsman.StartWorkspaceSpec.prototype.setInitializer = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+proto.wsman.ExposedPorts.prototype.setPortsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ * @param {!proto.wsman.PortSpec=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.PortSpec}
  */
-proto.wsman.StartWorkspaceSpec.prototype.clearInitializer = function() {
-  return this.setInitializer(undefined);
+proto.wsman.ExposedPorts.prototype.addPorts = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.wsman.PortSpec, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.ExposedPorts} returns this
  */
-proto.wsman.StartWorkspaceSpec.prototype.hasInitializer = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.wsman.ExposedPorts.prototype.clearPortsList = function() {
+  return this.setPortsList([]);
 };
 
 
+
 /**
- * repeated PortSpec ports = 5;
- * @return {!Array<!proto.wsman.PortSpec>}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.wsman.StartWorkspaceSpec.prototype.getPortsList = function() {
-  return /** @type{!Array<!proto.wsman.PortSpec>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.wsman.PortSpec, 5));
-};
+proto.wsman.SSHPublicKeys.repeatedFields_ = [1];
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {!Array<!proto.wsman.PortSpec>} value
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
-*/
-proto.wsman.StartWorkspaceSpec.prototype.setPortsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 5, value);
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.SSHPublicKeys.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.SSHPublicKeys.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {!proto.wsman.PortSpec=} opt_value
- * @param {number=} opt_index
- * @return {!proto.wsman.PortSpec}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.SSHPublicKeys} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.StartWorkspaceSpec.prototype.addPorts = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.wsman.PortSpec, opt_index);
+proto.wsman.SSHPublicKeys.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    keysList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.SSHPublicKeys}
  */
-proto.wsman.StartWorkspaceSpec.prototype.clearPortsList = function() {
-  return this.setPortsList([]);
+proto.wsman.SSHPublicKeys.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.SSHPublicKeys;
+  return proto.wsman.SSHPublicKeys.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * repeated EnvironmentVariable envvars = 6;
- * @return {!Array<!proto.wsman.EnvironmentVariable>}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.SSHPublicKeys} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.SSHPublicKeys}
  */
-proto.wsman.StartWorkspaceSpec.prototype.getEnvvarsList = function() {
-  return /** @type{!Array<!proto.wsman.EnvironmentVariable>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.wsman.EnvironmentVariable, 6));
+proto.wsman.SSHPublicKeys.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addKeys(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {!Array<!proto.wsman.EnvironmentVariable>} value
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
-*/
-proto.wsman.StartWorkspaceSpec.prototype.setEnvvarsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 6, value);
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.SSHPublicKeys.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.SSHPublicKeys.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {!proto.wsman.EnvironmentVariable=} opt_value
- * @param {number=} opt_index
- * @return {!proto.wsman.EnvironmentVariable}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.SSHPublicKeys} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.StartWorkspaceSpec.prototype.addEnvvars = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.wsman.EnvironmentVariable, opt_index);
+proto.wsman.SSHPublicKeys.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getKeysList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ * repeated string keys = 1;
+ * @return {!Array<string>}
  */
-proto.wsman.StartWorkspaceSpec.prototype.clearEnvvarsList = function() {
-  return this.setEnvvarsList([]);
+proto.wsman.SSHPublicKeys.prototype.getKeysList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * optional string workspace_location = 8;
- * @return {string}
+ * @param {!Array<string>} value
+ * @return {!proto.wsman.SSHPublicKeys} returns this
  */
-proto.wsman.StartWorkspaceSpec.prototype.getWorkspaceLocation = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+proto.wsman.SSHPublicKeys.prototype.setKeysList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ * @param {number=} opt_index
+ * @return {!proto.wsman.SSHPublicKeys} returns this
  */
-proto.wsman.StartWorkspaceSpec.prototype.setWorkspaceLocation = function(value) {
-  return jspb.Message.setProto3StringField(this, 8, value);
+proto.wsman.SSHPublicKeys.prototype.addKeys = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
- * optional GitSpec git = 9;
- * @return {?proto.wsman.GitSpec}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.SSHPublicKeys} returns this
  */
-proto.wsman.StartWorkspaceSpec.prototype.getGit = function() {
-  return /** @type{?proto.wsman.GitSpec} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.GitSpec, 9));
+proto.wsman.SSHPublicKeys.prototype.clearKeysList = function() {
+  return this.setKeysList([]);
 };
 
 
-/**
- * @param {?proto.wsman.GitSpec|undefined} value
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
-*/
-proto.wsman.StartWorkspaceSpec.prototype.setGit = function(value) {
-  return jspb.Message.setWrapperField(this, 9, value);
-};
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.wsman.StartWorkspaceSpec.prototype.clearGit = function() {
-  return this.setGit(undefined);
+proto.wsman.DescribeClusterRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.DescribeClusterRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.DescribeClusterRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.StartWorkspaceSpec.prototype.hasGit = function() {
-  return jspb.Message.getField(this, 9) != null;
-};
+proto.wsman.DescribeClusterRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
 
+  };
 
-/**
- * optional string timeout = 10;
- * @return {string}
- */
-proto.wsman.StartWorkspaceSpec.prototype.getTimeout = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.DescribeClusterRequest}
  */
-proto.wsman.StartWorkspaceSpec.prototype.setTimeout = function(value) {
-  return jspb.Message.setProto3StringField(this, 10, value);
+proto.wsman.DescribeClusterRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.DescribeClusterRequest;
+  return proto.wsman.DescribeClusterRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * optional AdmissionLevel admission = 11;
- * @return {!proto.wsman.AdmissionLevel}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.DescribeClusterRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.DescribeClusterRequest}
  */
-proto.wsman.StartWorkspaceSpec.prototype.getAdmission = function() {
-  return /** @type {!proto.wsman.AdmissionLevel} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+proto.wsman.DescribeClusterRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {!proto.wsman.AdmissionLevel} value
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.wsman.StartWorkspaceSpec.prototype.setAdmission = function(value) {
-  return jspb.Message.setProto3EnumField(this, 11, value);
+proto.wsman.DescribeClusterRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.DescribeClusterRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * optional IDEImage ide_image = 12;
- * @return {?proto.wsman.IDEImage}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.DescribeClusterRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.StartWorkspaceSpec.prototype.getIdeImage = function() {
-  return /** @type{?proto.wsman.IDEImage} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.IDEImage, 12));
+proto.wsman.DescribeClusterRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
 };
 
 
+
 /**
- * @param {?proto.wsman.IDEImage|undefined} value
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
-*/
-proto.wsman.StartWorkspaceSpec.prototype.setIdeImage = function(value) {
-  return jspb.Message.setWrapperField(this, 12, value);
-};
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.wsman.DescribeClusterResponse.repeatedFields_ = [1,3,4];
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.wsman.StartWorkspaceSpec.prototype.clearIdeImage = function() {
-  return this.setIdeImage(undefined);
+proto.wsman.DescribeClusterResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.DescribeClusterResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.DescribeClusterResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.StartWorkspaceSpec.prototype.hasIdeImage = function() {
-  return jspb.Message.getField(this, 12) != null;
+proto.wsman.DescribeClusterResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    workspaceClassesList: jspb.Message.toObjectList(msg.getWorkspaceClassesList(),
+    proto.wsman.WorkspaceClass.toObject, includeInstance),
+    preferredWorkspaceClass: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    workspaceQuotasList: jspb.Message.toObjectList(msg.getWorkspaceQuotasList(),
+    proto.wsman.WorkspaceQuota.toObject, includeInstance),
+    organizationTimeoutsList: jspb.Message.toObjectList(msg.getOrganizationTimeoutsList(),
+    proto.wsman.OrganizationTimeouts.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional string class = 13;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.DescribeClusterResponse}
  */
-proto.wsman.StartWorkspaceSpec.prototype.getClass = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 13, ""));
+proto.wsman.DescribeClusterResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.DescribeClusterResponse;
+  return proto.wsman.DescribeClusterResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.DescribeClusterResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.DescribeClusterResponse}
  */
-proto.wsman.StartWorkspaceSpec.prototype.setClass = function(value) {
-  return jspb.Message.setProto3StringField(this, 13, value);
+proto.wsman.DescribeClusterResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.wsman.WorkspaceClass;
+      reader.readMessage(value,proto.wsman.WorkspaceClass.deserializeBinaryFromReader);
+      msg.addWorkspaceClasses(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPreferredWorkspaceClass(value);
+      break;
+    case 3:
+      var value = new proto.wsman.WorkspaceQuota;
+      reader.readMessage(value,proto.wsman.WorkspaceQuota.deserializeBinaryFromReader);
+      msg.addWorkspaceQuotas(value);
+      break;
+    case 4:
+      var value = new proto.wsman.OrganizationTimeouts;
+      reader.readMessage(value,proto.wsman.OrganizationTimeouts.deserializeBinaryFromReader);
+      msg.addOrganizationTimeouts(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * repeated string ssh_public_keys = 15;
- * @return {!Array<string>}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.wsman.StartWorkspaceSpec.prototype.getSshPublicKeysList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 15));
+proto.wsman.DescribeClusterResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.DescribeClusterResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.DescribeClusterResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.StartWorkspaceSpec.prototype.setSshPublicKeysList = function(value) {
-  return jspb.Message.setField(this, 15, value || []);
+proto.wsman.DescribeClusterResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getWorkspaceClassesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.wsman.WorkspaceClass.serializeBinaryToWriter
+    );
+  }
+  f = message.getPreferredWorkspaceClass();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getWorkspaceQuotasList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.wsman.WorkspaceQuota.serializeBinaryToWriter
+    );
+  }
+  f = message.getOrganizationTimeoutsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.wsman.OrganizationTimeouts.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ * repeated WorkspaceClass workspace_classes = 1;
+ * @return {!Array<!proto.wsman.WorkspaceClass>}
  */
-proto.wsman.StartWorkspaceSpec.prototype.addSshPublicKeys = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 15, value, opt_index);
+proto.wsman.DescribeClusterResponse.prototype.getWorkspaceClassesList = function() {
+  return /** @type{!Array<!proto.wsman.WorkspaceClass>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.WorkspaceClass, 1));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
- */
-proto.wsman.StartWorkspaceSpec.prototype.clearSshPublicKeysList = function() {
-  return this.setSshPublicKeysList([]);
+ * @param {!Array<!proto.wsman.WorkspaceClass>} value
+ * @return {!proto.wsman.DescribeClusterResponse} returns this
+*/
+proto.wsman.DescribeClusterResponse.prototype.setWorkspaceClassesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * repeated EnvironmentVariable sys_envvars = 16;
- * @return {!Array<!proto.wsman.EnvironmentVariable>}
+ * @param {!proto.wsman.WorkspaceClass=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.WorkspaceClass}
  */
-proto.wsman.StartWorkspaceSpec.prototype.getSysEnvvarsList = function() {
-  return /** @type{!Array<!proto.wsman.EnvironmentVariable>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.wsman.EnvironmentVariable, 16));
+proto.wsman.DescribeClusterResponse.prototype.addWorkspaceClasses = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.wsman.WorkspaceClass, opt_index);
 };
 
 
 /**
- * @param {!Array<!proto.wsman.EnvironmentVariable>} value
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
-*/
-proto.wsman.StartWorkspaceSpec.prototype.setSysEnvvarsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 16, value);
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.DescribeClusterResponse} returns this
+ */
+proto.wsman.DescribeClusterResponse.prototype.clearWorkspaceClassesList = function() {
+  return this.setWorkspaceClassesList([]);
 };
 
 
 /**
- * @param {!proto.wsman.EnvironmentVariable=} opt_value
- * @param {number=} opt_index
- * @return {!proto.wsman.EnvironmentVariable}
+ * optional string preferred_workspace_class = 2;
+ * @return {string}
  */
-proto.wsman.StartWorkspaceSpec.prototype.addSysEnvvars = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 16, opt_value, proto.wsman.EnvironmentVariable, opt_index);
+proto.wsman.DescribeClusterResponse.prototype.getPreferredWorkspaceClass = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ * @param {string} value
+ * @return {!proto.wsman.DescribeClusterResponse} returns this
  */
-proto.wsman.StartWorkspaceSpec.prototype.clearSysEnvvarsList = function() {
-  return this.setSysEnvvarsList([]);
+proto.wsman.DescribeClusterResponse.prototype.setPreferredWorkspaceClass = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * repeated string ide_image_layers = 17;
- * @return {!Array<string>}
+ * repeated WorkspaceQuota workspace_quotas = 3;
+ * @return {!Array<!proto.wsman.WorkspaceQuota>}
  */
-proto.wsman.StartWorkspaceSpec.prototype.getIdeImageLayersList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 17));
+proto.wsman.DescribeClusterResponse.prototype.getWorkspaceQuotasList = function() {
+  return /** @type{!Array<!proto.wsman.WorkspaceQuota>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.WorkspaceQuota, 3));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
- */
-proto.wsman.StartWorkspaceSpec.prototype.setIdeImageLayersList = function(value) {
-  return jspb.Message.setField(this, 17, value || []);
+ * @param {!Array<!proto.wsman.WorkspaceQuota>} value
+ * @return {!proto.wsman.DescribeClusterResponse} returns this
+*/
+proto.wsman.DescribeClusterResponse.prototype.setWorkspaceQuotasList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
 };
 
 
 /**
- * @param {string} value
+ * @param {!proto.wsman.WorkspaceQuota=} opt_value
  * @param {number=} opt_index
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ * @return {!proto.wsman.WorkspaceQuota}
  */
-proto.wsman.StartWorkspaceSpec.prototype.addIdeImageLayers = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 17, value, opt_index);
+proto.wsman.DescribeClusterResponse.prototype.addWorkspaceQuotas = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.wsman.WorkspaceQuota, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ * @return {!proto.wsman.DescribeClusterResponse} returns this
  */
-proto.wsman.StartWorkspaceSpec.prototype.clearIdeImageLayersList = function() {
-  return this.setIdeImageLayersList([]);
+proto.wsman.DescribeClusterResponse.prototype.clearWorkspaceQuotasList = function() {
+  return this.setWorkspaceQuotasList([]);
 };
 
 
 /**
- * optional string closed_timeout = 18;
- * @return {string}
+ * repeated OrganizationTimeouts organization_timeouts = 4;
+ * @return {!Array<!proto.wsman.OrganizationTimeouts>}
  */
-proto.wsman.StartWorkspaceSpec.prototype.getClosedTimeout = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 18, ""));
+proto.wsman.DescribeClusterResponse.prototype.getOrganizationTimeoutsList = function() {
+  return /** @type{!Array<!proto.wsman.OrganizationTimeouts>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.OrganizationTimeouts, 4));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
- */
-proto.wsman.StartWorkspaceSpec.prototype.setClosedTimeout = function(value) {
-  return jspb.Message.setProto3StringField(this, 18, value);
+ * @param {!Array<!proto.wsman.OrganizationTimeouts>} value
+ * @return {!proto.wsman.DescribeClusterResponse} returns this
+*/
+proto.wsman.DescribeClusterResponse.prototype.setOrganizationTimeoutsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
 };
 
 
 /**
- * optional string maximum_lifetime = 19;
- * @return {string}
+ * @param {!proto.wsman.OrganizationTimeouts=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.OrganizationTimeouts}
  */
-proto.wsman.StartWorkspaceSpec.prototype.getMaximumLifetime = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 19, ""));
+proto.wsman.DescribeClusterResponse.prototype.addOrganizationTimeouts = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.wsman.OrganizationTimeouts, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.DescribeClusterResponse} returns this
  */
-proto.wsman.StartWorkspaceSpec.prototype.setMaximumLifetime = function(value) {
-  return jspb.Message.setProto3StringField(this, 19, value);
+proto.wsman.DescribeClusterResponse.prototype.clearOrganizationTimeoutsList = function() {
+  return this.setOrganizationTimeoutsList([]);
 };
 
 
@@ -9254,8 +12385,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.GitSpec.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.GitSpec.toObject(opt_includeInstance, this);
+proto.wsman.WorkspaceClass.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceClass.toObject(opt_includeInstance, this);
 };
 
 
@@ -9264,14 +12395,17 @@ proto.wsman.GitSpec.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.GitSpec} msg The msg instance to transform.
+ * @param {!proto.wsman.WorkspaceClass} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.GitSpec.toObject = function(includeInstance, msg) {
+proto.wsman.WorkspaceClass.toObject = function(includeInstance, msg) {
   var f, obj = {
-    username: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    email: jspb.Message.getFieldWithDefault(msg, 2, "")
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    displayName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    description: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    creditsPerMinute: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0),
+    timeouts: (f = msg.getTimeouts()) && proto.wsman.WorkspaceTimeouts.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -9285,23 +12419,23 @@ proto.wsman.GitSpec.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.GitSpec}
+ * @return {!proto.wsman.WorkspaceClass}
  */
-proto.wsman.GitSpec.deserializeBinary = function(bytes) {
+proto.wsman.WorkspaceClass.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.GitSpec;
-  return proto.wsman.GitSpec.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.WorkspaceClass;
+  return proto.wsman.WorkspaceClass.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.GitSpec} msg The message object to deserialize into.
+ * @param {!proto.wsman.WorkspaceClass} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.GitSpec}
+ * @return {!proto.wsman.WorkspaceClass}
  */
-proto.wsman.GitSpec.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.WorkspaceClass.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9310,11 +12444,24 @@ proto.wsman.GitSpec.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUsername(value);
+      msg.setId(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setEmail(value);
+      msg.setDisplayName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDescription(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setCreditsPerMinute(value);
+      break;
+    case 5:
+      var value = new proto.wsman.WorkspaceTimeouts;
+      reader.readMessage(value,proto.wsman.WorkspaceTimeouts.deserializeBinaryFromReader);
+      msg.setTimeouts(value);
       break;
     default:
       reader.skipField();
@@ -9329,9 +12476,9 @@ proto.wsman.GitSpec.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.GitSpec.prototype.serializeBinary = function() {
+proto.wsman.WorkspaceClass.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.GitSpec.serializeBinaryToWriter(this, writer);
+  proto.wsman.WorkspaceClass.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9339,203 +12486,159 @@ proto.wsman.GitSpec.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.GitSpec} message
+ * @param {!proto.wsman.WorkspaceClass} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.GitSpec.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.WorkspaceClass.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUsername();
+  f = message.getId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getEmail();
+  f = message.getDisplayName();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
+  f = message.getDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getCreditsPerMinute();
+  if (f !== 0.0) {
+    writer.writeFloat(
+      4,
+      f
+    );
+  }
+  f = message.getTimeouts();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.wsman.WorkspaceTimeouts.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional string username = 1;
+ * optional string id = 1;
  * @return {string}
  */
-proto.wsman.GitSpec.prototype.getUsername = function() {
+proto.wsman.WorkspaceClass.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.GitSpec} returns this
+ * @return {!proto.wsman.WorkspaceClass} returns this
  */
-proto.wsman.GitSpec.prototype.setUsername = function(value) {
+proto.wsman.WorkspaceClass.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string email = 2;
+ * optional string display_name = 2;
  * @return {string}
  */
-proto.wsman.GitSpec.prototype.getEmail = function() {
+proto.wsman.WorkspaceClass.prototype.getDisplayName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.GitSpec} returns this
+ * @return {!proto.wsman.WorkspaceClass} returns this
  */
-proto.wsman.GitSpec.prototype.setEmail = function(value) {
+proto.wsman.WorkspaceClass.prototype.setDisplayName = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+/**
+ * optional string description = 3;
+ * @return {string}
+ */
+proto.wsman.WorkspaceClass.prototype.getDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceClass} returns this
  */
-proto.wsman.EnvironmentVariable.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.EnvironmentVariable.toObject(opt_includeInstance, this);
+proto.wsman.WorkspaceClass.prototype.setDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.wsman.EnvironmentVariable} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional float credits_per_minute = 4;
+ * @return {number}
  */
-proto.wsman.EnvironmentVariable.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    value: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    secret: (f = msg.getSecret()) && proto.wsman.EnvironmentVariable.SecretKeyRef.toObject(includeInstance, f)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.wsman.WorkspaceClass.prototype.getCreditsPerMinute = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.EnvironmentVariable}
+ * @param {number} value
+ * @return {!proto.wsman.WorkspaceClass} returns this
  */
-proto.wsman.EnvironmentVariable.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.EnvironmentVariable;
-  return proto.wsman.EnvironmentVariable.deserializeBinaryFromReader(msg, reader);
+proto.wsman.WorkspaceClass.prototype.setCreditsPerMinute = function(value) {
+  return jspb.Message.setProto3FloatField(this, 4, value);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.wsman.EnvironmentVariable} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.EnvironmentVariable}
+ * optional WorkspaceTimeouts timeouts = 5;
+ * @return {?proto.wsman.WorkspaceTimeouts}
  */
-proto.wsman.EnvironmentVariable.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setValue(value);
-      break;
-    case 3:
-      var value = new proto.wsman.EnvironmentVariable.SecretKeyRef;
-      reader.readMessage(value,proto.wsman.EnvironmentVariable.SecretKeyRef.deserializeBinaryFromReader);
-      msg.setSecret(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.wsman.WorkspaceClass.prototype.getTimeouts = function() {
+  return /** @type{?proto.wsman.WorkspaceTimeouts} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceTimeouts, 5));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
- */
-proto.wsman.EnvironmentVariable.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.wsman.EnvironmentVariable.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+ * @param {?proto.wsman.WorkspaceTimeouts|undefined} value
+ * @return {!proto.wsman.WorkspaceClass} returns this
+*/
+proto.wsman.WorkspaceClass.prototype.setTimeouts = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.EnvironmentVariable} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceClass} returns this
  */
-proto.wsman.EnvironmentVariable.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getValue();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getSecret();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      proto.wsman.EnvironmentVariable.SecretKeyRef.serializeBinaryToWriter
-    );
-  }
+proto.wsman.WorkspaceClass.prototype.clearTimeouts = function() {
+  return this.setTimeouts(undefined);
 };
 
 
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.WorkspaceClass.prototype.hasTimeouts = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
 
 
 
@@ -9552,8 +12655,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.EnvironmentVariable.SecretKeyRef.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.EnvironmentVariable.SecretKeyRef.toObject(opt_includeInstance, this);
+proto.wsman.WorkspaceTimeouts.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceTimeouts.toObject(opt_includeInstance, this);
 };
 
 
@@ -9562,14 +12665,17 @@ proto.wsman.EnvironmentVariable.SecretKeyRef.prototype.toObject = function(opt_i
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.EnvironmentVariable.SecretKeyRef} msg The msg instance to transform.
+ * @param {!proto.wsman.WorkspaceTimeouts} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.EnvironmentVariable.SecretKeyRef.toObject = function(includeInstance, msg) {
+proto.wsman.WorkspaceTimeouts.toObject = function(includeInstance, msg) {
   var f, obj = {
-    secretName: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    key: jspb.Message.getFieldWithDefault(msg, 2, "")
+    regularWorkspace: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    regularWorkspaceLimit: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    afterClose: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    maxLifetime: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    maxLifetimeLimit: jspb.Message.getFieldWithDefault(msg, 5, "")
   };
 
   if (includeInstance) {
@@ -9583,23 +12689,23 @@ proto.wsman.EnvironmentVariable.SecretKeyRef.toObject = function(includeInstance
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.EnvironmentVariable.SecretKeyRef}
+ * @return {!proto.wsman.WorkspaceTimeouts}
  */
-proto.wsman.EnvironmentVariable.SecretKeyRef.deserializeBinary = function(bytes) {
+proto.wsman.WorkspaceTimeouts.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.EnvironmentVariable.SecretKeyRef;
-  return proto.wsman.EnvironmentVariable.SecretKeyRef.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.WorkspaceTimeouts;
+  return proto.wsman.WorkspaceTimeouts.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.EnvironmentVariable.SecretKeyRef} msg The message object to deserialize into.
+ * @param {!proto.wsman.WorkspaceTimeouts} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.EnvironmentVariable.SecretKeyRef}
+ * @return {!proto.wsman.WorkspaceTimeouts}
  */
-proto.wsman.EnvironmentVariable.SecretKeyRef.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.WorkspaceTimeouts.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9608,11 +12714,23 @@ proto.wsman.EnvironmentVariable.SecretKeyRef.deserializeBinaryFromReader = funct
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSecretName(value);
+      msg.setRegularWorkspace(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setKey(value);
+      msg.setRegularWorkspaceLimit(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAfterClose(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMaxLifetime(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMaxLifetimeLimit(value);
       break;
     default:
       reader.skipField();
@@ -9627,157 +12745,151 @@ proto.wsman.EnvironmentVariable.SecretKeyRef.deserializeBinaryFromReader = funct
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.EnvironmentVariable.SecretKeyRef.prototype.serializeBinary = function() {
+proto.wsman.WorkspaceTimeouts.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.EnvironmentVariable.SecretKeyRef.serializeBinaryToWriter(this, writer);
+  proto.wsman.WorkspaceTimeouts.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.EnvironmentVariable.SecretKeyRef} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.wsman.EnvironmentVariable.SecretKeyRef.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getSecretName();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getKey();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string secret_name = 1;
+ * optional string regular_workspace = 1;
  * @return {string}
  */
-proto.wsman.EnvironmentVariable.SecretKeyRef.prototype.getSecretName = function() {
+proto.wsman.WorkspaceTimeouts.prototype.getRegularWorkspace = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.EnvironmentVariable.SecretKeyRef} returns this
+ * @return {!proto.wsman.WorkspaceTimeouts} returns this
  */
-proto.wsman.EnvironmentVariable.SecretKeyRef.prototype.setSecretName = function(value) {
+proto.wsman.WorkspaceTimeouts.prototype.setRegularWorkspace = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string key = 2;
+ * optional string regular_workspace_limit = 2;
  * @return {string}
  */
-proto.wsman.EnvironmentVariable.SecretKeyRef.prototype.getKey = function() {
+proto.wsman.WorkspaceTimeouts.prototype.getRegularWorkspaceLimit = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.EnvironmentVariable.SecretKeyRef} returns this
+ * @return {!proto.wsman.WorkspaceTimeouts} returns this
  */
-proto.wsman.EnvironmentVariable.SecretKeyRef.prototype.setKey = function(value) {
+proto.wsman.WorkspaceTimeouts.prototype.setRegularWorkspaceLimit = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string name = 1;
+ * optional string after_close = 3;
  * @return {string}
  */
-proto.wsman.EnvironmentVariable.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.wsman.WorkspaceTimeouts.prototype.getAfterClose = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.EnvironmentVariable} returns this
+ * @return {!proto.wsman.WorkspaceTimeouts} returns this
  */
-proto.wsman.EnvironmentVariable.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.wsman.WorkspaceTimeouts.prototype.setAfterClose = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional string value = 2;
+ * optional string max_lifetime = 4;
  * @return {string}
  */
-proto.wsman.EnvironmentVariable.prototype.getValue = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.wsman.WorkspaceTimeouts.prototype.getMaxLifetime = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.EnvironmentVariable} returns this
+ * @return {!proto.wsman.WorkspaceTimeouts} returns this
  */
-proto.wsman.EnvironmentVariable.prototype.setValue = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.wsman.WorkspaceTimeouts.prototype.setMaxLifetime = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * optional SecretKeyRef secret = 3;
- * @return {?proto.wsman.EnvironmentVariable.SecretKeyRef}
+ * optional string max_lifetime_limit = 5;
+ * @return {string}
  */
-proto.wsman.EnvironmentVariable.prototype.getSecret = function() {
-  return /** @type{?proto.wsman.EnvironmentVariable.SecretKeyRef} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.EnvironmentVariable.SecretKeyRef, 3));
-};
-
-
-/**
- * @param {?proto.wsman.EnvironmentVariable.SecretKeyRef|undefined} value
- * @return {!proto.wsman.EnvironmentVariable} returns this
-*/
-proto.wsman.EnvironmentVariable.prototype.setSecret = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+proto.wsman.WorkspaceTimeouts.prototype.getMaxLifetimeLimit = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.EnvironmentVariable} returns this
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceTimeouts} returns this
  */
-proto.wsman.EnvironmentVariable.prototype.clearSecret = function() {
-  return this.setSecret(undefined);
+proto.wsman.WorkspaceTimeouts.prototype.setMaxLifetimeLimit = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.WorkspaceTimeouts} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.EnvironmentVariable.prototype.hasSecret = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.wsman.WorkspaceTimeouts.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRegularWorkspace();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getRegularWorkspaceLimit();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getAfterClose();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getMaxLifetime();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getMaxLifetimeLimit();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.wsman.ExposedPorts.repeatedFields_ = [1];
-
-
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
@@ -9792,8 +12904,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.ExposedPorts.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.ExposedPorts.toObject(opt_includeInstance, this);
+proto.wsman.OrganizationTimeouts.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.OrganizationTimeouts.toObject(opt_includeInstance, this);
 };
 
 
@@ -9802,14 +12914,15 @@ proto.wsman.ExposedPorts.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.ExposedPorts} msg The msg instance to transform.
+ * @param {!proto.wsman.OrganizationTimeouts} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.ExposedPorts.toObject = function(includeInstance, msg) {
+proto.wsman.OrganizationTimeouts.toObject = function(includeInstance, msg) {
   var f, obj = {
-    portsList: jspb.Message.toObjectList(msg.getPortsList(),
-    proto.wsman.PortSpec.toObject, includeInstance)
+    team: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    workspaceClass: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    timeouts: (f = msg.getTimeouts()) && proto.wsman.WorkspaceTimeouts.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -9823,23 +12936,23 @@ proto.wsman.ExposedPorts.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.ExposedPorts}
+ * @return {!proto.wsman.OrganizationTimeouts}
  */
-proto.wsman.ExposedPorts.deserializeBinary = function(bytes) {
+proto.wsman.OrganizationTimeouts.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.ExposedPorts;
-  return proto.wsman.ExposedPorts.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.OrganizationTimeouts;
+  return proto.wsman.OrganizationTimeouts.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.ExposedPorts} msg The message object to deserialize into.
+ * @param {!proto.wsman.OrganizationTimeouts} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.ExposedPorts}
+ * @return {!proto.wsman.OrganizationTimeouts}
  */
-proto.wsman.ExposedPorts.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.OrganizationTimeouts.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9847,9 +12960,17 @@ proto.wsman.ExposedPorts.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.wsman.PortSpec;
-      reader.readMessage(value,proto.wsman.PortSpec.deserializeBinaryFromReader);
-      msg.addPorts(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTeam(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setWorkspaceClass(value);
+      break;
+    case 3:
+      var value = new proto.wsman.WorkspaceTimeouts;
+      reader.readMessage(value,proto.wsman.WorkspaceTimeouts.deserializeBinaryFromReader);
+      msg.setTimeouts(value);
       break;
     default:
       reader.skipField();
@@ -9864,68 +12985,117 @@ proto.wsman.ExposedPorts.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.ExposedPorts.prototype.serializeBinary = function() {
+proto.wsman.OrganizationTimeouts.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.ExposedPorts.serializeBinaryToWriter(this, writer);
+  proto.wsman.OrganizationTimeouts.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.ExposedPorts} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional string team = 1;
+ * @return {string}
  */
-proto.wsman.ExposedPorts.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getPortsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      1,
-      f,
-      proto.wsman.PortSpec.serializeBinaryToWriter
-    );
-  }
+proto.wsman.OrganizationTimeouts.prototype.getTeam = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * repeated PortSpec ports = 1;
- * @return {!Array<!proto.wsman.PortSpec>}
+ * @param {string} value
+ * @return {!proto.wsman.OrganizationTimeouts} returns this
  */
-proto.wsman.ExposedPorts.prototype.getPortsList = function() {
-  return /** @type{!Array<!proto.wsman.PortSpec>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.wsman.PortSpec, 1));
+proto.wsman.OrganizationTimeouts.prototype.setTeam = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string workspace_class = 2;
+ * @return {string}
+ */
+proto.wsman.OrganizationTimeouts.prototype.getWorkspaceClass = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.OrganizationTimeouts} returns this
+ */
+proto.wsman.OrganizationTimeouts.prototype.setWorkspaceClass = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional WorkspaceTimeouts timeouts = 3;
+ * @return {?proto.wsman.WorkspaceTimeouts}
+ */
+proto.wsman.OrganizationTimeouts.prototype.getTimeouts = function() {
+  return /** @type{?proto.wsman.WorkspaceTimeouts} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceTimeouts, 3));
+};
+
+
+/**
+ * @param {?proto.wsman.WorkspaceTimeouts|undefined} value
+ * @return {!proto.wsman.OrganizationTimeouts} returns this
+*/
+proto.wsman.OrganizationTimeouts.prototype.setTimeouts = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
 /**
- * @param {!Array<!proto.wsman.PortSpec>} value
- * @return {!proto.wsman.ExposedPorts} returns this
-*/
-proto.wsman.ExposedPorts.prototype.setPortsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.OrganizationTimeouts} returns this
+ */
+proto.wsman.OrganizationTimeouts.prototype.clearTimeouts = function() {
+  return this.setTimeouts(undefined);
 };
 
 
 /**
- * @param {!proto.wsman.PortSpec=} opt_value
- * @param {number=} opt_index
- * @return {!proto.wsman.PortSpec}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.wsman.ExposedPorts.prototype.addPorts = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.wsman.PortSpec, opt_index);
+proto.wsman.OrganizationTimeouts.prototype.hasTimeouts = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.wsman.ExposedPorts} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.OrganizationTimeouts} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.ExposedPorts.prototype.clearPortsList = function() {
-  return this.setPortsList([]);
+proto.wsman.OrganizationTimeouts.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTeam();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getWorkspaceClass();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getTimeouts();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.wsman.WorkspaceTimeouts.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -9935,7 +13105,7 @@ proto.wsman.ExposedPorts.prototype.clearPortsList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.wsman.SSHPublicKeys.repeatedFields_ = [1];
+proto.wsman.WorkspaceQuota.repeatedFields_ = [6,7];
 
 
 
@@ -9952,8 +13122,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.SSHPublicKeys.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.SSHPublicKeys.toObject(opt_includeInstance, this);
+proto.wsman.WorkspaceQuota.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceQuota.toObject(opt_includeInstance, this);
 };
 
 
@@ -9962,13 +13132,21 @@ proto.wsman.SSHPublicKeys.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.SSHPublicKeys} msg The msg instance to transform.
+ * @param {!proto.wsman.WorkspaceQuota} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.SSHPublicKeys.toObject = function(includeInstance, msg) {
+proto.wsman.WorkspaceQuota.toObject = function(includeInstance, msg) {
   var f, obj = {
-    keysList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+    team: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    runningWorkspacesLimit: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    runningWorkspaces: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    prebuildsLimit: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    prebuilds: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    classesList: jspb.Message.toObjectList(msg.getClassesList(),
+    proto.wsman.WorkspaceQuotaClass.toObject, includeInstance),
+    resourcesList: jspb.Message.toObjectList(msg.getResourcesList(),
+    proto.wsman.WorkspaceQuotaResource.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -9982,23 +13160,23 @@ proto.wsman.SSHPublicKeys.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.SSHPublicKeys}
+ * @return {!proto.wsman.WorkspaceQuota}
  */
-proto.wsman.SSHPublicKeys.deserializeBinary = function(bytes) {
+proto.wsman.WorkspaceQuota.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.SSHPublicKeys;
-  return proto.wsman.SSHPublicKeys.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.WorkspaceQuota;
+  return proto.wsman.WorkspaceQuota.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.SSHPublicKeys} msg The message object to deserialize into.
+ * @param {!proto.wsman.WorkspaceQuota} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.SSHPublicKeys}
+ * @return {!proto.wsman.WorkspaceQuota}
  */
-proto.wsman.SSHPublicKeys.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.WorkspaceQuota.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10007,7 +13185,33 @@ proto.wsman.SSHPublicKeys.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.addKeys(value);
+      msg.setTeam(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRunningWorkspacesLimit(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRunningWorkspaces(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPrebuildsLimit(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPrebuilds(value);
+      break;
+    case 6:
+      var value = new proto.wsman.WorkspaceQuotaClass;
+      reader.readMessage(value,proto.wsman.WorkspaceQuotaClass.deserializeBinaryFromReader);
+      msg.addClasses(value);
+      break;
+    case 7:
+      var value = new proto.wsman.WorkspaceQuotaResource;
+      reader.readMessage(value,proto.wsman.WorkspaceQuotaResource.deserializeBinaryFromReader);
+      msg.addResources(value);
       break;
     default:
       reader.skipField();
@@ -10022,179 +13226,243 @@ proto.wsman.SSHPublicKeys.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.SSHPublicKeys.prototype.serializeBinary = function() {
+proto.wsman.WorkspaceQuota.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.SSHPublicKeys.serializeBinaryToWriter(this, writer);
+  proto.wsman.WorkspaceQuota.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.SSHPublicKeys} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional string team = 1;
+ * @return {string}
  */
-proto.wsman.SSHPublicKeys.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getKeysList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      1,
-      f
-    );
-  }
+proto.wsman.WorkspaceQuota.prototype.getTeam = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * repeated string keys = 1;
- * @return {!Array<string>}
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceQuota} returns this
  */
-proto.wsman.SSHPublicKeys.prototype.getKeysList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.wsman.WorkspaceQuota.prototype.setTeam = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.wsman.SSHPublicKeys} returns this
+ * optional int32 running_workspaces_limit = 2;
+ * @return {number}
  */
-proto.wsman.SSHPublicKeys.prototype.setKeysList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.wsman.WorkspaceQuota.prototype.getRunningWorkspacesLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.wsman.SSHPublicKeys} returns this
+ * @param {number} value
+ * @return {!proto.wsman.WorkspaceQuota} returns this
  */
-proto.wsman.SSHPublicKeys.prototype.addKeys = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.wsman.WorkspaceQuota.prototype.setRunningWorkspacesLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.wsman.SSHPublicKeys} returns this
+ * optional int32 running_workspaces = 3;
+ * @return {number}
  */
-proto.wsman.SSHPublicKeys.prototype.clearKeysList = function() {
-  return this.setKeysList([]);
+proto.wsman.WorkspaceQuota.prototype.getRunningWorkspaces = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
+/**
+ * @param {number} value
+ * @return {!proto.wsman.WorkspaceQuota} returns this
+ */
+proto.wsman.WorkspaceQuota.prototype.setRunningWorkspaces = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
 
+/**
+ * optional int32 prebuilds_limit = 4;
+ * @return {number}
+ */
+proto.wsman.WorkspaceQuota.prototype.getPrebuildsLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {number} value
+ * @return {!proto.wsman.WorkspaceQuota} returns this
  */
-proto.wsman.DescribeClusterRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.DescribeClusterRequest.toObject(opt_includeInstance, this);
+proto.wsman.WorkspaceQuota.prototype.setPrebuildsLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.wsman.DescribeClusterRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional int32 prebuilds = 5;
+ * @return {number}
  */
-proto.wsman.DescribeClusterRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
+proto.wsman.WorkspaceQuota.prototype.getPrebuilds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
 
-  };
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+/**
+ * @param {number} value
+ * @return {!proto.wsman.WorkspaceQuota} returns this
+ */
+proto.wsman.WorkspaceQuota.prototype.setPrebuilds = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.DescribeClusterRequest}
+ * repeated WorkspaceQuotaClass classes = 6;
+ * @return {!Array<!proto.wsman.WorkspaceQuotaClass>}
  */
-proto.wsman.DescribeClusterRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.DescribeClusterRequest;
-  return proto.wsman.DescribeClusterRequest.deserializeBinaryFromReader(msg, reader);
+proto.wsman.WorkspaceQuota.prototype.getClassesList = function() {
+  return /** @type{!Array<!proto.wsman.WorkspaceQuotaClass>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.WorkspaceQuotaClass, 6));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.wsman.DescribeClusterRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.DescribeClusterRequest}
+ * @param {!Array<!proto.wsman.WorkspaceQuotaClass>} value
+ * @return {!proto.wsman.WorkspaceQuota} returns this
+*/
+proto.wsman.WorkspaceQuota.prototype.setClassesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 6, value);
+};
+
+
+/**
+ * @param {!proto.wsman.WorkspaceQuotaClass=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.WorkspaceQuotaClass}
  */
-proto.wsman.DescribeClusterRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    default:
-      reader.skipField();
-      break;
-    }
+proto.wsman.WorkspaceQuota.prototype.addClasses = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.wsman.WorkspaceQuotaClass, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.WorkspaceQuota} returns this
+ */
+proto.wsman.WorkspaceQuota.prototype.clearClassesList = function() {
+  return this.setClassesList([]);
+};
+
+
+/**
+ * repeated WorkspaceQuotaResource resources = 7;
+ * @return {!Array<!proto.wsman.WorkspaceQuotaResource>}
+ */
+proto.wsman.WorkspaceQuota.prototype.getResourcesList = function() {
+  return /** @type{!Array<!proto.wsman.WorkspaceQuotaResource>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.WorkspaceQuotaResource, 7));
+};
+
+
+/**
+ * @param {!Array<!proto.wsman.WorkspaceQuotaResource>} value
+ * @return {!proto.wsman.WorkspaceQuota} returns this
+*/
+proto.wsman.WorkspaceQuota.prototype.setResourcesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 7, value);
+};
+
+
+/**
+ * @param {!proto.wsman.WorkspaceQuotaResource=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.WorkspaceQuotaResource}
+ */
+proto.wsman.WorkspaceQuota.prototype.addResources = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.wsman.WorkspaceQuotaResource, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.WorkspaceQuota} returns this
+ */
+proto.wsman.WorkspaceQuota.prototype.clearResourcesList = function() {
+  return this.setResourcesList([]);
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.WorkspaceQuota} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.WorkspaceQuota.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTeam();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getRunningWorkspacesLimit();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getRunningWorkspaces();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getPrebuildsLimit();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getPrebuilds();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
+  f = message.getClassesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      6,
+      f,
+      proto.wsman.WorkspaceQuotaClass.serializeBinaryToWriter
+    );
+  }
+  f = message.getResourcesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      7,
+      f,
+      proto.wsman.WorkspaceQuotaResource.serializeBinaryToWriter
+    );
   }
-  return msg;
-};
-
-
-/**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
- */
-proto.wsman.DescribeClusterRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.wsman.DescribeClusterRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
-};
-
-
-/**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.DescribeClusterRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.wsman.DescribeClusterRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.wsman.DescribeClusterResponse.repeatedFields_ = [1];
-
-
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
@@ -10209,8 +13477,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.DescribeClusterResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.DescribeClusterResponse.toObject(opt_includeInstance, this);
+proto.wsman.WorkspaceQuotaClass.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceQuotaClass.toObject(opt_includeInstance, this);
 };
 
 
@@ -10219,15 +13487,15 @@ proto.wsman.DescribeClusterResponse.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.DescribeClusterResponse} msg The msg instance to transform.
+ * @param {!proto.wsman.WorkspaceQuotaClass} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.DescribeClusterResponse.toObject = function(includeInstance, msg) {
+proto.wsman.WorkspaceQuotaClass.toObject = function(includeInstance, msg) {
   var f, obj = {
-    workspaceClassesList: jspb.Message.toObjectList(msg.getWorkspaceClassesList(),
-    proto.wsman.WorkspaceClass.toObject, includeInstance),
-    preferredWorkspaceClass: jspb.Message.getFieldWithDefault(msg, 2, "")
+    workspaceClass: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    limit: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    used: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -10241,23 +13509,23 @@ proto.wsman.DescribeClusterResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.DescribeClusterResponse}
+ * @return {!proto.wsman.WorkspaceQuotaClass}
  */
-proto.wsman.DescribeClusterResponse.deserializeBinary = function(bytes) {
+proto.wsman.WorkspaceQuotaClass.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.DescribeClusterResponse;
-  return proto.wsman.DescribeClusterResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.WorkspaceQuotaClass;
+  return proto.wsman.WorkspaceQuotaClass.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.DescribeClusterResponse} msg The message object to deserialize into.
+ * @param {!proto.wsman.WorkspaceQuotaClass} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.DescribeClusterResponse}
+ * @return {!proto.wsman.WorkspaceQuotaClass}
  */
-proto.wsman.DescribeClusterResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.WorkspaceQuotaClass.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10265,13 +13533,16 @@ proto.wsman.DescribeClusterResponse.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.wsman.WorkspaceClass;
-      reader.readMessage(value,proto.wsman.WorkspaceClass.deserializeBinaryFromReader);
-      msg.addWorkspaceClasses(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setWorkspaceClass(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPreferredWorkspaceClass(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLimit(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setUsed(value);
       break;
     default:
       reader.skipField();
@@ -10286,99 +13557,102 @@ proto.wsman.DescribeClusterResponse.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.DescribeClusterResponse.prototype.serializeBinary = function() {
+proto.wsman.WorkspaceQuotaClass.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.DescribeClusterResponse.serializeBinaryToWriter(this, writer);
+  proto.wsman.WorkspaceQuotaClass.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.DescribeClusterResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional string workspace_class = 1;
+ * @return {string}
  */
-proto.wsman.DescribeClusterResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getWorkspaceClassesList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      1,
-      f,
-      proto.wsman.WorkspaceClass.serializeBinaryToWriter
-    );
-  }
-  f = message.getPreferredWorkspaceClass();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
+proto.wsman.WorkspaceQuotaClass.prototype.getWorkspaceClass = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * repeated WorkspaceClass workspace_classes = 1;
- * @return {!Array<!proto.wsman.WorkspaceClass>}
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceQuotaClass} returns this
  */
-proto.wsman.DescribeClusterResponse.prototype.getWorkspaceClassesList = function() {
-  return /** @type{!Array<!proto.wsman.WorkspaceClass>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.wsman.WorkspaceClass, 1));
+proto.wsman.WorkspaceQuotaClass.prototype.setWorkspaceClass = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {!Array<!proto.wsman.WorkspaceClass>} value
- * @return {!proto.wsman.DescribeClusterResponse} returns this
-*/
-proto.wsman.DescribeClusterResponse.prototype.setWorkspaceClassesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * optional int32 limit = 2;
+ * @return {number}
+ */
+proto.wsman.WorkspaceQuotaClass.prototype.getLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {!proto.wsman.WorkspaceClass=} opt_value
- * @param {number=} opt_index
- * @return {!proto.wsman.WorkspaceClass}
+ * @param {number} value
+ * @return {!proto.wsman.WorkspaceQuotaClass} returns this
  */
-proto.wsman.DescribeClusterResponse.prototype.addWorkspaceClasses = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.wsman.WorkspaceClass, opt_index);
+proto.wsman.WorkspaceQuotaClass.prototype.setLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.wsman.DescribeClusterResponse} returns this
+ * optional int32 used = 3;
+ * @return {number}
  */
-proto.wsman.DescribeClusterResponse.prototype.clearWorkspaceClassesList = function() {
-  return this.setWorkspaceClassesList([]);
+proto.wsman.WorkspaceQuotaClass.prototype.getUsed = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * optional string preferred_workspace_class = 2;
- * @return {string}
+ * @param {number} value
+ * @return {!proto.wsman.WorkspaceQuotaClass} returns this
  */
-proto.wsman.DescribeClusterResponse.prototype.getPreferredWorkspaceClass = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.wsman.WorkspaceQuotaClass.prototype.setUsed = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.DescribeClusterResponse} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.WorkspaceQuotaClass} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.DescribeClusterResponse.prototype.setPreferredWorkspaceClass = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.wsman.WorkspaceQuotaClass.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getWorkspaceClass();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getLimit();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getUsed();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
 };
 
 
 
 
-
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -10392,8 +13666,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.WorkspaceClass.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.WorkspaceClass.toObject(opt_includeInstance, this);
+proto.wsman.WorkspaceQuotaResource.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceQuotaResource.toObject(opt_includeInstance, this);
 };
 
 
@@ -10402,16 +13676,15 @@ proto.wsman.WorkspaceClass.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.WorkspaceClass} msg The msg instance to transform.
+ * @param {!proto.wsman.WorkspaceQuotaResource} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceClass.toObject = function(includeInstance, msg) {
+proto.wsman.WorkspaceQuotaResource.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    displayName: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    description: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    creditsPerMinute: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0)
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    limit: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    used: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -10425,23 +13698,23 @@ proto.wsman.WorkspaceClass.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.WorkspaceClass}
+ * @return {!proto.wsman.WorkspaceQuotaResource}
  */
-proto.wsman.WorkspaceClass.deserializeBinary = function(bytes) {
+proto.wsman.WorkspaceQuotaResource.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.WorkspaceClass;
-  return proto.wsman.WorkspaceClass.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.WorkspaceQuotaResource;
+  return proto.wsman.WorkspaceQuotaResource.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.WorkspaceClass} msg The message object to deserialize into.
+ * @param {!proto.wsman.WorkspaceQuotaResource} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.WorkspaceClass}
+ * @return {!proto.wsman.WorkspaceQuotaResource}
  */
-proto.wsman.WorkspaceClass.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.WorkspaceQuotaResource.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10450,19 +13723,15 @@ proto.wsman.WorkspaceClass.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.setName(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setDisplayName(value);
+      msg.setLimit(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setDescription(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readFloat());
-      msg.setCreditsPerMinute(value);
+      msg.setUsed(value);
       break;
     default:
       reader.skipField();
@@ -10477,122 +13746,97 @@ proto.wsman.WorkspaceClass.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.WorkspaceClass.prototype.serializeBinary = function() {
+proto.wsman.WorkspaceQuotaResource.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.WorkspaceClass.serializeBinaryToWriter(this, writer);
+  proto.wsman.WorkspaceQuotaResource.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.WorkspaceClass} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.wsman.WorkspaceClass.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getDisplayName();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getDescription();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getCreditsPerMinute();
-  if (f !== 0.0) {
-    writer.writeFloat(
-      4,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string id = 1;
+ * optional string name = 1;
  * @return {string}
  */
-proto.wsman.WorkspaceClass.prototype.getId = function() {
+proto.wsman.WorkspaceQuotaResource.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.WorkspaceClass} returns this
+ * @return {!proto.wsman.WorkspaceQuotaResource} returns this
  */
-proto.wsman.WorkspaceClass.prototype.setId = function(value) {
+proto.wsman.WorkspaceQuotaResource.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string display_name = 2;
+ * optional string limit = 2;
  * @return {string}
  */
-proto.wsman.WorkspaceClass.prototype.getDisplayName = function() {
+proto.wsman.WorkspaceQuotaResource.prototype.getLimit = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.WorkspaceClass} returns this
+ * @return {!proto.wsman.WorkspaceQuotaResource} returns this
  */
-proto.wsman.WorkspaceClass.prototype.setDisplayName = function(value) {
+proto.wsman.WorkspaceQuotaResource.prototype.setLimit = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string description = 3;
+ * optional string used = 3;
  * @return {string}
  */
-proto.wsman.WorkspaceClass.prototype.getDescription = function() {
+proto.wsman.WorkspaceQuotaResource.prototype.getUsed = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.WorkspaceClass} returns this
+ * @return {!proto.wsman.WorkspaceQuotaResource} returns this
  */
-proto.wsman.WorkspaceClass.prototype.setDescription = function(value) {
+proto.wsman.WorkspaceQuotaResource.prototype.setUsed = function(value) {
   return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional float credits_per_minute = 4;
- * @return {number}
- */
-proto.wsman.WorkspaceClass.prototype.getCreditsPerMinute = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.wsman.WorkspaceClass} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.WorkspaceQuotaResource} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceClass.prototype.setCreditsPerMinute = function(value) {
-  return jspb.Message.setProto3FloatField(this, 4, value);
+proto.wsman.WorkspaceQuotaResource.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getLimit();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getUsed();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
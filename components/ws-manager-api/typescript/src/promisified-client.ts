@@ -32,6 +32,12 @@ import {
     UpdateSSHKeyResponse,
     DescribeClusterRequest,
     DescribeClusterResponse,
+    SetStorageQuotaRequest,
+    SetStorageQuotaResponse,
+    MigrateWorkspaceRequest,
+    MigrateWorkspaceResponse,
+    RenderWorkspacePodRequest,
+    RenderWorkspacePodResponse,
 } from "./core_pb";
 import { TraceContext } from "@gitpod/gitpod-protocol/lib/util/tracing";
 import * as opentracing from "opentracing";
@@ -426,6 +432,78 @@ export class PromisifiedWorkspaceManagerClient implements Disposable {
         );
     }
 
+    public setStorageQuota(ctx: TraceContext, request: SetStorageQuotaRequest): Promise<SetStorageQuotaResponse> {
+        return this.retryIfUnavailable(
+            (attempt: number) =>
+                new Promise<SetStorageQuotaResponse>((resolve, reject) => {
+                    const span = TraceContext.startSpan(`/ws-manager/setStorageQuota`, ctx);
+                    span.log({ attempt });
+                    this.client.setStorageQuota(
+                        request,
+                        withTracing({ span }),
+                        this.getDefaultUnaryOptions(),
+                        (err, resp) => {
+                            span.finish();
+                            if (err) {
+                                TraceContext.setError(ctx, err);
+                                reject(err);
+                            } else {
+                                resolve(resp);
+                            }
+                        },
+                    );
+                }),
+        );
+    }
+
+    public migrateWorkspace(ctx: TraceContext, request: MigrateWorkspaceRequest): Promise<MigrateWorkspaceResponse> {
+        return this.retryIfUnavailable(
+            (attempt: number) =>
+                new Promise<MigrateWorkspaceResponse>((resolve, reject) => {
+                    const span = TraceContext.startSpan(`/ws-manager/migrateWorkspace`, ctx);
+                    span.log({ attempt });
+                    this.client.migrateWorkspace(
+                        request,
+                        withTracing({ span }),
+                        this.getDefaultUnaryOptions(),
+                        (err, resp) => {
+                            span.finish();
+                            if (err) {
+                                TraceContext.setError(ctx, err);
+                                reject(err);
+                            } else {
+                                resolve(resp);
+                            }
+                        },
+                    );
+                }),
+        );
+    }
+
+    public renderWorkspacePod(ctx: TraceContext, request: RenderWorkspacePodRequest): Promise<RenderWorkspacePodResponse> {
+        return this.retryIfUnavailable(
+            (attempt: number) =>
+                new Promise<RenderWorkspacePodResponse>((resolve, reject) => {
+                    const span = TraceContext.startSpan(`/ws-manager/renderWorkspacePod`, ctx);
+                    span.log({ attempt });
+                    this.client.renderWorkspacePod(
+                        request,
+                        withTracing({ span }),
+                        this.getDefaultUnaryOptions(),
+                        (err, resp) => {
+                            span.finish();
+                            if (err) {
+                                TraceContext.setError(ctx, err);
+                                reject(err);
+                            } else {
+                                resolve(resp);
+                            }
+                        },
+                    );
+                }),
+        );
+    }
+
     protected getDefaultUnaryOptions(): Partial<grpc.CallOptions> {
         let deadline = new Date(new Date().getTime() + 30000);
         return {
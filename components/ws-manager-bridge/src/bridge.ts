@@ -330,6 +330,7 @@ export class WorkspaceManagerBridge implements Disposable {
             );
             instance.status.conditions.headlessTaskFailed = status.conditions.headlessTaskFailed;
             instance.status.conditions.stoppedByRequest = toBool(status.conditions.stoppedByRequest);
+            instance.status.conditions.paused = toBool(status.conditions.paused);
             instance.status.message = status.message;
             instance.status.nodeName = instance.status.nodeName || status.runtime?.nodeName;
             instance.status.podName = instance.status.podName || status.runtime?.podName;
@@ -68,7 +68,7 @@ type startWorkspaceContext struct {
 
 // createWorkspacePod creates the actual workspace pod based on the definite workspace pod and appropriate
 // templates. The result of this function is not expected to be modified prior to being passed to Kubernetes.
-func (r *WorkspaceReconciler) createWorkspacePod(sctx *startWorkspaceContext) (*corev1.Pod, error) {
+func createWorkspacePod(sctx *startWorkspaceContext) (*corev1.Pod, error) {
 	class, ok := sctx.Config.WorkspaceClasses[sctx.Workspace.Spec.Class]
 	if !ok {
 		return nil, xerrors.Errorf("unknown workspace class: %s", sctx.Workspace.Spec.Class)
@@ -416,6 +416,58 @@ func createDefiniteWorkspacePod(sctx *startWorkspaceContext) (*corev1.Pod, error
 	return &pod, nil
 }
 
+// preferNode asks the scheduler to place a pod on the given node. Should the node not fit the pod,
+// the pod is scheduled like any other.
+func preferNode(pod *corev1.Pod, node string) {
+	if pod.Spec.Affinity == nil {
+		pod.Spec.Affinity = &corev1.Affinity{}
+	}
+	if pod.Spec.Affinity.NodeAffinity == nil {
+		pod.Spec.Affinity.NodeAffinity = &corev1.NodeAffinity{}
+	}
+	pod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(pod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution, corev1.PreferredSchedulingTerm{
+		Weight: 100,
+		Preference: corev1.NodeSelectorTerm{
+			MatchExpressions: []corev1.NodeSelectorRequirement{{
+				Key:      "kubernetes.io/hostname",
+				Operator: corev1.NodeSelectorOpIn,
+				Values:   []string{node},
+			}},
+		},
+	})
+}
+
+// avoidNode prevents the scheduler from placing a pod on the given node.
+func avoidNode(pod *corev1.Pod, node string) {
+	addRequiredNodeExpression(pod, corev1.NodeSelectorRequirement{
+		Key:      "kubernetes.io/hostname",
+		Operator: corev1.NodeSelectorOpNotIn,
+		Values:   []string{node},
+	})
+}
+
+func addRequiredNodeExpression(pod *corev1.Pod, req corev1.NodeSelectorRequirement) {
+	if pod.Spec.Affinity == nil {
+		pod.Spec.Affinity = &corev1.Affinity{}
+	}
+	if pod.Spec.Affinity.NodeAffinity == nil {
+		pod.Spec.Affinity.NodeAffinity = &corev1.NodeAffinity{}
+	}
+	if pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{}
+	}
+
+	// node selector terms are OR'ed, hence every one of them has to contain the expression
+	terms := pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	if len(terms) == 0 {
+		terms = []corev1.NodeSelectorTerm{{}}
+	}
+	for i := range terms {
+		terms[i].MatchExpressions = append(terms[i].MatchExpressions, req)
+	}
+	pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms = terms
+}
+
 func createWorkspaceContainer(sctx *startWorkspaceContext) (*corev1.Container, error) {
 	class, ok := sctx.Config.WorkspaceClasses[sctx.Workspace.Spec.Class]
 	if !ok {
@@ -560,6 +612,17 @@ func createWorkspaceEnvironment(sctx *startWorkspaceContext) ([]corev1.EnvVar, e
 
 	result = append(result, corev1.EnvVar{Name: "GITPOD_SSH_CA_PUBLIC_KEY", Value: sctx.Workspace.Spec.SSHGatewayCAPublicKey})
 
+	// supervisor adds its startup spans to the trace the workspace was started in. We don't pass on our JAEGER_* env,
+	// as the workspace's processes would pick it up, but only where to report the spans to. Collector credentials
+	// must not end up in the workspace either, hence supervisor cannot report to a collector which requires them.
+	if traceID := sctx.Workspace.Annotations[wsk8s.WorkspaceTraceContextAnnotation]; traceID != "" {
+		if collectorEndpoint, agentHostPort := tracing.ReporterEndpoint(); collectorEndpoint != "" || agentHostPort != "" {
+			result = append(result, corev1.EnvVar{Name: "GITPOD_TRACE_CONTEXT", Value: traceID})
+			result = append(result, corev1.EnvVar{Name: "GITPOD_TRACE_COLLECTOR_ENDPOINT", Value: collectorEndpoint})
+			result = append(result, corev1.EnvVar{Name: "GITPOD_TRACE_AGENT_HOST_PORT", Value: agentHostPort})
+		}
+	}
+
 	// We don't require that Git be configured for workspaces
 	if sctx.Workspace.Spec.Git != nil {
 		result = append(result, corev1.EnvVar{Name: "GITPOD_GIT_USER_NAME", Value: sctx.Workspace.Spec.Git.Username})
@@ -705,6 +768,7 @@ func newStartWorkspaceContext(ctx context.Context, cfg *config.Configuration, ws
 			wsk8s.MetaIDLabel:             ws.Spec.Ownership.WorkspaceID,
 			wsk8s.WorkspaceIDLabel:        ws.Name,
 			wsk8s.OwnerLabel:              ws.Spec.Ownership.Owner,
+			wsk8s.TeamLabel:               ws.Spec.Ownership.Team,
 			wsk8s.TypeLabel:               strings.ToLower(string(ws.Spec.Type)),
 			wsk8s.WorkspaceManagedByLabel: constants.ManagedBy,
 			instanceIDLabel:               ws.Name,
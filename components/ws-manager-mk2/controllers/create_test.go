@@ -7,10 +7,12 @@ package controllers
 import (
 	"testing"
 
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
 	"github.com/gitpod-io/gitpod/ws-manager/api/config"
 	v1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestCreateWorkspaceEnvironment(t *testing.T) {
@@ -20,6 +22,7 @@ func TestCreateWorkspaceEnvironment(t *testing.T) {
 	}
 	tests := []struct {
 		Name        string
+		Env         map[string]string
 		Expectation Expectation
 		Context     *startWorkspaceContext
 	}{
@@ -85,10 +88,47 @@ func TestCreateWorkspaceEnvironment(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "with trace context",
+			Env:  map[string]string{"JAEGER_ENDPOINT": "http://otel-collector:14268/api/traces", "JAEGER_USER": "gitpod", "JAEGER_PASSWORD": "secret"},
+			Context: &startWorkspaceContext{
+				Config: &config.Configuration{
+					WorkspaceClasses: map[string]*config.WorkspaceClass{
+						"default": {Name: "default"},
+					},
+				},
+				Workspace: &v1.Workspace{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{wsk8s.WorkspaceTraceContextAnnotation: "trace-context"},
+					},
+					Spec: v1.WorkspaceSpec{
+						Class: "default",
+					},
+				},
+			},
+			Expectation: Expectation{
+				Vars: []corev1.EnvVar{
+					{Name: "GITPOD_REPO_ROOT", Value: "/workspace"},
+					{Name: "GITPOD_REPO_ROOTS", Value: "/workspace"},
+					{Name: "GITPOD_THEIA_PORT", Value: "0"},
+					{Name: "THEIA_WORKSPACE_ROOT", Value: "/workspace"},
+					{Name: "GITPOD_WORKSPACE_CLASS", Value: "default"},
+					{Name: "THEIA_SUPERVISOR_ENDPOINT", Value: ":0"},
+					{Name: "THEIA_WEBVIEW_EXTERNAL_ENDPOINT", Value: "webview-{{hostname}}"},
+					{Name: "THEIA_MINI_BROWSER_HOST_PATTERN", Value: "browser-{{hostname}}"},
+					{Name: "GITPOD_TRACE_CONTEXT", Value: "trace-context"},
+					{Name: "GITPOD_TRACE_COLLECTOR_ENDPOINT", Value: "http://otel-collector:14268/api/traces"},
+					{Name: "GITPOD_INTERVAL", Value: "0"}, {Name: "GITPOD_MEMORY", Value: "0"}, {Name: "GITPOD_CPU_COUNT", Value: "0"},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
+			for k, v := range test.Env {
+				t.Setenv(k, v)
+			}
 			var act Expectation
 
 			res, err := createWorkspaceEnvironment(test.Context)
@@ -103,3 +143,35 @@ func TestCreateWorkspaceEnvironment(t *testing.T) {
 		})
 	}
 }
+
+func TestPreferNode(t *testing.T) {
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Affinity: &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{
+							{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "gitpod.io/workload_workspace_regular", Operator: corev1.NodeSelectorOpExists}}},
+						},
+					},
+				},
+			},
+		},
+	}
+	preferNode(pod, "node-a")
+
+	expectedRequired := []corev1.NodeSelectorTerm{
+		{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "gitpod.io/workload_workspace_regular", Operator: corev1.NodeSelectorOpExists}}},
+	}
+	if diff := cmp.Diff(expectedRequired, pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms); diff != "" {
+		t.Errorf("node must be preferred, not required (-want +got):\n%s", diff)
+	}
+	expectedPreferred := []corev1.PreferredSchedulingTerm{
+		{Weight: 100, Preference: corev1.NodeSelectorTerm{MatchExpressions: []corev1.NodeSelectorRequirement{
+			{Key: "kubernetes.io/hostname", Operator: corev1.NodeSelectorOpIn, Values: []string{"node-a"}},
+		}}},
+	}
+	if diff := cmp.Diff(expectedPreferred, pod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution); diff != "" {
+		t.Errorf("unexpected preferred scheduling terms (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+	config "github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+const (
+	memoryPressureReconcileInterval = 10 * time.Second
+
+	// memoryPressureStopMessage is the message of the Failed condition of workspaces we stopped for memory pressure
+	memoryPressureStopMessage = "workspace stopped because it remained under memory pressure"
+)
+
+func NewMemoryPressureReconciler(c client.Client, recorder record.EventRecorder, cfg *config.Configuration) *MemoryPressureReconciler {
+	return &MemoryPressureReconciler{
+		Client:   c,
+		Config:   cfg,
+		Recorder: recorder,
+	}
+}
+
+// MemoryPressureReconciler stops workspaces which ws-daemon found to remain under memory pressure despite reclaim
+// (see WorkspaceMemoryPressureAnnotation). Of all such workspaces on a node it stops the one under the highest
+// pressure, which frees the memory of the node in a controlled manner before the OOM killer picks a random victim.
+// It stops one workspace per node at a time, as stopping one may relieve the pressure of the others.
+type MemoryPressureReconciler struct {
+	client.Client
+
+	Config   *config.Configuration
+	Recorder record.EventRecorder
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *MemoryPressureReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return mgr.Add(r)
+}
+
+// Start implements manager.Runnable
+func (r *MemoryPressureReconciler) Start(ctx context.Context) error {
+	log := log.FromContext(ctx).WithName("memory-pressure")
+
+	ticker := time.NewTicker(memoryPressureReconcileInterval)
+	defer ticker.Stop()
+	for {
+		err := r.reconcile(ctx)
+		if err != nil {
+			log.Error(err, "cannot stop workspaces under memory pressure")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *MemoryPressureReconciler) reconcile(ctx context.Context) error {
+	var workspaces workspacev1.WorkspaceList
+	err := r.List(ctx, &workspaces, client.InNamespace(r.Config.Namespace))
+	if err != nil {
+		return fmt.Errorf("cannot list workspaces: %w", err)
+	}
+
+	var (
+		noisiest = make(map[string]*workspacev1.Workspace)
+		pressure = make(map[string]float64)
+		stopping = make(map[string]struct{})
+	)
+	for i := range workspaces.Items {
+		ws := &workspaces.Items[i]
+		value, ok := ws.Annotations[wsk8s.WorkspaceMemoryPressureAnnotation]
+		if !ok || ws.Status.Runtime == nil || ws.Status.Runtime.NodeName == "" {
+			continue
+		}
+		node := ws.Status.Runtime.NodeName
+		if ws.Status.Phase != workspacev1.WorkspacePhaseRunning || ws.DeletionTimestamp != nil || ws.IsConditionTrue(workspacev1.WorkspaceConditionFailed) {
+			if ws.Status.Phase != workspacev1.WorkspacePhaseStopped {
+				// the workspace is on its way out already and about to free its memory
+				stopping[node] = struct{}{}
+			}
+			continue
+		}
+
+		p, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			log.FromContext(ctx).Error(err, "invalid memory pressure annotation", "workspace", ws.Name, "value", value)
+			continue
+		}
+		if cur, ok := noisiest[node]; ok && (p < pressure[node] || p == pressure[node] && cur.CreationTimestamp.Before(&ws.CreationTimestamp)) {
+			continue
+		}
+		noisiest[node] = ws
+		pressure[node] = p
+	}
+
+	for node, ws := range noisiest {
+		if _, ok := stopping[node]; ok {
+			continue
+		}
+
+		err = r.stop(ctx, ws, pressure[node])
+		if err != nil {
+			log.FromContext(ctx).Error(err, "cannot stop workspace under memory pressure", "workspace", ws.Name, "node", node)
+		}
+	}
+	return nil
+}
+
+func (r *MemoryPressureReconciler) stop(ctx context.Context, ws *workspacev1.Workspace, pressure float64) error {
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		err := r.Get(ctx, client.ObjectKeyFromObject(ws), ws)
+		if err != nil {
+			return err
+		}
+		ws.Status.SetCondition(workspacev1.NewWorkspaceConditionFailed(memoryPressureStopMessage))
+		return r.Status().Update(ctx, ws)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.FromContext(ctx).Info("stopped workspace under memory pressure", "workspace", ws.Name, "pressure", pressure)
+	r.Recorder.Event(ws, corev1.EventTypeWarning, "MemoryPressure", memoryPressureStopMessage)
+	return nil
+}
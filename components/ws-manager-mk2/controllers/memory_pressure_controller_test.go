@@ -0,0 +1,104 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+func TestMemoryPressureReconcile(t *testing.T) {
+	workspace := func(name, node, pressure string, phase workspacev1.WorkspacePhase) *workspacev1.Workspace {
+		ws := &workspacev1.Workspace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "default",
+				Annotations: map[string]string{},
+			},
+			Status: workspacev1.WorkspaceStatus{
+				Phase:   phase,
+				Runtime: &workspacev1.WorkspaceRuntimeStatus{NodeName: node},
+			},
+		}
+		if pressure != "" {
+			ws.Annotations[wsk8s.WorkspaceMemoryPressureAnnotation] = pressure
+		}
+		return ws
+	}
+
+	tests := []struct {
+		Name            string
+		Objects         []client.Object
+		ExpectedStopped []string
+	}{
+		{
+			Name: "noisiest workspace per node",
+			Objects: []client.Object{
+				workspace("a-quiet", "node-a", "", workspacev1.WorkspacePhaseRunning),
+				workspace("a-low", "node-a", "0.40", workspacev1.WorkspacePhaseRunning),
+				workspace("a-high", "node-a", "0.90", workspacev1.WorkspacePhaseRunning),
+				workspace("b-high", "node-b", "0.50", workspacev1.WorkspacePhaseRunning),
+			},
+			ExpectedStopped: []string{"a-high", "b-high"},
+		},
+		{
+			Name: "one workspace per node at a time",
+			Objects: []client.Object{
+				workspace("a-stopping", "node-a", "0.90", workspacev1.WorkspacePhaseStopping),
+				workspace("a-running", "node-a", "0.40", workspacev1.WorkspacePhaseRunning),
+			},
+		},
+		{
+			Name: "no pressure",
+			Objects: []client.Object{
+				workspace("a-quiet", "node-a", "", workspacev1.WorkspacePhaseRunning),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(scheme)
+			_ = workspacev1.AddToScheme(scheme)
+			clnt := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&workspacev1.Workspace{}).WithObjects(test.Objects...).Build()
+
+			r := NewMemoryPressureReconciler(clnt, record.NewFakeRecorder(10), &config.Configuration{Namespace: "default"})
+			err := r.reconcile(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var workspaces workspacev1.WorkspaceList
+			err = clnt.List(context.Background(), &workspaces)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var act []string
+			for _, ws := range workspaces.Items {
+				if ws.IsConditionTrue(workspacev1.WorkspaceConditionFailed) {
+					act = append(act, ws.Name)
+				}
+			}
+			sort.Strings(act)
+			if diff := cmp.Diff(test.ExpectedStopped, act); diff != "" {
+				t.Errorf("unexpected stopped workspaces (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
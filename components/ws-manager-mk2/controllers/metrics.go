@@ -37,6 +37,7 @@ const (
 	workspaceRestoresFailureTotal string = "workspace_restores_failure_total"
 	workspaceNodeUtilization      string = "workspace_node_utilization"
 	workspaceActivityTotal        string = "workspace_activity_total"
+	workspaceWarmPoolBindSeconds  string = "workspace_warm_pool_bind_seconds"
 )
 
 type StopReason string
@@ -55,6 +56,7 @@ type controllerMetrics struct {
 	startupTimeHistVec           *prometheus.HistogramVec
 	pendingTimeHistVec           *prometheus.HistogramVec
 	creatingTimeHistVec          *prometheus.HistogramVec
+	warmPoolBindTimeHistVec      *prometheus.HistogramVec
 	totalStartsFailureCounterVec *prometheus.CounterVec
 	totalFailuresCounterVec      *prometheus.CounterVec
 	totalStopsCounterVec         *prometheus.CounterVec
@@ -104,6 +106,13 @@ func newControllerMetrics(r *WorkspaceReconciler) (*controllerMetrics, error) {
 			Help:      "time the workspace spent in creation",
 			Buckets:   prometheus.ExponentialBuckets(2, 2, 10),
 		}, []string{"type", "class"}),
+		warmPoolBindTimeHistVec: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsWorkspaceSubsystem,
+			Name:      workspaceWarmPoolBindSeconds,
+			Help:      "time from claiming a warm pool pod until the workspace pod was scheduled to the node of that pod",
+			Buckets:   prometheus.ExponentialBuckets(0.1, 2, 10),
+		}, []string{"class"}),
 		totalStartsFailureCounterVec: prometheus.NewCounterVec(prometheus.CounterOpts{
 			Namespace: metricsNamespace,
 			Subsystem: metricsWorkspaceSubsystem,
@@ -199,6 +208,17 @@ func (m *controllerMetrics) recordWorkspaceCreatingTime(log *logr.Logger, ws *wo
 	hist.Observe(time.Since(creatingTs).Seconds())
 }
 
+func (m *controllerMetrics) recordWarmPoolBindTime(log *logr.Logger, ws *workspacev1.Workspace, claimedAt time.Time) {
+	class := ws.Spec.Class
+
+	hist, err := m.warmPoolBindTimeHistVec.GetMetricWithLabelValues(class)
+	if err != nil {
+		log.Error(err, "could not record warm pool bind time", "class", class)
+	}
+
+	hist.Observe(time.Since(claimedAt).Seconds())
+}
+
 func (m *controllerMetrics) countWorkspaceStartFailures(log *logr.Logger, ws *workspacev1.Workspace) {
 	class := ws.Spec.Class
 	tpe := string(ws.Spec.Type)
@@ -308,6 +328,7 @@ type metricState struct {
 	recordedBackupFailed    bool
 	recordedBackupCompleted bool
 	recordedRecreations     int
+	recordedWarmPoolBind    bool
 }
 
 func newMetricState(ws *workspacev1.Workspace) metricState {
@@ -316,7 +337,7 @@ func newMetricState(ws *workspacev1.Workspace) metricState {
 		// Here we assume that we've recorded metrics for the following states already if their conditions already exist.
 		// This is to prevent these from being re-recorded after the controller restarts and clears the metric state for
 		// each workspace.
-		recordedStartTime:       ws.Status.Phase == workspacev1.WorkspacePhaseRunning,
+		recordedStartTime:       ws.Status.Phase == workspacev1.WorkspacePhaseRunning || ws.Status.Phase == workspacev1.WorkspacePhasePaused,
 		recordedInitFailure:     wsk8s.ConditionWithStatusAndReason(ws.Status.Conditions, string(workspacev1.WorkspaceConditionContentReady), false, workspacev1.ReasonInitializationFailure),
 		recordedStartFailure:    ws.Status.Phase == workspacev1.WorkspacePhaseStopped && isStartFailure(ws),
 		recordedFailure:         ws.IsConditionTrue(workspacev1.WorkspaceConditionFailed),
@@ -324,6 +345,7 @@ func newMetricState(ws *workspacev1.Workspace) metricState {
 		recordedBackupFailed:    ws.IsConditionTrue(workspacev1.WorkspaceConditionBackupFailure),
 		recordedBackupCompleted: ws.IsConditionTrue(workspacev1.WorkspaceConditionBackupComplete),
 		recordedRecreations:     ws.Status.PodRecreated,
+		recordedWarmPoolBind:    ws.Status.Runtime != nil && ws.Status.Runtime.NodeName != "",
 	}
 }
 
@@ -342,6 +364,7 @@ func (m *controllerMetrics) Describe(ch chan<- *prometheus.Desc) {
 	m.startupTimeHistVec.Describe(ch)
 	m.pendingTimeHistVec.Describe(ch)
 	m.creatingTimeHistVec.Describe(ch)
+	m.warmPoolBindTimeHistVec.Describe(ch)
 	m.totalStopsCounterVec.Describe(ch)
 	m.totalStartsFailureCounterVec.Describe(ch)
 	m.totalFailuresCounterVec.Describe(ch)
@@ -362,6 +385,7 @@ func (m *controllerMetrics) Collect(ch chan<- prometheus.Metric) {
 	m.startupTimeHistVec.Collect(ch)
 	m.pendingTimeHistVec.Collect(ch)
 	m.creatingTimeHistVec.Collect(ch)
+	m.warmPoolBindTimeHistVec.Collect(ch)
 	m.totalStopsCounterVec.Collect(ch)
 	m.totalStartsFailureCounterVec.Collect(ch)
 	m.totalFailuresCounterVec.Collect(ch)
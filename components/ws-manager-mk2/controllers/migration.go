@@ -0,0 +1,132 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+// isMigratingFrom returns true if the workspace is being moved away from the node the pod runs on
+func isMigratingFrom(ws *workspacev1.Workspace, pod *corev1.Pod) bool {
+	return ws.IsConditionTrue(workspacev1.WorkspaceConditionMigrating) &&
+		ws.Status.Migration != nil &&
+		pod.Spec.NodeName == ws.Status.Migration.SourceNode
+}
+
+// isMigratedTo returns true if the pod is the replacement of a migrating workspace, and it runs
+func isMigratedTo(ws *workspacev1.Workspace, pod *corev1.Pod) bool {
+	return ws.IsConditionTrue(workspacev1.WorkspaceConditionMigrating) &&
+		ws.Status.Migration != nil &&
+		ws.Status.Phase == workspacev1.WorkspacePhaseRunning &&
+		pod.Spec.NodeName != "" &&
+		pod.Spec.NodeName != ws.Status.Migration.SourceNode
+}
+
+// restoreMigratedWorkspace starts a replacement pod for a migrating workspace once its pod has stopped. The replacement
+// restores the backup ws-daemon took when the pod stopped, and is never scheduled on the node we migrate away from.
+func (r *WorkspaceReconciler) restoreMigratedWorkspace(ctx context.Context, workspace *workspacev1.Workspace) (ctrl.Result, error) {
+	var reason string
+	switch {
+	case workspace.Status.Migration == nil:
+		reason = "the migration status is missing"
+	case workspace.IsConditionTrue(workspacev1.WorkspaceConditionStoppedByRequest),
+		workspace.IsConditionTrue(workspacev1.WorkspaceConditionTimeout),
+		workspace.IsConditionTrue(workspacev1.WorkspaceConditionFailed):
+		reason = "the workspace was stopped during the migration"
+	case !workspace.IsConditionTrue(workspacev1.WorkspaceConditionBackupComplete):
+		reason = "the workspace content was not backed up"
+	default:
+		hasSecrets, err := r.hasWorkspaceSecrets(ctx, workspace)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !hasSecrets {
+			reason = "the workspace secrets are missing"
+		}
+	}
+	if reason != "" {
+		msg := fmt.Sprintf("migration failed: %s", reason)
+		workspace.Status.SetCondition(workspacev1.NewWorkspaceConditionMigrating(msg, metav1.ConditionFalse))
+		workspace.Status.Migration = nil
+		if err := r.Status().Update(ctx, workspace); err != nil {
+			return ctrl.Result{}, err
+		}
+		r.Recorder.Event(workspace, corev1.EventTypeWarning, "MigrationFailed", msg)
+		return ctrl.Result{Requeue: true}, nil // requeue so we end up in the "Stopped" case
+	}
+
+	var init csapi.WorkspaceInitializer
+	if err := proto.Unmarshal(workspace.Spec.Initializer, &init); err != nil {
+		return ctrl.Result{}, fmt.Errorf("cannot unmarshal initializer: %w", err)
+	}
+	if init.GetBackup() == nil {
+		var checkoutLocation string
+		if locs := csapi.GetCheckoutLocationsFromInitializer(&init); len(locs) > 0 {
+			checkoutLocation = locs[0]
+		}
+		initializer, err := proto.Marshal(&csapi.WorkspaceInitializer{
+			Spec: &csapi.WorkspaceInitializer_Backup{
+				Backup: &csapi.FromBackupInitializer{
+					CheckoutLocation: checkoutLocation,
+				},
+			},
+		})
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("cannot serialise initializer: %w", err)
+		}
+
+		workspace.Spec.Initializer = initializer
+		if err := r.Update(ctx, workspace); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	sourceNode := workspace.Status.Migration.SourceNode
+	return r.recreateWorkspacePod(ctx, workspace, r.podRecreationTimeout(), func(retry int) metav1.Condition {
+		return workspacev1.NewWorkspaceConditionMigrating(fmt.Sprintf("restoring workspace on a node other than %s", sourceNode), metav1.ConditionTrue)
+	})
+}
+
+// finishMigration marks the migration of a workspace as done once its replacement pod runs
+func (r *WorkspaceReconciler) finishMigration(ctx context.Context, workspace *workspacev1.Workspace, pod *corev1.Pod) (ctrl.Result, error) {
+	msg := fmt.Sprintf("moved from node %s to %s", workspace.Status.Migration.SourceNode, pod.Spec.NodeName)
+	workspace.Status.SetCondition(workspacev1.NewWorkspaceConditionMigrating(msg, metav1.ConditionFalse))
+	workspace.Status.Migration = nil
+	if err := r.Status().Update(ctx, workspace); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	r.Recorder.Event(workspace, corev1.EventTypeNormal, "Migrated", msg)
+	return ctrl.Result{}, nil
+}
+
+// hasWorkspaceSecrets returns true if the secrets a workspace pod refers to exist
+func (r *WorkspaceReconciler) hasWorkspaceSecrets(ctx context.Context, workspace *workspacev1.Workspace) (bool, error) {
+	for _, secret := range []types.NamespacedName{
+		{Namespace: r.Config.Namespace, Name: fmt.Sprintf("%s-%s", workspace.Name, "env")},
+		{Namespace: r.Config.SecretsNamespace, Name: fmt.Sprintf("%s-%s", workspace.Name, "tokens")},
+	} {
+		err := r.Client.Get(ctx, secret, &corev1.Secret{})
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+func TestRestoreMigratedWorkspace(t *testing.T) {
+	gitInit, err := proto.Marshal(&csapi.WorkspaceInitializer{
+		Spec: &csapi.WorkspaceInitializer_Git{
+			Git: &csapi.GitInitializer{RemoteUri: "https://github.com/gitpod-io/gitpod", CheckoutLocation: "gitpod"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Name              string
+		Conditions        []metav1.Condition
+		MissingSecrets    bool
+		ExpectedPhase     workspacev1.WorkspacePhase
+		ExpectedMigrating bool
+	}{
+		{
+			Name:              "backup complete",
+			Conditions:        []metav1.Condition{workspacev1.NewWorkspaceConditionBackupComplete()},
+			ExpectedPhase:     workspacev1.WorkspacePhasePending,
+			ExpectedMigrating: true,
+		},
+		{
+			Name:           "secrets missing",
+			Conditions:     []metav1.Condition{workspacev1.NewWorkspaceConditionBackupComplete()},
+			MissingSecrets: true,
+			ExpectedPhase:  workspacev1.WorkspacePhaseStopped,
+		},
+		{
+			Name:          "backup failed",
+			Conditions:    []metav1.Condition{workspacev1.NewWorkspaceConditionBackupFailure("no space left")},
+			ExpectedPhase: workspacev1.WorkspacePhaseStopped,
+		},
+		{
+			Name: "stopped by request",
+			Conditions: []metav1.Condition{
+				workspacev1.NewWorkspaceConditionBackupComplete(),
+				workspacev1.NewWorkspaceConditionStoppedByRequest("30s"),
+			},
+			ExpectedPhase: workspacev1.WorkspacePhaseStopped,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			lastActivity := metav1.NewTime(time.Now().Add(-time.Minute))
+			ws := &workspacev1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{Name: "ws", Namespace: "default"},
+				Spec: workspacev1.WorkspaceSpec{
+					Type:        workspacev1.WorkspaceTypeRegular,
+					Initializer: gitInit,
+				},
+				Status: workspacev1.WorkspaceStatus{
+					Phase:           workspacev1.WorkspacePhaseStopped,
+					PodStarts:       1,
+					PodDeletionTime: &metav1.Time{Time: time.Now().Add(-time.Minute)},
+					LastActivity:    &lastActivity,
+					OwnerToken:      "owner-token",
+					URL:             "https://ws.gitpod.io",
+					Migration: &workspacev1.WorkspaceMigrationStatus{
+						SourceNode: "node-a",
+						Started:    metav1.Now(),
+					},
+				},
+			}
+			ws.Status.SetCondition(workspacev1.NewWorkspaceConditionMigrating("", metav1.ConditionTrue))
+			for _, c := range test.Conditions {
+				ws.Status.SetCondition(c)
+			}
+
+			scheme := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(scheme)
+			_ = workspacev1.AddToScheme(scheme)
+			objs := []client.Object{ws}
+			if !test.MissingSecrets {
+				objs = append(objs,
+					&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ws-env", Namespace: "default"}},
+					&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ws-tokens", Namespace: "workspace-secrets"}},
+				)
+			}
+			clnt := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&workspacev1.Workspace{}).WithObjects(objs...).Build()
+			r := &WorkspaceReconciler{
+				Client:   clnt,
+				Scheme:   scheme,
+				Config:   &config.Configuration{Namespace: "default", SecretsNamespace: "workspace-secrets", PodRecreationBackoff: 1},
+				Recorder: record.NewFakeRecorder(10),
+			}
+			r.metrics, err = newControllerMetrics(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// the first round replaces the initializer, the second one resets the status
+			for i := 0; i < 2; i++ {
+				var act workspacev1.Workspace
+				err = clnt.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "ws"}, &act)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if act.Status.Phase != workspacev1.WorkspacePhaseStopped {
+					break
+				}
+				_, err = r.restoreMigratedWorkspace(context.Background(), &act)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var act workspacev1.Workspace
+			err = clnt.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "ws"}, &act)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if act.Status.Phase != test.ExpectedPhase {
+				t.Errorf("expected phase %s, got %s", test.ExpectedPhase, act.Status.Phase)
+			}
+			if migrating := act.IsConditionTrue(workspacev1.WorkspaceConditionMigrating); migrating != test.ExpectedMigrating {
+				t.Errorf("expected migrating %v, got %v", test.ExpectedMigrating, migrating)
+			}
+			if !test.ExpectedMigrating {
+				if act.Status.Migration != nil {
+					t.Errorf("expected migration status to be removed, got %+v", act.Status.Migration)
+				}
+				return
+			}
+
+			if act.Status.Migration == nil || act.Status.Migration.SourceNode != "node-a" {
+				t.Errorf("expected migration status to be kept, got %+v", act.Status.Migration)
+			}
+			if act.Status.OwnerToken != "owner-token" || act.Status.LastActivity == nil {
+				t.Errorf("expected owner token and last activity to be kept, got %+v", act.Status)
+			}
+			var init csapi.WorkspaceInitializer
+			err = proto.Unmarshal(act.Spec.Initializer, &init)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff("gitpod", init.GetBackup().GetCheckoutLocation()); diff != "" {
+				t.Errorf("unexpected checkout location (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAvoidNode(t *testing.T) {
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Affinity: &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{
+							{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "gitpod.io/workload_workspace_regular", Operator: corev1.NodeSelectorOpExists}}},
+							{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "gitpod.io/workload_workspace_headless", Operator: corev1.NodeSelectorOpExists}}},
+						},
+					},
+				},
+			},
+		},
+	}
+	avoidNode(pod, "node-a")
+
+	exclude := corev1.NodeSelectorRequirement{Key: "kubernetes.io/hostname", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"node-a"}}
+	for i, term := range pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+		if diff := cmp.Diff(exclude, term.MatchExpressions[len(term.MatchExpressions)-1]); diff != "" {
+			t.Errorf("term %d does not exclude the node (-want +got):\n%s", i, diff)
+		}
+	}
+
+	empty := &corev1.Pod{}
+	avoidNode(empty, "node-a")
+	if diff := cmp.Diff([]corev1.NodeSelectorTerm{{MatchExpressions: []corev1.NodeSelectorRequirement{exclude}}}, empty.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms); diff != "" {
+		t.Errorf("unexpected node selector terms (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+// supervisorNotificationPort is the port supervisor accepts notifications from ws-manager on. It serves nothing
+// but informational notifications, unlike the supervisor API.
+const supervisorNotificationPort = 22998
+
+// WorkspaceNotifier shows notifications to the user of a running workspace
+type WorkspaceNotifier interface {
+	Notify(ctx context.Context, ws *workspacev1.Workspace, message string) error
+}
+
+// NewSupervisorNotifier produces a notifier which shows warnings through the notification endpoint of supervisor
+func NewSupervisorNotifier() WorkspaceNotifier {
+	return &supervisorNotifier{
+		Client: &http.Client{Timeout: 10 * time.Second},
+		Port:   supervisorNotificationPort,
+	}
+}
+
+type supervisorNotifier struct {
+	Client *http.Client
+	Port   int
+}
+
+func (n *supervisorNotifier) Notify(ctx context.Context, ws *workspacev1.Workspace, message string) error {
+	if ws.Status.Runtime == nil || ws.Status.Runtime.PodIP == "" {
+		return fmt.Errorf("workspace %s has no pod IP", ws.Name)
+	}
+
+	body, err := json.Marshal(struct {
+		Message string `json:"message"`
+	}{
+		Message: message,
+	})
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("http://%s/notify", net.JoinHostPort(ws.Status.Runtime.PodIP, strconv.Itoa(n.Port)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("cannot notify workspace %s: %w", ws.Name, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("cannot notify workspace %s: supervisor responded with %s", ws.Name, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gitpod-io/gitpod/ws-manager-mk2/pkg/activity"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+// isPausable returns true if the workspace is to be paused instead of stopped once it has been idle for too long.
+// We only pause regular workspaces which were used before and have not reached their maximum lifetime.
+func (r *TimeoutReconciler) isPausable(ws *workspacev1.Workspace) bool {
+	if r.Config.Pause == nil || ws.Spec.Paused {
+		return false
+	}
+	if ws.Spec.Type != workspacev1.WorkspaceTypeRegular || ws.Status.Phase != workspacev1.WorkspacePhaseRunning {
+		return false
+	}
+	if activity.Last(ws) == nil {
+		return false
+	}
+	return r.clock.Since(ws.CreationTimestamp.Time) < time.Duration(r.getMaxLifetime(ws))
+}
+
+// pauseWorkspace requests ws-daemon to freeze the processes of the workspace
+func (r *TimeoutReconciler) pauseWorkspace(ctx context.Context, ws *workspacev1.Workspace, reason string) error {
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		err := r.Get(ctx, types.NamespacedName{Name: ws.Name, Namespace: ws.Namespace}, ws)
+		if err != nil {
+			return err
+		}
+
+		ws.Spec.Paused = true
+		return r.Update(ctx, ws)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to pause workspace: %w", err)
+	}
+
+	log.FromContext(ctx).V(2).Info("Pausing workspace", "reason", reason)
+	r.recorder.Event(ws, corev1.EventTypeNormal, "Pausing", reason)
+	return nil
+}
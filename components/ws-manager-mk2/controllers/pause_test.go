@@ -0,0 +1,133 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+func TestPauseIdleWorkspace(t *testing.T) {
+	now := time.Now()
+	lastActivity := metav1.NewTime(now.Add(-90 * time.Minute))
+	ws := &workspacev1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{Name: "ws", Namespace: "default", CreationTimestamp: metav1.NewTime(now.Add(-3 * time.Hour))},
+		Spec:       workspacev1.WorkspaceSpec{Type: workspacev1.WorkspaceTypeRegular},
+		Status: workspacev1.WorkspaceStatus{
+			Phase:        workspacev1.WorkspacePhaseRunning,
+			LastActivity: &lastActivity,
+		},
+	}
+	clnt := newScheduleTestClient(t, ws)
+
+	cfg := newTestConfig()
+	cfg.Pause = &config.PauseConfiguration{MaxDuration: util.Duration(2 * time.Hour)}
+	r, err := NewTimeoutReconciler(clnt, record.NewFakeRecorder(10), cfg, &fakeMaintenance{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	clock := clocktesting.NewFakeClock(now)
+	r.clock = clock
+
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "ws"}}
+	step := func(name string, mod func(ws *workspacev1.Workspace), expectPaused, expectTimeout bool) {
+		var act workspacev1.Workspace
+		err := clnt.Get(context.Background(), req.NamespacedName, &act)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if mod != nil {
+			mod(&act)
+			err = clnt.Status().Update(context.Background(), &act)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		_, err = r.Reconcile(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = clnt.Get(context.Background(), req.NamespacedName, &act)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if act.Spec.Paused != expectPaused {
+			t.Errorf("%s: expected paused %v, got %v", name, expectPaused, act.Spec.Paused)
+		}
+		if timedOut := act.IsConditionTrue(workspacev1.WorkspaceConditionTimeout); timedOut != expectTimeout {
+			t.Errorf("%s: expected timeout %v, got %v", name, expectTimeout, timedOut)
+		}
+	}
+
+	step("idle workspace", nil, true, false)
+	step("waiting for ws-daemon to freeze the workspace", nil, true, false)
+	step("frozen", func(ws *workspacev1.Workspace) {
+		ws.Status.Phase = workspacev1.WorkspacePhasePaused
+		ws.Status.SetCondition(workspacev1.NewWorkspaceConditionPaused("", metav1.ConditionTrue))
+	}, true, false)
+
+	clock.Step(3 * time.Hour)
+	step("paused for too long", nil, true, true)
+}
+
+func TestIsPausable(t *testing.T) {
+	now := time.Now()
+	lastActivity := metav1.NewTime(now.Add(-time.Hour))
+	newWorkspace := func(mod func(ws *workspacev1.Workspace)) *workspacev1.Workspace {
+		ws := &workspacev1.Workspace{
+			ObjectMeta: metav1.ObjectMeta{Name: "ws", Namespace: "default", CreationTimestamp: metav1.NewTime(now.Add(-2 * time.Hour))},
+			Spec:       workspacev1.WorkspaceSpec{Type: workspacev1.WorkspaceTypeRegular},
+			Status: workspacev1.WorkspaceStatus{
+				Phase:        workspacev1.WorkspacePhaseRunning,
+				LastActivity: &lastActivity,
+			},
+		}
+		if mod != nil {
+			mod(ws)
+		}
+		return ws
+	}
+
+	tests := []struct {
+		Name        string
+		Workspace   *workspacev1.Workspace
+		Disabled    bool
+		Expectation bool
+	}{
+		{Name: "idle", Workspace: newWorkspace(nil), Expectation: true},
+		{Name: "pause disabled", Workspace: newWorkspace(nil), Disabled: true},
+		{Name: "already paused", Workspace: newWorkspace(func(ws *workspacev1.Workspace) { ws.Spec.Paused = true })},
+		{Name: "prebuild", Workspace: newWorkspace(func(ws *workspacev1.Workspace) { ws.Spec.Type = workspacev1.WorkspaceTypePrebuild })},
+		{Name: "never used", Workspace: newWorkspace(func(ws *workspacev1.Workspace) { ws.Status.LastActivity = nil })},
+		{Name: "stopping", Workspace: newWorkspace(func(ws *workspacev1.Workspace) { ws.Status.Phase = workspacev1.WorkspacePhaseStopping })},
+		{Name: "max lifetime reached", Workspace: newWorkspace(func(ws *workspacev1.Workspace) {
+			ws.CreationTimestamp = metav1.NewTime(now.Add(-48 * time.Hour))
+		})},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			cfg := newTestConfig()
+			if !test.Disabled {
+				cfg.Pause = &config.PauseConfiguration{MaxDuration: util.Duration(2 * time.Hour)}
+			}
+			r := &TimeoutReconciler{Config: cfg, clock: clocktesting.NewFakeClock(now)}
+			if act := r.isPausable(test.Workspace); act != test.Expectation {
+				t.Errorf("expected pausable %v, got %v", test.Expectation, act)
+			}
+		})
+	}
+}
@@ -0,0 +1,406 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/ws-manager-mk2/pkg/constants"
+	config "github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+const (
+	preemptionReconcileInterval = 10 * time.Second
+
+	workspacePreemptionsTotal string = "workspace_preemptions_total"
+)
+
+func NewPreemptionReconciler(c client.Client, reader client.Reader, recorder record.EventRecorder, cfg *config.Configuration, reg prometheus.Registerer) (*PreemptionReconciler, error) {
+	r := &PreemptionReconciler{
+		Client:   c,
+		Reader:   reader,
+		Config:   cfg,
+		Recorder: recorder,
+		preemptionsCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsWorkspaceSubsystem,
+			Name:      workspacePreemptionsTotal,
+			Help:      "total number of workspaces preempted in favour of workspaces of higher priority",
+		}, []string{"type", "class"}),
+		lastPreemption: make(map[string]time.Time),
+	}
+
+	err := reg.Register(r.preemptionsCounter)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// PreemptionReconciler preempts headless workspaces when workspaces of higher priority cannot be scheduled.
+// For every workspace which has been unschedulable for lack of CPU or memory for longer than the pending timeout,
+// it marks the running headless workspace of lowest priority below the pending workspace's priority as preempted,
+// provided the pending workspace may run on the node of that workspace and fits onto the node once it is gone.
+// The workspace controller then stops the preempted workspace, which takes a backup as usual, and starts it again later.
+type PreemptionReconciler struct {
+	client.Client
+
+	// Reader lists the pods of all namespaces running on a node, which the cache of the client does not hold
+	Reader client.Reader
+
+	Config   *config.Configuration
+	Recorder record.EventRecorder
+
+	preemptionsCounter *prometheus.CounterVec
+
+	// lastPreemption is the time we last preempted a workspace on behalf of a pending workspace
+	lastPreemption map[string]time.Time
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *PreemptionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return mgr.Add(r)
+}
+
+// Start implements manager.Runnable
+func (r *PreemptionReconciler) Start(ctx context.Context) error {
+	log := log.FromContext(ctx).WithName("preemption")
+
+	ticker := time.NewTicker(preemptionReconcileInterval)
+	defer ticker.Stop()
+	for {
+		err := r.reconcile(ctx)
+		if err != nil {
+			log.Error(err, "cannot preempt workspaces")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *PreemptionReconciler) reconcile(ctx context.Context) error {
+	if r.Config.Preemption == nil {
+		return nil
+	}
+	pendingTimeout := time.Duration(r.Config.Preemption.PendingTimeout)
+
+	var workspaces workspacev1.WorkspaceList
+	err := r.List(ctx, &workspaces, client.InNamespace(r.Config.Namespace))
+	if err != nil {
+		return fmt.Errorf("cannot list workspaces: %w", err)
+	}
+	var pods corev1.PodList
+	err = r.List(ctx, &pods, client.InNamespace(r.Config.Namespace), client.MatchingLabels{wsk8s.WorkspaceManagedByLabel: constants.ManagedBy})
+	if err != nil {
+		return fmt.Errorf("cannot list workspace pods: %w", err)
+	}
+	podsByWorkspace := make(map[string]*corev1.Pod, len(pods.Items))
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		podsByWorkspace[pod.Labels[wsk8s.WorkspaceIDLabel]] = pod
+	}
+
+	var (
+		pending    []*workspacev1.Workspace
+		candidates []*workspacev1.Workspace
+		stillThere = make(map[string]struct{})
+	)
+	for i := range workspaces.Items {
+		ws := &workspaces.Items[i]
+		switch {
+		case isUnschedulableFor(podsByWorkspace[ws.Name], pendingTimeout):
+			stillThere[ws.Name] = struct{}{}
+			if t, ok := r.lastPreemption[ws.Name]; ok && time.Since(t) < pendingTimeout {
+				// We preempted a workspace on its behalf recently - give the scheduler a chance to make use of that.
+				continue
+			}
+			pending = append(pending, ws)
+		case isPreemptible(ws):
+			candidates = append(candidates, ws)
+		}
+	}
+	for name := range r.lastPreemption {
+		if _, ok := stillThere[name]; !ok {
+			delete(r.lastPreemption, name)
+		}
+	}
+	if len(pending) == 0 || len(candidates) == 0 {
+		return nil
+	}
+
+	// Pending workspaces of highest priority go first. Of the candidates we preempt those of lowest priority first,
+	// and among those the youngest ones as they have the least work to lose.
+	sort.SliceStable(pending, func(i, j int) bool {
+		pi, pj := r.priority(pending[i]), r.priority(pending[j])
+		if pi != pj {
+			return pi > pj
+		}
+		return pending[i].CreationTimestamp.Before(&pending[j].CreationTimestamp)
+	})
+	sort.SliceStable(candidates, func(i, j int) bool {
+		pi, pj := r.priority(candidates[i]), r.priority(candidates[j])
+		if pi != pj {
+			return pi < pj
+		}
+		return candidates[j].CreationTimestamp.Before(&candidates[i].CreationTimestamp)
+	})
+
+	nodes := make(map[string]*nodeCapacity)
+	for _, ws := range pending {
+		pod := podsByWorkspace[ws.Name]
+		required := podRequests(pod)
+		for i, victim := range candidates {
+			if r.priority(victim) >= r.priority(ws) {
+				// candidates are sorted by priority, hence there is no candidate of lower priority left
+				break
+			}
+
+			nodeName := victim.Status.Runtime.NodeName
+			node, ok := nodes[nodeName]
+			if !ok {
+				node, err = r.nodeCapacity(ctx, nodeName)
+				if err != nil {
+					log.FromContext(ctx).Error(err, "cannot determine node capacity", "node", nodeName)
+				}
+				nodes[nodeName] = node
+			}
+			if node == nil || !matchesNodeAffinity(pod, node.Node) {
+				continue
+			}
+			freed := podRequests(podsByWorkspace[victim.Name])
+			if !fits(required, node.Free, freed) {
+				continue
+			}
+
+			err = r.preempt(ctx, victim, ws)
+			if err != nil {
+				log.FromContext(ctx).Error(err, "cannot preempt workspace", "workspace", victim.Name, "for", ws.Name)
+				continue
+			}
+			r.lastPreemption[ws.Name] = time.Now()
+			candidates = append(candidates[:i], candidates[i+1:]...)
+
+			// the pending workspace is going to take the space of the victim
+			for name, q := range freed {
+				free := node.Free[name]
+				free.Add(q)
+				node.Free[name] = free
+			}
+			for name, q := range required {
+				free := node.Free[name]
+				free.Sub(q)
+				node.Free[name] = free
+			}
+			break
+		}
+	}
+
+	return nil
+}
+
+// nodeCapacity describes a node and the resources which are not requested by any of the pods running on it
+type nodeCapacity struct {
+	Node *corev1.Node
+	Free corev1.ResourceList
+}
+
+func (r *PreemptionReconciler) nodeCapacity(ctx context.Context, name string) (*nodeCapacity, error) {
+	var node corev1.Node
+	err := r.Get(ctx, client.ObjectKey{Name: name}, &node)
+	if err != nil {
+		return nil, err
+	}
+	var pods corev1.PodList
+	err = r.Reader.List(ctx, &pods, client.MatchingFields{"spec.nodeName": name})
+	if err != nil {
+		return nil, fmt.Errorf("cannot list pods: %w", err)
+	}
+
+	free := make(corev1.ResourceList, len(preemptionResources))
+	for _, name := range preemptionResources {
+		free[name] = node.Status.Allocatable[name].DeepCopy()
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		for name, q := range podRequests(pod) {
+			f := free[name]
+			f.Sub(q)
+			free[name] = f
+		}
+	}
+	return &nodeCapacity{Node: &node, Free: free}, nil
+}
+
+// preemptionResources are the resources preemption makes room for
+var preemptionResources = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+
+// podRequests returns the CPU and memory a pod requests, i.e. the larger of the sum of its containers' and
+// the largest of its init containers' requests
+func podRequests(pod *corev1.Pod) corev1.ResourceList {
+	res := make(corev1.ResourceList, len(preemptionResources))
+	if pod == nil {
+		return res
+	}
+	for _, name := range preemptionResources {
+		var sum resource.Quantity
+		for _, c := range pod.Spec.Containers {
+			sum.Add(c.Resources.Requests[name])
+		}
+		for _, c := range pod.Spec.InitContainers {
+			if q := c.Resources.Requests[name]; q.Cmp(sum) > 0 {
+				sum = q.DeepCopy()
+			}
+		}
+		res[name] = sum
+	}
+	return res
+}
+
+// fits returns true if the required resources are available once the freed resources are added to the free ones
+func fits(required, free, freed corev1.ResourceList) bool {
+	for _, name := range preemptionResources {
+		avail := free[name].DeepCopy()
+		avail.Add(freed[name])
+		if avail.Cmp(required[name]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesNodeAffinity returns true if the node selector and the required node affinity of a pod allow it to run on the node
+func matchesNodeAffinity(pod *corev1.Pod, node *corev1.Node) bool {
+	for k, v := range pod.Spec.NodeSelector {
+		if l, ok := node.Labels[k]; !ok || l != v {
+			return false
+		}
+	}
+	if pod.Spec.Affinity == nil || pod.Spec.Affinity.NodeAffinity == nil || pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return true
+	}
+	// node selector terms are OR'ed, their requirements AND'ed
+	for _, term := range pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+		if matchesNodeSelectorTerm(term, node) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesNodeSelectorTerm(term corev1.NodeSelectorTerm, node *corev1.Node) bool {
+	if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+		// an empty term matches no node
+		return false
+	}
+	for _, req := range term.MatchExpressions {
+		if !matchesNodeSelectorRequirement(req, node.Labels) {
+			return false
+		}
+	}
+	for _, req := range term.MatchFields {
+		if req.Key != "metadata.name" || !matchesNodeSelectorRequirement(req, map[string]string{req.Key: node.Name}) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchesNodeSelectorRequirement(req corev1.NodeSelectorRequirement, labels map[string]string) bool {
+	val, exists := labels[req.Key]
+	switch req.Operator {
+	case corev1.NodeSelectorOpExists:
+		return exists
+	case corev1.NodeSelectorOpDoesNotExist:
+		return !exists
+	case corev1.NodeSelectorOpIn:
+		return exists && slices.Contains(req.Values, val)
+	case corev1.NodeSelectorOpNotIn:
+		return !exists || !slices.Contains(req.Values, val)
+	default:
+		// we do not evaluate Gt and Lt, hence we cannot tell whether the pod may run on the node
+		return false
+	}
+}
+
+func (r *PreemptionReconciler) priority(ws *workspacev1.Workspace) int32 {
+	return r.Config.WorkspacePriority(string(ws.Spec.Type), ws.Spec.Class)
+}
+
+func (r *PreemptionReconciler) preempt(ctx context.Context, victim, ws *workspacev1.Workspace) error {
+	msg := fmt.Sprintf("preempted in favour of workspace %s", ws.Name)
+	victim.Status.SetCondition(workspacev1.NewWorkspaceConditionPreempted(msg, metav1.ConditionTrue))
+	err := r.Status().Update(ctx, victim)
+	if err != nil {
+		return err
+	}
+
+	log.FromContext(ctx).Info("preempted workspace", "workspace", victim.Name, "for", ws.Name)
+	r.Recorder.Event(victim, corev1.EventTypeWarning, "Preempted", msg)
+	r.preemptionsCounter.WithLabelValues(string(victim.Spec.Type), victim.Spec.Class).Inc()
+	return nil
+}
+
+// isUnschedulableFor returns true if the scheduler has not been able to find a node for a pod for at least the given
+// duration, because the nodes lack CPU or memory. Preempting a workspace does not help pods which are unschedulable
+// for other reasons, e.g. because they do not tolerate a taint or their volumes cannot be bound.
+func isUnschedulableFor(pod *corev1.Pod, d time.Duration) bool {
+	if pod == nil || pod.Spec.NodeName != "" || pod.DeletionTimestamp != nil {
+		return false
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type != corev1.PodScheduled || c.Status != corev1.ConditionFalse || c.Reason != corev1.PodReasonUnschedulable {
+			continue
+		}
+		if !strings.Contains(c.Message, "Insufficient cpu") && !strings.Contains(c.Message, "Insufficient memory") {
+			return false
+		}
+		return time.Since(c.LastTransitionTime.Time) >= d
+	}
+	return false
+}
+
+// isPreemptible returns true if a workspace can be preempted, i.e. it is a headless workspace which occupies a node
+// and is not about to stop anyway
+func isPreemptible(ws *workspacev1.Workspace) bool {
+	if !ws.IsHeadless() || ws.DeletionTimestamp != nil {
+		return false
+	}
+	if ws.Status.Runtime == nil || ws.Status.Runtime.NodeName == "" {
+		return false
+	}
+	switch ws.Status.Phase {
+	case workspacev1.WorkspacePhaseCreating, workspacev1.WorkspacePhaseInitializing, workspacev1.WorkspacePhaseRunning:
+	default:
+		return false
+	}
+	return !ws.IsConditionTrue(workspacev1.WorkspaceConditionPreempted) &&
+		!ws.IsConditionTrue(workspacev1.WorkspaceConditionStoppedByRequest) &&
+		!ws.IsConditionTrue(workspacev1.WorkspaceConditionTimeout) &&
+		!ws.IsConditionTrue(workspacev1.WorkspaceConditionFailed)
+}
@@ -0,0 +1,270 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/ws-manager-mk2/pkg/constants"
+	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+func TestPreemptionReconcile(t *testing.T) {
+	now := time.Now()
+	workspace := func(name string, tpe workspacev1.WorkspaceType, phase workspacev1.WorkspacePhase, age time.Duration) *workspacev1.Workspace {
+		ws := &workspacev1.Workspace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "default",
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			},
+			Spec: workspacev1.WorkspaceSpec{
+				Type:  tpe,
+				Class: "default",
+			},
+			Status: workspacev1.WorkspaceStatus{Phase: phase},
+		}
+		if phase != workspacev1.WorkspacePhasePending {
+			ws.Status.Runtime = &workspacev1.WorkspaceRuntimeStatus{NodeName: "node"}
+		}
+		return ws
+	}
+	workspacePod := func(ws, node, cpu string, workloadType string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ws-" + ws,
+				Namespace: "default",
+				Labels: map[string]string{
+					wsk8s.WorkspaceIDLabel:        ws,
+					wsk8s.WorkspaceManagedByLabel: constants.ManagedBy,
+				},
+			},
+			Spec: corev1.PodSpec{
+				NodeName: node,
+				Affinity: &corev1.Affinity{
+					NodeAffinity: &corev1.NodeAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+							NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+								MatchExpressions: []corev1.NodeSelectorRequirement{{
+									Key:      "gitpod.io/workload_workspace_" + workloadType,
+									Operator: corev1.NodeSelectorOpExists,
+								}},
+							}},
+						},
+					},
+				},
+				Containers: []corev1.Container{{
+					Name: "workspace",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse(cpu),
+							corev1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+					},
+				}},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+	}
+	unschedulablePod := func(ws string, since time.Duration, cpu, reason string) *corev1.Pod {
+		pod := workspacePod(ws, "", cpu, "regular")
+		pod.Status = corev1.PodStatus{
+			Phase: corev1.PodPending,
+			Conditions: []corev1.PodCondition{
+				{
+					Type:               corev1.PodScheduled,
+					Status:             corev1.ConditionFalse,
+					Reason:             corev1.PodReasonUnschedulable,
+					Message:            "0/1 nodes are available: 1 " + reason + ".",
+					LastTransitionTime: metav1.NewTime(now.Add(-since)),
+				},
+			},
+		}
+		return pod
+	}
+	node := func(cpu string, workloadTypes ...string) *corev1.Node {
+		n := &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "node",
+				Labels: map[string]string{},
+			},
+			Status: corev1.NodeStatus{
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(cpu),
+					corev1.ResourceMemory: resource.MustParse("16Gi"),
+				},
+			},
+		}
+		for _, tpe := range workloadTypes {
+			n.Labels["gitpod.io/workload_workspace_"+tpe] = "true"
+		}
+		return n
+	}
+
+	tests := []struct {
+		Name              string
+		Objects           []client.Object
+		ExpectedPreempted []string
+	}{
+		{
+			Name: "youngest prebuild of lowest priority",
+			Objects: []client.Object{
+				node("6", "regular", "headless"),
+				workspace("regular", workspacev1.WorkspaceTypeRegular, workspacev1.WorkspacePhasePending, time.Minute),
+				unschedulablePod("regular", time.Minute, "2", "Insufficient cpu"),
+				workspace("prebuild-old", workspacev1.WorkspaceTypePrebuild, workspacev1.WorkspacePhaseRunning, time.Hour),
+				workspacePod("prebuild-old", "node", "2", "headless"),
+				workspace("prebuild-young", workspacev1.WorkspaceTypePrebuild, workspacev1.WorkspacePhaseRunning, 10*time.Minute),
+				workspacePod("prebuild-young", "node", "2", "headless"),
+				workspace("imagebuild", workspacev1.WorkspaceTypeImageBuild, workspacev1.WorkspacePhaseRunning, 5*time.Minute),
+				workspacePod("imagebuild", "node", "2", "headless"),
+			},
+			ExpectedPreempted: []string{"prebuild-young"},
+		},
+		{
+			Name: "insufficient memory",
+			Objects: []client.Object{
+				node("2", "regular", "headless"),
+				workspace("regular", workspacev1.WorkspaceTypeRegular, workspacev1.WorkspacePhasePending, time.Minute),
+				unschedulablePod("regular", time.Minute, "1", "Insufficient memory"),
+				workspace("prebuild", workspacev1.WorkspaceTypePrebuild, workspacev1.WorkspacePhaseRunning, time.Hour),
+				workspacePod("prebuild", "node", "2", "headless"),
+			},
+			ExpectedPreempted: []string{"prebuild"},
+		},
+		{
+			Name: "unschedulable for other reasons",
+			Objects: []client.Object{
+				node("2", "regular", "headless"),
+				workspace("regular", workspacev1.WorkspaceTypeRegular, workspacev1.WorkspacePhasePending, time.Minute),
+				unschedulablePod("regular", time.Minute, "1", "node(s) had untolerated taint {node.kubernetes.io/unreachable: }"),
+				workspace("prebuild", workspacev1.WorkspaceTypePrebuild, workspacev1.WorkspacePhaseRunning, time.Hour),
+				workspacePod("prebuild", "node", "2", "headless"),
+			},
+		},
+		{
+			Name: "victim frees too little",
+			Objects: []client.Object{
+				node("4", "regular", "headless"),
+				workspace("regular", workspacev1.WorkspaceTypeRegular, workspacev1.WorkspacePhasePending, time.Minute),
+				unschedulablePod("regular", time.Minute, "3", "Insufficient cpu"),
+				workspace("prebuild", workspacev1.WorkspaceTypePrebuild, workspacev1.WorkspacePhaseRunning, time.Hour),
+				workspacePod("prebuild", "node", "2", "headless"),
+				workspace("other-prebuild", workspacev1.WorkspaceTypePrebuild, workspacev1.WorkspacePhaseRunning, time.Hour),
+				workspacePod("other-prebuild", "node", "2", "headless"),
+			},
+		},
+		{
+			Name: "victim on a node the workspace cannot run on",
+			Objects: []client.Object{
+				node("2", "headless"),
+				workspace("regular", workspacev1.WorkspaceTypeRegular, workspacev1.WorkspacePhasePending, time.Minute),
+				unschedulablePod("regular", time.Minute, "1", "Insufficient cpu"),
+				workspace("prebuild", workspacev1.WorkspaceTypePrebuild, workspacev1.WorkspacePhaseRunning, time.Hour),
+				workspacePod("prebuild", "node", "2", "headless"),
+			},
+		},
+		{
+			Name: "not pending for long enough",
+			Objects: []client.Object{
+				node("2", "regular", "headless"),
+				workspace("regular", workspacev1.WorkspaceTypeRegular, workspacev1.WorkspacePhasePending, time.Minute),
+				unschedulablePod("regular", 10*time.Second, "1", "Insufficient cpu"),
+				workspace("prebuild", workspacev1.WorkspaceTypePrebuild, workspacev1.WorkspacePhaseRunning, time.Hour),
+				workspacePod("prebuild", "node", "2", "headless"),
+			},
+		},
+		{
+			Name: "regular workspaces are not preempted",
+			Objects: []client.Object{
+				node("2", "regular", "headless"),
+				workspace("prebuild", workspacev1.WorkspaceTypePrebuild, workspacev1.WorkspacePhasePending, time.Minute),
+				unschedulablePod("prebuild", time.Minute, "1", "Insufficient cpu"),
+				workspace("regular", workspacev1.WorkspaceTypeRegular, workspacev1.WorkspacePhaseRunning, time.Hour),
+				workspacePod("regular", "node", "2", "regular"),
+			},
+		},
+		{
+			Name: "same priority",
+			Objects: []client.Object{
+				node("2", "regular", "headless"),
+				workspace("prebuild-pending", workspacev1.WorkspaceTypePrebuild, workspacev1.WorkspacePhasePending, time.Minute),
+				unschedulablePod("prebuild-pending", time.Minute, "1", "Insufficient cpu"),
+				workspace("prebuild-running", workspacev1.WorkspaceTypePrebuild, workspacev1.WorkspacePhaseRunning, time.Hour),
+				workspacePod("prebuild-running", "node", "2", "headless"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(scheme)
+			_ = workspacev1.AddToScheme(scheme)
+			clnt := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithStatusSubresource(&workspacev1.Workspace{}).
+				WithIndex(&corev1.Pod{}, "spec.nodeName", func(o client.Object) []string {
+					return []string{o.(*corev1.Pod).Spec.NodeName}
+				}).
+				WithObjects(test.Objects...).
+				Build()
+
+			cfg := &config.Configuration{
+				Namespace: "default",
+				WorkspaceClasses: map[string]*config.WorkspaceClass{
+					"default": {},
+				},
+				Preemption: &config.PreemptionConfiguration{
+					TypePriorities: map[string]int32{
+						string(workspacev1.WorkspaceTypeRegular):    100,
+						string(workspacev1.WorkspaceTypeImageBuild): 50,
+						string(workspacev1.WorkspaceTypePrebuild):   10,
+					},
+					PendingTimeout: util.Duration(30 * time.Second),
+				},
+			}
+			r, err := NewPreemptionReconciler(clnt, clnt, record.NewFakeRecorder(10), cfg, prometheus.NewRegistry())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = r.reconcile(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var workspaces workspacev1.WorkspaceList
+			err = clnt.List(context.Background(), &workspaces)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var act []string
+			for _, ws := range workspaces.Items {
+				if ws.IsConditionTrue(workspacev1.WorkspaceConditionPreempted) {
+					act = append(act, ws.Name)
+				}
+			}
+			if len(act) != len(test.ExpectedPreempted) || (len(act) > 0 && act[0] != test.ExpectedPreempted[0]) {
+				t.Errorf("unexpected preempted workspaces: expected %v, got %v", test.ExpectedPreempted, act)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+
+	config "github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+// PodRenderer produces the pod the workspace controller would create for a workspace, without creating anything.
+// Unlike the controller, it does not place the pod on a particular node, e.g. for warm pools or migrations.
+type PodRenderer struct {
+	discovery discovery.ServerVersionInterface
+}
+
+// NewPodRenderer creates a pod renderer which discovers the Kubernetes version using restConfig
+func NewPodRenderer(restConfig *rest.Config) (*PodRenderer, error) {
+	dc, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create discovery client: %w", err)
+	}
+	return &PodRenderer{discovery: dc}, nil
+}
+
+// RenderWorkspacePod renders the workspace pod using the configuration cfg
+func (p *PodRenderer) RenderWorkspacePod(ctx context.Context, cfg *config.Configuration, ws *workspacev1.Workspace) (*corev1.Pod, error) {
+	sctx, err := newStartWorkspaceContext(ctx, cfg, ws, getServerVersion(ctx, p.discovery))
+	if err != nil {
+		return nil, err
+	}
+	return createWorkspacePod(sctx)
+}
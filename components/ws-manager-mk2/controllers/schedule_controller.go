@@ -0,0 +1,262 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	config "github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+const (
+	// schedulePrewarmGracePeriod is how long after the start of a window we still start the workspace if we missed
+	// the time to do so, e.g. because ws-manager was not running.
+	schedulePrewarmGracePeriod = 15 * time.Minute
+	// scheduleMaxRequeue is the longest we wait before reconciling a schedule again
+	scheduleMaxRequeue = time.Hour
+	// scheduleRetry is how long we wait before retrying a failed stop warning or prewarm request
+	scheduleRetry = time.Minute
+)
+
+// WorkspacePrewarmer asks for the workspace of a schedule to be started ahead of a window. ws-manager cannot start
+// the workspace itself, as only the server knows how to start it, e.g. which instance, token and secrets it needs.
+type WorkspacePrewarmer interface {
+	RequestPrewarm(ctx context.Context, schedule *workspacev1.WorkspaceSchedule, window time.Time) error
+}
+
+func NewScheduleReconciler(c client.Client, recorder record.EventRecorder, cfg *config.Configuration, notifier WorkspaceNotifier, prewarmer WorkspacePrewarmer) *ScheduleReconciler {
+	return &ScheduleReconciler{
+		Client:    c,
+		Config:    cfg,
+		Recorder:  recorder,
+		Notifier:  notifier,
+		Prewarmer: prewarmer,
+		clock:     clock.RealClock{},
+	}
+}
+
+// ScheduleReconciler reconciles WorkspaceSchedules. It warns users ahead of the end of a window that their workspace
+// will be stopped, and asks for workspaces to be started ahead of the start of a window. Stopping workspaces
+// outside of their windows is the job of the TimeoutReconciler.
+type ScheduleReconciler struct {
+	client.Client
+
+	Config    *config.Configuration
+	Recorder  record.EventRecorder
+	Notifier  WorkspaceNotifier
+	Prewarmer WorkspacePrewarmer
+
+	clock clock.PassiveClock
+}
+
+//+kubebuilder:rbac:groups=workspace.gitpod.io,resources=workspaceschedules,verbs=get;list;watch
+//+kubebuilder:rbac:groups=workspace.gitpod.io,resources=workspaceschedules/status,verbs=get;update;patch
+
+func (r *ScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx).WithValues("schedule", req.NamespacedName)
+
+	var schedule workspacev1.WorkspaceSchedule
+	err := r.Get(ctx, req.NamespacedName, &schedule)
+	if err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	now := r.clock.Now()
+	start, end, inWindow, err := schedule.Spec.Window(now)
+	if err != nil {
+		// There's no point in retrying until the schedule changes.
+		log.Error(err, "invalid workspace schedule")
+		r.Recorder.Event(&schedule, corev1.EventTypeWarning, "InvalidSchedule", err.Error())
+		return ctrl.Result{}, nil
+	}
+	nextStart, _, hasNext, err := schedule.Spec.NextWindow(now)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	workspaces, err := r.scheduledWorkspaces(ctx, &schedule)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	requeue := scheduleMaxRequeue
+	next := func(t time.Time) {
+		if d := t.Sub(now); d > 0 && d < requeue {
+			requeue = d
+		}
+	}
+
+	status := schedule.Status.DeepCopy()
+	if inWindow {
+		next(end)
+
+		due, nextWarning := stopWarningDue(schedule.Spec.StopWarnings, end, now, schedule.Status.LastStopWarning)
+		next(nextWarning)
+		if !due.IsZero() && len(workspaces) > 0 {
+			loc, _ := schedule.Spec.Location()
+			msg := fmt.Sprintf("This workspace will be stopped at %s (%s) according to its schedule.", end.In(loc).Format("15:04"), loc)
+
+			var notified bool
+			for i := range workspaces {
+				err := r.Notifier.Notify(ctx, &workspaces[i], msg)
+				if err != nil {
+					log.Error(err, "cannot warn about scheduled stop", "workspace", workspaces[i].Name)
+					continue
+				}
+				notified = true
+			}
+			if notified {
+				status.LastStopWarning = &metav1.Time{Time: now}
+			} else {
+				next(now.Add(scheduleRetry))
+			}
+		}
+	}
+
+	if prewarm := schedule.Spec.Prewarm; prewarm != nil {
+		if hasNext {
+			next(nextStart.Add(-prewarm.Lead.Duration))
+		}
+
+		target := nextStart
+		if inWindow {
+			target = start
+		}
+		switch {
+		case !inWindow && !hasNext:
+		case status.LastPrewarm != nil && status.LastPrewarm.Time.Equal(target):
+			// we took care of this window already
+		case now.Before(target.Add(-prewarm.Lead.Duration)):
+		case now.After(target.Add(schedulePrewarmGracePeriod)):
+			// too late to start the workspace for this window
+		case len(workspaces) > 0:
+			// the workspace is running already
+			status.LastPrewarm = &metav1.Time{Time: target}
+		default:
+			err := r.Prewarmer.RequestPrewarm(ctx, &schedule, target)
+			if err != nil {
+				log.Error(err, "cannot request workspace to be started ahead of scheduled window", "window", target)
+				r.Recorder.Event(&schedule, corev1.EventTypeWarning, "PrewarmFailed", err.Error())
+				next(now.Add(scheduleRetry))
+				break
+			}
+			log.Info("requested workspace to be started ahead of scheduled window", "window", target)
+			r.Recorder.Event(&schedule, corev1.EventTypeNormal, "PrewarmRequested", fmt.Sprintf("requested workspace to be started for window at %s", target.Format(time.RFC3339)))
+			status.LastPrewarm = &metav1.Time{Time: target}
+			status.LastPrewarmRequest = &metav1.Time{Time: now}
+		}
+	}
+
+	if !equality.Semantic.DeepEqual(*status, schedule.Status) {
+		schedule.Status = *status
+		err = r.Status().Update(ctx, &schedule)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("cannot update schedule status: %w", err)
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: requeue}, nil
+}
+
+// scheduledWorkspaces returns the regular workspaces a schedule applies to which are neither stopping nor stopped
+func (r *ScheduleReconciler) scheduledWorkspaces(ctx context.Context, schedule *workspacev1.WorkspaceSchedule) ([]workspacev1.Workspace, error) {
+	var list workspacev1.WorkspaceList
+	err := r.List(ctx, &list, client.InNamespace(schedule.Namespace))
+	if err != nil {
+		return nil, fmt.Errorf("cannot list workspaces: %w", err)
+	}
+
+	var res []workspacev1.Workspace
+	for _, ws := range list.Items {
+		if ws.Spec.Ownership.WorkspaceID != schedule.Name || ws.IsHeadless() || ws.DeletionTimestamp != nil {
+			continue
+		}
+		if ws.Status.Phase == workspacev1.WorkspacePhaseStopping || ws.Status.Phase == workspacev1.WorkspacePhaseStopped {
+			continue
+		}
+		res = append(res, ws)
+	}
+	return res, nil
+}
+
+// stopWarningDue returns the time of the latest stop warning which is due and has not been sent yet, and the time
+// of the next warning which is not due yet. Either is zero if there is no such warning.
+func stopWarningDue(warnings []metav1.Duration, end, now time.Time, lastWarning *metav1.Time) (due, next time.Time) {
+	times := make([]time.Time, 0, len(warnings))
+	for _, w := range warnings {
+		times = append(times, end.Add(-w.Duration))
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+
+	for _, t := range times {
+		if t.After(now) {
+			return due, t
+		}
+		if lastWarning == nil || lastWarning.Time.Before(t) {
+			due = t
+		}
+	}
+	return due, time.Time{}
+}
+
+// prewarmedBySchedule returns true if the workspace was started because its schedule asked for it, i.e. it was
+// created after the last prewarm request and before the window it was requested for was over the grace period
+func prewarmedBySchedule(ws *workspacev1.Workspace, schedule *workspacev1.WorkspaceSchedule) bool {
+	requested, window := schedule.Status.LastPrewarmRequest, schedule.Status.LastPrewarm
+	if requested == nil || window == nil {
+		return false
+	}
+	created := ws.CreationTimestamp.Time
+	return !created.Before(requested.Time.Truncate(time.Second)) && created.Before(window.Time.Add(schedulePrewarmGracePeriod))
+}
+
+// getWorkspaceSchedule returns the schedule of a workspace, or nil if it has none
+func getWorkspaceSchedule(ctx context.Context, c client.Reader, ws *workspacev1.Workspace) (*workspacev1.WorkspaceSchedule, error) {
+	if ws.Spec.Ownership.WorkspaceID == "" {
+		return nil, nil
+	}
+
+	var schedule workspacev1.WorkspaceSchedule
+	err := c.Get(ctx, types.NamespacedName{Namespace: ws.Namespace, Name: ws.Spec.Ownership.WorkspaceID}, &schedule)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ScheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("schedule").
+		For(&workspacev1.WorkspaceSchedule{}).
+		Watches(&workspacev1.Workspace{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, o client.Object) []reconcile.Request {
+			ws, ok := o.(*workspacev1.Workspace)
+			if !ok || ws.Spec.Ownership.WorkspaceID == "" {
+				return nil
+			}
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: ws.Namespace, Name: ws.Spec.Ownership.WorkspaceID}}}
+		})).
+		Complete(r)
+}
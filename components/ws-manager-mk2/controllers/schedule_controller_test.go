@@ -0,0 +1,245 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+type fakeNotifier struct {
+	Messages map[string][]string
+}
+
+func (n *fakeNotifier) Notify(ctx context.Context, ws *workspacev1.Workspace, message string) error {
+	n.Messages[ws.Name] = append(n.Messages[ws.Name], message)
+	return nil
+}
+
+type fakePrewarmer struct {
+	Windows []time.Time
+	Err     error
+}
+
+func (p *fakePrewarmer) RequestPrewarm(ctx context.Context, schedule *workspacev1.WorkspaceSchedule, window time.Time) error {
+	if p.Err != nil {
+		return p.Err
+	}
+	p.Windows = append(p.Windows, window)
+	return nil
+}
+
+func newScheduleTestClient(t *testing.T, objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = workspacev1.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&workspacev1.Workspace{}, &workspacev1.WorkspaceSchedule{}).WithObjects(objs...).Build()
+}
+
+func newScheduleTestSchedule() *workspacev1.WorkspaceSchedule {
+	return &workspacev1.WorkspaceSchedule{
+		ObjectMeta: metav1.ObjectMeta{Name: "gitpodio-gitpod-abc", Namespace: "default"},
+		Spec: workspacev1.WorkspaceScheduleSpec{
+			Timezone: "Europe/Berlin",
+			Windows: []workspacev1.WorkspaceScheduleWindow{
+				{Days: []workspacev1.WorkspaceScheduleDay{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"}, Start: "09:00", End: "17:00"},
+			},
+			StopWarnings: []metav1.Duration{{Duration: 30 * time.Minute}, {Duration: 5 * time.Minute}},
+		},
+	}
+}
+
+func newScheduleTestWorkspace(name string) *workspacev1.Workspace {
+	return &workspacev1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: workspacev1.WorkspaceSpec{
+			Type:      workspacev1.WorkspaceTypeRegular,
+			Ownership: workspacev1.Ownership{WorkspaceID: "gitpodio-gitpod-abc"},
+		},
+		Status: workspacev1.WorkspaceStatus{
+			Phase:   workspacev1.WorkspacePhaseRunning,
+			Runtime: &workspacev1.WorkspaceRuntimeStatus{PodIP: "10.0.0.1"},
+		},
+	}
+}
+
+func berlin(t *testing.T, s string) time.Time {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := time.ParseInLocation("2006-01-02 15:04", s, loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+func TestScheduleStopWarnings(t *testing.T) {
+	clnt := newScheduleTestClient(t, newScheduleTestSchedule(), newScheduleTestWorkspace("ws"))
+	notifier := &fakeNotifier{Messages: make(map[string][]string)}
+	// Friday after the clocks went forward
+	clock := clocktesting.NewFakeClock(berlin(t, "2026-04-03 16:20"))
+	r := NewScheduleReconciler(clnt, record.NewFakeRecorder(10), &config.Configuration{}, notifier, &fakePrewarmer{})
+	r.clock = clock
+
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "gitpodio-gitpod-abc"}}
+	steps := []struct {
+		Time             string
+		ExpectedMessages int
+		ExpectedRequeue  time.Duration
+	}{
+		{Time: "2026-04-03 16:20", ExpectedMessages: 0, ExpectedRequeue: 10 * time.Minute},
+		{Time: "2026-04-03 16:31", ExpectedMessages: 1, ExpectedRequeue: 24 * time.Minute},
+		{Time: "2026-04-03 16:40", ExpectedMessages: 1, ExpectedRequeue: 15 * time.Minute},
+		{Time: "2026-04-03 16:56", ExpectedMessages: 2, ExpectedRequeue: 4 * time.Minute},
+		{Time: "2026-04-03 16:58", ExpectedMessages: 2, ExpectedRequeue: 2 * time.Minute},
+	}
+	for _, step := range steps {
+		clock.SetTime(berlin(t, step.Time))
+		res, err := r.Reconcile(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if act := len(notifier.Messages["ws"]); act != step.ExpectedMessages {
+			t.Errorf("%s: expected %d messages, got %d: %v", step.Time, step.ExpectedMessages, act, notifier.Messages["ws"])
+		}
+		if res.RequeueAfter != step.ExpectedRequeue {
+			t.Errorf("%s: expected requeue after %s, got %s", step.Time, step.ExpectedRequeue, res.RequeueAfter)
+		}
+	}
+	if exp := "This workspace will be stopped at 17:00 (Europe/Berlin) according to its schedule."; notifier.Messages["ws"][0] != exp {
+		t.Errorf("unexpected message: %s", notifier.Messages["ws"][0])
+	}
+}
+
+func TestSchedulePrewarm(t *testing.T) {
+	schedule := newScheduleTestSchedule()
+	schedule.Spec.Prewarm = &workspacev1.WorkspaceSchedulePrewarm{
+		Lead: metav1.Duration{Duration: 15 * time.Minute},
+	}
+	clnt := newScheduleTestClient(t, schedule)
+	clock := clocktesting.NewFakeClock(berlin(t, "2026-03-30 08:30"))
+	prewarmer := &fakePrewarmer{Err: errors.New("no webhook accepts prewarm events")}
+	r := NewScheduleReconciler(clnt, record.NewFakeRecorder(10), &config.Configuration{}, &fakeNotifier{Messages: make(map[string][]string)}, prewarmer)
+	r.clock = clock
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: schedule.Name}}
+
+	res, err := r.Reconcile(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.RequeueAfter != 15*time.Minute {
+		t.Errorf("expected requeue after 15m, got %s", res.RequeueAfter)
+	}
+
+	// A failed request is retried
+	clock.SetTime(berlin(t, "2026-03-30 08:45"))
+	res, err = r.Reconcile(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.RequeueAfter != scheduleRetry {
+		t.Errorf("expected requeue after %s, got %s", scheduleRetry, res.RequeueAfter)
+	}
+
+	prewarmer.Err = nil
+	clock.SetTime(berlin(t, "2026-03-30 08:46"))
+	for i := 0; i < 2; i++ {
+		_, err = r.Reconcile(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(prewarmer.Windows) != 1 || !prewarmer.Windows[0].Equal(berlin(t, "2026-03-30 09:00")) {
+		t.Fatalf("expected one prewarm request for the 09:00 window, got %v", prewarmer.Windows)
+	}
+
+	var act workspacev1.WorkspaceSchedule
+	err = clnt.Get(context.Background(), req.NamespacedName, &act)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if act.Status.LastPrewarm == nil || !act.Status.LastPrewarm.Time.Equal(berlin(t, "2026-03-30 09:00")) ||
+		act.Status.LastPrewarmRequest == nil || !act.Status.LastPrewarmRequest.Time.Equal(berlin(t, "2026-03-30 08:46")) {
+		t.Errorf("unexpected status: %+v", act.Status)
+	}
+
+	// The server starts the workspace, and the user stops it again during the window. That must not make us
+	// ask for it to be started again. The finalizer keeps the workspace around, but marks it as being deleted.
+	ws := newScheduleTestWorkspace("prewarmed")
+	ws.Finalizers = []string{workspacev1.GitpodFinalizerName}
+	err = clnt.Create(context.Background(), ws)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = clnt.Delete(context.Background(), ws)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clock.SetTime(berlin(t, "2026-03-30 09:05"))
+	_, err = r.Reconcile(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(prewarmer.Windows) != 1 {
+		t.Errorf("workspace must not be requested twice for the same window, got %v", prewarmer.Windows)
+	}
+}
+
+func TestTimeoutSchedule(t *testing.T) {
+	schedule := newScheduleTestSchedule()
+	schedule.Status.LastPrewarm = &metav1.Time{Time: berlin(t, "2026-03-30 09:00")}
+	schedule.Status.LastPrewarmRequest = &metav1.Time{Time: berlin(t, "2026-03-30 08:45")}
+
+	prewarmed := newScheduleTestWorkspace("prewarmed")
+	prewarmed.CreationTimestamp = metav1.Time{Time: berlin(t, "2026-03-30 08:45")}
+	regular := newScheduleTestWorkspace("regular")
+	regular.CreationTimestamp = metav1.Time{Time: berlin(t, "2026-03-30 08:00")}
+	unscheduled := newScheduleTestWorkspace("unscheduled")
+	unscheduled.Spec.Ownership.WorkspaceID = "gitpodio-gitpod-xyz"
+
+	tests := []struct {
+		Name           string
+		Time           string
+		Workspace      *workspacev1.Workspace
+		ExpectedReason bool
+		ExpectedExempt bool
+	}{
+		{Name: "within window", Time: "2026-03-30 10:00", Workspace: regular},
+		{Name: "outside window", Time: "2026-03-30 18:00", Workspace: regular, ExpectedReason: true},
+		{Name: "weekend", Time: "2026-03-29 10:00", Workspace: regular, ExpectedReason: true},
+		{Name: "prewarmed without activity", Time: "2026-03-30 10:00", Workspace: prewarmed, ExpectedExempt: true},
+		{Name: "prewarmed outside window", Time: "2026-03-30 18:00", Workspace: prewarmed, ExpectedReason: true},
+		{Name: "no schedule", Time: "2026-03-30 18:00", Workspace: unscheduled},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			r := &TimeoutReconciler{
+				Client: newScheduleTestClient(t, schedule),
+				clock:  clocktesting.NewFakeClock(berlin(t, test.Time)),
+			}
+			reason, exempt := r.checkSchedule(context.Background(), test.Workspace)
+			if (reason != "") != test.ExpectedReason || exempt != test.ExpectedExempt {
+				t.Errorf("unexpected result: reason \"%s\", exempt %v", reason, exempt)
+			}
+		})
+	}
+}
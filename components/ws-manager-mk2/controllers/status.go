@@ -220,6 +220,10 @@ func (r *WorkspaceReconciler) updateWorkspaceStatus(ctx context.Context, workspa
 			// if the workspace container is not ready anymore. This is to avoid the workspace
 			// moving back to Initializing and becoming unusable.
 			workspace.Status.Phase = workspacev1.WorkspacePhaseRunning
+			if workspace.IsConditionTrue(workspacev1.WorkspaceConditionPaused) {
+				// ws-daemon froze the workspace's processes. Its readiness probe fails while it's paused, too.
+				workspace.Status.Phase = workspacev1.WorkspacePhasePaused
+			}
 		} else {
 			contentReady := workspace.IsConditionTrue(workspacev1.WorkspaceConditionContentReady)
 			var ideReady bool
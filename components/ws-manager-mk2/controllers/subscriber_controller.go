@@ -67,6 +67,16 @@ func (r *SubscriberReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, nil
 	}
 
+	if workspace.IsConditionTrue(workspacev1.WorkspaceConditionPreempted) {
+		// The workspace is stopping to make way for another one and will be started again. Same as above, clients need not see that.
+		return ctrl.Result{}, nil
+	}
+
+	if workspace.IsConditionTrue(workspacev1.WorkspaceConditionMigrating) {
+		// The workspace moves to another node. To clients it keeps running, under the same ID and URL.
+		return ctrl.Result{}, nil
+	}
+
 	if r.OnReconcile != nil {
 		r.OnReconcile(ctx, &workspace)
 	}
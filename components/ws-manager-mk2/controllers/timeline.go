@@ -0,0 +1,185 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	tracelog "github.com/opentracing/opentracing-go/log"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+// timelineLimit is the number of events the timeline of a workspace keeps
+const timelineLimit = 64
+
+// recordTimeline adds the phase and the conditions of the workspace and its pod, as well as the image pulls of the pod,
+// to the workspace's timeline if they changed since they were last recorded. It returns the number of events it added
+// at the end of the timeline.
+func recordTimeline(ws *workspacev1.Workspace, pod *corev1.Pod, now time.Time) (added int) {
+	ts := metav1.NewMicroTime(now)
+	record := func(tpe workspacev1.WorkspaceTimelineEventType, name string, status metav1.ConditionStatus) {
+		if last := lastTimelineEvent(ws.Status.Timeline, tpe, name); last != nil && last.Status == status {
+			return
+		}
+		ws.Status.Timeline = append(ws.Status.Timeline, workspacev1.WorkspaceTimelineEvent{Type: tpe, Name: name, Status: status, Time: ts})
+		added++
+	}
+
+	if pod != nil {
+		for _, c := range pod.Status.Conditions {
+			record(workspacev1.WorkspaceTimelineEventPod, string(c.Type), metav1.ConditionStatus(c.Status))
+		}
+		for _, statuses := range [][]corev1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+			for _, cs := range statuses {
+				if status := imagePullStatus(cs); status != "" {
+					record(workspacev1.WorkspaceTimelineEventImagePull, cs.Name, status)
+				}
+			}
+		}
+	}
+	for _, c := range ws.Status.Conditions {
+		if c.Type == string(workspacev1.WorkspaceConditionRefresh) {
+			// ws-daemon sets this condition merely to trigger a reconciliation
+			continue
+		}
+		record(workspacev1.WorkspaceTimelineEventCondition, c.Type, c.Status)
+	}
+	if ws.Status.Phase != "" {
+		if last := lastTimelineEvent(ws.Status.Timeline, workspacev1.WorkspaceTimelineEventPhase, ""); last == nil || last.Name != string(ws.Status.Phase) {
+			ws.Status.Timeline = append(ws.Status.Timeline, workspacev1.WorkspaceTimelineEvent{Type: workspacev1.WorkspaceTimelineEventPhase, Name: string(ws.Status.Phase), Time: ts})
+			added++
+		}
+	}
+
+	for len(ws.Status.Timeline) > timelineLimit {
+		ws.Status.Timeline = trimTimeline(ws.Status.Timeline)
+	}
+	return added
+}
+
+// imagePullStatus is True while the container waits for its image and False once the container was created from it.
+// The kubelet does not report pulls in the pod status, hence a container which waits before it was created is as close
+// as we get. The status is empty if the container did not get that far yet.
+func imagePullStatus(cs corev1.ContainerStatus) metav1.ConditionStatus {
+	if cs.ImageID != "" {
+		return metav1.ConditionFalse
+	}
+	if cs.State.Waiting == nil {
+		return ""
+	}
+	switch cs.State.Waiting.Reason {
+	case "ContainerCreating", "ErrImagePull", "ImagePullBackOff":
+		return metav1.ConditionTrue
+	}
+	return ""
+}
+
+// lastTimelineEvent returns the latest event of the type and name. An empty name matches all events of that type.
+func lastTimelineEvent(timeline []workspacev1.WorkspaceTimelineEvent, tpe workspacev1.WorkspaceTimelineEventType, name string) *workspacev1.WorkspaceTimelineEvent {
+	for i := len(timeline) - 1; i >= 0; i-- {
+		if timeline[i].Type == tpe && (name == "" || timeline[i].Name == name) {
+			return &timeline[i]
+		}
+	}
+	return nil
+}
+
+// trimTimeline drops one event from the timeline. The startup of the workspace is what we're most interested in,
+// hence we drop the oldest event after the workspace first ran which was superseded by a later event of the same kind.
+// Keeping the latest event of every kind lets recordTimeline tell what changed.
+func trimTimeline(timeline []workspacev1.WorkspaceTimelineEvent) []workspacev1.WorkspaceTimelineEvent {
+	start := 0
+	for i, evt := range timeline {
+		if evt.Type == workspacev1.WorkspaceTimelineEventPhase && evt.Name == string(workspacev1.WorkspacePhaseRunning) {
+			start = i + 1
+			break
+		}
+	}
+
+	drop := start
+	for i := start; i < len(timeline); i++ {
+		if last := lastTimelineEvent(timeline, timeline[i].Type, timeline[i].Name); last != &timeline[i] {
+			drop = i
+			break
+		}
+	}
+	if drop >= len(timeline) {
+		drop = 0
+	}
+	return append(timeline[:drop], timeline[drop+1:]...)
+}
+
+// traceTimeline reports the phases the workspace left, as recorded in the last added events of its timeline, as spans
+// of the trace the workspace was started in. The events which happened during a phase are logged on its span.
+func traceTimeline(ws *workspacev1.Workspace, added int) {
+	traceID := ws.Annotations[wsk8s.WorkspaceTraceContextAnnotation]
+	if traceID == "" {
+		return
+	}
+
+	timeline := ws.Status.Timeline
+	for cur := max(len(timeline)-added, 0); cur < len(timeline); cur++ {
+		evt := timeline[cur]
+		if evt.Type == workspacev1.WorkspaceTimelineEventImagePull {
+			traceImagePull(ws, traceID, timeline[:cur], evt)
+			continue
+		}
+		if evt.Type != workspacev1.WorkspaceTimelineEventPhase {
+			continue
+		}
+		prev := cur - 1
+		for prev >= 0 && timeline[prev].Type != workspacev1.WorkspaceTimelineEventPhase {
+			prev--
+		}
+		if prev < 0 {
+			continue
+		}
+
+		span := opentracing.StartSpan(fmt.Sprintf("Workspace%s", timeline[prev].Name), tracing.ChildOfTraceID(traceID), opentracing.StartTime(timeline[prev].Time.Time))
+		tracing.ApplyOWI(span, ws.OWI())
+		var logs []opentracing.LogRecord
+		for _, e := range timeline[prev+1 : cur] {
+			logs = append(logs, opentracing.LogRecord{
+				Timestamp: e.Time.Time,
+				Fields: []tracelog.Field{
+					tracelog.String("event", string(e.Type)),
+					tracelog.String("name", e.Name),
+					tracelog.String("status", string(e.Status)),
+				},
+			})
+		}
+		span.FinishWithOptions(opentracing.FinishOptions{FinishTime: evt.Time.Time, LogRecords: logs})
+
+		if evt.Name == string(workspacev1.WorkspacePhaseRunning) && lastTimelineEvent(timeline[:cur], workspacev1.WorkspaceTimelineEventPhase, evt.Name) == nil {
+			span := opentracing.StartSpan("WorkspaceStartup", tracing.ChildOfTraceID(traceID), opentracing.StartTime(timeline[0].Time.Time))
+			tracing.ApplyOWI(span, ws.OWI())
+			span.FinishWithOptions(opentracing.FinishOptions{FinishTime: evt.Time.Time})
+		}
+	}
+}
+
+// traceImagePull reports the pull of a container's image as a span once it finished.
+func traceImagePull(ws *workspacev1.Workspace, traceID string, before []workspacev1.WorkspaceTimelineEvent, evt workspacev1.WorkspaceTimelineEvent) {
+	if evt.Status != metav1.ConditionFalse {
+		return
+	}
+	start := lastTimelineEvent(before, workspacev1.WorkspaceTimelineEventImagePull, evt.Name)
+	if start == nil || start.Status != metav1.ConditionTrue {
+		// we never saw the container wait for its image
+		return
+	}
+
+	span := opentracing.StartSpan("ImagePull", tracing.ChildOfTraceID(traceID), opentracing.StartTime(start.Time.Time))
+	tracing.ApplyOWI(span, ws.OWI())
+	span.SetTag("container", evt.Name)
+	span.FinishWithOptions(opentracing.FinishOptions{FinishTime: evt.Time.Time})
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+type timelineEntry struct {
+	Type   workspacev1.WorkspaceTimelineEventType
+	Name   string
+	Status metav1.ConditionStatus
+}
+
+func timelineEntries(timeline []workspacev1.WorkspaceTimelineEvent) []timelineEntry {
+	res := make([]timelineEntry, 0, len(timeline))
+	for _, evt := range timeline {
+		res = append(res, timelineEntry{Type: evt.Type, Name: evt.Name, Status: evt.Status})
+	}
+	return res
+}
+
+func TestRecordTimeline(t *testing.T) {
+	now := time.Now()
+	ws := &workspacev1.Workspace{
+		Status: workspacev1.WorkspaceStatus{
+			Phase: workspacev1.WorkspacePhasePending,
+		},
+	}
+	pod := &corev1.Pod{}
+
+	if added := recordTimeline(ws, pod, now); added != 1 {
+		t.Errorf("expected 1 added event, got %d", added)
+	}
+
+	pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodScheduled, Status: corev1.ConditionTrue}}
+	ws.Status.Phase = workspacev1.WorkspacePhaseCreating
+	ws.Status.Conditions = []metav1.Condition{
+		{Type: string(workspacev1.WorkspaceConditionRefresh), Status: metav1.ConditionTrue},
+		{Type: string(workspacev1.WorkspaceConditionContentReady), Status: metav1.ConditionFalse},
+	}
+	if added := recordTimeline(ws, pod, now.Add(time.Second)); added != 3 {
+		t.Errorf("expected 3 added events, got %d", added)
+	}
+
+	// nothing changed
+	if added := recordTimeline(ws, pod, now.Add(2*time.Second)); added != 0 {
+		t.Errorf("expected no added events, got %d", added)
+	}
+
+	ws.Status.Conditions[1].Status = metav1.ConditionTrue
+	ws.Status.Phase = workspacev1.WorkspacePhaseRunning
+	if added := recordTimeline(ws, nil, now.Add(3*time.Second)); added != 2 {
+		t.Errorf("expected 2 added events, got %d", added)
+	}
+
+	expected := []timelineEntry{
+		{Type: workspacev1.WorkspaceTimelineEventPhase, Name: string(workspacev1.WorkspacePhasePending)},
+		{Type: workspacev1.WorkspaceTimelineEventPod, Name: string(corev1.PodScheduled), Status: metav1.ConditionTrue},
+		{Type: workspacev1.WorkspaceTimelineEventCondition, Name: string(workspacev1.WorkspaceConditionContentReady), Status: metav1.ConditionFalse},
+		{Type: workspacev1.WorkspaceTimelineEventPhase, Name: string(workspacev1.WorkspacePhaseCreating)},
+		{Type: workspacev1.WorkspaceTimelineEventCondition, Name: string(workspacev1.WorkspaceConditionContentReady), Status: metav1.ConditionTrue},
+		{Type: workspacev1.WorkspaceTimelineEventPhase, Name: string(workspacev1.WorkspacePhaseRunning)},
+	}
+	if diff := cmp.Diff(expected, timelineEntries(ws.Status.Timeline)); diff != "" {
+		t.Errorf("unexpected timeline (-want +got):\n%s", diff)
+	}
+	if got := ws.Status.Timeline[len(ws.Status.Timeline)-1].Time.Time; !got.Equal(now.Add(3 * time.Second)) {
+		t.Errorf("expected the last event at %v, got %v", now.Add(3*time.Second), got)
+	}
+}
+
+func TestRecordTimelineLimit(t *testing.T) {
+	now := time.Now()
+	ws := &workspacev1.Workspace{
+		Status: workspacev1.WorkspaceStatus{
+			Phase: workspacev1.WorkspacePhasePending,
+		},
+	}
+	recordTimeline(ws, nil, now)
+	ws.Status.Phase = workspacev1.WorkspacePhaseRunning
+	recordTimeline(ws, nil, now.Add(time.Second))
+
+	for i := 0; i < 2*timelineLimit; i++ {
+		status := metav1.ConditionFalse
+		if i%2 == 0 {
+			status = metav1.ConditionTrue
+		}
+		ws.Status.Conditions = []metav1.Condition{{Type: string(workspacev1.WorkspaceConditionEverReady), Status: status}}
+		recordTimeline(ws, nil, now.Add(time.Duration(i+2)*time.Second))
+	}
+
+	timeline := ws.Status.Timeline
+	if len(timeline) != timelineLimit {
+		t.Fatalf("expected %d events, got %d", timelineLimit, len(timeline))
+	}
+	startup := []timelineEntry{
+		{Type: workspacev1.WorkspaceTimelineEventPhase, Name: string(workspacev1.WorkspacePhasePending)},
+		{Type: workspacev1.WorkspaceTimelineEventPhase, Name: string(workspacev1.WorkspacePhaseRunning)},
+	}
+	if diff := cmp.Diff(startup, timelineEntries(timeline[:2])); diff != "" {
+		t.Errorf("startup was not kept (-want +got):\n%s", diff)
+	}
+	last := lastTimelineEvent(timeline, workspacev1.WorkspaceTimelineEventCondition, string(workspacev1.WorkspaceConditionEverReady))
+	if last == nil || last.Status != metav1.ConditionFalse || last != &timeline[len(timeline)-1] {
+		t.Errorf("latest condition was not kept: %v", last)
+	}
+}
+
+func TestRecordTimelineImagePull(t *testing.T) {
+	now := time.Now()
+	ws := &workspacev1.Workspace{}
+	pod := &corev1.Pod{
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "workspace", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}}},
+				{Name: "sidecar"},
+			},
+		},
+	}
+	recordTimeline(ws, pod, now)
+
+	pod.Status.ContainerStatuses[0].State.Waiting.Reason = "ImagePullBackOff"
+	if added := recordTimeline(ws, pod, now.Add(time.Second)); added != 0 {
+		t.Errorf("expected no added events while the image is still being pulled, got %d", added)
+	}
+
+	pod.Status.ContainerStatuses[0] = corev1.ContainerStatus{
+		Name:    "workspace",
+		ImageID: "docker.io/library/workspace@sha256:0000",
+		State:   corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+	}
+	recordTimeline(ws, pod, now.Add(2*time.Second))
+
+	expected := []timelineEntry{
+		{Type: workspacev1.WorkspaceTimelineEventImagePull, Name: "workspace", Status: metav1.ConditionTrue},
+		{Type: workspacev1.WorkspaceTimelineEventImagePull, Name: "workspace", Status: metav1.ConditionFalse},
+	}
+	if diff := cmp.Diff(expected, timelineEntries(ws.Status.Timeline)); diff != "" {
+		t.Errorf("unexpected timeline (-want +got):\n%s", diff)
+	}
+}
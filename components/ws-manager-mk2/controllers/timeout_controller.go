@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -45,6 +46,7 @@ func NewTimeoutReconciler(c client.Client, recorder record.EventRecorder, cfg co
 		reconcileInterval: reconcileInterval,
 		recorder:          recorder,
 		maintenance:       maintenance,
+		clock:             clock.RealClock{},
 	}, nil
 }
 
@@ -59,10 +61,12 @@ type TimeoutReconciler struct {
 	reconcileInterval time.Duration
 	recorder          record.EventRecorder
 	maintenance       maintenance.Maintenance
+	clock             clock.PassiveClock
 }
 
 //+kubebuilder:rbac:groups=workspace.gitpod.io,resources=workspaces,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=workspace.gitpod.io,resources=workspaces/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=workspace.gitpod.io,resources=workspaceschedules,verbs=get;list;watch
 
 // Reconcile will check the given workspace for timing out. When done, a new event gets
 // requeued automatically to ensure the workspace gets reconciled at least every reconcileInterval.
@@ -103,7 +107,13 @@ func (r *TimeoutReconciler) Reconcile(ctx context.Context, req ctrl.Request) (re
 		result.RequeueAfter = r.reconcileInterval
 	}()
 
-	timedout := r.isWorkspaceTimedOut(&workspace)
+	timedout, exempt := r.checkSchedule(ctx, &workspace)
+	if timedout == "" && !exempt {
+		timedout = r.isWorkspaceTimedOut(&workspace)
+		if timedout != "" && r.isPausable(&workspace) {
+			return ctrl.Result{}, r.pauseWorkspace(ctx, &workspace, timedout)
+		}
+	}
 	if timedout == "" {
 		// Hasn't timed out.
 		return ctrl.Result{}, nil
@@ -128,6 +138,36 @@ func (r *TimeoutReconciler) Reconcile(ctx context.Context, req ctrl.Request) (re
 	return ctrl.Result{}, nil
 }
 
+// checkSchedule returns a reason if the workspace must be stopped because it is running outside of its scheduled
+// windows. Workspaces which their schedule started and which have not seen any activity yet are exempt from all
+// other timeouts while their window lasts, as they are meant to wait for their user.
+func (r *TimeoutReconciler) checkSchedule(ctx context.Context, ws *workspacev1.Workspace) (reason string, exempt bool) {
+	if ws.Status.Phase != workspacev1.WorkspacePhaseRunning || ws.IsHeadless() {
+		return "", false
+	}
+
+	log := log.FromContext(ctx)
+	schedule, err := getWorkspaceSchedule(ctx, r.Client, ws)
+	if err != nil {
+		log.Error(err, "cannot get workspace schedule")
+		return "", false
+	}
+	if schedule == nil {
+		return "", false
+	}
+
+	_, _, inWindow, err := schedule.Spec.Window(r.clock.Now())
+	if err != nil {
+		log.Error(err, "invalid workspace schedule", "schedule", schedule.Name)
+		return "", false
+	}
+	if !inWindow {
+		return "workspace stopped outside of its scheduled hours", false
+	}
+
+	return "", prewarmedBySchedule(ws, schedule) && activity.Last(ws) == nil
+}
+
 type timeoutActivity string
 
 const (
@@ -142,6 +182,7 @@ const (
 	activityInterrupted        timeoutActivity = "workspace interruption"
 	activityStopping           timeoutActivity = "stopping"
 	activityBackup             timeoutActivity = "backup"
+	activityPaused             timeoutActivity = "being paused"
 )
 
 // isWorkspaceTimedOut determines if a workspace is timed out based on the manager configuration and state the pod is in.
@@ -152,7 +193,7 @@ func (r *TimeoutReconciler) isWorkspaceTimedOut(ws *workspacev1.Workspace) (reas
 
 	decide := func(start time.Time, timeout util.Duration, activity timeoutActivity) string {
 		td := time.Duration(timeout)
-		inactivity := time.Since(start)
+		inactivity := r.clock.Since(start)
 		if inactivity < td {
 			return ""
 		}
@@ -164,12 +205,18 @@ func (r *TimeoutReconciler) isWorkspaceTimedOut(ws *workspacev1.Workspace) (reas
 	lastActivity := activity.Last(ws)
 	isClosed := ws.IsConditionTrue(workspacev1.WorkspaceConditionClosed)
 
+	startup := start
+	if ws.Status.Migration != nil {
+		// the replacement pod of a migrated workspace starts long after the workspace was created
+		startup = ws.Status.Migration.Started.Time
+	}
+
 	switch phase {
 	case workspacev1.WorkspacePhasePending:
-		return decide(start, timeouts.Initialization, activityInit)
+		return decide(startup, timeouts.Initialization, activityInit)
 
 	case workspacev1.WorkspacePhaseInitializing:
-		return decide(start, timeouts.TotalStartup, activityStartup)
+		return decide(startup, timeouts.TotalStartup, activityStartup)
 
 	case workspacev1.WorkspacePhaseCreating:
 		activity := activityCreatingContainers
@@ -177,7 +224,7 @@ func (r *TimeoutReconciler) isWorkspaceTimedOut(ws *workspacev1.Workspace) (reas
 		// if status.Conditions.PullingImages == api.WorkspaceConditionBool_TRUE {
 		// 	activity = activityPullingImages
 		// }
-		return decide(start, timeouts.TotalStartup, activity)
+		return decide(startup, timeouts.TotalStartup, activity)
 
 	case workspacev1.WorkspacePhaseRunning:
 		// First check is always for the max lifetime
@@ -186,11 +233,16 @@ func (r *TimeoutReconciler) isWorkspaceTimedOut(ws *workspacev1.Workspace) (reas
 			return msg
 		}
 
-		timeout := timeouts.RegularWorkspace
+		policy := r.Config.TimeoutsFor(ws.Spec.Ownership.Team, ws.Spec.Class)
+		timeout := policy.RegularWorkspace
 		if customTimeout := ws.Spec.Timeout.Time; customTimeout != nil {
 			timeout = util.Duration(customTimeout.Duration)
 		}
 		activity := activityNone
+		if ws.Spec.Paused && r.Config.Pause != nil && lastActivity != nil {
+			// ws-daemon has yet to freeze the workspace. Should that never happen, we stop it when it would have been paused for too long.
+			return decide(*lastActivity, timeout+r.Config.Pause.MaxDuration, activityPaused)
+		}
 		if ws.IsHeadless() {
 			timeout = timeouts.HeadlessWorkspace
 			lastActivity = &start
@@ -200,7 +252,7 @@ func (r *TimeoutReconciler) isWorkspaceTimedOut(ws *workspacev1.Workspace) (reas
 			return decide(start, timeouts.TotalStartup, activityNone)
 		} else if isClosed {
 			reason := func() string {
-				afterClosed := timeouts.AfterClose
+				afterClosed := policy.AfterClose
 				if customClosedTimeout := ws.Spec.Timeout.ClosedTimeout; customClosedTimeout != nil {
 					afterClosed = util.Duration(customClosedTimeout.Duration)
 					if afterClosed == 0 {
@@ -215,6 +267,19 @@ func (r *TimeoutReconciler) isWorkspaceTimedOut(ws *workspacev1.Workspace) (reas
 		}
 		return decide(*lastActivity, timeout, activity)
 
+	case workspacev1.WorkspacePhasePaused:
+		if msg := decide(start, r.getMaxLifetime(ws), activityMaxLifetime); msg != "" {
+			return msg
+		}
+		if r.Config.Pause == nil {
+			return "workspace timed out after being paused, which is disabled"
+		}
+		pausedSince := start
+		if c := k8s.GetCondition(ws.Status.Conditions, string(workspacev1.WorkspaceConditionPaused)); c != nil {
+			pausedSince = c.LastTransitionTime.Time
+		}
+		return decide(pausedSince, r.Config.Pause.MaxDuration, activityPaused)
+
 	case workspacev1.WorkspacePhaseStopping:
 		if isWorkspaceBeingDeleted(ws) && !ws.IsConditionTrue(workspacev1.WorkspaceConditionBackupComplete) {
 			// Beware: we apply the ContentFinalization timeout only to workspaces which are currently being deleted.
@@ -238,7 +303,7 @@ func (r *TimeoutReconciler) getMaxLifetime(ws *workspacev1.Workspace) util.Durat
 		return util.Duration(ws.Spec.Timeout.MaximumLifetime.Duration)
 	}
 
-	return r.Config.Timeouts.MaxLifetime
+	return r.Config.TimeoutsFor(ws.Spec.Ownership.Team, ws.Spec.Class).MaxLifetime
 }
 
 func formatDuration(d time.Duration) string {
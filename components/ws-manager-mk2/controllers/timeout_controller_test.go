@@ -5,9 +5,12 @@
 package controllers
 
 import (
+	"testing"
 	"time"
 
 	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/ws-manager/api/config"
 	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
@@ -15,6 +18,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -225,3 +229,60 @@ func expectTimeout(c client.Client, ws *workspacev1.Workspace) {
 		g.Expect(cond.Status).To(Equal(metav1.ConditionTrue))
 	}, timeout, interval).Should(Succeed())
 }
+
+func TestTimeoutPolicies(t *testing.T) {
+	now := time.Now()
+	newRunningWorkspace := func(class, team string, lastActivityAgo time.Duration, mod func(ws *workspacev1.Workspace)) *workspacev1.Workspace {
+		lastActivity := metav1.NewTime(now.Add(-lastActivityAgo))
+		ws := &workspacev1.Workspace{
+			ObjectMeta: metav1.ObjectMeta{Name: "ws", CreationTimestamp: metav1.NewTime(now.Add(-3 * time.Hour))},
+			Spec: workspacev1.WorkspaceSpec{
+				Type:      workspacev1.WorkspaceTypeRegular,
+				Class:     class,
+				Ownership: workspacev1.Ownership{Team: team},
+			},
+			Status: workspacev1.WorkspaceStatus{
+				Phase:        workspacev1.WorkspacePhaseRunning,
+				LastActivity: &lastActivity,
+			},
+		}
+		if mod != nil {
+			mod(ws)
+		}
+		return ws
+	}
+
+	cfg := newTestConfig()
+	cfg.WorkspaceClasses["small"] = &config.WorkspaceClass{Timeouts: &config.TimeoutPolicy{
+		RegularWorkspace: util.Duration(15 * time.Minute),
+		MaxLifetime:      util.Duration(2 * time.Hour),
+	}}
+	cfg.OrganizationTimeouts = map[string]*config.TimeoutPolicy{
+		"acme": {RegularWorkspace: util.Duration(45 * time.Minute), MaxLifetime: util.Duration(4 * time.Hour)},
+	}
+	r := &TimeoutReconciler{Config: cfg, clock: clocktesting.NewFakeClock(now)}
+
+	tests := []struct {
+		Name          string
+		Workspace     *workspacev1.Workspace
+		ExpectTimeout bool
+	}{
+		{Name: "global timeout", Workspace: newRunningWorkspace("default", "", 30*time.Minute, nil)},
+		{Name: "class timeout", Workspace: newRunningWorkspace("small", "", 30*time.Minute, func(ws *workspacev1.Workspace) {
+			ws.CreationTimestamp = metav1.NewTime(now.Add(-time.Hour))
+		}), ExpectTimeout: true},
+		{Name: "class max lifetime", Workspace: newRunningWorkspace("small", "", time.Minute, nil), ExpectTimeout: true},
+		{Name: "organization overrides class", Workspace: newRunningWorkspace("small", "acme", 30*time.Minute, nil)},
+		{Name: "custom timeout", Workspace: newRunningWorkspace("small", "acme", 30*time.Minute, func(ws *workspacev1.Workspace) {
+			ws.Spec.Timeout.Time = &metav1.Duration{Duration: 20 * time.Minute}
+		}), ExpectTimeout: true},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			reason := r.isWorkspaceTimedOut(test.Workspace)
+			if timedOut := reason != ""; timedOut != test.ExpectTimeout {
+				t.Errorf("expected timeout %v, got %q", test.ExpectTimeout, reason)
+			}
+		})
+	}
+}
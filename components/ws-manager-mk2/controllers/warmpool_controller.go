@@ -0,0 +1,328 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gitpod-io/gitpod/ws-manager-mk2/pkg/warmpool"
+	config "github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+const (
+	warmPoolReconcileInterval = 10 * time.Second
+	// warmPoolClaimTimeout is the time after which we consider a claim abandoned if the
+	// workspace which claimed the pod does not exist. Workspaces are created right after
+	// they claim a pod.
+	warmPoolClaimTimeout = 2 * time.Minute
+
+	workspaceWarmPoolPods       string = "workspace_warm_pool_pods"
+	workspaceWarmPoolTargetSize string = "workspace_warm_pool_target_size"
+)
+
+func NewWarmPoolReconciler(c client.Client, cfg *config.Configuration, reg prometheus.Registerer) (*WarmPoolReconciler, error) {
+	r := &WarmPoolReconciler{
+		Client: c,
+		Config: cfg,
+		podsGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsWorkspaceSubsystem,
+			Name:      workspaceWarmPoolPods,
+			Help:      "number of pods in the warm pool of a workspace class",
+		}, []string{"class", "state"}),
+		targetGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsWorkspaceSubsystem,
+			Name:      workspaceWarmPoolTargetSize,
+			Help:      "number of available pods the warm pool of a workspace class should have",
+		}, []string{"class"}),
+	}
+
+	err := reg.Register(r.podsGauge)
+	if err != nil {
+		return nil, err
+	}
+	err = reg.Register(r.targetGauge)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// WarmPoolReconciler maintains the warm pools of all workspace classes. Pooled pods are placeholders: they hold a
+// workspace worth of capacity on a workspace node and pull the configured workspace images onto it, but do not
+// run anything of the workspace. Workspaces claim them during StartWorkspace, after which the workspace pod
+// prefers the node of the pooled pod and replaces it there.
+//
+// Pooled pods are not associated with any resource we could watch, which is why this reconciler runs
+// periodically rather than in response to events.
+type WarmPoolReconciler struct {
+	client.Client
+
+	Config *config.Configuration
+
+	podsGauge   *prometheus.GaugeVec
+	targetGauge *prometheus.GaugeVec
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *WarmPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return mgr.Add(r)
+}
+
+// Start implements manager.Runnable
+func (r *WarmPoolReconciler) Start(ctx context.Context) error {
+	log := log.FromContext(ctx).WithName("warmpool")
+
+	ticker := time.NewTicker(warmPoolReconcileInterval)
+	defer ticker.Stop()
+	for {
+		err := r.reconcile(ctx)
+		if err != nil {
+			log.Error(err, "cannot reconcile warm pools")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *WarmPoolReconciler) reconcile(ctx context.Context) error {
+	log := log.FromContext(ctx)
+
+	var pods corev1.PodList
+	err := r.List(ctx, &pods, client.InNamespace(r.Config.Namespace), client.HasLabels{warmpool.PoolLabel})
+	if err != nil {
+		return fmt.Errorf("cannot list warm pool pods: %w", err)
+	}
+
+	pools := make(map[string][]*corev1.Pod)
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		class := pod.Labels[warmpool.PoolLabel]
+		if r.isObsolete(ctx, pod) {
+			log.V(2).Info("deleting warm pool pod", "pod", pod.Name, "class", class)
+			err = r.deletePod(ctx, pod)
+			if err != nil {
+				log.Error(err, "cannot delete warm pool pod", "pod", pod.Name)
+			}
+			continue
+		}
+
+		pools[class] = append(pools[class], pod)
+	}
+
+	r.podsGauge.Reset()
+	r.targetGauge.Reset()
+	for name, class := range r.Config.WorkspaceClasses {
+		var size int
+		if class.WarmPool != nil {
+			size = class.WarmPool.Size
+		}
+		r.targetGauge.WithLabelValues(name).Set(float64(size))
+
+		var available []*corev1.Pod
+		for _, pod := range pools[name] {
+			state := pod.Labels[warmpool.StateLabel]
+			if state == warmpool.StateAvailable && !warmpool.IsReady(pod) {
+				state = "pending"
+			}
+			r.podsGauge.WithLabelValues(name, state).Inc()
+
+			if pod.Labels[warmpool.StateLabel] == warmpool.StateAvailable {
+				available = append(available, pod)
+			}
+		}
+
+		if len(available) > size {
+			// Get rid of the pods which are furthest from being ready first, and of the youngest ones among those.
+			sort.Slice(available, func(i, j int) bool {
+				ri, rj := warmpool.IsReady(available[i]), warmpool.IsReady(available[j])
+				if ri != rj {
+					return !ri
+				}
+				return available[j].CreationTimestamp.Before(&available[i].CreationTimestamp)
+			})
+			for _, pod := range available[:len(available)-size] {
+				err = r.deletePod(ctx, pod)
+				if err != nil {
+					log.Error(err, "cannot delete warm pool pod", "pod", pod.Name)
+				}
+			}
+			continue
+		}
+
+		for i := len(available); i < size; i++ {
+			pod, err := newWarmPoolPod(r.Config, name, class)
+			if err != nil {
+				return fmt.Errorf("cannot produce warm pool pod for class %s: %w", name, err)
+			}
+			err = r.Create(ctx, pod)
+			if err != nil {
+				log.Error(err, "cannot create warm pool pod", "class", name)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// isObsolete returns true if a pooled pod must be deleted, because it can no longer be claimed, because its
+// class no longer has a pool or because the workspace which claimed it no longer needs it.
+func (r *WarmPoolReconciler) isObsolete(ctx context.Context, pod *corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodFailed || pod.Status.Phase == corev1.PodSucceeded {
+		return true
+	}
+
+	class, ok := r.Config.WorkspaceClasses[pod.Labels[warmpool.PoolLabel]]
+	if !ok || class.WarmPool == nil {
+		return true
+	}
+
+	if pod.Labels[warmpool.StateLabel] != warmpool.StateClaimed {
+		return false
+	}
+
+	// The workspace controller deletes claimed pods once it creates the workspace pod. We only clean up
+	// after workspaces which got that far without doing so, or which are gone altogether.
+	var ws workspacev1.Workspace
+	err := r.Get(ctx, types.NamespacedName{Namespace: pod.Namespace, Name: pod.Annotations[warmpool.ClaimedByAnnotation]}, &ws)
+	if apierrors.IsNotFound(err) {
+		claimedAt, err := time.Parse(time.RFC3339Nano, pod.Annotations[warmpool.ClaimedAtAnnotation])
+		return err != nil || time.Since(claimedAt) > warmPoolClaimTimeout
+	}
+	if err != nil {
+		return false
+	}
+	return ws.Status.PodStarts > 0 || ws.Annotations[warmpool.PodAnnotation] != pod.Name
+}
+
+func (r *WarmPoolReconciler) deletePod(ctx context.Context, pod *corev1.Pod) error {
+	return client.IgnoreNotFound(r.Delete(ctx, pod, client.GracePeriodSeconds(0)))
+}
+
+// newWarmPoolPod produces a pooled pod for a workspace class. The pod requests the same resources as the
+// workspace container of the class and is scheduled on the same nodes as regular workspace pods.
+func newWarmPoolPod(cfg *config.Configuration, className string, class *config.WorkspaceClass) (*corev1.Pod, error) {
+	requests, err := class.Container.Requests.ResourceList()
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse workspace container requests: %w", err)
+	}
+
+	// The init containers do nothing but make sure the workspace images are present on the node.
+	initContainers := make([]corev1.Container, 0, len(class.WarmPool.Images))
+	for i, img := range class.WarmPool.Images {
+		initContainers = append(initContainers, corev1.Container{
+			Name:            fmt.Sprintf("pull-%d", i),
+			Image:           img,
+			ImagePullPolicy: corev1.PullIfNotPresent,
+			Command:         []string{"/bin/sh", "-c", "true"},
+		})
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "warmpool-",
+			Namespace:    cfg.Namespace,
+			Labels: map[string]string{
+				"app":               "gitpod",
+				"component":         "workspace-warmpool",
+				warmpool.PoolLabel:  className,
+				warmpool.StateLabel: warmpool.StateAvailable,
+			},
+		},
+		Spec: corev1.PodSpec{
+			AutomountServiceAccountToken:  pointer.Bool(false),
+			EnableServiceLinks:            pointer.Bool(false),
+			SchedulerName:                 cfg.SchedulerName,
+			RestartPolicy:                 corev1.RestartPolicyNever,
+			TerminationGracePeriodSeconds: pointer.Int64(0),
+			InitContainers:                initContainers,
+			Containers: []corev1.Container{
+				{
+					Name:            "placeholder",
+					Image:           class.WarmPool.PlaceholderImage,
+					ImagePullPolicy: corev1.PullIfNotPresent,
+					Resources: corev1.ResourceRequirements{
+						Requests: requests,
+					},
+				},
+			},
+			Affinity: &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{
+							{
+								MatchExpressions: []corev1.NodeSelectorRequirement{
+									{
+										Key:      "gitpod.io/workload_workspace_regular",
+										Operator: corev1.NodeSelectorOpExists,
+									},
+									{
+										Key:      "gitpod.io/ws-daemon_ready_ns_" + cfg.Namespace,
+										Operator: corev1.NodeSelectorOpExists,
+									},
+									{
+										Key:      "gitpod.io/registry-facade_ready_ns_" + cfg.Namespace,
+										Operator: corev1.NodeSelectorOpExists,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// Pooled pods must land where the workspace pods of their class would land, hence we honour the scheduling
+	// related parts of the class' pod templates - but nothing else, as pooled pods run none of the workspace containers.
+	for _, path := range []string{class.Templates.DefaultPath, class.Templates.RegularPath} {
+		tpl, err := config.GetWorkspacePodTemplate(path)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read pod template - this is a configuration problem: %w", err)
+		}
+		if tpl == nil {
+			continue
+		}
+
+		err = combineDefiniteWorkspacePodWithTemplate(pod, &corev1.Pod{
+			Spec: corev1.PodSpec{
+				Affinity:          tpl.Spec.Affinity,
+				Tolerations:       tpl.Spec.Tolerations,
+				NodeSelector:      tpl.Spec.NodeSelector,
+				PriorityClassName: tpl.Spec.PriorityClassName,
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("cannot apply pod template: %w", err)
+		}
+	}
+
+	return pod, nil
+}
@@ -19,6 +19,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
@@ -37,6 +38,7 @@ import (
 	"github.com/gitpod-io/gitpod/common-go/tracing"
 	"github.com/gitpod-io/gitpod/ws-manager-mk2/pkg/constants"
 	"github.com/gitpod-io/gitpod/ws-manager-mk2/pkg/maintenance"
+	"github.com/gitpod-io/gitpod/ws-manager-mk2/pkg/warmpool"
 	config "github.com/gitpod-io/gitpod/ws-manager/api/config"
 	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
 	"github.com/go-logr/logr"
@@ -93,6 +95,7 @@ type WorkspaceReconciler struct {
 //+kubebuilder:rbac:groups=workspace.gitpod.io,resources=workspaces,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=workspace.gitpod.io,resources=workspaces/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=workspace.gitpod.io,resources=workspaces/finalizers,verbs=update
+//+kubebuilder:rbac:groups=workspace.gitpod.io,resources=workspacequotas,verbs=get;list;watch
 //+kubebuilder:rbac:groups=core,resources=pod,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=pod/status,verbs=get
 
@@ -142,7 +145,13 @@ func (r *WorkspaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return ctrl.Result{}, fmt.Errorf("failed to compute latest workspace status: %w", err)
 	}
 
-	r.updateMetrics(ctx, &workspace)
+	var pod *corev1.Pod
+	if len(workspacePods.Items) == 1 {
+		pod = &workspacePods.Items[0]
+	}
+	timelineEvents := recordTimeline(&workspace, pod, time.Now())
+
+	r.updateMetrics(ctx, &workspace, pod)
 	r.emitPhaseEvents(ctx, &workspace, oldStatus)
 
 	var podStatus *corev1.PodStatus
@@ -158,6 +167,7 @@ func (r *WorkspaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	if err != nil {
 		return errorResultLogConflict(log, fmt.Errorf("failed to update workspace status: %w", err))
 	}
+	traceTimeline(&workspace, timelineEvents)
 
 	result, err = r.actOnStatus(ctx, &workspace, workspacePods)
 	if err != nil {
@@ -201,7 +211,7 @@ func (r *WorkspaceReconciler) actOnStatus(ctx context.Context, workspace *worksp
 				return ctrl.Result{Requeue: true}, err
 			}
 
-			pod, err := r.createWorkspacePod(sctx)
+			pod, err := createWorkspacePod(sctx)
 			if err != nil {
 				log.Error(err, "unable to produce workspace pod")
 				return ctrl.Result{}, err
@@ -211,7 +221,28 @@ func (r *WorkspaceReconciler) actOnStatus(ctx context.Context, workspace *worksp
 				return ctrl.Result{}, err
 			}
 
+			warmPoolNode := warmpool.ClaimedNode(workspace)
+			if warmPoolNode != "" && workspace.Status.PodStarts == 0 {
+				// The workspace claimed a placeholder pod from the warm pool. We prefer the node it was
+				// scheduled to, which has pulled the images we need and holds the capacity we require.
+				// The workspace pod asks for that node before we release the pooled pod, so that the
+				// capacity most likely goes to the workspace pod rather than whichever pod the scheduler
+				// sees next. Should the node not fit the workspace pod after all, e.g. because another pod
+				// took the capacity, the workspace pod is scheduled like any other.
+				preferNode(pod, warmPoolNode)
+			}
+			if workspace.Status.Migration != nil {
+				avoidNode(pod, workspace.Status.Migration.SourceNode)
+			}
+
 			err = r.Create(ctx, pod)
+			if err == nil || apierrors.IsAlreadyExists(err) {
+				// Should releasing the pooled pod fail, the warm pool controller deletes it once it sees the
+				// workspace pod was created. Until then the workspace pod waits for the capacity.
+				if rerr := warmpool.Release(ctx, r.Client, workspace); rerr != nil {
+					log.Error(rerr, "unable to release warm pool pod")
+				}
+			}
 			if apierrors.IsAlreadyExists(err) {
 				// pod exists, we're good
 			} else if err != nil {
@@ -238,43 +269,36 @@ func (r *WorkspaceReconciler) actOnStatus(ctx context.Context, workspace *worksp
 				workspace.Status.SetCondition(workspacev1.NewWorkspaceConditionPodRejected(fmt.Sprintf("Pod reached maximum recreations %d, failing", workspace.Status.PodRecreated), metav1.ConditionFalse))
 				return ctrl.Result{Requeue: true}, nil // requeue so we end up in the "Stopped" case below
 			}
-			log = log.WithValues("PodStarts", workspace.Status.PodStarts, "PodRecreated", workspace.Status.PodRecreated, "Phase", workspace.Status.Phase)
 
-			// Make sure to wait for "recreationTimeout" before creating the pod again
-			if workspace.Status.PodDeletionTime == nil {
-				log.Info("pod recreation: waiting for pod deletion time to be populated...")
-				return ctrl.Result{Requeue: true, RequeueAfter: 5 * time.Second}, nil
+			return r.recreateWorkspacePod(ctx, workspace, r.podRecreationTimeout(), func(retry int) metav1.Condition {
+				return workspacev1.NewWorkspaceConditionPodRejected(fmt.Sprintf("Recreating pod... (%d retry)", retry), metav1.ConditionFalse)
+			})
+
+		case workspace.Status.Phase == workspacev1.WorkspacePhaseStopped && workspace.IsConditionTrue(workspacev1.WorkspaceConditionPreempted):
+			var (
+				maxRetries int
+				retryDelay time.Duration
+			)
+			if r.Config.Preemption != nil {
+				maxRetries = r.Config.Preemption.MaxRetries
+				retryDelay = time.Duration(r.Config.Preemption.RetryDelay)
 			}
-
-			recreationTimeout := r.podRecreationTimeout()
-			podDeletionTime := workspace.Status.PodDeletionTime.Time
-			waitTime := time.Until(podDeletionTime.Add(recreationTimeout))
-			log = log.WithValues("waitTime", waitTime.String(), "recreationTimeout", recreationTimeout.String(), "podDeletionTime", podDeletionTime.String())
-			if waitTime > 0 {
-				log.Info("pod recreation: waiting for timeout...")
-				return ctrl.Result{Requeue: true, RequeueAfter: waitTime}, nil
-			}
-			log.Info("trigger pod recreation")
-
-			// Reset status
-			sc := workspace.Status.DeepCopy()
-			workspace.Status = workspacev1.WorkspaceStatus{}
-			workspace.Status.Phase = workspacev1.WorkspacePhasePending
-			workspace.Status.OwnerToken = sc.OwnerToken
-			workspace.Status.PodStarts = sc.PodStarts
-			workspace.Status.PodRecreated = sc.PodRecreated + 1
-			workspace.Status.SetCondition(workspacev1.NewWorkspaceConditionPodRejected(fmt.Sprintf("Recreating pod... (%d retry)", workspace.Status.PodRecreated), metav1.ConditionFalse))
-
-			if err := r.Status().Update(ctx, workspace); err != nil {
-				log.Error(err, "Failed to update workspace status-reset")
-				return ctrl.Result{}, err
+			if workspace.Status.PodRecreated >= maxRetries {
+				msg := fmt.Sprintf("workspace was preempted and reached the maximum of %d restarts", maxRetries)
+				workspace.Status.SetCondition(workspacev1.NewWorkspaceConditionPreempted(msg, metav1.ConditionFalse))
+				workspace.Status.SetCondition(workspacev1.NewWorkspaceConditionFailed(msg))
+				if err := r.Status().Update(ctx, workspace); err != nil {
+					return ctrl.Result{}, err
+				}
+				return ctrl.Result{Requeue: true}, nil // requeue so we end up in the "Stopped" case below
 			}
 
-			// Reset metrics cache
-			r.metrics.forgetWorkspace(workspace)
+			return r.recreateWorkspacePod(ctx, workspace, retryDelay, func(retry int) metav1.Condition {
+				return workspacev1.NewWorkspaceConditionPreempted(fmt.Sprintf("Restarting preempted workspace... (%d retry)", retry), metav1.ConditionFalse)
+			})
 
-			r.Recorder.Event(workspace, corev1.EventTypeNormal, "Recreating", "")
-			return ctrl.Result{Requeue: true}, nil
+		case workspace.Status.Phase == workspacev1.WorkspacePhaseStopped && workspace.IsConditionTrue(workspacev1.WorkspaceConditionMigrating):
+			return r.restoreMigratedWorkspace(ctx, workspace)
 
 		case workspace.Status.Phase == workspacev1.WorkspacePhaseStopped:
 			if err := r.deleteWorkspaceSecrets(ctx, workspace); err != nil {
@@ -332,6 +356,14 @@ func (r *WorkspaceReconciler) actOnStatus(ctx context.Context, workspace *worksp
 			return ctrl.Result{Requeue: true}, err
 		}
 
+	// if the workspace was preempted, delete the pod. We'll start it again once it has stopped.
+	case workspace.IsConditionTrue(workspacev1.WorkspaceConditionPreempted) && !isPodBeingDeleted(pod):
+		return r.deleteWorkspacePod(ctx, pod, "preempted")
+
+	// if the workspace is moved to another node, delete the pod. We'll start a replacement elsewhere once it has stopped.
+	case isMigratingFrom(workspace, pod) && !isPodBeingDeleted(pod):
+		return r.deleteWorkspacePod(ctx, pod, "migrating")
+
 	// if the node disappeared, delete the pod.
 	case workspace.IsConditionTrue(workspacev1.WorkspaceConditionNodeDisappeared) && !isPodBeingDeleted(pod):
 		return r.deleteWorkspacePod(ctx, pod, "node disappeared")
@@ -357,7 +389,14 @@ func (r *WorkspaceReconciler) actOnStatus(ctx context.Context, workspace *worksp
 			return ctrl.Result{Requeue: true}, err
 		}
 
+	case isMigratedTo(workspace, pod):
+		return r.finishMigration(ctx, workspace, pod)
+
 	case workspace.Status.Phase == workspacev1.WorkspacePhaseRunning:
+		if workspace.IsConditionTrue(workspacev1.WorkspaceConditionMigrating) {
+			// a migration recreates the secrets for the replacement pod - we remove them once that one runs
+			break
+		}
 		err := r.deleteWorkspaceSecrets(ctx, workspace)
 		if err != nil {
 			log.Error(err, "could not delete workspace secrets")
@@ -380,6 +419,50 @@ func (r *WorkspaceReconciler) actOnStatus(ctx context.Context, workspace *worksp
 	return ctrl.Result{}, nil
 }
 
+// recreateWorkspacePod resets the status of a stopped workspace once its pod has been gone for at least delay,
+// which makes us create a new pod for the workspace. condition produces the condition which records the retry.
+func (r *WorkspaceReconciler) recreateWorkspacePod(ctx context.Context, workspace *workspacev1.Workspace, delay time.Duration, condition func(retry int) metav1.Condition) (ctrl.Result, error) {
+	log := log.FromContext(ctx).WithValues("PodStarts", workspace.Status.PodStarts, "PodRecreated", workspace.Status.PodRecreated, "Phase", workspace.Status.Phase)
+
+	// Make sure to wait for "delay" before creating the pod again
+	if workspace.Status.PodDeletionTime == nil {
+		log.Info("pod recreation: waiting for pod deletion time to be populated...")
+		return ctrl.Result{Requeue: true, RequeueAfter: 5 * time.Second}, nil
+	}
+
+	podDeletionTime := workspace.Status.PodDeletionTime.Time
+	waitTime := time.Until(podDeletionTime.Add(delay))
+	log = log.WithValues("waitTime", waitTime.String(), "recreationTimeout", delay.String(), "podDeletionTime", podDeletionTime.String())
+	if waitTime > 0 {
+		log.Info("pod recreation: waiting for timeout...")
+		return ctrl.Result{Requeue: true, RequeueAfter: waitTime}, nil
+	}
+	log.Info("trigger pod recreation")
+
+	// Reset status
+	sc := workspace.Status.DeepCopy()
+	workspace.Status = workspacev1.WorkspaceStatus{}
+	workspace.Status.Phase = workspacev1.WorkspacePhasePending
+	workspace.Status.OwnerToken = sc.OwnerToken
+	workspace.Status.PodStarts = sc.PodStarts
+	workspace.Status.PodRecreated = sc.PodRecreated + 1
+	workspace.Status.LastActivity = sc.LastActivity
+	workspace.Status.Migration = sc.Migration
+	workspace.Status.Timeline = sc.Timeline
+	workspace.Status.SetCondition(condition(workspace.Status.PodRecreated))
+
+	if err := r.Status().Update(ctx, workspace); err != nil {
+		log.Error(err, "Failed to update workspace status-reset")
+		return ctrl.Result{}, err
+	}
+
+	// Reset metrics cache
+	r.metrics.forgetWorkspace(workspace)
+
+	r.Recorder.Event(workspace, corev1.EventTypeNormal, "Recreating", "")
+	return ctrl.Result{Requeue: true}, nil
+}
+
 func (r *WorkspaceReconciler) podRecreationTimeout() time.Duration {
 	recreationTimeout := 15 * time.Second // waiting less time creates issues with ws-daemon's pod-centric control loop ("Dispatch") if the workspace ends up on the same node again
 	if r.Config.PodRecreationBackoff != 0 {
@@ -388,7 +471,7 @@ func (r *WorkspaceReconciler) podRecreationTimeout() time.Duration {
 	return recreationTimeout
 }
 
-func (r *WorkspaceReconciler) updateMetrics(ctx context.Context, workspace *workspacev1.Workspace) {
+func (r *WorkspaceReconciler) updateMetrics(ctx context.Context, workspace *workspacev1.Workspace, pod *corev1.Pod) {
 	log := log.FromContext(ctx)
 
 	ok, lastState := r.metrics.getWorkspace(&log, workspace)
@@ -420,6 +503,15 @@ func (r *WorkspaceReconciler) updateMetrics(ctx context.Context, workspace *work
 		lastState.creatingStartTime = time.Time{}
 	}
 
+	if !lastState.recordedWarmPoolBind && pod != nil && pod.Spec.NodeName != "" {
+		// Only the first pod of a workspace takes over the node of the pooled pod the workspace claimed,
+		// and only if the scheduler did not have to place it elsewhere
+		if claimedAt, ok := warmpool.ClaimedAt(workspace); ok && workspace.Status.PodStarts <= 1 && pod.Spec.NodeName == warmpool.ClaimedNode(workspace) {
+			r.metrics.recordWarmPoolBindTime(&log, workspace, claimedAt)
+		}
+		lastState.recordedWarmPoolBind = true
+	}
+
 	if !lastState.recordedContentReady && workspace.IsConditionTrue(workspacev1.WorkspaceConditionContentReady) {
 		r.metrics.countTotalRestores(&log, workspace)
 		lastState.recordedContentReady = true
@@ -473,7 +565,9 @@ func isStartFailure(ws *workspacev1.Workspace) bool {
 	isStoppedByRequest := ws.IsConditionTrue(workspacev1.WorkspaceConditionStoppedByRequest)
 	// Also ignore pods that got rejected by the node
 	isPodRejected := ws.IsConditionTrue(workspacev1.WorkspaceConditionPodRejected)
-	return !everReady && !isAborted && !isStoppedByRequest && !isPodRejected
+	// Also ignore workspaces that were preempted before they became ready
+	isPreempted := ws.IsConditionTrue(workspacev1.WorkspaceConditionPreempted)
+	return !everReady && !isAborted && !isStoppedByRequest && !isPodRejected && !isPreempted
 }
 
 func (r *WorkspaceReconciler) emitPhaseEvents(ctx context.Context, ws *workspacev1.Workspace, old *workspacev1.WorkspaceStatus) {
@@ -481,10 +575,16 @@ func (r *WorkspaceReconciler) emitPhaseEvents(ctx context.Context, ws *workspace
 		r.Recorder.Event(ws, corev1.EventTypeNormal, "Initializing", "")
 	}
 
-	if ws.Status.Phase == workspacev1.WorkspacePhaseRunning && old.Phase != workspacev1.WorkspacePhaseRunning {
+	if ws.Status.Phase == workspacev1.WorkspacePhaseRunning && old.Phase == workspacev1.WorkspacePhasePaused {
+		r.Recorder.Event(ws, corev1.EventTypeNormal, "Resumed", "")
+	} else if ws.Status.Phase == workspacev1.WorkspacePhaseRunning && old.Phase != workspacev1.WorkspacePhaseRunning {
 		r.Recorder.Event(ws, corev1.EventTypeNormal, "Running", "")
 	}
 
+	if ws.Status.Phase == workspacev1.WorkspacePhasePaused && old.Phase != workspacev1.WorkspacePhasePaused {
+		r.Recorder.Event(ws, corev1.EventTypeNormal, "Paused", "")
+	}
+
 	if ws.Status.Phase == workspacev1.WorkspacePhaseStopping && old.Phase != workspacev1.WorkspacePhaseStopping {
 		r.Recorder.Event(ws, corev1.EventTypeNormal, "Stopping", "")
 	}
@@ -642,9 +742,13 @@ func (r *WorkspaceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 }
 
 func (r *WorkspaceReconciler) getServerVersion(ctx context.Context) *version.Info {
+	return getServerVersion(ctx, r.kubeClient.Discovery())
+}
+
+func getServerVersion(ctx context.Context, discovery discovery.ServerVersionInterface) *version.Info {
 	log := log.FromContext(ctx)
 
-	serverVersion, err := r.kubeClient.Discovery().ServerVersion()
+	serverVersion, err := discovery.ServerVersion()
 	if err != nil {
 		log.Error(err, "cannot get server version! Assuming 1.30 going forward")
 		serverVersion = &version.Info{
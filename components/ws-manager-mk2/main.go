@@ -6,11 +6,13 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"flag"
 	"fmt"
 	"net"
 	"os"
+	"path/filepath"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -46,6 +48,7 @@ import (
 	"github.com/gitpod-io/gitpod/ws-manager-mk2/controllers"
 	"github.com/gitpod-io/gitpod/ws-manager-mk2/pkg/maintenance"
 	imgproxy "github.com/gitpod-io/gitpod/ws-manager-mk2/pkg/proxy"
+	lifecycle "github.com/gitpod-io/gitpod/ws-manager-mk2/pkg/webhook"
 	"github.com/gitpod-io/gitpod/ws-manager-mk2/service"
 	wsmanapi "github.com/gitpod-io/gitpod/ws-manager/api"
 	config "github.com/gitpod-io/gitpod/ws-manager/api/config"
@@ -129,6 +132,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	// The admission webhook shares the certificate of the gRPC server
+	var webhookCertDir string
+	if tls := cfg.RPCServer.TLS; tls.Certificate != "" && tls.PrivateKey != "" && filepath.Dir(tls.Certificate) == filepath.Dir(tls.PrivateKey) {
+		webhookCertDir = filepath.Dir(tls.Certificate)
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:  scheme,
 		Metrics: metricsserver.Options{BindAddress: cfg.Prometheus.Addr},
@@ -139,7 +148,10 @@ func main() {
 			},
 		},
 		WebhookServer: webhook.NewServer(webhook.Options{
-			Port: 9443,
+			Port:     9443,
+			CertDir:  webhookCertDir,
+			CertName: filepath.Base(cfg.RPCServer.TLS.Certificate),
+			KeyName:  filepath.Base(cfg.RPCServer.TLS.PrivateKey),
 		}),
 		HealthProbeBindAddress:        cfg.Health.Addr,
 		LeaderElection:                true,
@@ -176,7 +188,13 @@ func main() {
 		os.Exit(1)
 	}
 
-	wsmanService, err := setupGRPCService(cfg, mgr.GetClient(), maintenanceReconciler)
+	podRenderer, err := controllers.NewPodRenderer(mgr.GetConfig())
+	if err != nil {
+		setupLog.Error(err, "unable to create pod renderer")
+		os.Exit(1)
+	}
+
+	wsmanService, err := setupGRPCService(cfg, mgr.GetClient(), mgr.GetAPIReader(), maintenanceReconciler, podRenderer.RenderWorkspacePod)
 	if err != nil {
 		setupLog.Error(err, "unable to start manager service")
 		os.Exit(1)
@@ -188,7 +206,16 @@ func main() {
 		os.Exit(1)
 	}
 
-	subscriberReconciler.OnReconcile = wsmanService.OnWorkspaceReconcile
+	webhooks, err := lifecycle.NewDispatcher(cfg.Manager.Webhooks, metrics.Registry)
+	if err != nil {
+		setupLog.Error(err, "unable to create webhook dispatcher")
+		os.Exit(1)
+	}
+
+	subscriberReconciler.OnReconcile = func(ctx context.Context, ws *workspacev1.Workspace) {
+		wsmanService.OnWorkspaceReconcile(ctx, ws)
+		webhooks.OnWorkspaceReconcile(ctx, ws)
+	}
 
 	if err = subscriberReconciler.SetupWithManager(mgrCtx, mgr); err != nil {
 		setupLog.Error(err, "unable to setup workspace controller with manager", "controller", "Subscribers")
@@ -217,6 +244,47 @@ func main() {
 		}
 	}()
 
+	warmPoolReconciler, err := controllers.NewWarmPoolReconciler(mgr.GetClient(), &cfg.Manager, metrics.Registry)
+	if err != nil {
+		setupLog.Error(err, "unable to create warm pool controller", "controller", "WarmPool")
+		os.Exit(1)
+	}
+
+	if err = warmPoolReconciler.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to setup warm pool controller with manager", "controller", "WarmPool")
+		os.Exit(1)
+	}
+
+	preemptionReconciler, err := controllers.NewPreemptionReconciler(mgr.GetClient(), mgr.GetAPIReader(), mgr.GetEventRecorderFor("workspace"), &cfg.Manager, metrics.Registry)
+	if err != nil {
+		setupLog.Error(err, "unable to create preemption controller", "controller", "Preemption")
+		os.Exit(1)
+	}
+
+	if err = preemptionReconciler.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to setup preemption controller with manager", "controller", "Preemption")
+		os.Exit(1)
+	}
+
+	memoryPressureReconciler := controllers.NewMemoryPressureReconciler(mgr.GetClient(), mgr.GetEventRecorderFor("workspace"), &cfg.Manager)
+	if err = memoryPressureReconciler.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to setup memory pressure controller with manager", "controller", "MemoryPressure")
+		os.Exit(1)
+	}
+
+	scheduleReconciler := controllers.NewScheduleReconciler(mgr.GetClient(), mgr.GetEventRecorderFor("workspace"), &cfg.Manager, controllers.NewSupervisorNotifier(), webhooks)
+	if err = scheduleReconciler.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to setup schedule controller with manager", "controller", "Schedule")
+		os.Exit(1)
+	}
+
+	if len(cfg.Manager.Webhooks) > 0 {
+		if err = mgr.Add(webhooks); err != nil {
+			setupLog.Error(err, "unable to add webhook dispatcher to manager")
+			os.Exit(1)
+		}
+	}
+
 	if err = timeoutReconciler.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to setup timeout controller with manager", "controller", "Timeout")
 		os.Exit(1)
@@ -227,10 +295,15 @@ func main() {
 		os.Exit(1)
 	}
 
-	// if err = (&workspacev1.Workspace{}).SetupWebhookWithManager(mgr); err != nil {
-	// 	setupLog.Error(err, "unable to create webhook", "webhook", "Workspace")
-	// 	os.Exit(1)
-	// }
+	if webhookCertDir != "" {
+		quotas := &workspacev1.WorkspaceQuotaEnforcer{Client: mgr.GetAPIReader(), Namespace: cfg.Manager.Namespace, Resources: cfg.Manager.WorkspaceClassRequests}
+		if err = (&workspacev1.Workspace{}).SetupWebhookWithPolicies(mgr, quotas, wsmanService.TimeoutLimits); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Workspace")
+			os.Exit(1)
+		}
+	} else {
+		setupLog.Info("no TLS configured - not serving the workspace admission webhook")
+	}
 
 	//+kubebuilder:scaffold:builder
 
@@ -250,7 +323,7 @@ func main() {
 	}
 }
 
-func setupGRPCService(cfg *config.ServiceConfiguration, k8s client.Client, maintenance maintenance.Maintenance) (*service.WorkspaceManagerServer, error) {
+func setupGRPCService(cfg *config.ServiceConfiguration, k8s client.Client, apiReader client.Reader, maintenance maintenance.Maintenance, renderPod service.PodRenderer) (*service.WorkspaceManagerServer, error) {
 	// TODO(cw): remove use of common-go/log
 
 	if len(cfg.RPCServer.RateLimits) > 0 {
@@ -309,7 +382,7 @@ func setupGRPCService(cfg *config.ServiceConfiguration, k8s client.Client, maint
 		imgbldr.RegisterImageBuilderServer(grpcServer, imgproxy.ImageBuilder{D: imgbldr.NewImageBuilderClient(conn)})
 	}
 
-	srv := service.NewWorkspaceManagerServer(k8s, &cfg.Manager, metrics.Registry, maintenance)
+	srv := service.NewWorkspaceManagerServer(k8s, apiReader, &cfg.Manager, metrics.Registry, maintenance, renderPod)
 
 	grpc_prometheus.Register(grpcServer)
 	wsmanapi.RegisterWorkspaceManagerServer(grpcServer, srv)
@@ -0,0 +1,146 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package warmpool
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+const (
+	// PoolLabel marks pods which belong to a warm pool. Its value is the workspace class of the pool.
+	PoolLabel = "gitpod.io/warmPool"
+	// StateLabel is the state of a pooled pod, i.e. StateAvailable or StateClaimed
+	StateLabel = "gitpod.io/warmPoolState"
+	// ClaimedByAnnotation is the name of the workspace which claimed a pooled pod
+	ClaimedByAnnotation = "gitpod.io/warmPoolClaimedBy"
+	// ClaimedAtAnnotation is the time (RFC3339) a pooled pod was claimed. It is set on the pod and the workspace.
+	ClaimedAtAnnotation = "gitpod.io/warmPoolClaimedAt"
+
+	// PodAnnotation is set on workspaces which claimed a pooled pod and contains the name of that pod
+	PodAnnotation = "gitpod.io/warmPoolPod"
+	// NodeAnnotation is set on workspaces which claimed a pooled pod and contains the node that pod ran on
+	NodeAnnotation = "gitpod.io/warmPoolNode"
+
+	StateAvailable = "available"
+	StateClaimed   = "claimed"
+)
+
+// Claim takes an available pod out of the warm pool of the given workspace class and hands it to the workspace.
+// The workspace is annotated with the pod and its node, so that the workspace pod prefers that node and
+// takes over the capacity of the pooled pod once it is released. The content initializer does not travel with
+// the pod but remains part of the workspace spec, from where it is picked up once the workspace pod runs.
+//
+// Claim returns nil if the pool has no pod available.
+func Claim(ctx context.Context, clnt client.Client, namespace, class string, ws *workspacev1.Workspace) (*corev1.Pod, error) {
+	var pods corev1.PodList
+	err := clnt.List(ctx, &pods,
+		client.InNamespace(namespace),
+		client.MatchingLabels{
+			PoolLabel:  class,
+			StateLabel: StateAvailable,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list warm pool pods: %w", err)
+	}
+
+	candidates := make([]*corev1.Pod, 0, len(pods.Items))
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if !IsReady(pod) {
+			continue
+		}
+		candidates = append(candidates, pod)
+	}
+	// The oldest pods have been around for the longest and are most likely to have pulled all images.
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].CreationTimestamp.Before(&candidates[j].CreationTimestamp)
+	})
+
+	for _, pod := range candidates {
+		pod.Labels[StateLabel] = StateClaimed
+		if pod.Annotations == nil {
+			pod.Annotations = make(map[string]string)
+		}
+		claimedAt := time.Now().UTC().Format(time.RFC3339Nano)
+		pod.Annotations[ClaimedByAnnotation] = ws.Name
+		pod.Annotations[ClaimedAtAnnotation] = claimedAt
+
+		// The update fails if someone else claimed the pod in the meantime, in which case we try the next one.
+		err = clnt.Update(ctx, pod)
+		if apierrors.IsConflict(err) || apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot claim warm pool pod %s: %w", pod.Name, err)
+		}
+
+		if ws.Annotations == nil {
+			ws.Annotations = make(map[string]string)
+		}
+		ws.Annotations[PodAnnotation] = pod.Name
+		ws.Annotations[NodeAnnotation] = pod.Spec.NodeName
+		ws.Annotations[ClaimedAtAnnotation] = claimedAt
+		return pod, nil
+	}
+
+	return nil, nil
+}
+
+// IsReady returns true if a pooled pod has been scheduled and is running, i.e. its node has pulled the images
+// of the pool.
+func IsReady(pod *corev1.Pod) bool {
+	return pod.DeletionTimestamp == nil &&
+		pod.Spec.NodeName != "" &&
+		pod.Status.Phase == corev1.PodRunning
+}
+
+// ClaimedNode returns the node of the pooled pod a workspace claimed, or an empty string if it did not claim one.
+func ClaimedNode(ws *workspacev1.Workspace) string {
+	return ws.Annotations[NodeAnnotation]
+}
+
+// ClaimedAt returns the time a workspace claimed a pooled pod, and false if it did not claim one.
+func ClaimedAt(ws *workspacev1.Workspace) (time.Time, bool) {
+	if ClaimedNode(ws) == "" {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339Nano, ws.Annotations[ClaimedAtAnnotation])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// Release deletes the pooled pod a workspace claimed, so that its capacity on the node becomes available
+// to the workspace pod. Releasing a pod which is already gone is not an error.
+func Release(ctx context.Context, clnt client.Client, ws *workspacev1.Workspace) error {
+	name, ok := ws.Annotations[PodAnnotation]
+	if !ok {
+		return nil
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ws.Namespace,
+		},
+	}
+	err := clnt.Delete(ctx, pod, client.GracePeriodSeconds(0))
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("cannot release warm pool pod %s: %w", name, err)
+	}
+	return nil
+}
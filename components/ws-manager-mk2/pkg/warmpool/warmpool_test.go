@@ -0,0 +1,117 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package warmpool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+func TestClaim(t *testing.T) {
+	now := time.Now()
+	pod := func(name, class, state, node string, phase corev1.PodPhase, age time.Duration) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "default",
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+				Labels: map[string]string{
+					PoolLabel:  class,
+					StateLabel: state,
+				},
+			},
+			Spec:   corev1.PodSpec{NodeName: node},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+	}
+
+	tests := []struct {
+		Name         string
+		Pods         []client.Object
+		ExpectedPod  string
+		ExpectedNode string
+	}{
+		{
+			Name: "empty pool",
+		},
+		{
+			Name: "oldest ready pod",
+			Pods: []client.Object{
+				pod("young", "default", StateAvailable, "node-a", corev1.PodRunning, time.Minute),
+				pod("old", "default", StateAvailable, "node-b", corev1.PodRunning, time.Hour),
+				pod("pending", "default", StateAvailable, "", corev1.PodPending, 2*time.Hour),
+			},
+			ExpectedPod:  "old",
+			ExpectedNode: "node-b",
+		},
+		{
+			Name: "no ready pod",
+			Pods: []client.Object{
+				pod("pending", "default", StateAvailable, "", corev1.PodPending, time.Hour),
+				pod("pulling", "default", StateAvailable, "node-a", corev1.PodPending, time.Hour),
+			},
+		},
+		{
+			Name: "claimed and other classes",
+			Pods: []client.Object{
+				pod("claimed", "default", StateClaimed, "node-a", corev1.PodRunning, time.Hour),
+				pod("other", "large", StateAvailable, "node-b", corev1.PodRunning, time.Hour),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			clnt := fake.NewClientBuilder().WithObjects(test.Pods...).Build()
+			ws := &workspacev1.Workspace{ObjectMeta: metav1.ObjectMeta{Name: "ws", Namespace: "default"}}
+
+			act, err := Claim(context.Background(), clnt, "default", "default", ws)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if test.ExpectedPod == "" {
+				if act != nil {
+					t.Fatalf("expected no pod, got %s", act.Name)
+				}
+				if _, ok := ws.Annotations[PodAnnotation]; ok {
+					t.Errorf("workspace must not be annotated if no pod was claimed")
+				}
+				if _, ok := ClaimedAt(ws); ok {
+					t.Errorf("workspace must not have a claim time if no pod was claimed")
+				}
+				return
+			}
+
+			if act == nil || act.Name != test.ExpectedPod {
+				t.Fatalf("expected pod %s, got %v", test.ExpectedPod, act)
+			}
+			if ws.Annotations[PodAnnotation] != test.ExpectedPod || ClaimedNode(ws) != test.ExpectedNode {
+				t.Errorf("unexpected workspace annotations: %v", ws.Annotations)
+			}
+			if claimedAt, ok := ClaimedAt(ws); !ok || time.Since(claimedAt) > time.Minute {
+				t.Errorf("unexpected claim time: %v (%v)", claimedAt, ok)
+			}
+
+			var stored corev1.Pod
+			err = clnt.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: test.ExpectedPod}, &stored)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if stored.Labels[StateLabel] != StateClaimed || stored.Annotations[ClaimedByAnnotation] != ws.Name {
+				t.Errorf("pod was not claimed: labels %v, annotations %v", stored.Labels, stored.Annotations)
+			}
+		})
+	}
+}
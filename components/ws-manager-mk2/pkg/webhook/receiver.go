@@ -0,0 +1,110 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultTolerance is how old the timestamp of an event may be before a Receiver rejects it
+const DefaultTolerance = 5 * time.Minute
+
+// Receiver is an http.Handler which accepts webhook events, e.g. to test an endpoint configuration locally.
+// It rejects events without a valid signature, ignores events delivered more than once and records events
+// which arrive out of order, i.e. with a sequence lower than that of an event received before for the same workspace.
+type Receiver struct {
+	Secret    []byte
+	Tolerance time.Duration
+
+	// OnEvent is called for every event the receiver accepts
+	OnEvent func(evt *Event, inOrder bool)
+
+	mu         sync.Mutex
+	seen       map[string]struct{}
+	sequences  map[string]int64
+	events     []*Event
+	outOfOrder []*Event
+}
+
+// NewReceiver creates a receiver for events signed with the secret
+func NewReceiver(secret []byte) *Receiver {
+	return &Receiver{
+		Secret:    secret,
+		Tolerance: DefaultTolerance,
+		seen:      make(map[string]struct{}),
+		sequences: make(map[string]int64),
+	}
+}
+
+// ServeHTTP implements http.Handler
+func (r *Receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(req.Body, 1<<20))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	err = Verify(r.Secret, req.Header.Get(HeaderTimestamp), req.Header.Get(HeaderSignature), body, r.Tolerance, time.Now())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	var evt Event
+	err = json.Unmarshal(body, &evt)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if evt.ID != req.Header.Get(HeaderID) {
+		http.Error(w, "event ID does not match header", http.StatusBadRequest)
+		return
+	}
+
+	r.mu.Lock()
+	if _, dup := r.seen[evt.ID]; dup {
+		r.mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	r.seen[evt.ID] = struct{}{}
+	inOrder := evt.Sequence > r.sequences[evt.Workspace.InstanceID]
+	if inOrder {
+		r.sequences[evt.Workspace.InstanceID] = evt.Sequence
+	} else {
+		r.outOfOrder = append(r.outOfOrder, &evt)
+	}
+	r.events = append(r.events, &evt)
+	r.mu.Unlock()
+
+	if r.OnEvent != nil {
+		r.OnEvent(&evt, inOrder)
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// Events returns all events received so far, in the order they arrived
+func (r *Receiver) Events() []*Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*Event(nil), r.events...)
+}
+
+// OutOfOrder returns the events which arrived after an event of the same workspace with a higher sequence
+func (r *Receiver) OutOfOrder() []*Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*Event(nil), r.outOfOrder...)
+}
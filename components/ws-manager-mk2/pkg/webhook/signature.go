@@ -0,0 +1,48 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const signaturePrefix = "sha256="
+
+// Sign produces the signature of an event body sent at the given time. The signature is the hex encoded
+// HMAC-SHA256 of "<timestamp>.<body>", where timestamp is the Unix time in seconds.
+func Sign(secret []byte, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of an event body, using the values of the timestamp and signature headers.
+// Events signed longer than tolerance ago are rejected to prevent replays.
+func Verify(secret []byte, timestamp, signature string, body []byte, tolerance time.Duration, now time.Time) error {
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp: %w", err)
+	}
+	sent := time.Unix(ts, 0)
+	if age := now.Sub(sent); age > tolerance || age < -tolerance {
+		return fmt.Errorf("timestamp is outside of the tolerance of %s", tolerance)
+	}
+
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return fmt.Errorf("unsupported signature")
+	}
+	if !hmac.Equal([]byte(signature), []byte(Sign(secret, sent, body))) {
+		return fmt.Errorf("signature mismatch")
+	}
+	return nil
+}
@@ -0,0 +1,541 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+const (
+	// EventTypePhase is the type of events sent when a workspace enters a new phase
+	EventTypePhase = "workspace.phase"
+	// EventTypeCondition is the type of events sent when a condition of a workspace changes its status
+	EventTypeCondition = "workspace.condition"
+	// EventTypePrewarm is the type of events sent when the schedule of a workspace wants it to be started ahead of
+	// a window. The receiver is expected to start the workspace like any other, i.e. the server issues a regular
+	// StartWorkspace for it.
+	EventTypePrewarm = "workspace.prewarm"
+
+	// HeaderID carries the ID of an event. Events which are delivered more than once keep their ID.
+	HeaderID = "X-Gitpod-Webhook-Id"
+	// HeaderTimestamp carries the Unix time (in seconds) the event was signed at
+	HeaderTimestamp = "X-Gitpod-Webhook-Timestamp"
+	// HeaderSignature carries the signature of the event, see Sign
+	HeaderSignature = "X-Gitpod-Webhook-Signature"
+
+	defaultTimeout      = 10 * time.Second
+	defaultMaxAttempts  = 5
+	defaultRetryBackoff = 1 * time.Second
+	defaultQueueSize    = 1000
+
+	// stoppedStateRetention is how long we remember a stopped workspace, in case further updates come in for it
+	stoppedStateRetention = 10 * time.Minute
+	gcInterval            = 1 * time.Minute
+
+	metricsNamespace = "gitpod"
+	metricsSubsystem = "ws_manager_mk2"
+)
+
+// Event is the body of a webhook request
+type Event struct {
+	ID   string    `json:"id"`
+	Type string    `json:"type"`
+	Time time.Time `json:"time"`
+	// Sequence increases with every event of a workspace. Receivers can use it to detect events which arrive out of order.
+	Sequence  int64     `json:"sequence"`
+	Workspace Workspace `json:"workspace"`
+
+	// Phase and PreviousPhase are set on phase events
+	Phase         string `json:"phase,omitempty"`
+	PreviousPhase string `json:"previousPhase,omitempty"`
+	// Condition is set on condition events
+	Condition *Condition `json:"condition,omitempty"`
+	// Window is set on prewarm events and is the start of the window the workspace is started for
+	Window *time.Time `json:"window,omitempty"`
+}
+
+// Workspace identifies the workspace an event is about. Prewarm events are about a workspace which has no instance yet.
+type Workspace struct {
+	InstanceID     string `json:"instanceId,omitempty"`
+	WorkspaceID    string `json:"workspaceId"`
+	OwnerID        string `json:"ownerId"`
+	OrganizationID string `json:"organizationId,omitempty"`
+	Type           string `json:"type"`
+	Class          string `json:"class,omitempty"`
+}
+
+// Condition is the state of a workspace condition
+type Condition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// Dispatcher produces events from the changes of workspaces and delivers them to the configured endpoints.
+// Events are produced and delivered only after Start was called, i.e. by the elected leader.
+type Dispatcher struct {
+	endpoints []*endpoint
+	client    *http.Client
+
+	mu         sync.Mutex
+	started    time.Time
+	workspaces map[string]*workspaceState
+
+	eventsTotal      *prometheus.CounterVec
+	deliveryFailures *prometheus.CounterVec
+
+	now func() time.Time
+}
+
+type endpoint struct {
+	Name          string
+	URL           string
+	Secret        []byte
+	Timeout       time.Duration
+	MaxAttempts   int
+	RetryBackoff  time.Duration
+	DeadLetterDir string
+
+	events         map[string]struct{}
+	phases         map[string]struct{}
+	conditions     map[string]struct{}
+	workspaceTypes map[string]struct{}
+
+	queue chan *Event
+}
+
+type workspaceState struct {
+	Phase      workspacev1.WorkspacePhase
+	Conditions map[string]metav1.ConditionStatus
+	Sequence   int64
+	LastSeen   time.Time
+}
+
+// NewDispatcher creates a dispatcher for the configured endpoints and reads their secrets
+func NewDispatcher(cfgs []*config.WebhookConfiguration, reg prometheus.Registerer) (*Dispatcher, error) {
+	d := &Dispatcher{
+		client:     &http.Client{},
+		workspaces: make(map[string]*workspaceState),
+		eventsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "webhook_events_total",
+			Help:      "Number of webhook events by endpoint and outcome (delivered, dead_lettered)",
+		}, []string{"endpoint", "outcome"}),
+		deliveryFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "webhook_delivery_failures_total",
+			Help:      "Number of failed attempts to deliver a webhook event",
+		}, []string{"endpoint"}),
+		now: time.Now,
+	}
+
+	for _, cfg := range cfgs {
+		secret, err := os.ReadFile(cfg.SecretFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read secret of webhook %s: %w", cfg.Name, err)
+		}
+		secret = bytes.TrimSpace(secret)
+		if len(secret) == 0 {
+			return nil, fmt.Errorf("secret of webhook %s is empty", cfg.Name)
+		}
+
+		ep := &endpoint{
+			Name:           cfg.Name,
+			URL:            cfg.URL,
+			Secret:         secret,
+			Timeout:        time.Duration(cfg.Timeout),
+			MaxAttempts:    cfg.MaxAttempts,
+			RetryBackoff:   time.Duration(cfg.RetryBackoff),
+			DeadLetterDir:  cfg.DeadLetterDir,
+			events:         toSet(cfg.Events),
+			phases:         toSet(cfg.Phases),
+			conditions:     toSet(cfg.Conditions),
+			workspaceTypes: toSet(cfg.WorkspaceTypes),
+		}
+		if ep.Timeout == 0 {
+			ep.Timeout = defaultTimeout
+		}
+		if ep.MaxAttempts == 0 {
+			ep.MaxAttempts = defaultMaxAttempts
+		}
+		if ep.RetryBackoff == 0 {
+			ep.RetryBackoff = defaultRetryBackoff
+		}
+		queueSize := cfg.QueueSize
+		if queueSize == 0 {
+			queueSize = defaultQueueSize
+		}
+		ep.queue = make(chan *Event, queueSize)
+		d.endpoints = append(d.endpoints, ep)
+	}
+
+	if reg != nil {
+		err := reg.Register(d.eventsTotal)
+		if err != nil {
+			return nil, err
+		}
+		err = reg.Register(d.deliveryFailures)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return d, nil
+}
+
+// Start delivers events until the context is cancelled. Every endpoint has its own worker, so that a slow or failing
+// endpoint does not hold up the others, and each endpoint receives the events in the order they were produced.
+func (d *Dispatcher) Start(ctx context.Context) error {
+	d.mu.Lock()
+	d.started = d.now()
+	d.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, ep := range d.endpoints {
+		wg.Add(1)
+		go func(ep *endpoint) {
+			defer wg.Done()
+			d.deliver(ctx, ep)
+		}(ep)
+	}
+
+	ticker := time.NewTicker(gcInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return nil
+		case <-ticker.C:
+			d.gc()
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Only the leader delivers events,
+// otherwise every replica would send them.
+func (d *Dispatcher) NeedLeaderElection() bool {
+	return true
+}
+
+// OnWorkspaceReconcile produces events for the changes of a workspace since we last saw it
+func (d *Dispatcher) OnWorkspaceReconcile(ctx context.Context, ws *workspacev1.Workspace) {
+	if len(d.endpoints) == 0 {
+		return
+	}
+
+	for _, evt := range d.diff(ws) {
+		for _, ep := range d.endpoints {
+			if !ep.accepts(evt) {
+				continue
+			}
+
+			select {
+			case ep.queue <- evt:
+			default:
+				d.deadLetter(ctx, ep, evt, 0, fmt.Errorf("queue is full"))
+			}
+		}
+	}
+}
+
+// RequestPrewarm asks the endpoints which accept prewarm events to start the workspace of a schedule ahead of the
+// window which starts at the given time. It fails if no endpoint accepts the event, as then nobody would start the workspace.
+func (d *Dispatcher) RequestPrewarm(ctx context.Context, schedule *workspacev1.WorkspaceSchedule, window time.Time) error {
+	d.mu.Lock()
+	started := !d.started.IsZero()
+	now := d.now()
+	d.mu.Unlock()
+	if !started {
+		return fmt.Errorf("webhooks are not running")
+	}
+
+	window = window.UTC()
+	evt := &Event{
+		ID:   uuid.NewString(),
+		Type: EventTypePrewarm,
+		Time: now.UTC(),
+		// There is a single prewarm event per window, hence it needs no sequence of its own
+		Sequence: now.UnixNano(),
+		Workspace: Workspace{
+			WorkspaceID: schedule.Name,
+			Type:        string(workspacev1.WorkspaceTypeRegular),
+		},
+		Window: &window,
+	}
+
+	var enqueued bool
+	for _, ep := range d.endpoints {
+		if !ep.accepts(evt) {
+			continue
+		}
+
+		select {
+		case ep.queue <- evt:
+			enqueued = true
+		default:
+			d.deadLetter(ctx, ep, evt, 0, fmt.Errorf("queue is full"))
+		}
+	}
+	if !enqueued {
+		return fmt.Errorf("no webhook accepts prewarm events")
+	}
+	return nil
+}
+
+func (d *Dispatcher) diff(ws *workspacev1.Workspace) []*Event {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.started.IsZero() {
+		return nil
+	}
+
+	now := d.now()
+	state, known := d.workspaces[ws.Name]
+	if !known {
+		state = &workspaceState{Conditions: make(map[string]metav1.ConditionStatus)}
+		d.workspaces[ws.Name] = state
+	}
+	state.LastSeen = now
+
+	// When we see a workspace for the first time, e.g. after a restart or a leader change, we cannot tell which
+	// of its changes were sent before. We only send the ones which happened after we started.
+	createdSinceStart := !ws.CreationTimestamp.Time.Before(d.started.Truncate(time.Second))
+
+	var res []*Event
+	for _, c := range ws.Status.Conditions {
+		if prev, ok := state.Conditions[c.Type]; ok && prev == c.Status {
+			continue
+		}
+		state.Conditions[c.Type] = c.Status
+		if !known && !createdSinceStart && c.LastTransitionTime.Time.Before(d.started.Truncate(time.Second)) {
+			continue
+		}
+
+		evt := d.newEvent(ws, state, EventTypeCondition, now)
+		evt.Condition = &Condition{
+			Type:    c.Type,
+			Status:  string(c.Status),
+			Reason:  c.Reason,
+			Message: c.Message,
+		}
+		res = append(res, evt)
+	}
+
+	if ws.Status.Phase != state.Phase {
+		previous := state.Phase
+		state.Phase = ws.Status.Phase
+		if known || createdSinceStart {
+			evt := d.newEvent(ws, state, EventTypePhase, now)
+			evt.Phase = string(ws.Status.Phase)
+			evt.PreviousPhase = string(previous)
+			res = append(res, evt)
+		}
+	}
+
+	return res
+}
+
+func (d *Dispatcher) newEvent(ws *workspacev1.Workspace, state *workspaceState, tpe string, now time.Time) *Event {
+	// The sequence is based on the time, so that it keeps increasing across restarts of ws-manager
+	seq := now.UnixNano()
+	if seq <= state.Sequence {
+		seq = state.Sequence + 1
+	}
+	state.Sequence = seq
+
+	return &Event{
+		ID:       uuid.NewString(),
+		Type:     tpe,
+		Time:     now.UTC(),
+		Sequence: seq,
+		Workspace: Workspace{
+			InstanceID:     ws.Name,
+			WorkspaceID:    ws.Spec.Ownership.WorkspaceID,
+			OwnerID:        ws.Spec.Ownership.Owner,
+			OrganizationID: ws.Spec.Ownership.Team,
+			Type:           string(ws.Spec.Type),
+			Class:          ws.Spec.Class,
+		},
+	}
+}
+
+// gc forgets workspaces which stopped a while ago
+func (d *Dispatcher) gc() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	for name, state := range d.workspaces {
+		if state.Phase == workspacev1.WorkspacePhaseStopped && now.Sub(state.LastSeen) > stoppedStateRetention {
+			delete(d.workspaces, name)
+		}
+	}
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, ep *endpoint) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case evt := <-ep.queue:
+			d.send(ctx, ep, evt)
+		}
+	}
+}
+
+// send delivers an event, retrying with exponential backoff. Events which cannot be delivered are dead-lettered.
+func (d *Dispatcher) send(ctx context.Context, ep *endpoint, evt *Event) {
+	body, err := json.Marshal(evt)
+	if err != nil {
+		d.deadLetter(ctx, ep, evt, 0, fmt.Errorf("cannot marshal event: %w", err))
+		return
+	}
+
+	var (
+		backoff = ep.RetryBackoff
+		attempt int
+	)
+	for attempt = 1; attempt <= ep.MaxAttempts; attempt++ {
+		if attempt > 1 {
+			select {
+			case <-ctx.Done():
+				d.deadLetter(ctx, ep, evt, attempt-1, ctx.Err())
+				return
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+
+		err = d.post(ctx, ep, evt.ID, body)
+		if err == nil {
+			d.eventsTotal.WithLabelValues(ep.Name, "delivered").Inc()
+			return
+		}
+		d.deliveryFailures.WithLabelValues(ep.Name).Inc()
+		log.FromContext(ctx).V(1).Info("cannot deliver webhook event", "endpoint", ep.Name, "event", evt.ID, "attempt", attempt, "error", err.Error())
+	}
+	d.deadLetter(ctx, ep, evt, ep.MaxAttempts, err)
+}
+
+func (d *Dispatcher) post(ctx context.Context, ep *endpoint, id string, body []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, ep.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ep.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	now := d.now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderID, id)
+	req.Header.Set(HeaderTimestamp, fmt.Sprint(now.Unix()))
+	req.Header.Set(HeaderSignature, Sign(ep.Secret, now, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("endpoint responded with %s", resp.Status)
+	}
+	return nil
+}
+
+// DeadLetter is an event which could not be delivered, as written to the dead letter directory of an endpoint
+type DeadLetter struct {
+	Endpoint string    `json:"endpoint"`
+	Attempts int       `json:"attempts"`
+	Error    string    `json:"error"`
+	Time     time.Time `json:"time"`
+	Event    *Event    `json:"event"`
+}
+
+func (d *Dispatcher) deadLetter(ctx context.Context, ep *endpoint, evt *Event, attempts int, reason error) {
+	d.eventsTotal.WithLabelValues(ep.Name, "dead_lettered").Inc()
+
+	log := log.FromContext(ctx).WithValues("endpoint", ep.Name, "event", evt.ID, "instanceId", evt.Workspace.InstanceID, "type", evt.Type)
+	log.Error(reason, "dead-lettering webhook event", "attempts", attempts)
+	if ep.DeadLetterDir == "" {
+		return
+	}
+
+	letter, err := json.Marshal(DeadLetter{
+		Endpoint: ep.Name,
+		Attempts: attempts,
+		Error:    reason.Error(),
+		Time:     d.now().UTC(),
+		Event:    evt,
+	})
+	if err != nil {
+		log.Error(err, "cannot marshal dead letter")
+		return
+	}
+	dir := filepath.Join(ep.DeadLetterDir, ep.Name)
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		log.Error(err, "cannot create dead letter directory")
+		return
+	}
+	err = os.WriteFile(filepath.Join(dir, fmt.Sprintf("%d-%s.json", evt.Sequence, evt.ID)), letter, 0644)
+	if err != nil {
+		log.Error(err, "cannot write dead letter")
+	}
+}
+
+func (ep *endpoint) accepts(evt *Event) bool {
+	if !matches(ep.events, evt.Type) || !matches(ep.workspaceTypes, evt.Workspace.Type) {
+		return false
+	}
+	switch evt.Type {
+	case EventTypePhase:
+		return matches(ep.phases, evt.Phase)
+	case EventTypeCondition:
+		return matches(ep.conditions, evt.Condition.Type)
+	}
+	return true
+}
+
+// matches returns true if the filter is empty or contains the value. Values are compared case-insensitively.
+func matches(filter map[string]struct{}, value string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	_, ok := filter[strings.ToLower(value)]
+	return ok
+}
+
+func toSet(values []string) map[string]struct{} {
+	res := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		res[strings.ToLower(v)] = struct{}{}
+	}
+	return res
+}
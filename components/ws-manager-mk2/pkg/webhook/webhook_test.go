@@ -0,0 +1,284 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+func TestVerify(t *testing.T) {
+	secret := []byte("secret")
+	body := []byte(`{"id":"foo"}`)
+	now := time.Now()
+
+	tests := []struct {
+		Name      string
+		Secret    []byte
+		Body      []byte
+		SignedAt  time.Time
+		ExpectErr bool
+	}{
+		{Name: "valid", Secret: secret, Body: body, SignedAt: now},
+		{Name: "wrong secret", Secret: []byte("other"), Body: body, SignedAt: now, ExpectErr: true},
+		{Name: "tampered body", Secret: secret, Body: []byte(`{"id":"bar"}`), SignedAt: now, ExpectErr: true},
+		{Name: "replayed", Secret: secret, Body: body, SignedAt: now.Add(-time.Hour), ExpectErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			signature := Sign(test.Secret, test.SignedAt, body)
+			err := Verify(secret, unixString(test.SignedAt), signature, test.Body, DefaultTolerance, now)
+			if (err != nil) != test.ExpectErr {
+				t.Errorf("expected error %v, got %v", test.ExpectErr, err)
+			}
+		})
+	}
+}
+
+func TestDispatcher(t *testing.T) {
+	receiver := NewReceiver([]byte("secret"))
+	var failures int32 = 2
+	flaky := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&failures, -1) >= 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		receiver.ServeHTTP(w, r)
+	}))
+	defer flaky.Close()
+
+	conditionsOnly := NewReceiver([]byte("secret"))
+	conditionsSrv := httptest.NewServer(conditionsOnly)
+	defer conditionsSrv.Close()
+
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer broken.Close()
+
+	deadLetters := t.TempDir()
+	d := newTestDispatcher(t, []*config.WebhookConfiguration{
+		{Name: "all", URL: flaky.URL},
+		{Name: "timeouts", URL: conditionsSrv.URL, Events: []string{EventTypeCondition}, Conditions: []string{"timeout"}},
+		{Name: "prebuilds", URL: conditionsSrv.URL, WorkspaceTypes: []string{"Prebuild"}},
+		{Name: "broken", URL: broken.URL, MaxAttempts: 2, Events: []string{EventTypePhase}, Phases: []string{"Running"}, DeadLetterDir: deadLetters},
+	})
+
+	ws := &workspacev1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{Name: "instance", CreationTimestamp: metav1.Now()},
+		Spec: workspacev1.WorkspaceSpec{
+			Type:      workspacev1.WorkspaceTypeRegular,
+			Ownership: workspacev1.Ownership{Owner: "user", WorkspaceID: "workspace", Team: "org"},
+		},
+	}
+	for _, phase := range []workspacev1.WorkspacePhase{workspacev1.WorkspacePhasePending, workspacev1.WorkspacePhaseCreating, workspacev1.WorkspacePhaseRunning} {
+		ws.Status.Phase = phase
+		d.OnWorkspaceReconcile(context.Background(), ws)
+		// unchanged workspaces produce no events
+		d.OnWorkspaceReconcile(context.Background(), ws)
+	}
+	ws.Status.SetCondition(workspacev1.NewWorkspaceConditionTimeout("timed out"))
+	ws.Status.Phase = workspacev1.WorkspacePhaseStopping
+	d.OnWorkspaceReconcile(context.Background(), ws)
+
+	waitFor(t, func() bool { return len(receiver.Events()) == 5 && len(conditionsOnly.Events()) == 1 })
+
+	type summary struct {
+		Type, Phase, PreviousPhase, Condition string
+	}
+	var act []summary
+	for _, evt := range receiver.Events() {
+		s := summary{Type: evt.Type, Phase: evt.Phase, PreviousPhase: evt.PreviousPhase}
+		if evt.Condition != nil {
+			s.Condition = evt.Condition.Type + "=" + evt.Condition.Status
+		}
+		act = append(act, s)
+		if evt.Workspace != (Workspace{InstanceID: "instance", WorkspaceID: "workspace", OwnerID: "user", OrganizationID: "org", Type: "Regular"}) {
+			t.Errorf("unexpected workspace %+v", evt.Workspace)
+		}
+	}
+	expected := []summary{
+		{Type: EventTypePhase, Phase: "Pending"},
+		{Type: EventTypePhase, Phase: "Creating", PreviousPhase: "Pending"},
+		{Type: EventTypePhase, Phase: "Running", PreviousPhase: "Creating"},
+		{Type: EventTypeCondition, Condition: "Timeout=True"},
+		{Type: EventTypePhase, Phase: "Stopping", PreviousPhase: "Running"},
+	}
+	if diff := cmp.Diff(expected, act); diff != "" {
+		t.Errorf("unexpected events (-want +got):\n%s", diff)
+	}
+	if ooo := receiver.OutOfOrder(); len(ooo) > 0 {
+		t.Errorf("events arrived out of order: %v", ooo)
+	}
+	if c := conditionsOnly.Events()[0].Condition; c == nil || c.Type != string(workspacev1.WorkspaceConditionTimeout) {
+		t.Errorf("expected only the timeout condition, got %+v", conditionsOnly.Events())
+	}
+
+	var letters []string
+	waitFor(t, func() bool {
+		letters, _ = filepath.Glob(filepath.Join(deadLetters, "broken", "*.json"))
+		return len(letters) == 1
+	})
+	content, err := os.ReadFile(letters[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var letter DeadLetter
+	err = json.Unmarshal(content, &letter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if letter.Attempts != 2 || letter.Event == nil || letter.Event.Phase != "Running" {
+		t.Errorf("unexpected dead letter %+v", letter)
+	}
+}
+
+func TestDispatcherKnownWorkspaces(t *testing.T) {
+	receiver := NewReceiver([]byte("secret"))
+	srv := httptest.NewServer(receiver)
+	defer srv.Close()
+
+	started := metav1.NewTime(time.Now().Add(-time.Hour))
+	ws := &workspacev1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{Name: "instance", CreationTimestamp: started},
+		Spec:       workspacev1.WorkspaceSpec{Type: workspacev1.WorkspaceTypeRegular},
+		Status: workspacev1.WorkspaceStatus{
+			Phase: workspacev1.WorkspacePhaseRunning,
+			Conditions: []metav1.Condition{
+				{Type: string(workspacev1.WorkspaceConditionContentReady), Status: metav1.ConditionTrue, LastTransitionTime: started},
+			},
+		},
+	}
+
+	d, err := NewDispatcher([]*config.WebhookConfiguration{{Name: "all", URL: srv.URL, SecretFile: secretFile(t)}}, prometheus.NewRegistry())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// events are only produced by the leader, i.e. once the dispatcher was started
+	d.OnWorkspaceReconcile(context.Background(), ws)
+	startDispatcher(t, d)
+
+	// changes which happened before we started were sent by the previous leader
+	d.OnWorkspaceReconcile(context.Background(), ws)
+	ws.Status.Phase = workspacev1.WorkspacePhaseStopping
+	d.OnWorkspaceReconcile(context.Background(), ws)
+
+	waitFor(t, func() bool { return len(receiver.Events()) > 0 })
+	time.Sleep(50 * time.Millisecond)
+	evts := receiver.Events()
+	if len(evts) != 1 || evts[0].Phase != "Stopping" || evts[0].PreviousPhase != "Running" {
+		t.Errorf("expected only the phase change after the start, got %+v", evts)
+	}
+}
+
+func TestDispatcherRequestPrewarm(t *testing.T) {
+	server := NewReceiver([]byte("secret"))
+	serverSrv := httptest.NewServer(server)
+	defer serverSrv.Close()
+	phasesOnly := NewReceiver([]byte("secret"))
+	phasesSrv := httptest.NewServer(phasesOnly)
+	defer phasesSrv.Close()
+
+	schedule := &workspacev1.WorkspaceSchedule{ObjectMeta: metav1.ObjectMeta{Name: "gitpodio-gitpod-abc"}}
+	window := time.Date(2026, 3, 30, 9, 0, 0, 0, time.UTC)
+
+	d, err := NewDispatcher([]*config.WebhookConfiguration{{Name: "server", URL: serverSrv.URL, SecretFile: secretFile(t), Events: []string{EventTypePrewarm}}}, prometheus.NewRegistry())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = d.RequestPrewarm(context.Background(), schedule, window)
+	if err == nil {
+		t.Error("expected an error before the dispatcher was started")
+	}
+
+	d = newTestDispatcher(t, []*config.WebhookConfiguration{
+		{Name: "server", URL: serverSrv.URL, Events: []string{EventTypePrewarm}},
+		{Name: "phases", URL: phasesSrv.URL, Events: []string{EventTypePhase}},
+	})
+	err = d.RequestPrewarm(context.Background(), schedule, window)
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, func() bool { return len(server.Events()) > 0 })
+	evt := server.Events()[0]
+	if evt.Type != EventTypePrewarm || evt.Workspace.WorkspaceID != schedule.Name || evt.Workspace.InstanceID != "" || evt.Window == nil || !evt.Window.Equal(window) {
+		t.Errorf("unexpected prewarm event %+v", evt)
+	}
+	if len(phasesOnly.Events()) != 0 {
+		t.Errorf("endpoint without prewarm events received %+v", phasesOnly.Events())
+	}
+
+	d = newTestDispatcher(t, []*config.WebhookConfiguration{{Name: "phases", URL: phasesSrv.URL, Events: []string{EventTypePhase}}})
+	err = d.RequestPrewarm(context.Background(), schedule, window)
+	if err == nil {
+		t.Error("expected an error if no endpoint accepts prewarm events")
+	}
+}
+
+func newTestDispatcher(t *testing.T, cfgs []*config.WebhookConfiguration) *Dispatcher {
+	secret := secretFile(t)
+	for _, cfg := range cfgs {
+		cfg.SecretFile = secret
+		cfg.RetryBackoff = util.Duration(time.Millisecond)
+	}
+	d, err := NewDispatcher(cfgs, prometheus.NewRegistry())
+	if err != nil {
+		t.Fatal(err)
+	}
+	startDispatcher(t, d)
+	return d
+}
+
+func startDispatcher(t *testing.T, d *Dispatcher) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() {
+		_ = d.Start(ctx)
+	}()
+	waitFor(t, func() bool {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		return !d.started.IsZero()
+	})
+}
+
+func secretFile(t *testing.T) string {
+	fn := filepath.Join(t.TempDir(), "secret")
+	err := os.WriteFile(fn, []byte("secret\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fn
+}
+
+func unixString(t time.Time) string {
+	return strconv.FormatInt(t.Unix(), 10)
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for condition")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -7,6 +7,7 @@ package service
 import (
 	"context"
 	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strconv"
@@ -16,6 +17,7 @@ import (
 
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/opentracing/opentracing-go"
+	"github.com/pmezard/go-difflib/difflib"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/xerrors"
@@ -37,6 +39,7 @@ import (
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
 
 	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
 	"github.com/gitpod-io/gitpod/common-go/log"
@@ -46,6 +49,7 @@ import (
 	"github.com/gitpod-io/gitpod/ws-manager-mk2/pkg/activity"
 	"github.com/gitpod-io/gitpod/ws-manager-mk2/pkg/constants"
 	"github.com/gitpod-io/gitpod/ws-manager-mk2/pkg/maintenance"
+	"github.com/gitpod-io/gitpod/ws-manager-mk2/pkg/warmpool"
 	wsmanapi "github.com/gitpod-io/gitpod/ws-manager/api"
 	"github.com/gitpod-io/gitpod/ws-manager/api/config"
 	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
@@ -69,7 +73,12 @@ var (
 	}
 )
 
-func NewWorkspaceManagerServer(clnt client.Client, cfg *config.Configuration, reg prometheus.Registerer, maintenance maintenance.Maintenance) *WorkspaceManagerServer {
+// PodRenderer renders the pod the workspace controller would create for a workspace using the configuration cfg
+type PodRenderer func(ctx context.Context, cfg *config.Configuration, ws *workspacev1.Workspace) (*corev1.Pod, error)
+
+// NewWorkspaceManagerServer produces a new workspace manager server. The apiReader must read from the API server
+// rather than a cache, as we use it to enforce workspace quotas.
+func NewWorkspaceManagerServer(clnt client.Client, apiReader client.Reader, cfg *config.Configuration, reg prometheus.Registerer, maintenance maintenance.Maintenance, renderPod PodRenderer) *WorkspaceManagerServer {
 	metrics := newWorkspaceMetrics(cfg.Namespace, clnt)
 	reg.MustRegister(metrics)
 
@@ -78,6 +87,13 @@ func NewWorkspaceManagerServer(clnt client.Client, cfg *config.Configuration, re
 		Config:      cfg,
 		metrics:     metrics,
 		maintenance: maintenance,
+		renderPod:   renderPod,
+		apiReader:   apiReader,
+		quotas: &workspacev1.WorkspaceQuotaEnforcer{
+			Client:    apiReader,
+			Namespace: cfg.Namespace,
+			Resources: cfg.WorkspaceClassRequests,
+		},
 		subs: subscriptions{
 			subscribers: make(map[string]chan *wsmanapi.SubscribeResponse),
 		},
@@ -89,6 +105,9 @@ type WorkspaceManagerServer struct {
 	Config      *config.Configuration
 	metrics     *workspaceMetrics
 	maintenance maintenance.Maintenance
+	renderPod   PodRenderer
+	apiReader   client.Reader
+	quotas      *workspacev1.WorkspaceQuotaEnforcer
 
 	subs subscriptions
 	wsmanapi.UnimplementedWorkspaceManagerServer
@@ -117,6 +136,130 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 		return nil, err
 	}
 
+	objs, err := newWorkspace(req, wsm.Config)
+	if err != nil {
+		return nil, err
+	}
+	ws := objs.Workspace
+	classID := ws.Spec.Class
+
+	// the components involved in starting the workspace add their spans to this trace
+	if traceID := tracing.GetTraceID(span); traceID != "" {
+		ws.Annotations[wsk8s.WorkspaceTraceContextAnnotation] = traceID
+	}
+
+	exists, err := wsm.workspaceExists(ctx, req.Metadata.MetaId)
+	if err != nil {
+		return nil, fmt.Errorf("cannot check if workspace %s exists: %w", req.Metadata.MetaId, err)
+	}
+
+	if exists {
+		return nil, status.Errorf(codes.AlreadyExists, "workspace %s already exists", req.Metadata.MetaId)
+	}
+
+	err = wsm.TimeoutLimits(ws.Spec.Ownership.Team, classID).Admit(ws)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = wsm.quotas.Admit(ctx, ws)
+	var quotaErr *workspacev1.QuotaExceededError
+	if xerrors.As(err, &quotaErr) {
+		return nil, status.Error(codes.ResourceExhausted, quotaErr.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot check quota of workspace %s: %w", req.Metadata.MetaId, err)
+	}
+
+	err = wsm.createWorkspaceSecret(ctx, ws, objs.EnvSecretName, wsm.Config.Namespace, objs.EnvData)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create env secret for workspace %s: %w", req.Id, err)
+	}
+
+	err = wsm.createWorkspaceSecret(ctx, ws, fmt.Sprintf("%s-%s", req.Id, "tokens"), wsm.Config.SecretsNamespace, objs.TokenData)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create token secret for workspace %s: %w", req.Id, err)
+	}
+
+	if class, ok := wsm.Config.WorkspaceClasses[classID]; ok && class.WarmPool != nil && class.WarmPool.Size > 0 && ws.Spec.Type == workspacev1.WorkspaceTypeRegular {
+		pod, err := warmpool.Claim(ctx, wsm.Client, wsm.Config.Namespace, classID, ws)
+		if err != nil {
+			// the warm pool is an optimisation - we can start the workspace without it
+			log.WithError(err).WithFields(owi).Warn("cannot claim warm pool pod")
+		}
+		wsm.metrics.recordWarmPoolClaim(classID, pod != nil)
+	}
+
+	wsm.metrics.recordWorkspaceStart(ws)
+	err = wsm.Client.Create(ctx, ws)
+	if err != nil {
+		log.WithError(err).WithFields(owi).Error("error creating workspace")
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot create workspace")
+	}
+
+	// Concurrent starts of an organization's workspaces may all have passed the quota check above. Now that the
+	// workspace exists we check again, and fail it if it exceeds the quota. Workspaces racing each other may all
+	// fail this way, but an organization never exceeds its quota.
+	err = wsm.quotas.Admit(ctx, ws)
+	if xerrors.As(err, &quotaErr) {
+		ferr := wsm.failWorkspace(ctx, ws, quotaErr.Error())
+		if ferr != nil {
+			log.WithError(ferr).WithFields(owi).Error("cannot fail workspace which exceeds its quota")
+		}
+		return nil, status.Error(codes.ResourceExhausted, quotaErr.Error())
+	}
+	if err != nil {
+		// the workspace passed the quota check before, we let it start
+		log.WithError(err).WithFields(owi).Warn("cannot check quota of created workspace")
+	}
+
+	var wsr workspacev1.Workspace
+	err = wait.PollWithContext(ctx, 100*time.Millisecond, 30*time.Second, func(c context.Context) (done bool, err error) {
+		err = wsm.Client.Get(ctx, types.NamespacedName{Namespace: wsm.Config.Namespace, Name: ws.Name}, &wsr)
+		if err != nil {
+			return false, nil
+		}
+
+		if wsr.Status.OwnerToken != "" && wsr.Status.URL != "" {
+			return true, nil
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot wait for workspace URL: %q", err)
+	}
+
+	return &wsmanapi.StartWorkspaceResponse{
+		Url:        wsr.Status.URL,
+		OwnerToken: wsr.Status.OwnerToken,
+	}, nil
+}
+
+// failWorkspace marks a workspace as failed, upon which the workspace controller stops it
+func (wsm *WorkspaceManagerServer) failWorkspace(ctx context.Context, ws *workspacev1.Workspace, msg string) error {
+	return retry.RetryOnConflict(retryParams, func() error {
+		// the workspace may have been created just now and not be in our cache yet
+		err := wsm.apiReader.Get(ctx, client.ObjectKeyFromObject(ws), ws)
+		if err != nil {
+			return err
+		}
+
+		ws.Status.SetCondition(workspacev1.NewWorkspaceConditionFailed(msg))
+		return wsm.Client.Status().Update(ctx, ws)
+	})
+}
+
+// startWorkspaceObjects are the workspace resource and the content of the secrets StartWorkspace creates
+type startWorkspaceObjects struct {
+	Workspace     *workspacev1.Workspace
+	EnvSecretName string
+	EnvData       map[string]string
+	TokenData     map[string]string
+}
+
+// newWorkspace produces the objects StartWorkspace creates for a request, using the configuration cfg
+func newWorkspace(req *wsmanapi.StartWorkspaceRequest, cfg *config.Configuration) (*startWorkspaceObjects, error) {
 	var workspaceType workspacev1.WorkspaceType
 	switch req.Type {
 	case wsmanapi.WorkspaceType_IMAGEBUILD:
@@ -180,14 +323,14 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 	}
 
 	var classID string
-	_, ok := wsm.Config.WorkspaceClasses[req.Spec.Class]
+	_, ok := cfg.WorkspaceClasses[req.Spec.Class]
 	if !ok {
 		classID = config.DefaultWorkspaceClass
 	} else {
 		classID = req.Spec.Class
 	}
 
-	class, ok := wsm.Config.WorkspaceClasses[classID]
+	class, ok := cfg.WorkspaceClasses[classID]
 	if !ok {
 		return nil, status.Errorf(codes.InvalidArgument, "workspace class \"%s\" is unknown", req.Spec.Class)
 	}
@@ -213,6 +356,28 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 			annotations[wsk8s.WorkspaceCpuBurstLimitAnnotation] = limits.CPU.BurstLimit
 		}
 	}
+	if limits != nil && limits.Network != nil {
+		if limits.Network.Download != "" {
+			annotations[wsk8s.WorkspaceNetDownloadLimitAnnotation] = limits.Network.Download
+		}
+
+		if limits.Network.Upload != "" {
+			annotations[wsk8s.WorkspaceNetUploadLimitAnnotation] = limits.Network.Upload
+		}
+
+		if limits.Network.Burst != "" {
+			annotations[wsk8s.WorkspaceNetBurstAnnotation] = limits.Network.Burst
+		}
+	}
+
+	egressPolicyName, egressPolicy := cfg.EgressPolicyFor(req.Metadata.GetTeam(), classID)
+	if egressPolicy != nil {
+		policy, err := json.Marshal(egressPolicy)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "cannot serialise egress policy %s: %v", egressPolicyName, err)
+		}
+		annotations[wsk8s.WorkspaceEgressPolicyAnnotation] = string(policy)
+	}
 
 	var sshGatewayCAPublicKey string
 	for _, feature := range req.Spec.FeatureFlags {
@@ -222,7 +387,7 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 		case wsmanapi.WorkspaceFeatureFlag_WORKSPACE_PSI:
 			annotations[wsk8s.WorkspacePressureStallInfoAnnotation] = util.BooleanTrueString
 		case wsmanapi.WorkspaceFeatureFlag_SSH_CA:
-			sshGatewayCAPublicKey = wsm.Config.SSHGatewayCAPublicKey
+			sshGatewayCAPublicKey = cfg.SSHGatewayCAPublicKey
 		}
 	}
 
@@ -236,7 +401,7 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 		return nil, status.Errorf(codes.InvalidArgument, "cannot serialise content initializer: %v", err)
 	}
 
-	ws := workspacev1.Workspace{
+	ws := &workspacev1.Workspace{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: workspacev1.GroupVersion.String(),
 			Kind:       "Workspace",
@@ -244,7 +409,7 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        req.Id,
 			Annotations: annotations,
-			Namespace:   wsm.Config.Namespace,
+			Namespace:   cfg.Namespace,
 			Labels: map[string]string{
 				wsk8s.WorkspaceIDLabel:        req.Metadata.MetaId,
 				wsk8s.OwnerLabel:              req.Metadata.Owner,
@@ -255,6 +420,7 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 			Ownership: workspacev1.Ownership{
 				Owner:       req.Metadata.Owner,
 				WorkspaceID: req.Metadata.MetaId,
+				Team:        req.Metadata.GetTeam(),
 			},
 			Type:  workspaceType,
 			Class: classID,
@@ -285,56 +451,16 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 			SshPublicKeys:         req.Spec.SshPublicKeys,
 			StorageQuota:          int(storage.Value()),
 			SSHGatewayCAPublicKey: sshGatewayCAPublicKey,
+			EgressPolicy:          egressPolicyName,
 		},
 	}
-	controllerutil.AddFinalizer(&ws, workspacev1.GitpodFinalizerName)
-
-	exists, err := wsm.workspaceExists(ctx, req.Metadata.MetaId)
-	if err != nil {
-		return nil, fmt.Errorf("cannot check if workspace %s exists: %w", req.Metadata.MetaId, err)
-	}
-
-	if exists {
-		return nil, status.Errorf(codes.AlreadyExists, "workspace %s already exists", req.Metadata.MetaId)
-	}
-
-	err = wsm.createWorkspaceSecret(ctx, &ws, envSecretName, wsm.Config.Namespace, envData)
-	if err != nil {
-		return nil, fmt.Errorf("cannot create env secret for workspace %s: %w", req.Id, err)
-	}
-
-	err = wsm.createWorkspaceSecret(ctx, &ws, fmt.Sprintf("%s-%s", req.Id, "tokens"), wsm.Config.SecretsNamespace, tokenData)
-	if err != nil {
-		return nil, fmt.Errorf("cannot create token secret for workspace %s: %w", req.Id, err)
-	}
-
-	wsm.metrics.recordWorkspaceStart(&ws)
-	err = wsm.Client.Create(ctx, &ws)
-	if err != nil {
-		log.WithError(err).WithFields(owi).Error("error creating workspace")
-		return nil, status.Errorf(codes.FailedPrecondition, "cannot create workspace")
-	}
+	controllerutil.AddFinalizer(ws, workspacev1.GitpodFinalizerName)
 
-	var wsr workspacev1.Workspace
-	err = wait.PollWithContext(ctx, 100*time.Millisecond, 30*time.Second, func(c context.Context) (done bool, err error) {
-		err = wsm.Client.Get(ctx, types.NamespacedName{Namespace: wsm.Config.Namespace, Name: ws.Name}, &wsr)
-		if err != nil {
-			return false, nil
-		}
-
-		if wsr.Status.OwnerToken != "" && wsr.Status.URL != "" {
-			return true, nil
-		}
-
-		return false, nil
-	})
-	if err != nil {
-		return nil, status.Errorf(codes.FailedPrecondition, "cannot wait for workspace URL: %q", err)
-	}
-
-	return &wsmanapi.StartWorkspaceResponse{
-		Url:        wsr.Status.URL,
-		OwnerToken: wsr.Status.OwnerToken,
+	return &startWorkspaceObjects{
+		Workspace:     ws,
+		EnvSecretName: envSecretName,
+		EnvData:       envData,
+		TokenData:     tokenData,
 	}, nil
 }
 
@@ -513,7 +639,8 @@ func (wsm *WorkspaceManagerServer) MarkActive(ctx context.Context, req *wsmanapi
 	}
 
 	// if user already mark workspace as active and this request has IgnoreIfActive flag, just simple ignore it
-	if firstUserActivity != nil && req.IgnoreIfActive {
+	paused := ws.Spec.Paused
+	if firstUserActivity != nil && req.IgnoreIfActive && !paused {
 		return &wsmanapi.MarkActiveResponse{}, nil
 	}
 
@@ -529,6 +656,18 @@ func (wsm *WorkspaceManagerServer) MarkActive(ctx context.Context, req *wsmanapi
 		log.WithError(err).WithFields(log.OWI("", "", workspaceID)).Warn("was unable to update status")
 	}
 
+	// Activity resumes a paused workspace. We record the activity first, so that the workspace isn't paused again right away.
+	if paused {
+		err = wsm.modifyWorkspace(ctx, req.Id, false, func(ws *workspacev1.Workspace) error {
+			ws.Spec.Paused = false
+			return nil
+		})
+		if err != nil {
+			log.WithError(err).WithFields(log.OWI("", "", workspaceID)).Warn("was unable to resume workspace")
+			return nil, status.Errorf(codes.Internal, "cannot resume workspace: %v", err)
+		}
+	}
+
 	// We do however maintain the "closed" flag as condition on the workspace. This flag should not change
 	// very often and provides a better UX if it persists across ws-manager restarts.
 	isMarkedClosed := ws.IsConditionTrue(workspacev1.WorkspaceConditionClosed)
@@ -576,6 +715,11 @@ func (wsm *WorkspaceManagerServer) SetTimeout(ctx context.Context, req *wsmanapi
 		err = wsm.modifyWorkspace(ctx, req.Id, false, func(ws *workspacev1.Workspace) error {
 			ws.Spec.Timeout.Time = &metav1.Duration{Duration: duration}
 			ws.Spec.Timeout.ClosedTimeout = &metav1.Duration{Duration: time.Duration(0)}
+
+			err := wsm.TimeoutLimits(ws.Spec.Ownership.Team, ws.Spec.Class).Admit(ws)
+			if err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
 			return nil
 		})
 	} else if req.Type == wsmanapi.TimeoutType_CLOSED_TIMEOUT {
@@ -767,6 +911,193 @@ func (wsm *WorkspaceManagerServer) UpdateSSHKey(ctx context.Context, req *wsmana
 	return &wsmanapi.UpdateSSHKeyResponse{}, err
 }
 
+func (wsm *WorkspaceManagerServer) SetStorageQuota(ctx context.Context, req *wsmanapi.SetStorageQuotaRequest) (res *wsmanapi.SetStorageQuotaResponse, err error) {
+	span, ctx := tracing.FromContext(ctx, "SetStorageQuota")
+	tracing.ApplyOWI(span, log.OWI("", "", req.Id))
+	defer tracing.FinishSpan(span, &err)
+
+	quota, err := resource.ParseQuantity(req.Quota)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid quota: %v", err)
+	}
+	if quota.Value() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "quota must be positive")
+	}
+
+	// ws-daemon applies the new quota to the running workspace. Whether it fits onto the node
+	// and does not cut below the current usage can only be decided there.
+	err = wsm.modifyWorkspace(ctx, req.Id, false, func(ws *workspacev1.Workspace) error {
+		if ws.Status.Phase != workspacev1.WorkspacePhaseRunning {
+			return status.Errorf(codes.FailedPrecondition, "workspace is not running")
+		}
+		if ws.Spec.StorageQuota == 0 {
+			return status.Errorf(codes.FailedPrecondition, "workspace has no storage quota")
+		}
+
+		ws.Spec.StorageQuota = int(quota.Value())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &wsmanapi.SetStorageQuotaResponse{}, nil
+}
+
+func (wsm *WorkspaceManagerServer) MigrateWorkspace(ctx context.Context, req *wsmanapi.MigrateWorkspaceRequest) (res *wsmanapi.MigrateWorkspaceResponse, err error) {
+	span, ctx := tracing.FromContext(ctx, "MigrateWorkspace")
+	tracing.ApplyOWI(span, log.OWI("", "", req.Id))
+	defer tracing.FinishSpan(span, &err)
+
+	if !wsm.Config.EnableWorkspaceMigration {
+		return nil, status.Errorf(codes.FailedPrecondition, "workspace migration is not enabled")
+	}
+
+	var workspace workspacev1.Workspace
+	err = wsm.Client.Get(ctx, types.NamespacedName{Namespace: wsm.Config.Namespace, Name: req.Id}, &workspace)
+	if errors.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "workspace %s not found", req.Id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot get workspace: %v", err)
+	}
+
+	// Running workspaces have no secrets anymore. We recreate the ones the replacement pod needs from the request,
+	// which hence has to provide every environment variable the pod takes from the env secret.
+	envSecretName := fmt.Sprintf("%s-%s", req.Id, "env")
+	_, envData := extractWorkspaceUserEnv(envSecretName, req.Envvars, req.SysEnvvars)
+	for _, e := range workspace.Spec.UserEnvVars {
+		if e.ValueFrom == nil || e.ValueFrom.SecretKeyRef == nil || e.ValueFrom.SecretKeyRef.Name != envSecretName {
+			continue
+		}
+		if _, ok := envData[e.ValueFrom.SecretKeyRef.Key]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "environment variable %s of workspace %s is missing", e.Name, req.Id)
+		}
+	}
+
+	// The workspace controller takes it from here: it stops the pod, which makes ws-daemon back up the content,
+	// and restores that backup in a new pod on another node.
+	err = wsm.modifyWorkspace(ctx, req.Id, true, func(ws *workspacev1.Workspace) error {
+		if ws.IsConditionTrue(workspacev1.WorkspaceConditionMigrating) {
+			return nil
+		}
+		if ws.Spec.Type != workspacev1.WorkspaceTypeRegular {
+			return status.Errorf(codes.FailedPrecondition, "only regular workspaces can be migrated")
+		}
+		if ws.Status.Phase != workspacev1.WorkspacePhaseRunning || ws.Status.Runtime == nil || ws.Status.Runtime.NodeName == "" {
+			return status.Errorf(codes.FailedPrecondition, "workspace is not running")
+		}
+		if ws.IsConditionTrue(workspacev1.WorkspaceConditionStoppedByRequest) || ws.IsConditionTrue(workspacev1.WorkspaceConditionTimeout) || ws.IsConditionTrue(workspacev1.WorkspaceConditionFailed) {
+			return status.Errorf(codes.FailedPrecondition, "workspace is stopping")
+		}
+
+		ws.Status.Migration = &workspacev1.WorkspaceMigrationStatus{
+			SourceNode: ws.Status.Runtime.NodeName,
+			Started:    metav1.Now(),
+		}
+		ws.Status.SetCondition(workspacev1.NewWorkspaceConditionMigrating(fmt.Sprintf("moving workspace away from node %s", ws.Status.Runtime.NodeName), metav1.ConditionTrue))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The workspace controller keeps the secrets while the workspace migrates, and fails the migration if the
+	// replacement pod would start without them. The backup the replacement restores needs no tokens.
+	err = wsm.createWorkspaceSecret(ctx, &workspace, envSecretName, wsm.Config.Namespace, envData)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot create env secret: %v", err)
+	}
+	err = wsm.createWorkspaceSecret(ctx, &workspace, fmt.Sprintf("%s-%s", req.Id, "tokens"), wsm.Config.SecretsNamespace, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot create token secret: %v", err)
+	}
+
+	return &wsmanapi.MigrateWorkspaceResponse{}, nil
+}
+
+func (wsm *WorkspaceManagerServer) RenderWorkspacePod(ctx context.Context, req *wsmanapi.RenderWorkspacePodRequest) (res *wsmanapi.RenderWorkspacePodResponse, err error) {
+	span, ctx := tracing.FromContext(ctx, "RenderWorkspacePod")
+	defer tracing.FinishSpan(span, &err)
+
+	if req.Request == nil || req.Request.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "request and its metadata are required")
+	}
+	if err := validateStartWorkspaceRequest(req.Request); err != nil {
+		return nil, err
+	}
+	tracing.ApplyOWI(span, log.OWI(req.Request.Metadata.Owner, req.Request.Metadata.MetaId, req.Request.Id))
+
+	pod, err := wsm.renderWorkspacePod(ctx, wsm.Config, req.Request)
+	if err != nil {
+		return nil, err
+	}
+	res = &wsmanapi.RenderWorkspacePodResponse{Pod: pod}
+	if req.CompareClass == "" && req.CompareConfig == "" {
+		return res, nil
+	}
+
+	compareCfg := wsm.Config
+	if req.CompareConfig != "" {
+		compareCfg = &config.Configuration{}
+		err = json.Unmarshal([]byte(req.CompareConfig), compareCfg)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot parse compare config: %v", err)
+		}
+		err = compareCfg.Validate()
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid compare config: %v", err)
+		}
+	}
+	compareReq := req.Request
+	if req.CompareClass != "" {
+		if _, ok := compareCfg.WorkspaceClasses[req.CompareClass]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown workspace class %s", req.CompareClass)
+		}
+		compareReq = proto.Clone(req.Request).(*wsmanapi.StartWorkspaceRequest)
+		compareReq.Spec.Class = req.CompareClass
+	}
+
+	res.ComparePod, err = wsm.renderWorkspacePod(ctx, compareCfg, compareReq)
+	if err != nil {
+		return nil, err
+	}
+	if res.ComparePod != res.Pod {
+		res.Diff, err = difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(res.Pod),
+			B:        difflib.SplitLines(res.ComparePod),
+			FromFile: "pod",
+			ToFile:   "compare-pod",
+			Context:  3,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "cannot diff pods: %v", err)
+		}
+	}
+	return res, nil
+}
+
+// renderWorkspacePod renders the pod of a start workspace request as YAML
+func (wsm *WorkspaceManagerServer) renderWorkspacePod(ctx context.Context, cfg *config.Configuration, req *wsmanapi.StartWorkspaceRequest) (string, error) {
+	if wsm.renderPod == nil {
+		return "", status.Error(codes.Unimplemented, "pod rendering is not available")
+	}
+
+	objs, err := newWorkspace(req, cfg)
+	if err != nil {
+		return "", err
+	}
+	pod, err := wsm.renderPod(ctx, cfg, objs.Workspace)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "cannot render workspace pod: %v", err)
+	}
+	out, err := yaml.Marshal(pod)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "cannot marshal workspace pod: %v", err)
+	}
+	return string(out), nil
+}
+
 func (wsm *WorkspaceManagerServer) DescribeCluster(ctx context.Context, req *wsmanapi.DescribeClusterRequest) (res *wsmanapi.DescribeClusterResponse, err error) {
 	//nolint:ineffassign
 	span, ctx := tracing.FromContext(ctx, "DescribeCluster")
@@ -795,18 +1126,118 @@ func (wsm *WorkspaceManagerServer) DescribeCluster(ctx context.Context, req *wsm
 			DisplayName:      class.Name,
 			Description:      desc,
 			CreditsPerMinute: class.CreditsPerMinute,
+			Timeouts:         describeTimeouts(wsm.Config.TimeoutsFor("", id)),
 		})
 	}
 	sort.Slice(classes, func(i, j int) bool {
 		return classes[i].Id < classes[j].Id
 	})
 
+	orgTimeouts := make([]*wsmanapi.OrganizationTimeouts, 0, len(wsm.Config.OrganizationTimeouts))
+	for team := range wsm.Config.OrganizationTimeouts {
+		for _, class := range classes {
+			timeouts := describeTimeouts(wsm.Config.TimeoutsFor(team, class.Id))
+			if proto.Equal(timeouts, class.Timeouts) {
+				continue
+			}
+			orgTimeouts = append(orgTimeouts, &wsmanapi.OrganizationTimeouts{
+				Team:           team,
+				WorkspaceClass: class.Id,
+				Timeouts:       timeouts,
+			})
+		}
+	}
+	sort.Slice(orgTimeouts, func(i, j int) bool {
+		if orgTimeouts[i].Team != orgTimeouts[j].Team {
+			return orgTimeouts[i].Team < orgTimeouts[j].Team
+		}
+		return orgTimeouts[i].WorkspaceClass < orgTimeouts[j].WorkspaceClass
+	})
+
+	quotas, err := wsm.describeWorkspaceQuotas(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot describe workspace quotas: %v", err)
+	}
+
 	return &wsmanapi.DescribeClusterResponse{
 		WorkspaceClasses:        classes,
 		PreferredWorkspaceClass: wsm.Config.PreferredWorkspaceClass,
+		WorkspaceQuotas:         quotas,
+		OrganizationTimeouts:    orgTimeouts,
 	}, nil
 }
 
+func describeTimeouts(timeouts config.TimeoutPolicy) *wsmanapi.WorkspaceTimeouts {
+	limit := func(d util.Duration) string {
+		if d == 0 {
+			return ""
+		}
+		return d.String()
+	}
+	return &wsmanapi.WorkspaceTimeouts{
+		RegularWorkspace:      timeouts.RegularWorkspace.String(),
+		RegularWorkspaceLimit: limit(timeouts.RegularWorkspaceLimit),
+		AfterClose:            timeouts.AfterClose.String(),
+		MaxLifetime:           timeouts.MaxLifetime.String(),
+		MaxLifetimeLimit:      limit(timeouts.MaxLifetimeLimit),
+	}
+}
+
+// TimeoutLimits returns the longest timeouts regular workspaces of an organization and workspace class can ask for
+func (wsm *WorkspaceManagerServer) TimeoutLimits(organization, class string) workspacev1.TimeoutLimits {
+	timeouts := wsm.Config.TimeoutsFor(organization, class)
+	return workspacev1.TimeoutLimits{
+		Time:            time.Duration(timeouts.RegularWorkspaceLimit),
+		MaximumLifetime: time.Duration(timeouts.MaxLifetimeLimit),
+	}
+}
+
+// describeWorkspaceQuotas lists the quotas of all organizations together with how much of them they use
+func (wsm *WorkspaceManagerServer) describeWorkspaceQuotas(ctx context.Context) ([]*wsmanapi.WorkspaceQuota, error) {
+	quotas, usage, err := wsm.quotas.Usage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*wsmanapi.WorkspaceQuota, 0, len(quotas))
+	for _, q := range quotas {
+		u := usage[q.Spec.Team]
+		quota := &wsmanapi.WorkspaceQuota{
+			Team:                   q.Spec.Team,
+			RunningWorkspacesLimit: int32(q.Spec.RunningWorkspaces),
+			RunningWorkspaces:      int32(u.RunningWorkspaces),
+			PrebuildsLimit:         int32(q.Spec.Prebuilds),
+			Prebuilds:              int32(u.Prebuilds),
+		}
+		for class, limit := range q.Spec.Classes {
+			quota.Classes = append(quota.Classes, &wsmanapi.WorkspaceQuotaClass{
+				WorkspaceClass: class,
+				Limit:          int32(limit),
+				Used:           int32(u.Classes[class]),
+			})
+		}
+		sort.Slice(quota.Classes, func(i, j int) bool {
+			return quota.Classes[i].WorkspaceClass < quota.Classes[j].WorkspaceClass
+		})
+		for name, limit := range q.Spec.Resources {
+			used := u.Resources[name]
+			quota.Resources = append(quota.Resources, &wsmanapi.WorkspaceQuotaResource{
+				Name:  string(name),
+				Limit: limit.String(),
+				Used:  used.String(),
+			})
+		}
+		sort.Slice(quota.Resources, func(i, j int) bool {
+			return quota.Resources[i].Name < quota.Resources[j].Name
+		})
+		res = append(res, quota)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Team < res[j].Team
+	})
+	return res, nil
+}
+
 // modifyWorkspace modifies a workspace object using the mod function. If the mod function returns a gRPC status error, that error
 // is returned directly. If mod returns a non-gRPC error it is turned into one.
 func (wsm *WorkspaceManagerServer) modifyWorkspace(ctx context.Context, id string, updateStatus bool, mod func(ws *workspacev1.Workspace) error) (err error) {
@@ -1022,12 +1453,13 @@ func (wsm *WorkspaceManagerServer) extractWorkspaceStatus(ws *workspacev1.Worksp
 		tpe = wsmanapi.WorkspaceType_REGULAR
 	}
 
-	timeout := wsm.Config.Timeouts.RegularWorkspace.String()
+	timeouts := wsm.Config.TimeoutsFor(ws.Spec.Ownership.Team, ws.Spec.Class)
+	timeout := timeouts.RegularWorkspace.String()
 	if ws.Spec.Timeout.Time != nil {
 		timeout = ws.Spec.Timeout.Time.Duration.String()
 	}
 
-	closedTimeout := wsm.Config.Timeouts.AfterClose.String()
+	closedTimeout := timeouts.AfterClose.String()
 	if ws.Spec.Timeout.ClosedTimeout != nil {
 		closedTimeout = ws.Spec.Timeout.ClosedTimeout.Duration.String()
 	}
@@ -1045,6 +1477,10 @@ func (wsm *WorkspaceManagerServer) extractWorkspaceStatus(ws *workspacev1.Worksp
 		phase = wsmanapi.WorkspacePhase_INITIALIZING
 	case workspacev1.WorkspacePhaseRunning:
 		phase = wsmanapi.WorkspacePhase_RUNNING
+	case workspacev1.WorkspacePhasePaused:
+		// A paused workspace keeps its instance and resumes as soon as it is used. Clients tell it apart by
+		// the paused condition.
+		phase = wsmanapi.WorkspacePhase_RUNNING
 	case workspacev1.WorkspacePhaseStopping:
 		phase = wsmanapi.WorkspacePhase_STOPPING
 	case workspacev1.WorkspacePhaseStopped:
@@ -1145,6 +1581,7 @@ func (wsm *WorkspaceManagerServer) extractWorkspaceStatus(ws *workspacev1.Worksp
 			Type:           tpe,
 			Timeout:        timeout,
 			ClosedTimeout:  closedTimeout,
+			EgressPolicy:   ws.Spec.EgressPolicy,
 		},
 		Phase: phase,
 		Conditions: &wsmanapi.WorkspaceConditions{
@@ -1157,6 +1594,7 @@ func (wsm *WorkspaceManagerServer) extractWorkspaceStatus(ws *workspacev1.Worksp
 			StoppedByRequest:    convertCondition(ws.Status.Conditions, string(workspacev1.WorkspaceConditionStoppedByRequest)),
 			FinalBackupComplete: convertCondition(ws.Status.Conditions, string(workspacev1.WorkspaceConditionBackupComplete)),
 			Aborted:             convertCondition(ws.Status.Conditions, string(workspacev1.WorkspaceConditionAborted)),
+			Paused:              convertBool(ws.Status.Phase == workspacev1.WorkspacePhasePaused),
 		},
 		Runtime: runtime,
 		Auth: &wsmanapi.WorkspaceAuthentication{
@@ -1165,6 +1603,15 @@ func (wsm *WorkspaceManagerServer) extractWorkspaceStatus(ws *workspacev1.Worksp
 		},
 		Repo:               convertGitStatus(ws.Status.GitStatus),
 		InitializerMetrics: initializerMetrics,
+		Timeline:           convertTimeline(ws.Status.Timeline),
+	}
+
+	if ws.Status.Storage.QuotaLimit > 0 {
+		res.Storage = &wsmanapi.WorkspaceStorage{
+			QuotaBytes:     ws.Status.Storage.QuotaLimit,
+			UsedBytes:      ws.Status.Storage.QuotaUsed,
+			ResizeRejected: getConditionMessageIfTrue(ws.Status.Conditions, string(workspacev1.WorkspaceConditionStorageQuotaRejected)),
+		}
 	}
 
 	return res
@@ -1223,6 +1670,23 @@ func mapInitializerMetrics(in *workspacev1.InitializerMetrics) *wsmanapi.Initial
 	return result
 }
 
+func convertTimeline(timeline []workspacev1.WorkspaceTimelineEvent) []*wsmanapi.WorkspaceTimelineEvent {
+	if len(timeline) == 0 {
+		return nil
+	}
+
+	res := make([]*wsmanapi.WorkspaceTimelineEvent, 0, len(timeline))
+	for _, evt := range timeline {
+		res = append(res, &wsmanapi.WorkspaceTimelineEvent{
+			Type:   string(evt.Type),
+			Name:   evt.Name,
+			Status: string(evt.Status),
+			Time:   timestamppb.New(evt.Time.Time),
+		})
+	}
+	return res
+}
+
 func durationToProto(d *metav1.Duration) *durationpb.Duration {
 	if d == nil {
 		return nil
@@ -1269,6 +1733,13 @@ func convertCondition(conds []metav1.Condition, tpe string) wsmanapi.WorkspaceCo
 	}
 }
 
+func convertBool(b bool) wsmanapi.WorkspaceConditionBool {
+	if b {
+		return wsmanapi.WorkspaceConditionBool_TRUE
+	}
+	return wsmanapi.WorkspaceConditionBool_FALSE
+}
+
 func matchesMetadataAnnotations(ws *workspacev1.Workspace, filter *wsmanapi.MetadataFilter) bool {
 	if filter == nil {
 		return true
@@ -1505,7 +1976,8 @@ func (subs *subscriptions) OnChange(ctx context.Context, status *wsmanapi.Worksp
 }
 
 type workspaceMetrics struct {
-	totalStartsCounterVec *prometheus.CounterVec
+	totalStartsCounterVec         *prometheus.CounterVec
+	totalWarmPoolClaimsCounterVec *prometheus.CounterVec
 }
 
 func newWorkspaceMetrics(namespace string, k8s client.Client) *workspaceMetrics {
@@ -1516,6 +1988,12 @@ func newWorkspaceMetrics(namespace string, k8s client.Client) *workspaceMetrics
 			Name:      "workspace_starts_total",
 			Help:      "total number of workspaces started",
 		}, []string{"type", "class"}),
+		totalWarmPoolClaimsCounterVec: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "gitpod",
+			Subsystem: "ws_manager_mk2",
+			Name:      "workspace_warm_pool_claims_total",
+			Help:      "total number of attempts to claim a warm pool pod for a starting workspace",
+		}, []string{"class", "result"}),
 	}
 }
 
@@ -1530,12 +2008,23 @@ func (m *workspaceMetrics) recordWorkspaceStart(ws *workspacev1.Workspace) {
 	counter.Inc()
 }
 
+func (m *workspaceMetrics) recordWarmPoolClaim(class string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+
+	m.totalWarmPoolClaimsCounterVec.WithLabelValues(class, result).Inc()
+}
+
 // Describe implements Collector. It will send exactly one Desc to the provided channel.
 func (m *workspaceMetrics) Describe(ch chan<- *prometheus.Desc) {
 	m.totalStartsCounterVec.Describe(ch)
+	m.totalWarmPoolClaimsCounterVec.Describe(ch)
 }
 
 // Collect implements Collector.
 func (m *workspaceMetrics) Collect(ch chan<- prometheus.Metric) {
 	m.totalStartsCounterVec.Collect(ch)
+	m.totalWarmPoolClaimsCounterVec.Collect(ch)
 }
@@ -6,15 +6,31 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/gitpod-io/gitpod/common-go/util"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	"github.com/gitpod-io/gitpod/ws-manager/api"
 	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestDescribeCluster(t *testing.T) {
+	noTimeouts := &api.WorkspaceTimeouts{RegularWorkspace: "0s", AfterClose: "0s", MaxLifetime: "0s"}
+
 	type Expectation struct {
 		Error    string
 		Response *api.DescribeClusterResponse
@@ -23,10 +39,11 @@ func TestDescribeCluster(t *testing.T) {
 		Name        string
 		Expectation Expectation
 		Config      config.Configuration
+		Objects     []client.Object
 	}{
 		{
 			Name:        "empty config",
-			Expectation: Expectation{Response: &api.DescribeClusterResponse{WorkspaceClasses: []*api.WorkspaceClass{}}},
+			Expectation: Expectation{Response: &api.DescribeClusterResponse{WorkspaceClasses: []*api.WorkspaceClass{}, WorkspaceQuotas: []*api.WorkspaceQuota{}, OrganizationTimeouts: []*api.OrganizationTimeouts{}}},
 		},
 		{
 			Name: "preferred class",
@@ -50,8 +67,10 @@ func TestDescribeCluster(t *testing.T) {
 				Response: &api.DescribeClusterResponse{
 					PreferredWorkspaceClass: "default",
 					WorkspaceClasses: []*api.WorkspaceClass{
-						{Id: "default", DisplayName: "Default Workspace", Description: "10 vCPU, 15GB memory, 20GB disk", CreditsPerMinute: 0.4},
+						{Id: "default", DisplayName: "Default Workspace", Description: "10 vCPU, 15GB memory, 20GB disk", CreditsPerMinute: 0.4, Timeouts: noTimeouts},
 					},
+					WorkspaceQuotas:      []*api.WorkspaceQuota{},
+					OrganizationTimeouts: []*api.OrganizationTimeouts{},
 				},
 			},
 		},
@@ -66,8 +85,93 @@ func TestDescribeCluster(t *testing.T) {
 			Expectation: Expectation{
 				Response: &api.DescribeClusterResponse{
 					WorkspaceClasses: []*api.WorkspaceClass{
-						{Id: "large", Description: "0 vCPU, 0GB memory, 0GB disk"},
-						{Id: "xlarge", Description: "0 vCPU, 0GB memory, 0GB disk"},
+						{Id: "large", Description: "0 vCPU, 0GB memory, 0GB disk", Timeouts: noTimeouts},
+						{Id: "xlarge", Description: "0 vCPU, 0GB memory, 0GB disk", Timeouts: noTimeouts},
+					},
+					WorkspaceQuotas:      []*api.WorkspaceQuota{},
+					OrganizationTimeouts: []*api.OrganizationTimeouts{},
+				},
+			},
+		},
+		{
+			Name: "workspace quotas",
+			Config: config.Configuration{
+				Namespace: "default",
+				WorkspaceClasses: map[string]*config.WorkspaceClass{
+					"large": {
+						Container: config.ContainerConfiguration{
+							Requests: &config.ResourceRequestConfiguration{CPU: "4", Memory: "8G"},
+						},
+					},
+				},
+			},
+			Objects: []client.Object{
+				&workspacev1.WorkspaceQuota{
+					ObjectMeta: metav1.ObjectMeta{Name: "acme", Namespace: "default"},
+					Spec: workspacev1.WorkspaceQuotaSpec{
+						Team:              "acme",
+						RunningWorkspaces: 5,
+						Classes:           map[string]int{"large": 2},
+						Resources:         corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("16")},
+					},
+				},
+				newQuotaTestWorkspace("running", "acme", workspacev1.WorkspaceTypeRegular, workspacev1.WorkspacePhaseRunning),
+				newQuotaTestWorkspace("prebuild", "acme", workspacev1.WorkspaceTypePrebuild, workspacev1.WorkspacePhaseInitializing),
+				newQuotaTestWorkspace("stopped", "acme", workspacev1.WorkspaceTypeRegular, workspacev1.WorkspacePhaseStopped),
+				newQuotaTestWorkspace("other", "other-org", workspacev1.WorkspaceTypeRegular, workspacev1.WorkspacePhaseRunning),
+			},
+			Expectation: Expectation{
+				Response: &api.DescribeClusterResponse{
+					WorkspaceClasses: []*api.WorkspaceClass{
+						{Id: "large", Description: "4 vCPU, 8GB memory, 0GB disk", Timeouts: noTimeouts},
+					},
+					WorkspaceQuotas: []*api.WorkspaceQuota{
+						{
+							Team:                   "acme",
+							RunningWorkspacesLimit: 5,
+							RunningWorkspaces:      1,
+							Prebuilds:              1,
+							Classes: []*api.WorkspaceQuotaClass{
+								{WorkspaceClass: "large", Limit: 2, Used: 2},
+							},
+							Resources: []*api.WorkspaceQuotaResource{
+								{Name: "cpu", Limit: "16", Used: "8"},
+							},
+						},
+					},
+					OrganizationTimeouts: []*api.OrganizationTimeouts{},
+				},
+			},
+		},
+		{
+			Name: "timeouts",
+			Config: config.Configuration{
+				Timeouts: config.WorkspaceTimeoutConfiguration{
+					RegularWorkspace: util.Duration(30 * time.Minute),
+					AfterClose:       util.Duration(2 * time.Minute),
+					MaxLifetime:      util.Duration(36 * time.Hour),
+				},
+				WorkspaceClasses: map[string]*config.WorkspaceClass{
+					"default": {},
+					"large": {Timeouts: &config.TimeoutPolicy{
+						RegularWorkspaceLimit: util.Duration(time.Hour),
+						MaxLifetime:           util.Duration(8 * time.Hour),
+					}},
+				},
+				OrganizationTimeouts: map[string]*config.TimeoutPolicy{
+					"acme": {MaxLifetime: util.Duration(8 * time.Hour)},
+				},
+			},
+			Expectation: Expectation{
+				Response: &api.DescribeClusterResponse{
+					WorkspaceClasses: []*api.WorkspaceClass{
+						{Id: "default", Description: "0 vCPU, 0GB memory, 0GB disk", Timeouts: &api.WorkspaceTimeouts{RegularWorkspace: "30m0s", AfterClose: "2m0s", MaxLifetime: "36h0m0s"}},
+						{Id: "large", Description: "0 vCPU, 0GB memory, 0GB disk", Timeouts: &api.WorkspaceTimeouts{RegularWorkspace: "30m0s", RegularWorkspaceLimit: "1h0m0s", AfterClose: "2m0s", MaxLifetime: "8h0m0s"}},
+					},
+					WorkspaceQuotas: []*api.WorkspaceQuota{},
+					// the organization's timeouts only differ from those of the default class
+					OrganizationTimeouts: []*api.OrganizationTimeouts{
+						{Team: "acme", WorkspaceClass: "default", Timeouts: &api.WorkspaceTimeouts{RegularWorkspace: "30m0s", AfterClose: "2m0s", MaxLifetime: "8h0m0s"}},
 					},
 				},
 			},
@@ -78,7 +182,16 @@ func TestDescribeCluster(t *testing.T) {
 		t.Run(test.Name, func(t *testing.T) {
 			var act Expectation
 
-			srv := WorkspaceManagerServer{Config: &test.Config}
+			scheme := runtime.NewScheme()
+			_ = workspacev1.AddToScheme(scheme)
+			srv := WorkspaceManagerServer{
+				Config: &test.Config,
+				quotas: &workspacev1.WorkspaceQuotaEnforcer{
+					Client:    fake.NewClientBuilder().WithScheme(scheme).WithObjects(test.Objects...).Build(),
+					Namespace: test.Config.Namespace,
+					Resources: test.Config.WorkspaceClassRequests,
+				},
+			}
 			resp, err := srv.DescribeCluster(context.Background(), &api.DescribeClusterRequest{})
 			if err != nil {
 				act.Error = err.Error()
@@ -87,9 +200,130 @@ func TestDescribeCluster(t *testing.T) {
 				act.Response = resp
 			}
 
-			if diff := cmp.Diff(test.Expectation, act, cmpopts.IgnoreUnexported(api.DescribeClusterResponse{}, api.WorkspaceClass{})); diff != "" {
+			if diff := cmp.Diff(test.Expectation, act, cmpopts.IgnoreUnexported(api.DescribeClusterResponse{}, api.WorkspaceClass{}, api.WorkspaceQuota{}, api.WorkspaceQuotaClass{}, api.WorkspaceQuotaResource{}, api.WorkspaceTimeouts{}, api.OrganizationTimeouts{})); diff != "" {
 				t.Errorf("DescribeCluster() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
+
+func newQuotaTestWorkspace(name, team string, tpe workspacev1.WorkspaceType, phase workspacev1.WorkspacePhase) *workspacev1.Workspace {
+	return &workspacev1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: workspacev1.WorkspaceSpec{
+			Type:      tpe,
+			Class:     "large",
+			Ownership: workspacev1.Ownership{Team: team},
+		},
+		Status: workspacev1.WorkspaceStatus{Phase: phase},
+	}
+}
+
+func TestRenderWorkspacePod(t *testing.T) {
+	cfg := config.Configuration{
+		Namespace:            "default",
+		WorkspaceURLTemplate: "https://{{ .Prefix }}.ws.gitpod.io",
+		WorkspaceHostPath:    "/mnt/workingarea",
+		HeartbeatInterval:    util.Duration(30 * time.Second),
+		GitpodHostURL:        "https://gitpod.io",
+		ReconnectionInterval: util.Duration(30 * time.Second),
+		Timeouts: config.WorkspaceTimeoutConfiguration{
+			TotalStartup:        util.Duration(time.Hour),
+			Initialization:      util.Duration(30 * time.Minute),
+			RegularWorkspace:    util.Duration(30 * time.Minute),
+			MaxLifetime:         util.Duration(36 * time.Hour),
+			HeadlessWorkspace:   util.Duration(time.Hour),
+			AfterClose:          util.Duration(2 * time.Minute),
+			ContentFinalization: util.Duration(time.Hour),
+			Stopping:            util.Duration(time.Hour),
+		},
+		WorkspaceClasses: map[string]*config.WorkspaceClass{
+			config.DefaultWorkspaceClass: {Name: "Default", Container: config.ContainerConfiguration{Limits: &config.ResourceLimitConfiguration{CPU: &config.CpuResourceLimit{}, Storage: "10Gi"}}},
+			"large":                      {Name: "Large", Container: config.ContainerConfiguration{Limits: &config.ResourceLimitConfiguration{CPU: &config.CpuResourceLimit{}, Storage: "50Gi"}}},
+		},
+	}
+	otherCfg := cfg
+	otherCfg.Namespace = "other"
+	otherCfgJSON, err := json.Marshal(otherCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	renderPod := func(ctx context.Context, cfg *config.Configuration, ws *workspacev1.Workspace) (*corev1.Pod, error) {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: ws.Name, Namespace: cfg.Namespace},
+			Spec: corev1.PodSpec{
+				NodeSelector: map[string]string{"class": ws.Spec.Class},
+				Containers:   []corev1.Container{{Name: "workspace", Image: *ws.Spec.Image.Workspace.Ref}},
+			},
+		}, nil
+	}
+	newRequest := func() *api.StartWorkspaceRequest {
+		return &api.StartWorkspaceRequest{
+			Id:            "ws",
+			ServicePrefix: "ws",
+			Metadata:      &api.WorkspaceMetadata{Owner: "owner", MetaId: "ws"},
+			Spec: &api.StartWorkspaceSpec{
+				WorkspaceImage:    "workspace-image",
+				IdeImage:          &api.IDEImage{},
+				WorkspaceLocation: "/workspace",
+				Initializer:       &csapi.WorkspaceInitializer{},
+			},
+		}
+	}
+
+	tests := []struct {
+		Name          string
+		Request       *api.RenderWorkspacePodRequest
+		ExpectDiff    bool
+		ExpectedError codes.Code
+	}{
+		{Name: "render only", Request: &api.RenderWorkspacePodRequest{Request: newRequest()}},
+		{Name: "same class", Request: &api.RenderWorkspacePodRequest{Request: newRequest(), CompareClass: config.DefaultWorkspaceClass}},
+		{Name: "compare class", Request: &api.RenderWorkspacePodRequest{Request: newRequest(), CompareClass: "large"}, ExpectDiff: true},
+		{Name: "unknown compare class", Request: &api.RenderWorkspacePodRequest{Request: newRequest(), CompareClass: "xlarge"}, ExpectedError: codes.InvalidArgument},
+		{Name: "compare config", Request: &api.RenderWorkspacePodRequest{Request: newRequest(), CompareConfig: string(otherCfgJSON)}, ExpectDiff: true},
+		{Name: "invalid compare config", Request: &api.RenderWorkspacePodRequest{Request: newRequest(), CompareConfig: "{"}, ExpectedError: codes.InvalidArgument},
+		{Name: "no request", Request: &api.RenderWorkspacePodRequest{}, ExpectedError: codes.InvalidArgument},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			srv := WorkspaceManagerServer{Config: &cfg, renderPod: renderPod}
+			resp, err := srv.RenderWorkspacePod(context.Background(), test.Request)
+			if test.ExpectedError != codes.OK {
+				if status.Code(err) != test.ExpectedError {
+					t.Fatalf("expected %v error, got %v", test.ExpectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(resp.Pod, "image: workspace-image") {
+				t.Errorf("expected pod YAML to contain the workspace image, got:\n%s", resp.Pod)
+			}
+			if hasDiff := resp.Diff != ""; hasDiff != test.ExpectDiff {
+				t.Errorf("expected diff %v, got:\n%s", test.ExpectDiff, resp.Diff)
+			}
+		})
+	}
+}
+
+func TestExtractWorkspaceStatusPaused(t *testing.T) {
+	wsm := &WorkspaceManagerServer{Config: &config.Configuration{}}
+	for _, phase := range []workspacev1.WorkspacePhase{workspacev1.WorkspacePhaseRunning, workspacev1.WorkspacePhasePaused} {
+		ws := &workspacev1.Workspace{
+			ObjectMeta: metav1.ObjectMeta{Name: "ws"},
+			Spec:       workspacev1.WorkspaceSpec{Type: workspacev1.WorkspaceTypeRegular},
+			Status:     workspacev1.WorkspaceStatus{Phase: phase},
+		}
+		status := wsm.extractWorkspaceStatus(ws)
+
+		expPaused := api.WorkspaceConditionBool_FALSE
+		if phase == workspacev1.WorkspacePhasePaused {
+			expPaused = api.WorkspaceConditionBool_TRUE
+		}
+		if status.Phase != api.WorkspacePhase_RUNNING || status.Conditions.Paused != expPaused {
+			t.Errorf("%s: unexpected phase %s and paused condition %s", phase, status.Phase, status.Conditions.Paused)
+		}
+	}
+}
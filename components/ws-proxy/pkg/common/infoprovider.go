@@ -49,6 +49,12 @@ type WorkspaceInfoProvider interface {
 	ReleaseContext(id string)
 }
 
+// WorkspaceResumer is an entity that is able to resume paused workspaces.
+type WorkspaceResumer interface {
+	// Resume resumes a paused workspace instance. It returns before the workspace actually runs again.
+	Resume(ctx context.Context, instanceID string) error
+}
+
 // WorkspaceInfo is all the infos ws-proxy needs to know about a workspace.
 type WorkspaceInfo struct {
 	WorkspaceID string
@@ -71,6 +77,8 @@ type WorkspaceInfo struct {
 	OwnerUserId   string
 	SSHPublicKeys []string
 	IsRunning     bool
+	// IsPaused is true if the processes of the running workspace are frozen until it is resumed
+	IsPaused bool
 
 	IsEnabledSSHCA bool
 	IsManagedByMk2 bool
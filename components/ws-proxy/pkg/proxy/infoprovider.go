@@ -238,6 +238,9 @@ func (r *CRDWorkspaceInfoProvider) Reconcile(ctx context.Context, req ctrl.Reque
 	if managedBy, ok := ws.Labels[wsk8s.WorkspaceManagedByLabel]; ok && managedBy != "ws-manager-mk2" {
 		managedByMk2 = false
 	}
+	// requests to a paused workspace resume it
+	isPaused := ws.Status.Phase == workspacev1.WorkspacePhasePaused
+	isRunning := ws.Status.Phase == workspacev1.WorkspacePhaseRunning || ws.Status.Phase == workspacev1.WorkspacePhasePaused
 
 	wsinfo := &common.WorkspaceInfo{
 		WorkspaceID:     ws.Spec.Ownership.WorkspaceID,
@@ -252,12 +255,27 @@ func (r *CRDWorkspaceInfoProvider) Reconcile(ctx context.Context, req ctrl.Reque
 		StartedAt:       ws.CreationTimestamp.Time,
 		OwnerUserId:     ws.Spec.Ownership.Owner,
 		SSHPublicKeys:   ws.Spec.SshPublicKeys,
-		IsRunning:       ws.Status.Phase == workspacev1.WorkspacePhaseRunning,
+		IsRunning:       isRunning,
+		IsPaused:        isPaused,
 		IsEnabledSSHCA:  ws.Spec.SSHGatewayCAPublicKey != "",
 		IsManagedByMk2:  managedByMk2,
 	}
 
+	var prev *common.WorkspaceInfo
+	if obj, ok := r.store.Get(req.Name); ok {
+		prev = obj.(*common.WorkspaceInfo)
+	}
+	if prev != nil && ws.IsConditionTrue(workspacev1.WorkspaceConditionMigrating) && !wsinfo.IsRunning {
+		// The workspace moves to another node. We keep routing to it as we know it until the replacement pod runs.
+		log.WithField("workspace", req.Name).Debug("workspace is migrating, keeping workspace details")
+		return ctrl.Result{}, nil
+	}
+
 	r.store.Update(req.Name, wsinfo)
+	if prev != nil && prev.IPAddress != "" && wsinfo.IPAddress != "" && prev.IPAddress != wsinfo.IPAddress {
+		// connections to the previous pod are dead, clients have to reconnect to the new one
+		r.cancelConnectionContexts(wsinfo.WorkspaceID, xerrors.Errorf("workspace %s moved to %s", wsinfo.WorkspaceID, wsinfo.IPAddress))
+	}
 	r.invalidateConnectionContext(wsinfo)
 	log.WithField("workspace", req.Name).WithField("details", wsinfo).Debug("adding/updating workspace details")
 
@@ -296,6 +314,21 @@ func (r *CRDWorkspaceInfoProvider) invalidateConnectionContext(ws *common.Worksp
 	}
 }
 
+func (r *CRDWorkspaceInfoProvider) cancelConnectionContexts(workspaceID string, cause error) {
+	connCtxs, err := r.contextStore.ByIndex(workspaceIndex, workspaceID)
+	if err != nil {
+		return
+	}
+	for _, _connCtx := range connCtxs {
+		connCtx, ok := _connCtx.(*ConnectionContext)
+		if !ok {
+			continue
+		}
+		connCtx.CancelFunc(cause)
+		r.contextStore.Delete(connCtx.UUID)
+	}
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *CRDWorkspaceInfoProvider) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
@@ -0,0 +1,96 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+func TestCRDWorkspaceInfoProviderMigration(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = workspacev1.AddToScheme(scheme)
+
+	ws := &workspacev1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{Name: "instance", Namespace: "default"},
+		Spec: workspacev1.WorkspaceSpec{
+			Ownership: workspacev1.Ownership{WorkspaceID: "workspace"},
+		},
+		Status: workspacev1.WorkspaceStatus{
+			Phase:   workspacev1.WorkspacePhaseRunning,
+			URL:     "https://workspace.ws.gitpod.io",
+			Runtime: &workspacev1.WorkspaceRuntimeStatus{NodeName: "node-a", PodIP: "10.0.0.1"},
+		},
+	}
+	clnt := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&workspacev1.Workspace{}).WithObjects(ws).Build()
+	provider, err := NewCRDWorkspaceInfoProvider(clnt, scheme)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "instance"}}
+	reconcile := func() {
+		_, err := provider.Reconcile(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	updateStatus := func(mod func(ws *workspacev1.Workspace)) {
+		err := clnt.Get(context.Background(), req.NamespacedName, ws)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mod(ws)
+		err = clnt.Status().Update(context.Background(), ws)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reconcile()
+	}
+	reconcile()
+
+	ctx, id, err := provider.AcquireContext(context.Background(), "workspace", "22999")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer provider.ReleaseContext(id)
+
+	// the pod stops to migrate the workspace - we keep routing to it until the replacement runs
+	updateStatus(func(ws *workspacev1.Workspace) {
+		ws.Status.SetCondition(workspacev1.NewWorkspaceConditionMigrating("", metav1.ConditionTrue))
+		ws.Status.Phase = workspacev1.WorkspacePhaseStopping
+	})
+	updateStatus(func(ws *workspacev1.Workspace) {
+		ws.Status.Phase = workspacev1.WorkspacePhasePending
+		ws.Status.Runtime = nil
+	})
+	info := provider.WorkspaceInfo("workspace")
+	if info == nil || !info.IsRunning || info.IPAddress != "10.0.0.1" {
+		t.Fatalf("expected workspace details to be kept during the migration, got %+v", info)
+	}
+	if ctx.Err() != nil {
+		t.Fatal("connection must not be cancelled before the replacement runs")
+	}
+
+	updateStatus(func(ws *workspacev1.Workspace) {
+		ws.Status.Phase = workspacev1.WorkspacePhaseRunning
+		ws.Status.Runtime = &workspacev1.WorkspaceRuntimeStatus{NodeName: "node-b", PodIP: "10.0.1.1"}
+	})
+	info = provider.WorkspaceInfo("workspace")
+	if info == nil || info.IPAddress != "10.0.1.1" || info.URL != "https://workspace.ws.gitpod.io" {
+		t.Fatalf("expected routing to the replacement pod, got %+v", info)
+	}
+	if ctx.Err() == nil {
+		t.Error("expected connections to the previous pod to be cancelled")
+	}
+}
@@ -104,6 +104,23 @@ func NewSingleHostReverseProxy(target *url.URL, useTargetHost bool) *httputil.Re
 	return &httputil.ReverseProxy{Director: director}
 }
 
+// resumeWorkspace resumes the workspace a request is for if it is paused. Its frozen processes could not answer
+// the request otherwise. The request then waits for the workspace to run again, as the connection is accepted regardless.
+func resumeWorkspace(config *RouteHandlerConfig, infoProvider common.WorkspaceInfoProvider, req *http.Request) error {
+	if config.WorkspaceResumer == nil {
+		return nil
+	}
+	coords := getWorkspaceCoords(req)
+	if coords.ID == "" {
+		return nil
+	}
+	info := infoProvider.WorkspaceInfo(coords.ID)
+	if info == nil || !info.IsPaused {
+		return nil
+	}
+	return config.WorkspaceResumer.Resume(req.Context(), info.InstanceID)
+}
+
 // proxyPass is the function that assembles a ProxyHandler from the config, a resolver and various options and returns a http.HandlerFunc.
 func proxyPass(config *RouteHandlerConfig, infoProvider common.WorkspaceInfoProvider, resolver targetResolver, opts ...proxyPassOpt) http.HandlerFunc {
 	h := proxyPassConfig{
@@ -123,6 +140,13 @@ func proxyPass(config *RouteHandlerConfig, infoProvider common.WorkspaceInfoProv
 	}
 
 	return func(w http.ResponseWriter, req *http.Request) {
+		err := resumeWorkspace(config, infoProvider, req)
+		if err != nil {
+			log.WithError(err).WithField("workspaceId", getWorkspaceCoords(req).ID).Error("cannot resume paused workspace")
+			http.Error(w, "cannot resume workspace", http.StatusServiceUnavailable)
+			return
+		}
+
 		targetURL, targetResource, err := h.TargetResolver(config.Config, infoProvider, req)
 		if err != nil {
 			if h.ErrorHandler != nil {
@@ -30,6 +30,8 @@ type WorkspaceProxy struct {
 	WorkspaceRouter       WorkspaceRouter
 	WorkspaceInfoProvider common.WorkspaceInfoProvider
 	SSHGatewayServer      *sshproxy.Server
+	// WorkspaceResumer resumes paused workspaces if set
+	WorkspaceResumer common.WorkspaceResumer
 }
 
 // NewWorkspaceProxy creates a new workspace proxy.
@@ -135,7 +137,11 @@ func (p *WorkspaceProxy) Handler() (http.Handler, error) {
 	})
 
 	// install routes
-	handlerConfig, err := NewRouteHandlerConfig(&p.Config, WithDefaultAuth(p.WorkspaceInfoProvider))
+	opts := []RouteHandlerConfigOpt{WithDefaultAuth(p.WorkspaceInfoProvider)}
+	if p.WorkspaceResumer != nil {
+		opts = append(opts, WithWorkspaceResumer(p.WorkspaceResumer))
+	}
+	handlerConfig, err := NewRouteHandlerConfig(&p.Config, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -45,6 +45,7 @@ type RouteHandlerConfig struct {
 	Config               *Config
 	DefaultTransport     http.RoundTripper
 	WorkspaceAuthHandler mux.MiddlewareFunc
+	WorkspaceResumer     common.WorkspaceResumer
 }
 
 // RouteHandlerConfigOpt modifies the router handler config.
@@ -57,6 +58,13 @@ func WithDefaultAuth(infoprov common.WorkspaceInfoProvider) RouteHandlerConfigOp
 	}
 }
 
+// WithWorkspaceResumer resumes paused workspaces before requests are proxied to them.
+func WithWorkspaceResumer(resumer common.WorkspaceResumer) RouteHandlerConfigOpt {
+	return func(config *Config, c *RouteHandlerConfig) {
+		c.WorkspaceResumer = resumer
+	}
+}
+
 // NewRouteHandlerConfig creates a new instance.
 func NewRouteHandlerConfig(config *Config, opts ...RouteHandlerConfigOpt) (*RouteHandlerConfig, error) {
 	cfg := &RouteHandlerConfig{
@@ -982,6 +982,83 @@ func TestNoSSHGatewayRouter(t *testing.T) {
 
 }
 
+type fakeWorkspaceResumer struct {
+	resumed []string
+	err     error
+}
+
+func (r *fakeWorkspaceResumer) Resume(ctx context.Context, instanceID string) error {
+	r.resumed = append(r.resumed, instanceID)
+	return r.err
+}
+
+func TestPausedWorkspaceIsResumed(t *testing.T) {
+	tests := []struct {
+		Name          string
+		Paused        bool
+		ResumeErr     error
+		ExpectResumed bool
+		ExpectStatus  int
+	}{
+		{Name: "running workspace", ExpectStatus: http.StatusOK},
+		{Name: "paused workspace", Paused: true, ExpectResumed: true, ExpectStatus: http.StatusOK},
+		{Name: "resume fails", Paused: true, ResumeErr: fmt.Errorf("ws-manager unavailable"), ExpectResumed: true, ExpectStatus: http.StatusServiceUnavailable},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			infos := []common.WorkspaceInfo{workspaces[0]}
+			infos[0].IsRunning = true
+			infos[0].IsPaused = test.Paused
+
+			resumer := &fakeWorkspaceResumer{err: test.ResumeErr}
+			var resumedBeforeRequest bool
+			target := startTestTarget(t, portServeHost, "port", true)
+			defer target.Close()
+			target.Target.Handler = func(w http.ResponseWriter, r *http.Request, requestCount uint8) {
+				resumedBeforeRequest = len(resumer.resumed) > 0
+				w.WriteHeader(http.StatusOK)
+			}
+
+			router := HostBasedRouter(hostBasedHeader, wsHostSuffix, wsHostNameRegex)
+			ingress := HostBasedIngressConfig{
+				HTTPAddress:  "8080",
+				HTTPSAddress: "9090",
+				Header:       "",
+			}
+			proxy := NewWorkspaceProxy(ingress, config, router, &fakeWsInfoProvider{infos: infos}, nil)
+			proxy.WorkspaceResumer = resumer
+			handler, err := proxy.Handler()
+			if err != nil {
+				t.Fatalf("cannot create proxy handler: %q", err)
+			}
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, modifyRequest(httptest.NewRequest("GET", infos[0].Ports[0].Url, nil),
+				addHostHeader,
+			))
+			resp := rec.Result()
+			resp.Body.Close()
+
+			if resp.StatusCode != test.ExpectStatus {
+				t.Errorf("unexpected status code: want %d, got %d", test.ExpectStatus, resp.StatusCode)
+			}
+			var expectResumed []string
+			if test.ExpectResumed {
+				expectResumed = []string{infos[0].InstanceID}
+			}
+			if diff := cmp.Diff(expectResumed, resumer.resumed); diff != "" {
+				t.Errorf("unexpected resumed instances (-want +got):\n%s", diff)
+			}
+			if test.ExpectResumed && test.ResumeErr == nil && !resumedBeforeRequest {
+				t.Errorf("workspace was not resumed before the request was proxied")
+			}
+			if test.ResumeErr != nil && target.RequestCount != 0 {
+				t.Errorf("request was proxied although the workspace could not be resumed")
+			}
+		})
+	}
+}
+
 func TestRemoveSensitiveCookies(t *testing.T) {
 	var (
 		domain                  = "test-domain.com"
@@ -39,3 +39,11 @@ func (m *WorkspaceManagerHeartbeat) SendHeartbeat(instanceID string, isClosed, i
 		log.WithField("instanceId", instanceID).Debug("sent heartbeat to ws-manager")
 	}
 }
+
+// Resume resumes a paused workspace instance. ws-manager resumes a paused workspace when it is marked active.
+func (m *WorkspaceManagerHeartbeat) Resume(ctx context.Context, instanceID string) error {
+	_, err := m.Client.MarkActive(ctx, &wsmanapi.MarkActiveRequest{
+		Id: instanceID,
+	})
+	return err
+}
@@ -33,6 +33,13 @@ import (
 )
 
 // This is copy from proxy/workspacerouter.go
+const (
+	// how long we wait for a paused workspace to resume
+	resumeTimeout = 30 * time.Second
+	// how often we check whether a paused workspace resumed
+	resumePollInterval = 500 * time.Millisecond
+)
+
 const workspaceIDRegex = "([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}|[0-9a-z]{2,16}-[0-9a-z]{2,16}-[0-9a-z]{8,11})"
 
 var (
@@ -60,6 +67,7 @@ var (
 var (
 	ErrWorkspaceNotFound   = NewSSHErrorWithReject("WS_NOTFOUND", "not found workspace")
 	ErrWorkspaceNotRunning = NewSSHErrorWithReject("WS_NOT_RUNNING", "workspace not running")
+	ErrWorkspaceResume     = NewSSHError("WS_RESUME_FAILED", "cannot resume paused workspace")
 	ErrWorkspaceIDInvalid  = NewSSHErrorWithReject("WS_ID_INVALID", "workspace id invalid")
 	ErrUsernameFormat      = NewSSHErrorWithReject("USER_FORMAT", "username format is not correct")
 	ErrMissPrivateKey      = NewSSHErrorWithReject("MISS_KEY", "missing privateKey")
@@ -109,6 +117,8 @@ type Session struct {
 
 type Server struct {
 	Heartbeater Heartbeat
+	// Resumer resumes paused workspaces before we connect to them, if set
+	Resumer common.WorkspaceResumer
 
 	HostKeys              []ssh.Signer
 	sshConfig             *ssh.ServerConfig
@@ -137,6 +147,9 @@ func New(signers []ssh.Signer, workspaceInfoProvider common.WorkspaceInfoProvide
 	if heartbeat != nil {
 		server.Heartbeater = heartbeat
 	}
+	if resumer, ok := heartbeat.(common.WorkspaceResumer); ok {
+		server.Resumer = resumer
+	}
 
 	authWithWebsocketTunnel := func(conn ssh.ConnMetadata) (*ssh.Permissions, error) {
 		wsConn, ok := conn.RawConn().(*gitpod.WebsocketConnection)
@@ -306,6 +319,15 @@ func (s *Server) HandleConn(c net.Conn) {
 		return
 	}
 	log := log.WithField("instanceId", wsInfo.InstanceID).WithField("isMk2", wsInfo.IsManagedByMk2)
+	if wsInfo.IsPaused {
+		wsInfo, err = s.resumeWorkspace(context.Background(), wsInfo)
+		if err != nil {
+			s.TrackSSHConnection(wsInfo, "resume", ErrWorkspaceResume)
+			ReportSSHAttemptMetrics(ErrWorkspaceResume)
+			log.WithError(err).Error("failed to resume paused workspace")
+			return
+		}
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
 	supervisorPort := "22999"
 	if debugWorkspace {
@@ -458,6 +480,39 @@ func (s *Server) GetWorkspaceInfo(workspaceId string) (*common.WorkspaceInfo, er
 	return wsInfo, nil
 }
 
+// resumeWorkspace resumes a paused workspace and waits until it runs again. Unlike HTTP requests, which simply wait
+// for the workspace to answer, we need its supervisor to obtain the SSH key within a few seconds.
+func (s *Server) resumeWorkspace(ctx context.Context, wsInfo *common.WorkspaceInfo) (*common.WorkspaceInfo, error) {
+	if s.Resumer == nil {
+		return wsInfo, xerrors.Errorf("cannot resume workspace without ws-manager")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, resumeTimeout)
+	defer cancel()
+	err := s.Resumer.Resume(ctx, wsInfo.InstanceID)
+	if err != nil {
+		return wsInfo, err
+	}
+
+	ticker := time.NewTicker(resumePollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return wsInfo, xerrors.Errorf("workspace did not resume: %w", ctx.Err())
+		case <-ticker.C:
+		}
+
+		info := s.workspaceInfoProvider.WorkspaceInfo(wsInfo.WorkspaceID)
+		if info == nil || !info.IsRunning {
+			return wsInfo, ErrWorkspaceNotRunning
+		}
+		if !info.IsPaused {
+			return info, nil
+		}
+	}
+}
+
 func (s *Server) TrackSSHConnection(wsInfo *common.WorkspaceInfo, phase string, err error) {
 	// if we didn't find an associated user, we don't want to track
 	if wsInfo == nil {
@@ -0,0 +1,73 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sshproxy
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/gitpod-io/gitpod/ws-proxy/pkg/common"
+)
+
+// fakeWorkspace is both the info provider and the resumer of a single workspace
+type fakeWorkspace struct {
+	mu   sync.Mutex
+	info common.WorkspaceInfo
+}
+
+func (f *fakeWorkspace) WorkspaceInfo(workspaceID string) *common.WorkspaceInfo {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if workspaceID != f.info.WorkspaceID {
+		return nil
+	}
+	info := f.info
+	return &info
+}
+
+func (f *fakeWorkspace) AcquireContext(ctx context.Context, workspaceID, port string) (context.Context, string, error) {
+	return ctx, "", nil
+}
+
+func (f *fakeWorkspace) ReleaseContext(id string) {}
+
+func (f *fakeWorkspace) Resume(ctx context.Context, instanceID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if instanceID == f.info.InstanceID {
+		f.info.IsPaused = false
+	}
+	return nil
+}
+
+func TestResumeWorkspace(t *testing.T) {
+	ws := &fakeWorkspace{info: common.WorkspaceInfo{
+		WorkspaceID: "amaranth-smelt-9ba20cc1",
+		InstanceID:  "1943c611-a014-4f4d-bf5d-14ccf0123c60",
+		IPAddress:   "10.0.0.1",
+		IsRunning:   true,
+		IsPaused:    true,
+	}}
+
+	t.Run("without resumer", func(t *testing.T) {
+		s := &Server{workspaceInfoProvider: ws}
+		_, err := s.resumeWorkspace(context.Background(), ws.WorkspaceInfo(ws.info.WorkspaceID))
+		if err == nil {
+			t.Fatal("expected an error when the workspace cannot be resumed")
+		}
+	})
+
+	t.Run("with resumer", func(t *testing.T) {
+		s := &Server{workspaceInfoProvider: ws, Resumer: ws}
+		info, err := s.resumeWorkspace(context.Background(), ws.WorkspaceInfo(ws.info.WorkspaceID))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if info.IsPaused {
+			t.Error("expected the resumed workspace info")
+		}
+	})
+}
@@ -6,7 +6,11 @@ package cmd
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -64,9 +68,32 @@ Spec:
 		if err != nil {
 			log.Fatal(err)
 		}
+
+		if timeline, _ := cmd.Flags().GetBool("timeline"); timeline {
+			printTimeline(status.Timeline)
+		}
 	},
 }
 
+// printTimeline prints the timeline of a workspace with the time since its first and since the previous event
+func printTimeline(timeline []*api.WorkspaceTimelineEvent) {
+	fmt.Println("Timeline:")
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	defer w.Flush()
+
+	fmt.Fprintln(w, "  TIME\tOFFSET\tDELTA\tTYPE\tNAME\tSTATUS")
+	var first, prev time.Time
+	for i, evt := range timeline {
+		t := evt.Time.AsTime()
+		if i == 0 {
+			first, prev = t, t
+		}
+		fmt.Fprintf(w, "  %s\t+%s\t+%s\t%s\t%s\t%s\n", t.Format(time.RFC3339Nano), t.Sub(first).Round(time.Millisecond), t.Sub(prev).Round(time.Millisecond), evt.Type, evt.Name, evt.Status)
+		prev = t
+	}
+}
+
 func init() {
 	workspacesCmd.AddCommand(workspacesDescribeCmd)
+	workspacesDescribeCmd.Flags().Bool("timeline", false, "print the timeline of the workspace's startup")
 }
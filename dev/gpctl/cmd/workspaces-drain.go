@@ -0,0 +1,97 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+)
+
+// workspacesDrainCmd represents the drain command
+var workspacesDrainCmd = &cobra.Command{
+	Use:   "drain <nodeName>",
+	Short: "migrates all running regular workspaces off a node",
+	Long: `Migrates all running regular workspaces off a node, e.g. before maintenance. The node is cordoned first,
+so that neither new workspaces nor the replacements of the migrated ones are scheduled there.
+Headless workspaces are not migrated - wait for them to finish before taking the node down.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		nodeName := args[0]
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		if cordon, _ := cmd.Flags().GetBool("cordon"); cordon && !dryRun {
+			cfg, _, err := getKubeconfig()
+			if err != nil {
+				log.WithError(err).Fatal("cannot get kubeconfig")
+			}
+			clientSet, err := kubernetes.NewForConfig(cfg)
+			if err != nil {
+				log.WithError(err).Fatal("cannot connect to Kubernetes")
+			}
+			_, err = clientSet.CoreV1().Nodes().Patch(ctx, nodeName, types.StrategicMergePatchType, []byte(`{"spec":{"unschedulable":true}}`), metav1.PatchOptions{})
+			if err != nil {
+				log.WithError(err).Fatal("cannot cordon node")
+			}
+			log.WithField("node", nodeName).Info("node cordoned")
+		}
+
+		conn, client, err := getWorkspacesClient(ctx)
+		if err != nil {
+			log.WithError(err).Fatal("cannot connect")
+		}
+		defer conn.Close()
+
+		resp, err := client.GetWorkspaces(ctx, &api.GetWorkspacesRequest{})
+		if err != nil {
+			log.WithError(err).Fatal("error during RPC call")
+		}
+
+		var failed int
+		for _, ws := range resp.GetStatus() {
+			if ws.GetRuntime().GetNodeName() != nodeName {
+				continue
+			}
+			if ws.GetSpec().GetType() != api.WorkspaceType_REGULAR {
+				log.WithField("instanceId", ws.Id).WithField("type", ws.GetSpec().GetType().String()).Info("skipping workspace which is not a regular one")
+				continue
+			}
+			if ws.GetPhase() != api.WorkspacePhase_RUNNING {
+				log.WithField("instanceId", ws.Id).WithField("phase", ws.GetPhase().String()).Info("skipping workspace which is not running")
+				continue
+			}
+
+			if dryRun {
+				fmt.Printf("would migrate %s\n", ws.Id)
+				continue
+			}
+			_, err := client.MigrateWorkspace(ctx, &api.MigrateWorkspaceRequest{Id: ws.Id})
+			if err != nil {
+				log.WithError(err).WithField("instanceId", ws.Id).Error("cannot migrate workspace")
+				failed++
+				continue
+			}
+			fmt.Printf("migrating %s\n", ws.Id)
+		}
+		if failed > 0 {
+			log.Fatalf("%d workspaces could not be migrated", failed)
+		}
+	},
+}
+
+func init() {
+	workspacesCmd.AddCommand(workspacesDrainCmd)
+	workspacesDrainCmd.Flags().Bool("cordon", true, "cordon the node before migrating its workspaces")
+	workspacesDrainCmd.Flags().Bool("dry-run", false, "only list the workspaces which would be migrated")
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+)
+
+// workspacesMigrateCmd represents the migrate command
+var workspacesMigrateCmd = &cobra.Command{
+	Use:   "migrate <workspaceID>",
+	Short: "moves a running workspace to another node, keeping its ID and URL",
+	Long: `Moves a running workspace to another node, keeping its ID and URL.
+The workspace secrets are not part of the backup: workspaces with secret environment variables
+can only be migrated by callers that pass those variables along with the request.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		conn, client, err := getWorkspacesClient(ctx)
+		if err != nil {
+			log.WithError(err).Fatal("cannot connect")
+		}
+		defer conn.Close()
+
+		instanceID := args[0]
+		if strings.ContainsAny(instanceID, ".") || strings.HasPrefix(instanceID, "http://") || strings.HasPrefix(instanceID, "https://") {
+			s, err := getStatusByURL(ctx, client, instanceID)
+			if err != nil {
+				log.Fatal(err)
+			}
+			instanceID = s.Id
+		}
+
+		resp, err := client.MigrateWorkspace(ctx, &api.MigrateWorkspaceRequest{
+			Id: instanceID,
+		})
+		if err != nil {
+			log.WithError(err).Fatal("error during RPC call")
+		}
+
+		err = getOutputFormat("migrating\n", "").Print(resp)
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+func init() {
+	workspacesCmd.AddCommand(workspacesMigrateCmd)
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+)
+
+var renderWorkspaceReq = api.StartWorkspaceRequest{
+	Metadata: &api.WorkspaceMetadata{},
+}
+
+// workspacesRenderCmd renders the pod of a workspace without starting it
+var workspacesRenderCmd = &cobra.Command{
+	Use:   "render <id> <configfile.json>",
+	Short: "prints the pod ws-manager would create for a workspace, without starting it",
+	Long: `Prints the pod ws-manager would create for a workspace, without starting it.
+
+Use --compare-class or --compare-config to print a unified diff against the pod
+rendered with another workspace class or ws-manager configuration, e.g. to review
+configuration changes in CI.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		fc, err := os.ReadFile(args[1])
+		if err != nil {
+			log.WithError(err).Fatal("cannot read workspace spec")
+		}
+		var workspace api.StartWorkspaceSpec
+		if err := json.Unmarshal(fc, &workspace); err != nil {
+			log.WithError(err).Fatal("cannot parse workspace spec")
+		}
+
+		renderWorkspaceReq.Id = args[0]
+		if renderWorkspaceReq.ServicePrefix == "" {
+			renderWorkspaceReq.ServicePrefix = renderWorkspaceReq.Id
+		}
+		renderWorkspaceReq.Spec = &workspace
+
+		tpe, _ := cmd.Flags().GetString("type")
+		tpeidx, ok := api.WorkspaceType_value[strings.ToUpper(tpe)]
+		if !ok {
+			log.Fatalf("unknown workspace type: %s", tpe)
+		}
+		renderWorkspaceReq.Type = api.WorkspaceType(tpeidx)
+
+		req := &api.RenderWorkspacePodRequest{
+			Request: &renderWorkspaceReq,
+		}
+		req.CompareClass, _ = cmd.Flags().GetString("compare-class")
+		if fn, _ := cmd.Flags().GetString("compare-config"); fn != "" {
+			req.CompareConfig, err = readManagerConfig(fn)
+			if err != nil {
+				log.WithError(err).Fatal("cannot read compare config")
+			}
+		}
+
+		conn, client, err := getWorkspacesClient(ctx)
+		if err != nil {
+			log.WithError(err).Fatal("cannot connect")
+		}
+		defer conn.Close()
+
+		resp, err := client.RenderWorkspacePod(ctx, req)
+		if err != nil {
+			log.WithError(err).Fatal("error during RPC call")
+		}
+
+		if diffOnly, _ := cmd.Flags().GetBool("diff-only"); !diffOnly {
+			fmt.Print(resp.Pod)
+		}
+		if resp.Diff == "" {
+			return
+		}
+		fmt.Print(resp.Diff)
+		if failOnDiff, _ := cmd.Flags().GetBool("fail-on-diff"); failOnDiff {
+			os.Exit(1)
+		}
+	},
+}
+
+// readManagerConfig reads a ws-manager configuration file and returns its manager section.
+// Files which contain the manager section only are returned as they are.
+func readManagerConfig(fn string) (string, error) {
+	fc, err := os.ReadFile(fn)
+	if err != nil {
+		return "", err
+	}
+	var cfg struct {
+		Manager json.RawMessage `json:"manager"`
+	}
+	err = json.Unmarshal(fc, &cfg)
+	if err != nil {
+		return "", fmt.Errorf("cannot parse %s: %w", fn, err)
+	}
+	if len(cfg.Manager) > 0 {
+		return string(cfg.Manager), nil
+	}
+	return string(fc), nil
+}
+
+func init() {
+	workspacesCmd.AddCommand(workspacesRenderCmd)
+	workspacesRenderCmd.Flags().StringVar(&renderWorkspaceReq.ServicePrefix, "service-prefix", "", "use a service prefix different from the workspace ID")
+	workspacesRenderCmd.Flags().StringVar(&renderWorkspaceReq.Metadata.Owner, "owner", "gpctl", "set the workspace owner")
+	workspacesRenderCmd.Flags().StringVar(&renderWorkspaceReq.Metadata.MetaId, "workspace-id", "gpctl-render", "set the workspace ID")
+	workspacesRenderCmd.Flags().String("compare-class", "", "diff against the pod rendered with this workspace class")
+	workspacesRenderCmd.Flags().String("compare-config", "", "diff against the pod rendered with this ws-manager configuration file")
+	workspacesRenderCmd.Flags().Bool("diff-only", false, "print the diff only, not the pod")
+	workspacesRenderCmd.Flags().Bool("fail-on-diff", false, "exit with a non-zero status if the pods differ")
+
+	var types []string
+	for k := range api.WorkspaceType_value {
+		types = append(types, strings.ToLower(k))
+	}
+	workspacesRenderCmd.Flags().String("type", "regular", fmt.Sprintf("type of the workspace - valid values are: %s", strings.Join(types, ", ")))
+}
@@ -0,0 +1,235 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+	"github.com/gitpod-io/gitpod/gpctl/pkg/util"
+)
+
+var workspacesVerifyBackupOpts struct {
+	Owner   string
+	Host    string
+	Scratch string
+	Keep    bool
+}
+
+// workspacesVerifyBackupCmd represents the verify-backup command
+var workspacesVerifyBackupCmd = &cobra.Command{
+	Use:   "verify-backup <workspaceID>",
+	Short: "downloads and extracts the backup of a workspace into a scratch directory and checks it against its manifest",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		if workspacesVerifyBackupOpts.Owner == "" {
+			return xerrors.Errorf("missing --owner")
+		}
+
+		conn, client, err := getContentServiceWorkspaceClient(ctx, workspacesVerifyBackupOpts.Host)
+		if err != nil {
+			return xerrors.Errorf("cannot connect to content-service: %w", err)
+		}
+		defer conn.Close()
+
+		workspaceID := args[0]
+		resp, err := client.WorkspaceDownloadURL(ctx, &csapi.WorkspaceDownloadURLRequest{
+			OwnerId:     workspacesVerifyBackupOpts.Owner,
+			WorkspaceId: workspaceID,
+		})
+		if err != nil {
+			return xerrors.Errorf("cannot get backup download URL: %w", err)
+		}
+
+		scratch, err := os.MkdirTemp(workspacesVerifyBackupOpts.Scratch, fmt.Sprintf("verify-backup-%s-*", workspaceID))
+		if err != nil {
+			return err
+		}
+		if workspacesVerifyBackupOpts.Keep {
+			log.WithField("location", scratch).Info("keeping scratch directory")
+		} else {
+			defer os.RemoveAll(scratch)
+		}
+
+		var expected *archive.Manifest
+		if resp.ManifestUrl == "" {
+			log.Warn("backup has no manifest - can only check that it extracts")
+		} else {
+			expected, err = downloadBackupManifest(ctx, resp.ManifestUrl)
+			if err != nil {
+				return err
+			}
+		}
+
+		tarball := filepath.Join(scratch, "backup.tar")
+		err = downloadFile(ctx, resp.Url, tarball)
+		if err != nil {
+			return xerrors.Errorf("cannot download backup: %w", err)
+		}
+
+		f, err := os.Open(tarball)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		downloaded, err := archive.ComputeManifest(f)
+		if err != nil {
+			return xerrors.Errorf("backup is not a valid archive: %w", err)
+		}
+		_, err = f.Seek(0, 0)
+		if err != nil {
+			return err
+		}
+
+		content := filepath.Join(scratch, "content")
+		err = os.MkdirAll(content, 0755)
+		if err != nil {
+			return err
+		}
+		err = archive.ExtractTarbal(ctx, f, content)
+		if err != nil {
+			return xerrors.Errorf("cannot extract backup: %w", err)
+		}
+		extracted, err := archive.ComputeDirManifest(content)
+		if err != nil {
+			return err
+		}
+
+		log.WithField("archive", downloaded).WithField("extracted", extracted).Debug("computed manifests")
+
+		var mismatches []string
+		if expected != nil {
+			for _, m := range expected.Compare(downloaded) {
+				mismatches = append(mismatches, "downloaded archive: "+m)
+			}
+			for _, m := range expected.Compare(extracted) {
+				mismatches = append(mismatches, "extracted content: "+m)
+			}
+		} else {
+			for _, m := range downloaded.Compare(extracted) {
+				mismatches = append(mismatches, "extracted content: "+m)
+			}
+		}
+		if len(mismatches) == 0 {
+			fmt.Printf("backup of %s is intact: %d files, %d bytes\n", workspaceID, extracted.FileCount, extracted.TotalSize)
+			return nil
+		}
+
+		for _, m := range mismatches {
+			fmt.Println(m)
+		}
+		return xerrors.Errorf("backup of %s does not match its manifest", workspaceID)
+	},
+}
+
+func getContentServiceWorkspaceClient(ctx context.Context, host string) (*grpc.ClientConn, csapi.WorkspaceServiceClient, error) {
+	if host == "" {
+		cfg, namespace, err := getKubeconfig()
+		if err != nil {
+			return nil, nil, err
+		}
+		clientSet, err := kubernetes.NewForConfig(cfg)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		freePort, err := GetFreePort()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		port := fmt.Sprintf("%d:8080", freePort)
+		podName, err := util.FindAnyPodForComponent(clientSet, namespace, "content-service")
+		if err != nil {
+			return nil, nil, err
+		}
+		readychan, errchan := util.ForwardPort(ctx, cfg, namespace, podName, port)
+		select {
+		case <-readychan:
+		case err := <-errchan:
+			return nil, nil, err
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+		host = fmt.Sprintf("localhost:%d", freePort)
+	}
+
+	conn, err := grpc.Dial(host, grpc.WithTransportCredentials(insecure.NewCredentials()), util.WithClientUnaryInterceptor())
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, csapi.NewWorkspaceServiceClient(conn), nil
+}
+
+func downloadBackupManifest(ctx context.Context, url string) (*archive.Manifest, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot download backup manifest: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, xerrors.Errorf("cannot download backup manifest: %s", resp.Status)
+	}
+
+	var res archive.Manifest
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot parse backup manifest: %w", err)
+	}
+	return &res, nil
+}
+
+func downloadFile(ctx context.Context, url, dst string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return xerrors.Errorf("unexpected status: %s", resp.Status)
+	}
+
+	f, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, resp.Body)
+	return err
+}
+
+func init() {
+	workspacesVerifyBackupCmd.Flags().StringVar(&workspacesVerifyBackupOpts.Owner, "owner", "", "ID of the user owning the workspace")
+	workspacesVerifyBackupCmd.Flags().StringVar(&workspacesVerifyBackupOpts.Host, "content-service-host", "", "talk to a content-service host directly rather than port-forwarding to a pod")
+	workspacesVerifyBackupCmd.Flags().StringVar(&workspacesVerifyBackupOpts.Scratch, "scratch", "", "directory in which the backup is downloaded and extracted (defaults to the system's temp dir)")
+	workspacesVerifyBackupCmd.Flags().BoolVar(&workspacesVerifyBackupOpts.Keep, "keep", false, "do not remove the scratch directory after verification")
+	workspacesCmd.AddCommand(workspacesVerifyBackupCmd)
+}
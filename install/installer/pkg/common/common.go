@@ -736,6 +736,10 @@ var (
 		APIVersion: "apps/v1",
 		Kind:       "Deployment",
 	}
+	TypeMetaValidatingWebhookConfiguration = metav1.TypeMeta{
+		APIVersion: "admissionregistration.k8s.io/v1",
+		Kind:       "ValidatingWebhookConfiguration",
+	}
 	TypeMetaCertificate = metav1.TypeMeta{
 		APIVersion: "cert-manager.io/v1",
 		Kind:       "Certificate",
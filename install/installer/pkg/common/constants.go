@@ -41,6 +41,7 @@ const (
 	RegistryFacadeServicePort   = 31750
 	RegistryFacadeTLSCertSecret = "builtin-registry-facade-cert"
 	ServerComponent             = "server"
+	ServerServicePort           = 3000
 	ServerIAMSessionPort        = 9876
 	ServerInstallationAdminPort = 9000
 	ServerGRPCAPIPort           = 9877
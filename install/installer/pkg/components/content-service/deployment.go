@@ -73,6 +73,8 @@ func deployment(ctx *common.RenderContext) ([]runtime.Object, error) {
 			Env: common.CustomizeEnvvar(ctx, Component, common.MergeEnv(
 				common.DefaultEnv(&ctx.Config),
 				common.WorkspaceTracingEnv(ctx, Component),
+				// the retention reconciler looks up snapshot references in the database
+				common.DatabaseEnv(&ctx.Config),
 				[]corev1.EnvVar{{
 					Name:  "GRPC_GO_RETRY",
 					Value: "on",
@@ -8,6 +8,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/gitpod-io/gitpod/installer/pkg/common"
 	corev1 "k8s.io/api/core/v1"
@@ -61,3 +62,27 @@ func secret(ctx *common.RenderContext) ([]runtime.Object, error) {
 		},
 	}}, nil
 }
+
+// PullSecretName returns the name of the secret holding the credentials of the container registry workspace
+// images are built into and pulled from
+func PullSecretName(ctx *common.RenderContext) (string, error) {
+	if pointer.BoolDeref(ctx.Config.ContainerRegistry.InCluster, false) {
+		return BuiltInRegistryAuth, nil
+	}
+	if ctx.Config.ContainerRegistry.External != nil {
+		return ctx.Config.ContainerRegistry.External.Certificate.Name, nil
+	}
+	return "", fmt.Errorf("invalid container registry config")
+}
+
+// WorkspaceImageRegistry returns the container registry workspace images are built into and pulled from,
+// i.e. its host optionally followed by a path
+func WorkspaceImageRegistry(ctx *common.RenderContext) (string, error) {
+	if pointer.BoolDeref(ctx.Config.ContainerRegistry.InCluster, false) {
+		return fmt.Sprintf("%s.%s", RegistryName, ctx.Config.Domain), nil
+	}
+	if ctx.Config.ContainerRegistry.External != nil {
+		return strings.TrimSuffix(ctx.Config.ContainerRegistry.External.URL, "/"), nil
+	}
+	return "", fmt.Errorf("invalid container registry config")
+}
@@ -6,7 +6,6 @@ package image_builder_mk3
 
 import (
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/gitpod-io/gitpod/common-go/baseserver"
@@ -23,20 +22,15 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/utils/pointer"
 )
 
 func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
-	var registryName string
-	if pointer.BoolDeref(ctx.Config.ContainerRegistry.InCluster, false) {
-		registryName = fmt.Sprintf("%s.%s", dockerregistry.RegistryName, ctx.Config.Domain)
-	} else if ctx.Config.ContainerRegistry.External != nil {
-		registryName = strings.TrimSuffix(ctx.Config.ContainerRegistry.External.URL, "/")
-	} else {
-		return nil, fmt.Errorf("%s: invalid container registry config", Component)
+	registryName, err := dockerregistry.WorkspaceImageRegistry(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", Component, err)
 	}
 
-	secretName, err := pullSecretName(ctx)
+	secretName, err := dockerregistry.PullSecretName(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -22,18 +22,6 @@ import (
 	"k8s.io/utils/pointer"
 )
 
-func pullSecretName(ctx *common.RenderContext) (string, error) {
-	var secretName string
-	if pointer.BoolDeref(ctx.Config.ContainerRegistry.InCluster, false) {
-		secretName = dockerregistry.BuiltInRegistryAuth
-	} else if ctx.Config.ContainerRegistry.External != nil {
-		secretName = ctx.Config.ContainerRegistry.External.Certificate.Name
-	} else {
-		return "", fmt.Errorf("%s: invalid container registry config", Component)
-	}
-	return secretName, nil
-}
-
 func deployment(ctx *common.RenderContext) ([]runtime.Object, error) {
 	labels := common.CustomizeLabel(ctx, Component, common.TypeMetaDeployment)
 
@@ -44,7 +32,7 @@ func deployment(ctx *common.RenderContext) ([]runtime.Object, error) {
 		hashObj = append(hashObj, objs...)
 	}
 
-	secretName, err := pullSecretName(ctx)
+	secretName, err := dockerregistry.PullSecretName(ctx)
 	if err != nil {
 		return nil, err
 	}
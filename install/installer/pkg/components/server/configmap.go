@@ -16,6 +16,7 @@ import (
 	"github.com/gitpod-io/gitpod/installer/pkg/components/redis"
 	"github.com/gitpod-io/gitpod/installer/pkg/components/usage"
 	"github.com/gitpod-io/gitpod/installer/pkg/components/workspace"
+	wsmanagermk2 "github.com/gitpod-io/gitpod/installer/pkg/components/ws-manager-mk2"
 	"github.com/gitpod-io/gitpod/installer/pkg/config/v1/experimental"
 	"github.com/gitpod-io/gitpod/ws-manager/api/config"
 	corev1 "k8s.io/api/core/v1"
@@ -172,6 +173,12 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		return nil
 	})
 
+	var wsManagerWebhookSecretPath string
+	_ = ctx.WithExperimental(func(cfg *experimental.Config) error {
+		_, _, wsManagerWebhookSecretPath, _ = getWSManagerWebhookSecret(cfg)
+		return nil
+	})
+
 	var isDedicatedInstallation bool
 	_ = ctx.WithExperimental(func(cfg *experimental.Config) error {
 		if cfg.WebApp != nil && cfg.WebApp.Server != nil {
@@ -282,9 +289,10 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 				Period: 600,
 			},
 		},
-		WorkspaceClasses:               workspaceClasses,
-		InactivityPeriodForReposInDays: inactivityPeriodForReposInDays,
-		PATSigningKeyFile:              personalAccessTokenSigningKeyPath,
+		WorkspaceClasses:                  workspaceClasses,
+		InactivityPeriodForReposInDays:    inactivityPeriodForReposInDays,
+		PATSigningKeyFile:                 personalAccessTokenSigningKeyPath,
+		WorkspaceManagerWebhookSecretFile: wsManagerWebhookSecretPath,
 		Admin: AdminConfig{
 			CredentialsPath: adminCredentialsPath,
 		},
@@ -346,6 +354,37 @@ func getPersonalAccessTokenSigningKey(cfg *experimental.Config) (corev1.Volume,
 	return volume, mount, path, true
 }
 
+func getWSManagerWebhookSecret(cfg *experimental.Config) (corev1.Volume, corev1.VolumeMount, string, bool) {
+	var volume corev1.Volume
+	var mount corev1.VolumeMount
+	var path string
+
+	if cfg == nil || cfg.Workspace == nil || cfg.Workspace.WSManagerWebhookSecretName == "" {
+		return volume, mount, path, false
+	}
+
+	path = wsManagerWebhookSecretMountPath
+
+	volume = corev1.Volume{
+		Name: "ws-manager-webhook-secret",
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: cfg.Workspace.WSManagerWebhookSecretName,
+				Optional:   pointer.Bool(true),
+			},
+		},
+	}
+
+	mount = corev1.VolumeMount{
+		Name:      "ws-manager-webhook-secret",
+		MountPath: wsManagerWebhookSecretMountPath,
+		SubPath:   wsmanagermk2.EventWebhookSecretKey,
+		ReadOnly:  true,
+	}
+
+	return volume, mount, path, true
+}
+
 func getAdminCredentials() (corev1.Volume, corev1.VolumeMount, string) {
 	volume := corev1.Volume{
 		Name: "admin-credentials",
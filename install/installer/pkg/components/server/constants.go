@@ -23,8 +23,9 @@ const (
 	InstallationAdminName                  = "install-admin"
 	DebugPortName                          = "debug"
 	DebugNodePortName                      = "debugnode"
-	ServicePort                            = 3000
+	ServicePort                            = common.ServerServicePort
 	personalAccessTokenSigningKeyMountPath = "/secrets/personal-access-token-signing-key"
+	wsManagerWebhookSecretMountPath        = "/secrets/ws-manager-webhook-secret"
 	ProbesPort                             = 9400
 	ProbesPortName                         = "probes"
 
@@ -68,6 +68,24 @@ func Networkpolicy(ctx *common.RenderContext, component string) ([]runtime.Objec
 							},
 						},
 					},
+					{
+						// ws-manager-mk2 delivers webhook events, e.g. to prewarm scheduled workspaces
+						Ports: []networkingv1.NetworkPolicyPort{
+							{
+								Protocol: common.TCPProtocol,
+								Port:     &intstr.IntOrString{IntVal: ContainerPort},
+							},
+						},
+						From: []networkingv1.NetworkPolicyPeer{
+							{
+								PodSelector: &metav1.LabelSelector{
+									MatchLabels: map[string]string{
+										"component": common.WSManagerMk2Component,
+									},
+								},
+							},
+						},
+					},
 					{
 						Ports: []networkingv1.NetworkPolicyPort{
 							{
@@ -39,6 +39,7 @@ type ConfigSerialized struct {
 	EnablePayment                     bool        `json:"enablePayment"`
 	LinkedInSecretsFile               string      `json:"linkedInSecretsFile"`
 	PATSigningKeyFile                 string      `json:"patSigningKeyFile"`
+	WorkspaceManagerWebhookSecretFile string      `json:"workspaceManagerWebhookSecretFile,omitempty"`
 	Auth                              auth.Config `json:"auth"`
 	IsDedicatedInstallation           bool        `json:"isDedicatedInstallation"`
 
@@ -13,6 +13,7 @@ const (
 	SupervisorImage              = "supervisor"
 	WorkspacekitImage            = "workspacekit"
 	SupervisorPort               = 22999
+	SupervisorNotificationPort   = 22998
 	SupervisorDebugPort          = 24999
 	IDEDebugPort                 = 25000
 	DebugWorkspaceProxyPort      = 25003
@@ -65,6 +65,20 @@ func networkpolicy(ctx *common.RenderContext) ([]runtime.Object, error) {
 						},
 					},
 				},
+				{
+					// ws-manager-mk2 shows notifications, e.g. about scheduled stops, through the dedicated notification endpoint of supervisor
+					Ports: []networkingv1.NetworkPolicyPort{
+						{
+							Protocol: common.TCPProtocol,
+							Port:     &intstr.IntOrString{IntVal: SupervisorNotificationPort},
+						},
+					},
+					From: []networkingv1.NetworkPolicyPeer{
+						{
+							PodSelector: &metav1.LabelSelector{MatchLabels: common.DefaultLabels(common.WSManagerMk2Component)},
+						},
+					},
+				},
 				{
 					Ports: []networkingv1.NetworkPolicyPort{
 						{
@@ -47,6 +47,8 @@ func clusterrole(ctx *common.RenderContext) ([]runtime.Object, error) {
 						"get",
 						"list",
 						"watch",
+						// ws-daemon flags workspaces under sustained memory pressure
+						"patch",
 					},
 				},
 				{
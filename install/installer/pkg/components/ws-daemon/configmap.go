@@ -6,11 +6,13 @@ package wsdaemon
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/gitpod-io/gitpod/common-go/baseserver"
 	"github.com/gitpod-io/gitpod/common-go/util"
 	"github.com/gitpod-io/gitpod/installer/pkg/common"
+	dockerregistry "github.com/gitpod-io/gitpod/installer/pkg/components/docker-registry"
 	config "github.com/gitpod-io/gitpod/installer/pkg/config/v1"
 	"github.com/gitpod-io/gitpod/installer/pkg/config/v1/experimental"
 	wsdapi "github.com/gitpod-io/gitpod/ws-daemon/api"
@@ -30,6 +32,12 @@ import (
 )
 
 func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
+	// the pull secret holds the push credentials of the workspace image registry, which initializers never get
+	workspaceImageRegistry, err := dockerregistry.WorkspaceImageRegistry(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var fsshift wsdapi.FSShiftMethod
 	switch ctx.Config.Workspace.Runtime.FSShiftMethod {
 	case config.FSShiftShiftFS:
@@ -143,6 +151,11 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 				},
 				Initializer: content.InitializerConfig{
 					Command: "/app/content-initializer",
+					RegistryAuth: content.RegistryAuthConfig{
+						File:               "/mnt/pull-secret/pull-secret.json",
+						AllowedRegistries:  ctx.Config.ContainerRegistry.PrivateBaseImageAllowList,
+						InternalRegistries: []string{strings.SplitN(workspaceImageRegistry, "/", 2)[0]},
+					},
 				},
 			},
 			Uidmapper: iws.UidmapperConfig{
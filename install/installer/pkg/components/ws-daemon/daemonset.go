@@ -9,6 +9,7 @@ import (
 
 	"github.com/gitpod-io/gitpod/installer/pkg/cluster"
 	"github.com/gitpod-io/gitpod/installer/pkg/common"
+	dockerregistry "github.com/gitpod-io/gitpod/installer/pkg/components/docker-registry"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -28,6 +29,11 @@ func daemonset(ctx *common.RenderContext) ([]runtime.Object, error) {
 		return nil, err
 	}
 
+	pullSecret, err := dockerregistry.PullSecretName(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	initContainers := []corev1.Container{
 		{
 			Name:  "seccomp-profile-installer",
@@ -88,6 +94,13 @@ func daemonset(ctx *common.RenderContext) ([]runtime.Object, error) {
 				LocalObjectReference: corev1.LocalObjectReference{Name: Component},
 			}},
 		},
+		{
+			Name: "pull-secret",
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+				SecretName: pullSecret,
+				Items:      []corev1.KeyToPath{{Key: ".dockerconfigjson", Path: "pull-secret.json"}},
+			}},
+		},
 		{
 			Name: "containerd-socket",
 			VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{
@@ -153,6 +166,11 @@ func daemonset(ctx *common.RenderContext) ([]runtime.Object, error) {
 			Name:      "config",
 			MountPath: "/config",
 		},
+		{
+			Name:      "pull-secret",
+			MountPath: "/mnt/pull-secret",
+			ReadOnly:  true,
+		},
 		{
 			Name:      "containerd-socket",
 			MountPath: "/mnt/containerd",
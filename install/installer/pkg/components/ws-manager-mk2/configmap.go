@@ -85,6 +85,7 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 	hostWorkingArea := wsdaemon.HostWorkingAreaMk2
 
 	rateLimits := map[string]grpc.RateLimit{}
+	var webhooks []*config.WebhookConfiguration
 
 	err = ctx.WithExperimental(func(ucfg *experimental.Config) error {
 		if ucfg.Workspace == nil {
@@ -152,6 +153,16 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		}
 		rateLimits = ucfg.Workspace.WSManagerRateLimits
 
+		if ucfg.Workspace.WSManagerWebhookSecretName != "" && common.WithLocalWsManager(ctx) {
+			// server starts the workspaces which schedules prewarm
+			webhooks = append(webhooks, &config.WebhookConfiguration{
+				Name:       common.ServerComponent,
+				URL:        fmt.Sprintf("http://%s/ws-manager/webhook", common.ClusterAddress(common.ServerComponent, ctx.Namespace, common.ServerServicePort)),
+				SecretFile: EventWebhookSecretPath,
+				Events:     []string{"workspace.prewarm"},
+			})
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -223,6 +234,7 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 			RegistryFacadeHost:               fmt.Sprintf("reg.%s:%d", ctx.Config.Domain, common.RegistryFacadeServicePort),
 			WorkspaceMaxConcurrentReconciles: 25,
 			TimeoutMaxConcurrentReconciles:   15,
+			Webhooks:                         webhooks,
 		},
 		Content: struct {
 			Storage storageconfig.StorageConfig `json:"storage"`
@@ -15,6 +15,7 @@ import (
 
 	"github.com/gitpod-io/gitpod/installer/pkg/common"
 	config "github.com/gitpod-io/gitpod/installer/pkg/config/v1"
+	"github.com/gitpod-io/gitpod/installer/pkg/config/v1/experimental"
 	"github.com/gitpod-io/gitpod/installer/pkg/config/versions"
 	wsmancfg "github.com/gitpod-io/gitpod/ws-manager/api/config"
 )
@@ -210,3 +211,67 @@ func TestWorkspaceURLTemplates(t *testing.T) {
 		})
 	}
 }
+
+func TestWebhooks(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Kind        config.InstallationKind
+		SecretName  string
+		Expectation []*wsmancfg.WebhookConfiguration
+	}{
+		{
+			Name: "disabled",
+			Kind: config.InstallationFull,
+		},
+		{
+			Name:       "enabled",
+			Kind:       config.InstallationFull,
+			SecretName: "ws-manager-webhook",
+			Expectation: []*wsmancfg.WebhookConfiguration{
+				{
+					Name:       "server",
+					URL:        "http://server.test_namespace.svc.cluster.local:3000/ws-manager/webhook",
+					SecretFile: EventWebhookSecretPath,
+					Events:     []string{"workspace.prewarm"},
+				},
+			},
+		},
+		{
+			Name:       "workspace cluster",
+			Kind:       config.InstallationWorkspace,
+			SecretName: "ws-manager-webhook",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctx, err := common.NewRenderContext(config.Config{
+				Domain: "example.com",
+				Kind:   test.Kind,
+				ObjectStorage: config.ObjectStorage{
+					InCluster: pointer.Bool(true),
+				},
+				Experimental: &experimental.Config{
+					Workspace: &experimental.WorkspaceConfig{
+						WSManagerWebhookSecretName: test.SecretName,
+					},
+				},
+			}, versions.Manifest{}, "test_namespace")
+			require.NoError(t, err)
+
+			objs, err := configmap(ctx)
+			require.NoError(t, err)
+
+			cfgmap, ok := objs[0].(*corev1.ConfigMap)
+			require.Truef(t, ok, "configmap function did not return a configmap")
+
+			serviceConfig := wsmancfg.ServiceConfiguration{}
+			err = json.Unmarshal([]byte(cfgmap.Data["config.json"]), &serviceConfig)
+			require.NoError(t, err)
+
+			if diff := cmp.Diff(test.Expectation, serviceConfig.Manager.Webhooks); diff != "" {
+				t.Errorf("unexpected webhooks (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
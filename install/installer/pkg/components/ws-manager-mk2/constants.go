@@ -11,6 +11,8 @@ const (
 	RPCPort                    = 8080
 	RPCPortName                = "rpc"
 	HealthPort                 = 9090
+	WebhookPort                = 9443
+	WebhookPortName            = "webhook"
 	TLSSecretNameSecret        = "ws-manager-mk2-tls"
 	TLSSecretNameClient        = "ws-manager-mk2-client-tls"
 	VolumeConfig               = "config"
@@ -18,4 +20,6 @@ const (
 	VolumeWorkspaceTemplate    = "workspace-template"
 	WorkspaceTemplatePath      = "/workspace-templates"
 	WorkspaceTemplateConfigMap = "workspace-templates"
+	EventWebhookSecretKey      = "secret"
+	EventWebhookSecretPath     = "/ws-manager-webhook-secret/secret"
 )
@@ -5,6 +5,8 @@
 package wsmanagermk2
 
 import (
+	"path/filepath"
+
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -17,6 +19,7 @@ import (
 	"github.com/gitpod-io/gitpod/installer/pkg/common"
 	wsdaemon "github.com/gitpod-io/gitpod/installer/pkg/components/ws-daemon"
 	"github.com/gitpod-io/gitpod/installer/pkg/config/v1"
+	"github.com/gitpod-io/gitpod/installer/pkg/config/v1/experimental"
 )
 
 func deployment(ctx *common.RenderContext) ([]runtime.Object, error) {
@@ -64,6 +67,25 @@ func deployment(ctx *common.RenderContext) ([]runtime.Object, error) {
 		})
 	}
 
+	_ = ctx.WithExperimental(func(ucfg *experimental.Config) error {
+		if ucfg.Workspace == nil || ucfg.Workspace.WSManagerWebhookSecretName == "" || !common.WithLocalWsManager(ctx) {
+			return nil
+		}
+
+		volumes = append(volumes, corev1.Volume{
+			Name: "webhook-secret",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: ucfg.Workspace.WSManagerWebhookSecretName},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      "webhook-secret",
+			MountPath: filepath.Dir(EventWebhookSecretPath),
+			ReadOnly:  true,
+		})
+		return nil
+	})
+
 	podSpec := corev1.PodSpec{
 		PriorityClassName:         common.SystemNodeCritical,
 		Affinity:                  cluster.WithNodeAffinityHostnameAntiAffinity(Component, cluster.AffinityLabelServices),
@@ -111,6 +133,10 @@ func deployment(ctx *common.RenderContext) ([]runtime.Object, error) {
 					Name:          RPCPortName,
 					ContainerPort: RPCPort,
 				},
+				{
+					Name:          WebhookPortName,
+					ContainerPort: WebhookPort,
+				},
 			},
 			SecurityContext: &corev1.SecurityContext{
 				Privileged: pointer.Bool(false),
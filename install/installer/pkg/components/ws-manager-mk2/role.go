@@ -76,6 +76,33 @@ var controllerRules = []rbacv1.PolicyRule{
 			"get",
 		},
 	},
+	{
+		APIGroups: []string{"workspace.gitpod.io"},
+		Resources: []string{"workspacequotas"},
+		Verbs: []string{
+			"get",
+			"list",
+			"watch",
+		},
+	},
+	{
+		APIGroups: []string{"workspace.gitpod.io"},
+		Resources: []string{"workspaceschedules"},
+		Verbs: []string{
+			"get",
+			"list",
+			"watch",
+		},
+	},
+	{
+		APIGroups: []string{"workspace.gitpod.io"},
+		Resources: []string{"workspaceschedules/status"},
+		Verbs: []string{
+			"get",
+			"patch",
+			"update",
+		},
+	},
 	{
 		APIGroups: []string{""},
 		Resources: []string{"secrets"},
@@ -112,6 +139,14 @@ var controllerClusterRules = []rbacv1.PolicyRule{
 			"watch",
 		},
 	},
+	// preemption needs to know what the pods of all namespaces request on a node
+	{
+		APIGroups: []string{""},
+		Resources: []string{"pods"},
+		Verbs: []string{
+			"list",
+		},
+	},
 }
 
 // ConfigMap, Leases, and Events access is required for leader-election.
@@ -0,0 +1,73 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package wsmanagermk2
+
+import (
+	"fmt"
+
+	"github.com/gitpod-io/gitpod/installer/pkg/common"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+)
+
+// webhook registers the admission webhook which enforces workspace quotas and timeout limits. The webhook is
+// served using the ws-manager-mk2 server certificate, whose CA cert-manager injects into the configuration.
+func webhook(ctx *common.RenderContext) ([]runtime.Object, error) {
+	failurePolicy := admissionregistrationv1.Fail
+	sideEffects := admissionregistrationv1.SideEffectClassNone
+	scope := admissionregistrationv1.NamespacedScope
+
+	return []runtime.Object{
+		&admissionregistrationv1.ValidatingWebhookConfiguration{
+			TypeMeta: common.TypeMetaValidatingWebhookConfiguration,
+			ObjectMeta: metav1.ObjectMeta{
+				// webhook configurations are cluster-scoped, hence the name must be unique across installations
+				Name:   fmt.Sprintf("%s-%s", Component, ctx.Namespace),
+				Labels: common.DefaultLabels(Component),
+				Annotations: map[string]string{
+					"cert-manager.io/inject-ca-from": fmt.Sprintf("%s/%s", ctx.Namespace, TLSSecretNameSecret),
+				},
+			},
+			Webhooks: []admissionregistrationv1.ValidatingWebhook{
+				{
+					Name:                    "vworkspace.workspace.gitpod.io",
+					AdmissionReviewVersions: []string{"v1"},
+					ClientConfig: admissionregistrationv1.WebhookClientConfig{
+						Service: &admissionregistrationv1.ServiceReference{
+							Name:      Component,
+							Namespace: ctx.Namespace,
+							Path:      pointer.String("/validate-workspace-gitpod-io-v1-workspace"),
+							Port:      pointer.Int32(WebhookPort),
+						},
+					},
+					Rules: []admissionregistrationv1.RuleWithOperations{
+						{
+							Operations: []admissionregistrationv1.OperationType{
+								admissionregistrationv1.Create,
+								admissionregistrationv1.Update,
+							},
+							Rule: admissionregistrationv1.Rule{
+								APIGroups:   []string{"workspace.gitpod.io"},
+								APIVersions: []string{"v1"},
+								Resources:   []string{"workspaces"},
+								Scope:       &scope,
+							},
+						},
+					},
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"kubernetes.io/metadata.name": ctx.Namespace,
+						},
+					},
+					FailurePolicy: &failurePolicy,
+					SideEffects:   &sideEffects,
+				},
+			},
+		},
+	}, nil
+}
@@ -86,6 +86,10 @@ type WorkspaceConfig struct {
 
 	WSManagerRateLimits map[string]grpc.RateLimit `json:"wsManagerRateLimits,omitempty"`
 
+	// WSManagerWebhookSecretName names the secret ws-manager-mk2 signs its webhook events with. If set, ws-manager-mk2
+	// sends the prewarm events of workspace schedules to server, which verifies them using the same secret.
+	WSManagerWebhookSecretName string `json:"wsManagerWebhookSecretName,omitempty"`
+
 	RegistryFacade struct {
 		IPFSCache struct {
 			Enabled  bool   `json:"enabled"`